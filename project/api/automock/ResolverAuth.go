@@ -0,0 +1,196 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ResolverAuth is an autogenerated mock type for the ResolverAuth type
+type ResolverAuth struct {
+	mock.Mock
+}
+
+type ResolverAuth_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResolverAuth) EXPECT() *ResolverAuth_Expecter {
+	return &ResolverAuth_Expecter{mock: &_m.Mock}
+}
+
+// AuthenticateToken provides a mock function with given fields: ctx, accessToken
+func (_m *ResolverAuth) AuthenticateToken(ctx context.Context, accessToken string) (string, error) {
+	ret := _m.Called(ctx, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, accessToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, accessToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolverAuth_AuthenticateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticateToken'
+type ResolverAuth_AuthenticateToken_Call struct {
+	*mock.Call
+}
+
+// AuthenticateToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+func (_e *ResolverAuth_Expecter) AuthenticateToken(ctx interface{}, accessToken interface{}) *ResolverAuth_AuthenticateToken_Call {
+	return &ResolverAuth_AuthenticateToken_Call{Call: _e.mock.On("AuthenticateToken", ctx, accessToken)}
+}
+
+func (_c *ResolverAuth_AuthenticateToken_Call) Run(run func(ctx context.Context, accessToken string)) *ResolverAuth_AuthenticateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ResolverAuth_AuthenticateToken_Call) Return(_a0 string, _a1 error) *ResolverAuth_AuthenticateToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResolverAuth_AuthenticateToken_Call) RunAndReturn(run func(context.Context, string) (string, error)) *ResolverAuth_AuthenticateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: w, req
+func (_m *ResolverAuth) Login(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverAuth_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type ResolverAuth_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverAuth_Expecter) Login(w interface{}, req interface{}) *ResolverAuth_Login_Call {
+	return &ResolverAuth_Login_Call{Call: _e.mock.On("Login", w, req)}
+}
+
+func (_c *ResolverAuth_Login_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverAuth_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverAuth_Login_Call) Return() *ResolverAuth_Login_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverAuth_Login_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverAuth_Login_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RefreshToken provides a mock function with given fields: w, req
+func (_m *ResolverAuth) RefreshToken(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverAuth_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
+type ResolverAuth_RefreshToken_Call struct {
+	*mock.Call
+}
+
+// RefreshToken is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverAuth_Expecter) RefreshToken(w interface{}, req interface{}) *ResolverAuth_RefreshToken_Call {
+	return &ResolverAuth_RefreshToken_Call{Call: _e.mock.On("RefreshToken", w, req)}
+}
+
+func (_c *ResolverAuth_RefreshToken_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverAuth_RefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverAuth_RefreshToken_Call) Return() *ResolverAuth_RefreshToken_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverAuth_RefreshToken_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverAuth_RefreshToken_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RevokeToken provides a mock function with given fields: w, req
+func (_m *ResolverAuth) RevokeToken(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverAuth_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type ResolverAuth_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverAuth_Expecter) RevokeToken(w interface{}, req interface{}) *ResolverAuth_RevokeToken_Call {
+	return &ResolverAuth_RevokeToken_Call{Call: _e.mock.On("RevokeToken", w, req)}
+}
+
+func (_c *ResolverAuth_RevokeToken_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverAuth_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverAuth_RevokeToken_Call) Return() *ResolverAuth_RevokeToken_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverAuth_RevokeToken_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverAuth_RevokeToken_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewResolverAuth creates a new instance of ResolverAuth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResolverAuth(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResolverAuth {
+	mock := &ResolverAuth{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
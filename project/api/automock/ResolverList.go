@@ -1,4 +1,4 @@
-// Code generated by mockery v2.51.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -58,6 +58,40 @@ func (_c *ResolverList_AddUserToList_Call) RunAndReturn(run func(http.ResponseWr
 	return _c
 }
 
+// CreateLabel provides a mock function with given fields: w, req
+func (_m *ResolverList) CreateLabel(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_CreateLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLabel'
+type ResolverList_CreateLabel_Call struct {
+	*mock.Call
+}
+
+// CreateLabel is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) CreateLabel(w interface{}, req interface{}) *ResolverList_CreateLabel_Call {
+	return &ResolverList_CreateLabel_Call{Call: _e.mock.On("CreateLabel", w, req)}
+}
+
+func (_c *ResolverList_CreateLabel_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_CreateLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_CreateLabel_Call) Return() *ResolverList_CreateLabel_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_CreateLabel_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_CreateLabel_Call {
+	_c.Run(run)
+	return _c
+}
+
 // CreateList provides a mock function with given fields: w, req
 func (_m *ResolverList) CreateList(w http.ResponseWriter, req *http.Request) {
 	_m.Called(w, req)
@@ -92,6 +126,74 @@ func (_c *ResolverList_CreateList_Call) RunAndReturn(run func(http.ResponseWrite
 	return _c
 }
 
+// CreateTemplate provides a mock function with given fields: w, req
+func (_m *ResolverList) CreateTemplate(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type ResolverList_CreateTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateTemplate is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) CreateTemplate(w interface{}, req interface{}) *ResolverList_CreateTemplate_Call {
+	return &ResolverList_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", w, req)}
+}
+
+func (_c *ResolverList_CreateTemplate_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_CreateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_CreateTemplate_Call) Return() *ResolverList_CreateTemplate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_CreateTemplate_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_CreateTemplate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeleteLabel provides a mock function with given fields: w, req
+func (_m *ResolverList) DeleteLabel(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_DeleteLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLabel'
+type ResolverList_DeleteLabel_Call struct {
+	*mock.Call
+}
+
+// DeleteLabel is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) DeleteLabel(w interface{}, req interface{}) *ResolverList_DeleteLabel_Call {
+	return &ResolverList_DeleteLabel_Call{Call: _e.mock.On("DeleteLabel", w, req)}
+}
+
+func (_c *ResolverList_DeleteLabel_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_DeleteLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_DeleteLabel_Call) Return() *ResolverList_DeleteLabel_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_DeleteLabel_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_DeleteLabel_Call {
+	_c.Run(run)
+	return _c
+}
+
 // DeleteList provides a mock function with given fields: w, req
 func (_m *ResolverList) DeleteList(w http.ResponseWriter, req *http.Request) {
 	_m.Called(w, req)
@@ -126,6 +228,74 @@ func (_c *ResolverList_DeleteList_Call) RunAndReturn(run func(http.ResponseWrite
 	return _c
 }
 
+// DeleteTemplate provides a mock function with given fields: w, req
+func (_m *ResolverList) DeleteTemplate(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type ResolverList_DeleteTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteTemplate is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) DeleteTemplate(w interface{}, req interface{}) *ResolverList_DeleteTemplate_Call {
+	return &ResolverList_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", w, req)}
+}
+
+func (_c *ResolverList_DeleteTemplate_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_DeleteTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_DeleteTemplate_Call) Return() *ResolverList_DeleteTemplate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_DeleteTemplate_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_DeleteTemplate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DuplicateList provides a mock function with given fields: w, req
+func (_m *ResolverList) DuplicateList(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_DuplicateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateList'
+type ResolverList_DuplicateList_Call struct {
+	*mock.Call
+}
+
+// DuplicateList is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) DuplicateList(w interface{}, req interface{}) *ResolverList_DuplicateList_Call {
+	return &ResolverList_DuplicateList_Call{Call: _e.mock.On("DuplicateList", w, req)}
+}
+
+func (_c *ResolverList_DuplicateList_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_DuplicateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_DuplicateList_Call) Return() *ResolverList_DuplicateList_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_DuplicateList_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_DuplicateList_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetAllLists provides a mock function with given fields: w, req
 func (_m *ResolverList) GetAllLists(w http.ResponseWriter, req *http.Request) {
 	_m.Called(w, req)
@@ -160,6 +330,74 @@ func (_c *ResolverList_GetAllLists_Call) RunAndReturn(run func(http.ResponseWrit
 	return _c
 }
 
+// GetCurrentUserLists provides a mock function with given fields: w, req
+func (_m *ResolverList) GetCurrentUserLists(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_GetCurrentUserLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentUserLists'
+type ResolverList_GetCurrentUserLists_Call struct {
+	*mock.Call
+}
+
+// GetCurrentUserLists is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) GetCurrentUserLists(w interface{}, req interface{}) *ResolverList_GetCurrentUserLists_Call {
+	return &ResolverList_GetCurrentUserLists_Call{Call: _e.mock.On("GetCurrentUserLists", w, req)}
+}
+
+func (_c *ResolverList_GetCurrentUserLists_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_GetCurrentUserLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_GetCurrentUserLists_Call) Return() *ResolverList_GetCurrentUserLists_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_GetCurrentUserLists_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_GetCurrentUserLists_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetLabels provides a mock function with given fields: w, req
+func (_m *ResolverList) GetLabels(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_GetLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLabels'
+type ResolverList_GetLabels_Call struct {
+	*mock.Call
+}
+
+// GetLabels is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) GetLabels(w interface{}, req interface{}) *ResolverList_GetLabels_Call {
+	return &ResolverList_GetLabels_Call{Call: _e.mock.On("GetLabels", w, req)}
+}
+
+func (_c *ResolverList_GetLabels_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_GetLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_GetLabels_Call) Return() *ResolverList_GetLabels_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_GetLabels_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_GetLabels_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetListById provides a mock function with given fields: w, req
 func (_m *ResolverList) GetListById(w http.ResponseWriter, req *http.Request) {
 	_m.Called(w, req)
@@ -194,6 +432,74 @@ func (_c *ResolverList_GetListById_Call) RunAndReturn(run func(http.ResponseWrit
 	return _c
 }
 
+// GetTemplate provides a mock function with given fields: w, req
+func (_m *ResolverList) GetTemplate(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_GetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplate'
+type ResolverList_GetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetTemplate is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) GetTemplate(w interface{}, req interface{}) *ResolverList_GetTemplate_Call {
+	return &ResolverList_GetTemplate_Call{Call: _e.mock.On("GetTemplate", w, req)}
+}
+
+func (_c *ResolverList_GetTemplate_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_GetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_GetTemplate_Call) Return() *ResolverList_GetTemplate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_GetTemplate_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_GetTemplate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetTemplates provides a mock function with given fields: w, req
+func (_m *ResolverList) GetTemplates(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_GetTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplates'
+type ResolverList_GetTemplates_Call struct {
+	*mock.Call
+}
+
+// GetTemplates is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) GetTemplates(w interface{}, req interface{}) *ResolverList_GetTemplates_Call {
+	return &ResolverList_GetTemplates_Call{Call: _e.mock.On("GetTemplates", w, req)}
+}
+
+func (_c *ResolverList_GetTemplates_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_GetTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_GetTemplates_Call) Return() *ResolverList_GetTemplates_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_GetTemplates_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_GetTemplates_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetUserFromListById provides a mock function with given fields: w, req
 func (_m *ResolverList) GetUserFromListById(w http.ResponseWriter, req *http.Request) {
 	_m.Called(w, req)
@@ -228,6 +534,54 @@ func (_c *ResolverList_GetUserFromListById_Call) RunAndReturn(run func(http.Resp
 	return _c
 }
 
+// GetUserListRights provides a mock function with given fields: ctx, listId, username
+func (_m *ResolverList) GetUserListRights(ctx context.Context, listId uuid.UUID, username string) int {
+	ret := _m.Called(ctx, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserListRights")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) int); ok {
+		r0 = rf(ctx, listId, username)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// ResolverList_GetUserListRights_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserListRights'
+type ResolverList_GetUserListRights_Call struct {
+	*mock.Call
+}
+
+// GetUserListRights is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - username string
+func (_e *ResolverList_Expecter) GetUserListRights(ctx interface{}, listId interface{}, username interface{}) *ResolverList_GetUserListRights_Call {
+	return &ResolverList_GetUserListRights_Call{Call: _e.mock.On("GetUserListRights", ctx, listId, username)}
+}
+
+func (_c *ResolverList_GetUserListRights_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ResolverList_GetUserListRights_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ResolverList_GetUserListRights_Call) Return(_a0 int) *ResolverList_GetUserListRights_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResolverList_GetUserListRights_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) int) *ResolverList_GetUserListRights_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUsersFromListById provides a mock function with given fields: w, req
 func (_m *ResolverList) GetUsersFromListById(w http.ResponseWriter, req *http.Request) {
 	_m.Called(w, req)
@@ -262,99 +616,71 @@ func (_c *ResolverList_GetUsersFromListById_Call) RunAndReturn(run func(http.Res
 	return _c
 }
 
-// IsOwnerUserOwnerToListById provides a mock function with given fields: ctx, listId, username
-func (_m *ResolverList) IsOwnerUserOwnerToListById(ctx context.Context, listId uuid.UUID, username string) bool {
-	ret := _m.Called(ctx, listId, username)
-
-	if len(ret) == 0 {
-		panic("no return value specified for IsOwnerUserOwnerToListById")
-	}
-
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) bool); ok {
-		r0 = rf(ctx, listId, username)
-	} else {
-		r0 = ret.Get(0).(bool)
-	}
-
-	return r0
+// GetWorkflow provides a mock function with given fields: w, req
+func (_m *ResolverList) GetWorkflow(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
 }
 
-// ResolverList_IsOwnerUserOwnerToListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsOwnerUserOwnerToListById'
-type ResolverList_IsOwnerUserOwnerToListById_Call struct {
+// ResolverList_GetWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflow'
+type ResolverList_GetWorkflow_Call struct {
 	*mock.Call
 }
 
-// IsOwnerUserOwnerToListById is a helper method to define mock.On call
-//   - ctx context.Context
-//   - listId uuid.UUID
-//   - username string
-func (_e *ResolverList_Expecter) IsOwnerUserOwnerToListById(ctx interface{}, listId interface{}, username interface{}) *ResolverList_IsOwnerUserOwnerToListById_Call {
-	return &ResolverList_IsOwnerUserOwnerToListById_Call{Call: _e.mock.On("IsOwnerUserOwnerToListById", ctx, listId, username)}
+// GetWorkflow is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) GetWorkflow(w interface{}, req interface{}) *ResolverList_GetWorkflow_Call {
+	return &ResolverList_GetWorkflow_Call{Call: _e.mock.On("GetWorkflow", w, req)}
 }
 
-func (_c *ResolverList_IsOwnerUserOwnerToListById_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ResolverList_IsOwnerUserOwnerToListById_Call {
+func (_c *ResolverList_GetWorkflow_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_GetWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *ResolverList_IsOwnerUserOwnerToListById_Call) Return(_a0 bool) *ResolverList_IsOwnerUserOwnerToListById_Call {
-	_c.Call.Return(_a0)
+func (_c *ResolverList_GetWorkflow_Call) Return() *ResolverList_GetWorkflow_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *ResolverList_IsOwnerUserOwnerToListById_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) bool) *ResolverList_IsOwnerUserOwnerToListById_Call {
-	_c.Call.Return(run)
+func (_c *ResolverList_GetWorkflow_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_GetWorkflow_Call {
+	_c.Run(run)
 	return _c
 }
 
-// IsUserPartOfList provides a mock function with given fields: ctx, listId, username
-func (_m *ResolverList) IsUserPartOfList(ctx context.Context, listId uuid.UUID, username string) bool {
-	ret := _m.Called(ctx, listId, username)
-
-	if len(ret) == 0 {
-		panic("no return value specified for IsUserPartOfList")
-	}
-
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) bool); ok {
-		r0 = rf(ctx, listId, username)
-	} else {
-		r0 = ret.Get(0).(bool)
-	}
-
-	return r0
+// InstantiateTemplate provides a mock function with given fields: w, req
+func (_m *ResolverList) InstantiateTemplate(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
 }
 
-// ResolverList_IsUserPartOfList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserPartOfList'
-type ResolverList_IsUserPartOfList_Call struct {
+// ResolverList_InstantiateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstantiateTemplate'
+type ResolverList_InstantiateTemplate_Call struct {
 	*mock.Call
 }
 
-// IsUserPartOfList is a helper method to define mock.On call
-//   - ctx context.Context
-//   - listId uuid.UUID
-//   - username string
-func (_e *ResolverList_Expecter) IsUserPartOfList(ctx interface{}, listId interface{}, username interface{}) *ResolverList_IsUserPartOfList_Call {
-	return &ResolverList_IsUserPartOfList_Call{Call: _e.mock.On("IsUserPartOfList", ctx, listId, username)}
+// InstantiateTemplate is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) InstantiateTemplate(w interface{}, req interface{}) *ResolverList_InstantiateTemplate_Call {
+	return &ResolverList_InstantiateTemplate_Call{Call: _e.mock.On("InstantiateTemplate", w, req)}
 }
 
-func (_c *ResolverList_IsUserPartOfList_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ResolverList_IsUserPartOfList_Call {
+func (_c *ResolverList_InstantiateTemplate_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_InstantiateTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
 	})
 	return _c
 }
 
-func (_c *ResolverList_IsUserPartOfList_Call) Return(_a0 bool) *ResolverList_IsUserPartOfList_Call {
-	_c.Call.Return(_a0)
+func (_c *ResolverList_InstantiateTemplate_Call) Return() *ResolverList_InstantiateTemplate_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *ResolverList_IsUserPartOfList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) bool) *ResolverList_IsUserPartOfList_Call {
-	_c.Call.Return(run)
+func (_c *ResolverList_InstantiateTemplate_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_InstantiateTemplate_Call {
+	_c.Run(run)
 	return _c
 }
 
@@ -392,6 +718,40 @@ func (_c *ResolverList_RemoveUserFromList_Call) RunAndReturn(run func(http.Respo
 	return _c
 }
 
+// UpdateLabel provides a mock function with given fields: w, req
+func (_m *ResolverList) UpdateLabel(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_UpdateLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLabel'
+type ResolverList_UpdateLabel_Call struct {
+	*mock.Call
+}
+
+// UpdateLabel is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) UpdateLabel(w interface{}, req interface{}) *ResolverList_UpdateLabel_Call {
+	return &ResolverList_UpdateLabel_Call{Call: _e.mock.On("UpdateLabel", w, req)}
+}
+
+func (_c *ResolverList_UpdateLabel_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_UpdateLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_UpdateLabel_Call) Return() *ResolverList_UpdateLabel_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_UpdateLabel_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_UpdateLabel_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UpdateList provides a mock function with given fields: w, req
 func (_m *ResolverList) UpdateList(w http.ResponseWriter, req *http.Request) {
 	_m.Called(w, req)
@@ -426,6 +786,40 @@ func (_c *ResolverList_UpdateList_Call) RunAndReturn(run func(http.ResponseWrite
 	return _c
 }
 
+// UpdateWorkflow provides a mock function with given fields: w, req
+func (_m *ResolverList) UpdateWorkflow(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverList_UpdateWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkflow'
+type ResolverList_UpdateWorkflow_Call struct {
+	*mock.Call
+}
+
+// UpdateWorkflow is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverList_Expecter) UpdateWorkflow(w interface{}, req interface{}) *ResolverList_UpdateWorkflow_Call {
+	return &ResolverList_UpdateWorkflow_Call{Call: _e.mock.On("UpdateWorkflow", w, req)}
+}
+
+func (_c *ResolverList_UpdateWorkflow_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverList_UpdateWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverList_UpdateWorkflow_Call) Return() *ResolverList_UpdateWorkflow_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverList_UpdateWorkflow_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverList_UpdateWorkflow_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewResolverList creates a new instance of ResolverList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewResolverList(t interface {
@@ -0,0 +1,322 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ResolverUser is an autogenerated mock type for the ResolverUser type
+type ResolverUser struct {
+	mock.Mock
+}
+
+type ResolverUser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResolverUser) EXPECT() *ResolverUser_Expecter {
+	return &ResolverUser_Expecter{mock: &_m.Mock}
+}
+
+// CreateUser provides a mock function with given fields: w, req
+func (_m *ResolverUser) CreateUser(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverUser_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type ResolverUser_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverUser_Expecter) CreateUser(w interface{}, req interface{}) *ResolverUser_CreateUser_Call {
+	return &ResolverUser_CreateUser_Call{Call: _e.mock.On("CreateUser", w, req)}
+}
+
+func (_c *ResolverUser_CreateUser_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverUser_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverUser_CreateUser_Call) Return() *ResolverUser_CreateUser_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverUser_CreateUser_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverUser_CreateUser_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeactivateUser provides a mock function with given fields: w, req
+func (_m *ResolverUser) DeactivateUser(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverUser_DeactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateUser'
+type ResolverUser_DeactivateUser_Call struct {
+	*mock.Call
+}
+
+// DeactivateUser is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverUser_Expecter) DeactivateUser(w interface{}, req interface{}) *ResolverUser_DeactivateUser_Call {
+	return &ResolverUser_DeactivateUser_Call{Call: _e.mock.On("DeactivateUser", w, req)}
+}
+
+func (_c *ResolverUser_DeactivateUser_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverUser_DeactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverUser_DeactivateUser_Call) Return() *ResolverUser_DeactivateUser_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverUser_DeactivateUser_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverUser_DeactivateUser_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetAllUsers provides a mock function with given fields: w, req
+func (_m *ResolverUser) GetAllUsers(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverUser_GetAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllUsers'
+type ResolverUser_GetAllUsers_Call struct {
+	*mock.Call
+}
+
+// GetAllUsers is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverUser_Expecter) GetAllUsers(w interface{}, req interface{}) *ResolverUser_GetAllUsers_Call {
+	return &ResolverUser_GetAllUsers_Call{Call: _e.mock.On("GetAllUsers", w, req)}
+}
+
+func (_c *ResolverUser_GetAllUsers_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverUser_GetAllUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverUser_GetAllUsers_Call) Return() *ResolverUser_GetAllUsers_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverUser_GetAllUsers_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverUser_GetAllUsers_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetCurrentUser provides a mock function with given fields: w, req
+func (_m *ResolverUser) GetCurrentUser(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverUser_GetCurrentUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentUser'
+type ResolverUser_GetCurrentUser_Call struct {
+	*mock.Call
+}
+
+// GetCurrentUser is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverUser_Expecter) GetCurrentUser(w interface{}, req interface{}) *ResolverUser_GetCurrentUser_Call {
+	return &ResolverUser_GetCurrentUser_Call{Call: _e.mock.On("GetCurrentUser", w, req)}
+}
+
+func (_c *ResolverUser_GetCurrentUser_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverUser_GetCurrentUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverUser_GetCurrentUser_Call) Return() *ResolverUser_GetCurrentUser_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverUser_GetCurrentUser_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverUser_GetCurrentUser_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: w, req
+func (_m *ResolverUser) GetUser(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverUser_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type ResolverUser_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverUser_Expecter) GetUser(w interface{}, req interface{}) *ResolverUser_GetUser_Call {
+	return &ResolverUser_GetUser_Call{Call: _e.mock.On("GetUser", w, req)}
+}
+
+func (_c *ResolverUser_GetUser_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverUser_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverUser_GetUser_Call) Return() *ResolverUser_GetUser_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverUser_GetUser_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverUser_GetUser_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetUserRights provides a mock function with given fields: ctx, username
+func (_m *ResolverUser) GetUserRights(ctx context.Context, username string) int {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRights")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// ResolverUser_GetUserRights_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRights'
+type ResolverUser_GetUserRights_Call struct {
+	*mock.Call
+}
+
+// GetUserRights is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *ResolverUser_Expecter) GetUserRights(ctx interface{}, username interface{}) *ResolverUser_GetUserRights_Call {
+	return &ResolverUser_GetUserRights_Call{Call: _e.mock.On("GetUserRights", ctx, username)}
+}
+
+func (_c *ResolverUser_GetUserRights_Call) Run(run func(ctx context.Context, username string)) *ResolverUser_GetUserRights_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ResolverUser_GetUserRights_Call) Return(_a0 int) *ResolverUser_GetUserRights_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResolverUser_GetUserRights_Call) RunAndReturn(run func(context.Context, string) int) *ResolverUser_GetUserRights_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserPassword provides a mock function with given fields: w, req
+func (_m *ResolverUser) UpdateUserPassword(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverUser_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
+type ResolverUser_UpdateUserPassword_Call struct {
+	*mock.Call
+}
+
+// UpdateUserPassword is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverUser_Expecter) UpdateUserPassword(w interface{}, req interface{}) *ResolverUser_UpdateUserPassword_Call {
+	return &ResolverUser_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", w, req)}
+}
+
+func (_c *ResolverUser_UpdateUserPassword_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverUser_UpdateUserPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverUser_UpdateUserPassword_Call) Return() *ResolverUser_UpdateUserPassword_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverUser_UpdateUserPassword_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverUser_UpdateUserPassword_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateUserRole provides a mock function with given fields: w, req
+func (_m *ResolverUser) UpdateUserRole(w http.ResponseWriter, req *http.Request) {
+	_m.Called(w, req)
+}
+
+// ResolverUser_UpdateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRole'
+type ResolverUser_UpdateUserRole_Call struct {
+	*mock.Call
+}
+
+// UpdateUserRole is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - req *http.Request
+func (_e *ResolverUser_Expecter) UpdateUserRole(w interface{}, req interface{}) *ResolverUser_UpdateUserRole_Call {
+	return &ResolverUser_UpdateUserRole_Call{Call: _e.mock.On("UpdateUserRole", w, req)}
+}
+
+func (_c *ResolverUser_UpdateUserRole_Call) Run(run func(w http.ResponseWriter, req *http.Request)) *ResolverUser_UpdateUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.ResponseWriter), args[1].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *ResolverUser_UpdateUserRole_Call) Return() *ResolverUser_UpdateUserRole_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResolverUser_UpdateUserRole_Call) RunAndReturn(run func(http.ResponseWriter, *http.Request)) *ResolverUser_UpdateUserRole_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewResolverUser creates a new instance of ResolverUser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResolverUser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResolverUser {
+	mock := &ResolverUser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
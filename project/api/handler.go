@@ -4,12 +4,26 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
 	"net/http"
+	"os/signal"
+	"project/audit"
+	"project/auth"
+	"project/comment"
+	"project/config"
 	"project/list"
+	"project/memory"
+	"project/migrations"
+	"project/search"
+	"project/server"
+	"project/subtask"
 	"project/todo"
+	"project/uow"
+	"project/user"
 	"project/utils"
+	"syscall"
 )
 
 const (
@@ -27,72 +41,300 @@ type ResolverList interface {
 	RemoveUserFromList(w http.ResponseWriter, req *http.Request)
 	GetUserFromListById(w http.ResponseWriter, req *http.Request)
 	GetUsersFromListById(w http.ResponseWriter, req *http.Request)
-	IsOwnerUserOwnerToListById(ctx context.Context, listId uuid.UUID, username string) bool
-	IsUserPartOfList(ctx context.Context, listId uuid.UUID, username string) bool
+	GetCurrentUserLists(w http.ResponseWriter, req *http.Request)
+	GetLabels(w http.ResponseWriter, req *http.Request)
+	CreateLabel(w http.ResponseWriter, req *http.Request)
+	UpdateLabel(w http.ResponseWriter, req *http.Request)
+	DeleteLabel(w http.ResponseWriter, req *http.Request)
+	GetWorkflow(w http.ResponseWriter, req *http.Request)
+	UpdateWorkflow(w http.ResponseWriter, req *http.Request)
+	DuplicateList(w http.ResponseWriter, req *http.Request)
+	GetTemplates(w http.ResponseWriter, req *http.Request)
+	GetTemplate(w http.ResponseWriter, req *http.Request)
+	CreateTemplate(w http.ResponseWriter, req *http.Request)
+	DeleteTemplate(w http.ResponseWriter, req *http.Request)
+	InstantiateTemplate(w http.ResponseWriter, req *http.Request)
+	GetUserListRights(ctx context.Context, listId uuid.UUID, username string) int
 }
 
-func ServerHandler() {
-	db, err := utils.ConnectToDB()
+//go:generate mockery --name ResolverUser --output=automock --with-expecter=true
+type ResolverUser interface {
+	GetUser(w http.ResponseWriter, req *http.Request)
+	GetCurrentUser(w http.ResponseWriter, req *http.Request)
+	GetAllUsers(w http.ResponseWriter, req *http.Request)
+	CreateUser(w http.ResponseWriter, req *http.Request)
+	UpdateUserRole(w http.ResponseWriter, req *http.Request)
+	UpdateUserPassword(w http.ResponseWriter, req *http.Request)
+	DeactivateUser(w http.ResponseWriter, req *http.Request)
+	GetUserRights(ctx context.Context, username string) int
+}
+
+//go:generate mockery --name ResolverAuth --output=automock --with-expecter=true
+type ResolverAuth interface {
+	Login(w http.ResponseWriter, req *http.Request)
+	RefreshToken(w http.ResponseWriter, req *http.Request)
+	RevokeToken(w http.ResponseWriter, req *http.Request)
+	AuthenticateToken(ctx context.Context, accessToken string) (string, error)
+}
+
+func ServerHandler(cfg *config.Config) {
+	srv, err := NewServer(cfg)
 	if err != nil {
 		log.Fatal(err)
 		return
 	}
 
-	listRepoConvertor := list.NewRepositoryListConvertor()
-	listRepository := list.NewDBRepositoryList(db, *listRepoConvertor)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	err = srv.Run(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func NewServer(cfg *config.Config) (*server.Server, error) {
+	err := cfg.Auth.ValidateSigningKey()
+	if err != nil {
+		return nil, err
+	}
+
+	repos, err := newRepositories(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	listSrvConvertor := list.NewServiceListConvertor()
-	listService := list.NewServiceList(listRepository, *listSrvConvertor)
+	listService := list.NewServiceList(repos.list, *listSrvConvertor, repos.unitOfWork, repos.audit)
 	listR := list.NewResolverList(listService)
 
 	var lrInterface ResolverList = listR
-	todoRepoConvertor := todo.NewRepositoryTodoConvertor()
-	todoRepository := todo.NewDBRepositoryTodo(db, *todoRepoConvertor)
 	todoServiceConvertor := todo.NewServiceTodoConvertor()
-	todoService := todo.NewServiceTodo(todoRepository, *todoServiceConvertor)
-	todoR := todo.NewResolverTodo(todoService)
+	todoService := todo.NewServiceTodo(repos.todo, *todoServiceConvertor, repos.unitOfWork, repos.audit)
+	todoR := todo.NewResolverTodo(todoService, listService)
+
+	subtaskServiceConvertor := subtask.NewServiceSubtaskConvertor()
+	subtaskService := subtask.NewServiceSubtask(repos.subtask, *subtaskServiceConvertor, repos.unitOfWork)
+	subtaskR := subtask.NewResolverSubtask(subtaskService)
+
+	commentServiceConvertor := comment.NewServiceCommentConvertor()
+	commentService := comment.NewServiceComment(repos.comment, *commentServiceConvertor, repos.unitOfWork)
+	commentR := comment.NewResolverComment(commentService)
 
-	amw := NewAuthenticationMiddleware(&lrInterface)
+	auditServiceConvertor := audit.NewServiceAuditConvertor()
+	auditService := audit.NewServiceAudit(repos.audit, *auditServiceConvertor)
+	auditR := audit.NewResolverAudit(auditService)
+
+	searchServiceConvertor := search.NewServiceSearchConvertor()
+	searchService := search.NewServiceSearch(repos.search, *searchServiceConvertor)
+	searchR := search.NewResolverSearch(searchService)
+
+	userServiceConvertor := user.NewServiceUserConvertor()
+	userService := user.NewServiceUser(repos.user, *userServiceConvertor)
+	userR := user.NewResolverUser(userService)
+
+	var urInterface ResolverUser = userR
+	tokenManager := auth.NewTokenManager([]byte(cfg.Auth.SigningKey), cfg.Auth.AccessTokenTTL, cfg.Auth.RefreshTokenTTL)
+	authService := auth.NewServiceAuth(repos.auth, *tokenManager)
+	authR := auth.NewResolverAuth(authService)
+
+	var arInterface ResolverAuth = authR
+	amw := NewAuthenticationMiddleware(&lrInterface, &urInterface, &arInterface)
 
 	router := mux.NewRouter()
 	router.Use(LoggingMiddleware)
-	router.Use(amw.UserExistenceAuthentication)
 
-	authenticationAdminSubrouter := router.PathPrefix(basePath + "/list").Subrouter()
+	router.HandleFunc(basePath+"/auth/login", authR.Login).Methods(http.MethodPost)
+	router.HandleFunc(basePath+"/auth/refresh", authR.RefreshToken).Methods(http.MethodPost)
+	router.HandleFunc(basePath+"/auth/revoke", authR.RevokeToken).Methods(http.MethodPost)
+
+	authenticatedRouter := router.NewRoute().Subrouter()
+	authenticatedRouter.Use(amw.UserExistenceAuthentication)
+
+	authenticationAdminSubrouter := authenticatedRouter.PathPrefix(basePath + "/list").Subrouter()
 	authenticationAdminSubrouter.Use(amw.CheckForAdminPermissions)
 	authenticationAdminSubrouter.HandleFunc("", listR.GetAllLists).Methods(http.MethodGet)
 
-	authenticationReaderSubrouter := router.PathPrefix(basePath).Subrouter()
+	authenticationAuditSubrouter := authenticatedRouter.PathPrefix(basePath + "/audit").Subrouter()
+	authenticationAuditSubrouter.Use(amw.CheckForAdminPermissions)
+	authenticationAuditSubrouter.HandleFunc("", auditR.GetAllEntries).Methods(http.MethodGet)
+
+	authenticatedRouter.HandleFunc(basePath+"/me", userR.GetCurrentUser).Methods(http.MethodGet)
+	authenticatedRouter.HandleFunc(basePath+"/me/lists", listR.GetCurrentUserLists).Methods(http.MethodGet)
+	authenticatedRouter.HandleFunc(basePath+"/search", searchR.Search).Methods(http.MethodGet)
+
+	authenticationUserSubrouter := authenticatedRouter.PathPrefix(basePath + "/users").Subrouter()
+	authenticationUserSubrouter.Use(amw.CheckForAdminPermissions)
+	authenticationUserSubrouter.HandleFunc("", userR.GetAllUsers).Methods(http.MethodGet)
+	authenticationUserSubrouter.HandleFunc("", userR.CreateUser).Methods(http.MethodPost)
+	authenticationUserSubrouter.HandleFunc("/{userId}", userR.GetUser).Methods(http.MethodGet)
+	authenticationUserSubrouter.HandleFunc("/{userId}", userR.DeactivateUser).Methods(http.MethodDelete)
+	authenticationUserSubrouter.HandleFunc("/{userId}/role", userR.UpdateUserRole).Methods(http.MethodPatch)
+	authenticationUserSubrouter.HandleFunc("/{userId}/password", userR.UpdateUserPassword).Methods(http.MethodPatch)
+	authenticationUserSubrouter.HandleFunc("/{userId}/audit", auditR.GetUserEntries).Methods(http.MethodGet)
+
+	authenticatedRouter.HandleFunc(basePath+"/templates", listR.GetTemplates).Methods(http.MethodGet)
+	authenticatedRouter.HandleFunc(basePath+"/templates/{templateId}", listR.GetTemplate).Methods(http.MethodGet)
+	authenticatedRouter.HandleFunc(basePath+"/templates/{templateId}", listR.DeleteTemplate).Methods(http.MethodDelete)
+
+	authenticationTemplateSubrouter := authenticatedRouter.PathPrefix(basePath + "/templates/{templateId}").Subrouter()
+	authenticationTemplateSubrouter.Use(amw.CheckForListCreationPermissions)
+	authenticationTemplateSubrouter.HandleFunc("/instantiate", listR.InstantiateTemplate).Methods(http.MethodPost)
+
+	authenticationReaderSubrouter := authenticatedRouter.PathPrefix(basePath).Subrouter()
 	authenticationReaderSubrouter.Use(amw.CheckForReaderPermissions)
 	authenticationReaderSubrouter.HandleFunc("/list/{listId}", listR.GetListById).Methods(http.MethodGet)
 
-	authenticationWriterSubrouter := router.PathPrefix(basePath + "/list").Subrouter()
-	authenticationWriterSubrouter.Use(amw.CheckForWriterPermissions)
-	authenticationWriterSubrouter.HandleFunc("", listR.CreateList).Methods(http.MethodPost)
+	authenticationListCreationSubrouter := authenticatedRouter.PathPrefix(basePath + "/list").Subrouter()
+	authenticationListCreationSubrouter.Use(amw.CheckForListCreationPermissions)
+	authenticationListCreationSubrouter.HandleFunc("", listR.CreateList).Methods(http.MethodPost)
 
-	authenticationForTodoAccessSubrouter := router.PathPrefix(basePath + "/list/{listId}").Subrouter()
+	authenticationForTodoAccessSubrouter := authenticatedRouter.PathPrefix(basePath + "/list/{listId}").Subrouter()
 	authenticationForTodoAccessSubrouter.Use(amw.CheckForUserExistenceInList)
 	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}", todoR.GetTodo).Methods(http.MethodGet)
 	authenticationForTodoAccessSubrouter.HandleFunc("/todos", todoR.GetAllTasks).Methods(http.MethodGet)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/subtasks", subtaskR.GetSubtasks).Methods(http.MethodGet)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/comments", commentR.GetComments).Methods(http.MethodGet)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/comments", commentR.CreateComment).Methods(http.MethodPost)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/comments/{commentId}", commentR.UpdateComment).Methods(http.MethodPut)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/comments/{commentId}", commentR.DeleteComment).Methods(http.MethodDelete)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/watchers/{username}", todoR.AddTodoWatcher).Methods(http.MethodPut)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/watchers/{username}", todoR.RemoveTodoWatcher).Methods(http.MethodDelete)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/recurrence", todoR.GetTodoRecurrence).Methods(http.MethodGet)
+	authenticationForTodoAccessSubrouter.HandleFunc("/labels", listR.GetLabels).Methods(http.MethodGet)
+	authenticationForTodoAccessSubrouter.HandleFunc("/workflow", listR.GetWorkflow).Methods(http.MethodGet)
+	authenticationForTodoAccessSubrouter.HandleFunc("/audit", auditR.GetListEntries).Methods(http.MethodGet)
+	authenticationForTodoAccessSubrouter.HandleFunc("/todo/{todoId}/audit", auditR.GetTodoEntries).Methods(http.MethodGet)
+
+	authenticationForListDuplicationSubrouter := authenticationForTodoAccessSubrouter.PathPrefix("/duplicate").Subrouter()
+	authenticationForListDuplicationSubrouter.Use(amw.CheckForListCreationPermissions)
+	authenticationForListDuplicationSubrouter.HandleFunc("", listR.DuplicateList).Methods(http.MethodPost)
 
 	authenticationFroTodoModificationSubrouter := authenticationForTodoAccessSubrouter.PathPrefix("/todo").Subrouter()
 	authenticationFroTodoModificationSubrouter.Use(amw.CheckForWriterPermissions)
 	authenticationFroTodoModificationSubrouter.HandleFunc("", todoR.CreateTodo).Methods(http.MethodPost)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/move", todoR.MoveTodo).Methods(http.MethodPost)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/copy", todoR.CopyTodo).Methods(http.MethodPost)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/bulk", todoR.BulkUpdateTodos).Methods(http.MethodPost)
 	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}", todoR.UpdateTodo).Methods(http.MethodPut)
 	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}", todoR.DeleteTodo).Methods(http.MethodDelete)
 	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}", todoR.AssignUserToTodo).Methods(http.MethodPatch)
 	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/status", todoR.ChangeTodoStatus).Methods(http.MethodPatch)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/reopen", todoR.ReopenTodo).Methods(http.MethodPatch)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/step-back", todoR.StepBackTodo).Methods(http.MethodPatch)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/assignee", todoR.ReassignTodo).Methods(http.MethodPut)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/assignee", todoR.UnassignTodo).Methods(http.MethodDelete)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/assignees/{username}", todoR.AddTodoAssignee).Methods(http.MethodPut)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/assignees/{username}", todoR.RemoveTodoAssignee).Methods(http.MethodDelete)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/subtasks", subtaskR.CreateSubtask).Methods(http.MethodPost)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/subtasks/{subtaskId}", subtaskR.UpdateSubtask).Methods(http.MethodPut)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/subtasks/{subtaskId}", subtaskR.DeleteSubtask).Methods(http.MethodDelete)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/labels/{labelId}", todoR.AttachLabel).Methods(http.MethodPut)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/labels/{labelId}", todoR.DetachLabel).Methods(http.MethodDelete)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/recurrence", todoR.SetTodoRecurrence).Methods(http.MethodPut)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/recurrence", todoR.StopTodoRecurrence).Methods(http.MethodDelete)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/dependencies", todoR.AddTodoDependency).Methods(http.MethodPost)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/dependencies/{dependencyId}", todoR.RemoveTodoDependency).Methods(http.MethodDelete)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/move", todoR.MoveTodo).Methods(http.MethodPost)
+	authenticationFroTodoModificationSubrouter.HandleFunc("/{todoId}/copy", todoR.CopyTodo).Methods(http.MethodPost)
+
+	authenticationManagerSubrouter := authenticatedRouter.PathPrefix(basePath + "/list/{listId}").Subrouter()
+	authenticationManagerSubrouter.Use(amw.CheckForManagerPermissions)
+	authenticationManagerSubrouter.HandleFunc("", listR.UpdateList).Methods(http.MethodPut)
+	authenticationManagerSubrouter.HandleFunc("/users", listR.AddUserToList).Methods(http.MethodPost)
+	authenticationManagerSubrouter.HandleFunc("/users", listR.GetUsersFromListById).Methods(http.MethodGet)
+	authenticationManagerSubrouter.HandleFunc("/users/{userId}", listR.RemoveUserFromList).Methods(http.MethodDelete)
+	authenticationManagerSubrouter.HandleFunc("/users/{userId}", listR.GetUserFromListById).Methods(http.MethodGet)
+	authenticationManagerSubrouter.HandleFunc("/labels", listR.CreateLabel).Methods(http.MethodPost)
+	authenticationManagerSubrouter.HandleFunc("/labels/{labelId}", listR.UpdateLabel).Methods(http.MethodPut)
+	authenticationManagerSubrouter.HandleFunc("/labels/{labelId}", listR.DeleteLabel).Methods(http.MethodDelete)
+	authenticationManagerSubrouter.HandleFunc("/workflow", listR.UpdateWorkflow).Methods(http.MethodPut)
+	authenticationManagerSubrouter.HandleFunc("/template", listR.CreateTemplate).Methods(http.MethodPost)
 
-	authenticationOwnerSubrouter := router.PathPrefix(basePath + "/list/{listId}").Subrouter()
+	authenticationOwnerSubrouter := authenticatedRouter.PathPrefix(basePath + "/list/{listId}").Subrouter()
 	authenticationOwnerSubrouter.Use(amw.CheckForOwnerPermissions)
-	authenticationOwnerSubrouter.HandleFunc("", listR.UpdateList).Methods(http.MethodPut)
 	authenticationOwnerSubrouter.HandleFunc("", listR.DeleteList).Methods(http.MethodDelete)
-	authenticationOwnerSubrouter.HandleFunc("/users", listR.AddUserToList).Methods(http.MethodPost)
-	authenticationOwnerSubrouter.HandleFunc("/users", listR.GetUsersFromListById).Methods(http.MethodGet)
-	authenticationOwnerSubrouter.HandleFunc("/users/{userId}", listR.RemoveUserFromList).Methods(http.MethodDelete)
-	authenticationOwnerSubrouter.HandleFunc("/users/{userId}", listR.GetUserFromListById).Methods(http.MethodGet)
 
-	err = http.ListenAndServe(":8080", router)
+	srv := server.New("REST", cfg.REST, router)
+	if repos.db != nil {
+		if cfg.DB.AutoMigrate {
+			srv.OnStart(func(ctx context.Context) error {
+				return migrate(ctx, repos.db)
+			})
+		}
+		srv.OnStop(func(_ context.Context) error {
+			return repos.db.Close()
+		})
+	}
+
+	return srv, nil
+}
+
+type repositories struct {
+	db         *sqlx.DB
+	unitOfWork uow.UnitOfWork
+	list       list.RepositoryList
+	todo       todo.RepositoryTodo
+	subtask    subtask.RepositorySubtask
+	comment    comment.RepositoryComment
+	audit      audit.RepositoryAudit
+	search     search.RepositorySearch
+	user       user.RepositoryUser
+	auth       auth.RepositoryAuth
+}
+
+func newRepositories(cfg *config.Config) (*repositories, error) {
+	listRepoConvertor := list.NewRepositoryListConvertor()
+	todoRepoConvertor := todo.NewRepositoryTodoConvertor()
+	subtaskRepoConvertor := subtask.NewRepositorySubtaskConvertor()
+	commentRepoConvertor := comment.NewRepositoryCommentConvertor()
+	auditRepoConvertor := audit.NewRepositoryAuditConvertor()
+	searchRepoConvertor := search.NewRepositorySearchConvertor()
+	userRepoConvertor := user.NewRepositoryUserConvertor()
+
+	if cfg.Storage.Backend == config.StorageMemory {
+		log.Warn("using the in-memory storage backend, all data is lost on shutdown")
+
+		store := memory.NewStore()
+		store.SeedUsers(memory.DevelopmentUsers)
+		return &repositories{
+			unitOfWork: store,
+			list:       list.NewMemoryRepositoryList(store, *listRepoConvertor),
+			todo:       todo.NewMemoryRepositoryTodo(store, *todoRepoConvertor),
+			subtask:    subtask.NewMemoryRepositorySubtask(store, *subtaskRepoConvertor),
+			comment:    comment.NewMemoryRepositoryComment(store, *commentRepoConvertor),
+			audit:      audit.NewMemoryRepositoryAudit(store, *auditRepoConvertor),
+			search:     search.NewMemoryRepositorySearch(store, *searchRepoConvertor),
+			user:       user.NewMemoryRepositoryUser(store, *userRepoConvertor),
+			auth:       auth.NewMemoryRepositoryAuth(store),
+		}, nil
+	}
+
+	db, err := utils.ConnectToDB(cfg.DB)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
+
+	return &repositories{
+		db:         db,
+		unitOfWork: uow.NewDBUnitOfWork(db),
+		list:       list.NewDBRepositoryList(db, *listRepoConvertor),
+		todo:       todo.NewDBRepositoryTodo(db, *todoRepoConvertor),
+		subtask:    subtask.NewDBRepositorySubtask(db, *subtaskRepoConvertor),
+		comment:    comment.NewDBRepositoryComment(db, *commentRepoConvertor),
+		audit:      audit.NewDBRepositoryAudit(db, *auditRepoConvertor),
+		search:     search.NewDBRepositorySearch(db, *searchRepoConvertor),
+		user:       user.NewDBRepositoryUser(db, *userRepoConvertor),
+		auth:       auth.NewDBRepositoryAuth(db),
+	}, nil
+}
+
+func migrate(ctx context.Context, db *sqlx.DB) error {
+	availableMigrations, err := migrations.Embedded()
+	if err != nil {
+		return err
+	}
+
+	ctx = context.WithValue(ctx, utils.Logger, log.WithField("component", "migrations"))
+	return migrations.NewMigrator(db, availableMigrations).Up(ctx)
 }
@@ -0,0 +1,724 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"project/api"
+	"project/config"
+	"project/structures"
+	"project/utils"
+	"slices"
+	"testing"
+	"time"
+)
+
+func helperDoRequest(t *testing.T, method, url, token string, body any) *http.Response {
+	var payload bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+
+	req, err := http.NewRequest(method, url, &payload)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set(authorization, bearerPrefix+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		resp.Body.Close()
+	})
+
+	return resp
+}
+
+// helperStartServer starts a server on the memory storage and logs Ivan in, returning the base url and his tokens.
+func helperStartServer(t *testing.T) (string, structures.TokenOutput) {
+	cfg := config.Default()
+	cfg.Storage.Backend = config.StorageMemory
+	cfg.DB.Host = ""
+	cfg.REST.Port = 0
+	cfg.Auth.SigningKey = "test-signing-key"
+	require.NoError(t, cfg.Validate())
+
+	srv, err := api.NewServer(cfg)
+	require.NoError(t, err)
+	require.NoError(t, srv.Start(context.Background()))
+	t.Cleanup(func() {
+		srv.Stop(context.Background())
+	})
+	baseUrl := srv.URL() + "/todo/api"
+
+	resp := helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Ivan", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var tokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&tokens))
+
+	return baseUrl, tokens
+}
+
+func TestNewServerWithMemoryStorage(t *testing.T) {
+	baseUrl, tokens := helperStartServer(t)
+
+	resp := helperDoRequest(t, http.MethodPost, baseUrl+"/list", tokens.AccessToken, structures.ListInput{Name: testList})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var createdList structures.ListOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdList))
+	require.Equal(t, "Ivan", createdList.Owner)
+
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/list", tokens.AccessToken, structures.ListInput{Name: testList})
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	listUrl := baseUrl + "/list/" + createdList.Id.String()
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/todo", tokens.AccessToken, structures.TodoInput{
+		Name:        utils.TestTodoName,
+		Description: utils.TestTodoDescription,
+		Deadline:    time.Now().Add(24 * time.Hour),
+		Priority:    utils.MediumPriority,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var createdTodo structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdTodo))
+
+	subtasksUrl := listUrl + "/todo/" + createdTodo.Id.String() + "/subtasks"
+	resp = helperDoRequest(t, http.MethodPost, subtasksUrl, tokens.AccessToken, structures.SubtaskInput{Title: utils.TestSubtaskTitle, Done: true})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	first := 0
+	resp = helperDoRequest(t, http.MethodPost, subtasksUrl, tokens.AccessToken, structures.SubtaskInput{
+		Title:    utils.TestSubtaskTitle,
+		Assignee: "Ivan",
+		Position: &first,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, subtasksUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var subtasks []structures.SubtaskOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&subtasks))
+	require.Len(t, subtasks, 2)
+	require.Equal(t, "Ivan", subtasks[0].Assignee)
+	require.True(t, subtasks[1].Done)
+
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/labels", tokens.AccessToken, structures.LabelInput{
+		Name:  utils.TestLabelName,
+		Color: utils.TestLabelColor,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var createdLabel structures.LabelOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdLabel))
+
+	resp = helperDoRequest(t, http.MethodPut, listUrl+"/todo/"+createdTodo.Id.String()+"/labels/"+createdLabel.Id.String(), tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/todos?label="+utils.TestLabelName, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var todos []structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&todos))
+	require.Len(t, todos, 1)
+	require.Equal(t, utils.NotAssigned, todos[0].Status)
+	require.Equal(t, 50, todos[0].Progress)
+	require.Equal(t, []structures.LabelOutput{createdLabel}, todos[0].Labels)
+
+	commentsUrl := listUrl + "/todo/" + createdTodo.Id.String() + "/comments"
+	resp = helperDoRequest(t, http.MethodPost, commentsUrl, tokens.AccessToken, structures.CommentInput{Body: utils.TestCommentBody})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var createdComment structures.CommentOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdComment))
+	require.Equal(t, "Ivan", createdComment.Author)
+
+	resp = helperDoRequest(t, http.MethodPut, commentsUrl+"/"+createdComment.Id.String(), tokens.AccessToken, structures.CommentInput{Body: "edited"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, commentsUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "1", resp.Header.Get(utils.TotalCountHeader))
+	var comments []structures.CommentOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&comments))
+	require.Len(t, comments, 1)
+	require.Equal(t, "edited", comments[0].Body)
+	require.NotNil(t, comments[0].EditedAt)
+
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/todo/"+createdTodo.Id.String()+"/audit?limit=1", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "2", resp.Header.Get(utils.TotalCountHeader))
+	var todoEntries []structures.AuditOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&todoEntries))
+	require.Len(t, todoEntries, 1)
+	require.Equal(t, utils.AuditAttachLabel, todoEntries[0].Action)
+	require.Equal(t, "Ivan", todoEntries[0].Actor)
+	require.NotEmpty(t, todoEntries[0].RequestId)
+	require.JSONEq(t, `{"labels":[]}`, string(todoEntries[0].Before))
+
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/workflow", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var workflow structures.WorkflowOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&workflow))
+	require.Len(t, workflow.States, 5)
+	require.Equal(t, utils.NotAssigned, workflow.States[0].Name)
+
+	resp = helperDoRequest(t, http.MethodPut, listUrl+"/workflow", tokens.AccessToken, structures.WorkflowInput{
+		States:      []structures.WorkflowStateInput{{Name: utils.NotAssigned}, {Name: "Done", Done: true}},
+		Transitions: []structures.WorkflowTransitionInput{{From: utils.NotAssigned, To: "Done"}},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	statusUrl := listUrl + "/todo/" + createdTodo.Id.String() + "/status"
+	resp = helperDoRequest(t, http.MethodPatch, statusUrl, tokens.AccessToken, structures.TodoStatusInput{Status: utils.InProgress})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPatch, statusUrl, tokens.AccessToken, structures.TodoStatusInput{Status: "Done"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/todo/"+createdTodo.Id.String(), tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var doneTodo structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doneTodo))
+	require.Equal(t, "Done", doneTodo.Status)
+	require.True(t, doneTodo.Done)
+
+	resp = helperDoRequest(t, http.MethodPatch, listUrl+"/todo/"+createdTodo.Id.String()+"/step-back", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPatch, listUrl+"/todo/"+createdTodo.Id.String()+"/reopen", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/todo/"+createdTodo.Id.String(), tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var reopenedTodo structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&reopenedTodo))
+	require.Equal(t, utils.NotAssigned, reopenedTodo.Status)
+	require.False(t, reopenedTodo.Done)
+
+	todoUrl := listUrl + "/todo/" + createdTodo.Id.String()
+	resp = helperDoRequest(t, http.MethodPatch, todoUrl, tokens.AccessToken, structures.TodoAssigneeInput{Username: "Miro"})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPatch, todoUrl, tokens.AccessToken, structures.TodoAssigneeInput{Username: "Ivan"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPut, todoUrl+"/watchers/Ivan", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPut, todoUrl+"/watchers/Ivan", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, todoUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var watchedTodo structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&watchedTodo))
+	require.Equal(t, []string{"Ivan"}, watchedTodo.Assignees)
+	require.Equal(t, []string{"Ivan"}, watchedTodo.Watchers)
+
+	resp = helperDoRequest(t, http.MethodDelete, todoUrl+"/assignees/Ivan", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodDelete, listUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/todos", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, baseUrl+"/audit?list="+createdList.Id.String(), tokens.AccessToken, nil)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Niki", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var adminTokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&adminTokens))
+
+	resp = helperDoRequest(t, http.MethodGet, baseUrl+"/audit?list="+createdList.Id.String(), adminTokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var listEntries []structures.AuditOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&listEntries))
+	require.Len(t, listEntries, 11)
+	require.Equal(t, utils.AuditDeleteList, listEntries[0].Action)
+	require.Equal(t, utils.AuditRemoveAssignee, listEntries[1].Action)
+	require.Equal(t, utils.AuditAddWatcher, listEntries[2].Action)
+	require.Equal(t, utils.AuditAssignTodo, listEntries[3].Action)
+	require.Equal(t, utils.AuditReopenTodo, listEntries[4].Action)
+	require.Equal(t, utils.AuditUpdateWorkflow, listEntries[6].Action)
+	require.JSONEq(t, "null", string(listEntries[0].After))
+	require.Equal(t, utils.AuditCreateList, listEntries[10].Action)
+}
+
+func TestRecurringTodosWithMemoryStorage(t *testing.T) {
+	baseUrl, tokens := helperStartServer(t)
+
+	resp := helperDoRequest(t, http.MethodPost, baseUrl+"/list", tokens.AccessToken, structures.ListInput{Name: testList})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var createdList structures.ListOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdList))
+	listUrl := baseUrl + "/list/" + createdList.Id.String()
+
+	deadline := time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC)
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/todo", tokens.AccessToken, structures.TodoInput{
+		Name:        utils.TestTodoName,
+		Description: utils.TestTodoDescription,
+		Deadline:    deadline,
+		Priority:    utils.MediumPriority,
+		Recurrence:  "FREQ=YEARLY",
+	})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/todo", tokens.AccessToken, structures.TodoInput{
+		Name:        utils.TestTodoName,
+		Description: utils.TestTodoDescription,
+		Deadline:    deadline,
+		Priority:    utils.MediumPriority,
+		Recurrence:  "freq=weekly;count=2",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var createdTodo structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdTodo))
+	require.Equal(t, "FREQ=WEEKLY;COUNT=2", createdTodo.Recurrence)
+	require.Equal(t, createdTodo.Id, *createdTodo.SeriesId)
+	todoUrl := listUrl + "/todo/" + createdTodo.Id.String()
+
+	resp = helperDoRequest(t, http.MethodGet, todoUrl+"/recurrence?limit=3", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var recurrence structures.TodoRecurrenceOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&recurrence))
+	require.Equal(t, []time.Time{deadline.AddDate(0, 0, 7)}, recurrence.Upcoming)
+
+	resp = helperDoRequest(t, http.MethodPut, todoUrl+"/watchers/Ivan", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPut, listUrl+"/workflow", tokens.AccessToken, structures.WorkflowInput{
+		States:      []structures.WorkflowStateInput{{Name: utils.NotAssigned}, {Name: "Done", Done: true}},
+		Transitions: []structures.WorkflowTransitionInput{{From: utils.NotAssigned, To: "Done"}},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	complete := func(todoUrl string) {
+		resp := helperDoRequest(t, http.MethodPatch, todoUrl, tokens.AccessToken, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		resp = helperDoRequest(t, http.MethodPatch, todoUrl+"/status", tokens.AccessToken, structures.TodoStatusInput{Status: "Done"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	complete(todoUrl)
+
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/todos?sort=deadline", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var todos []structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&todos))
+	require.Len(t, todos, 2)
+	require.Equal(t, createdTodo.Id, todos[0].Id)
+	require.Empty(t, todos[0].Recurrence)
+	require.True(t, todos[0].Done)
+	nextTodo := todos[1]
+	require.Equal(t, utils.TestTodoName, nextTodo.Name)
+	require.True(t, deadline.AddDate(0, 0, 7).Equal(nextTodo.Deadline))
+	require.Equal(t, "FREQ=WEEKLY;COUNT=1", nextTodo.Recurrence)
+	require.Equal(t, createdTodo.Id, *nextTodo.SeriesId)
+	require.Equal(t, utils.NotAssigned, nextTodo.Status)
+	require.Equal(t, []string{"Ivan"}, nextTodo.Watchers)
+	require.Empty(t, nextTodo.Assignees)
+
+	nextUrl := listUrl + "/todo/" + nextTodo.Id.String()
+	complete(nextUrl)
+
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/todos", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&todos))
+	require.Len(t, todos, 2)
+
+	resp = helperDoRequest(t, http.MethodDelete, nextUrl+"/recurrence", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPut, nextUrl+"/recurrence", tokens.AccessToken, structures.TodoRecurrenceInput{Rule: "daily"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodDelete, nextUrl+"/recurrence", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestTodoDependenciesWithMemoryStorage(t *testing.T) {
+	baseUrl, tokens := helperStartServer(t)
+
+	createTodo := func(token, listUrl, name string) structures.TodoOutput {
+		resp := helperDoRequest(t, http.MethodPost, listUrl+"/todo", token, structures.TodoInput{
+			Name:        name,
+			Description: utils.TestTodoDescription,
+			Deadline:    time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC),
+			Priority:    utils.MediumPriority,
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var createdTodo structures.TodoOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdTodo))
+		return createdTodo
+	}
+	createList := func(token, name string) string {
+		resp := helperDoRequest(t, http.MethodPost, baseUrl+"/list", token, structures.ListInput{Name: name})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var createdList structures.ListOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdList))
+		return baseUrl + "/list/" + createdList.Id.String()
+	}
+
+	listUrl := createList(tokens.AccessToken, testList)
+	otherListUrl := createList(tokens.AccessToken, "Other list")
+	blocked := createTodo(tokens.AccessToken, listUrl, utils.TestTodoName)
+	blocker := createTodo(tokens.AccessToken, otherListUrl, "Blocker")
+	blockedUrl := listUrl + "/todo/" + blocked.Id.String()
+	blockerUrl := otherListUrl + "/todo/" + blocker.Id.String()
+
+	resp := helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Niki", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var adminTokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&adminTokens))
+	hidden := createTodo(adminTokens.AccessToken, createList(adminTokens.AccessToken, "Hidden list"), "Hidden")
+
+	resp = helperDoRequest(t, http.MethodPost, blockedUrl+"/dependencies", tokens.AccessToken,
+		structures.TodoDependencyInput{TodoId: hidden.Id, ListId: hidden.ListId})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, blockedUrl+"/dependencies", tokens.AccessToken,
+		structures.TodoDependencyInput{TodoId: blocker.Id, ListId: blocker.ListId})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, blockerUrl+"/dependencies", tokens.AccessToken,
+		structures.TodoDependencyInput{TodoId: blocked.Id, ListId: blocked.ListId})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodGet, blockedUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var todoOutput structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&todoOutput))
+	require.True(t, todoOutput.Blocked)
+	require.Equal(t, []structures.TodoDependencyOutput{{Id: blocker.Id, ListId: blocker.ListId, Name: "Blocker"}}, todoOutput.BlockedBy)
+	require.Empty(t, todoOutput.Blocks)
+
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Yosif", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var viewerTokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&viewerTokens))
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/users", tokens.AccessToken, structures.ListUserInput{Username: "Yosif", Role: utils.Viewer})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodGet, blockedUrl, viewerTokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var viewerOutput structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&viewerOutput))
+	require.True(t, viewerOutput.Blocked)
+	require.Empty(t, viewerOutput.BlockedBy)
+	resp = helperDoRequest(t, http.MethodGet, blockedUrl, adminTokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var adminOutput structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&adminOutput))
+	require.Equal(t, todoOutput.BlockedBy, adminOutput.BlockedBy)
+
+	resp = helperDoRequest(t, http.MethodPatch, blockedUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPatch, blockedUrl+"/status", tokens.AccessToken, structures.TodoStatusInput{Status: utils.InProgress})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPatch, blockedUrl+"/status", tokens.AccessToken, structures.TodoStatusInput{Status: utils.InReview})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodDelete, blockedUrl+"/dependencies/"+blocker.Id.String(), tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodDelete, blockedUrl+"/dependencies/"+blocker.Id.String(), tokens.AccessToken, nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPatch, blockedUrl+"/status", tokens.AccessToken, structures.TodoStatusInput{Status: utils.InReview})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestMoveAndCopyTodosWithMemoryStorage(t *testing.T) {
+	baseUrl, tokens := helperStartServer(t)
+
+	createTodo := func(token, listUrl, name string) structures.TodoOutput {
+		resp := helperDoRequest(t, http.MethodPost, listUrl+"/todo", token, structures.TodoInput{
+			Name:        name,
+			Description: utils.TestTodoDescription,
+			Deadline:    time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC),
+			Priority:    utils.MediumPriority,
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var createdTodo structures.TodoOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdTodo))
+		return createdTodo
+	}
+	createList := func(token, name string) structures.ListOutput {
+		resp := helperDoRequest(t, http.MethodPost, baseUrl+"/list", token, structures.ListInput{Name: name})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var createdList structures.ListOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdList))
+		return createdList
+	}
+
+	listEntity := createList(tokens.AccessToken, testList)
+	otherList := createList(tokens.AccessToken, "Other list")
+	listUrl := baseUrl + "/list/" + listEntity.Id.String()
+	first := createTodo(tokens.AccessToken, listUrl, utils.TestTodoName)
+	second := createTodo(tokens.AccessToken, listUrl, "Second")
+	createTodo(tokens.AccessToken, baseUrl+"/list/"+otherList.Id.String(), utils.TestTodoName)
+	firstUrl := listUrl + "/todo/" + first.Id.String()
+
+	resp := helperDoRequest(t, http.MethodPost, firstUrl+"/move", tokens.AccessToken, structures.TodoTransferInput{ListId: otherList.Id})
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, firstUrl+"/move", tokens.AccessToken,
+		structures.TodoTransferInput{ListId: otherList.Id, Conflict: "rename"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var moved structures.TodoOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&moved))
+	require.Equal(t, first.Id, moved.Id)
+	require.Equal(t, otherList.Id, moved.ListId)
+	require.Equal(t, utils.TestTodoName+" (2)", moved.Name)
+	resp = helperDoRequest(t, http.MethodGet, firstUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	for _, name := range []string{"Second (2)", "Second (3)"} {
+		resp = helperDoRequest(t, http.MethodPost, listUrl+"/todo/copy", tokens.AccessToken,
+			structures.TodoTransferInput{ListId: listEntity.Id, Conflict: "rename", TodoIds: []uuid.UUID{second.Id}})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var copied []structures.TodoOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&copied))
+		require.Len(t, copied, 1)
+		require.NotEqual(t, second.Id, copied[0].Id)
+		require.Equal(t, name, copied[0].Name)
+	}
+
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Niki", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var adminTokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&adminTokens))
+	hiddenList := createList(adminTokens.AccessToken, "Hidden list")
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/todo/"+second.Id.String()+"/move", tokens.AccessToken,
+		structures.TodoTransferInput{ListId: hiddenList.Id})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestListDuplicationAndTemplatesWithMemoryStorage(t *testing.T) {
+	baseUrl, tokens := helperStartServer(t)
+
+	resp := helperDoRequest(t, http.MethodPost, baseUrl+"/list", tokens.AccessToken, structures.ListInput{Name: testList})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var listEntity structures.ListOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&listEntity))
+	listUrl := baseUrl + "/list/" + listEntity.Id.String()
+	start := time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC)
+	for i, name := range []string{utils.TestTodoName, "Second"} {
+		resp = helperDoRequest(t, http.MethodPost, listUrl+"/todo", tokens.AccessToken, structures.TodoInput{
+			Name:        name,
+			Description: utils.TestTodoDescription,
+			Deadline:    start.AddDate(0, 0, 2*i),
+			Priority:    utils.MediumPriority,
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/duplicate", tokens.AccessToken, structures.ListDuplicateInput{Name: testList})
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/duplicate", tokens.AccessToken,
+		structures.ListDuplicateInput{Name: "Copied list", IncludeMembers: true})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var duplicated structures.ListUserOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&duplicated))
+	require.Equal(t, "Ivan", duplicated.Owner)
+	var todos []structures.TodoOutput
+	resp = helperDoRequest(t, http.MethodGet, baseUrl+"/list/"+duplicated.Id.String()+"/todos", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&todos))
+	require.Len(t, todos, 2)
+
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Yosif", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var writerTokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&writerTokens))
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/users", tokens.AccessToken, structures.ListUserInput{Username: "Yosif", Role: utils.Viewer})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/duplicate", writerTokens.AccessToken,
+		structures.ListDuplicateInput{Name: "Viewer copy", IncludeMembers: true})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/duplicate", writerTokens.AccessToken, structures.ListDuplicateInput{Name: "Viewer copy"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&duplicated))
+	require.Equal(t, "Yosif", duplicated.Owner)
+	require.Equal(t, []string{"Yosif"}, duplicated.Users)
+
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/template", tokens.AccessToken, structures.TemplateInput{Name: "Sprint"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var template structures.TemplateOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&template))
+	require.Len(t, template.Todos, 2)
+	require.Equal(t, 2, template.Todos[1].DeadlineOffset)
+
+	resp = helperDoRequest(t, http.MethodGet, baseUrl+"/templates", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var templates []structures.TemplateOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&templates))
+	require.Len(t, templates, 1)
+
+	templateUrl := baseUrl + "/templates/" + template.Id.String()
+	resp = helperDoRequest(t, http.MethodPost, templateUrl+"/instantiate", tokens.AccessToken, structures.TemplateInstanceInput{
+		Name:      "Next sprint",
+		StartDate: time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC),
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var instance structures.ListOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&instance))
+	resp = helperDoRequest(t, http.MethodGet, baseUrl+"/list/"+instance.Id.String()+"/todos", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&todos))
+	require.Len(t, todos, 2)
+	deadlines := []string{todos[0].Deadline.Format(time.DateOnly), todos[1].Deadline.Format(time.DateOnly)}
+	require.ElementsMatch(t, []string{"2026-03-02", "2026-03-04"}, deadlines)
+
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Miro", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var readerTokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&readerTokens))
+	resp = helperDoRequest(t, http.MethodGet, baseUrl+"/templates", readerTokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&templates))
+	require.Empty(t, templates)
+	resp = helperDoRequest(t, http.MethodGet, templateUrl, readerTokens.AccessToken, nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, templateUrl+"/instantiate", readerTokens.AccessToken,
+		structures.TemplateInstanceInput{Name: "Reader sprint", StartDate: start})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodDelete, templateUrl, readerTokens.AccessToken, nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPost, templateUrl+"/instantiate", writerTokens.AccessToken,
+		structures.TemplateInstanceInput{Name: "Writer sprint", StartDate: start})
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/list/"+instance.Id.String()+"/template", writerTokens.AccessToken,
+		structures.TemplateInput{Name: "Sprint"})
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/list", writerTokens.AccessToken, structures.ListInput{Name: "Writer list"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var writerList structures.ListOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&writerList))
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/list/"+writerList.Id.String()+"/template", writerTokens.AccessToken,
+		structures.TemplateInput{Name: "Sprint"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Niki", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var adminTokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&adminTokens))
+	resp = helperDoRequest(t, http.MethodGet, templateUrl, adminTokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodDelete, templateUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = helperDoRequest(t, http.MethodGet, templateUrl, tokens.AccessToken, nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestBulkUpdateTodosWithMemoryStorage(t *testing.T) {
+	baseUrl, tokens := helperStartServer(t)
+
+	resp := helperDoRequest(t, http.MethodPost, baseUrl+"/list", tokens.AccessToken, structures.ListInput{Name: testList})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var listEntity structures.ListOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&listEntity))
+	listUrl := baseUrl + "/list/" + listEntity.Id.String()
+
+	var todoIds []uuid.UUID
+	for _, name := range []string{utils.TestTodoName, "Second"} {
+		resp = helperDoRequest(t, http.MethodPost, listUrl+"/todo", tokens.AccessToken, structures.TodoInput{
+			Name:        name,
+			Description: utils.TestTodoDescription,
+			Deadline:    time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC),
+			Priority:    utils.MediumPriority,
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var createdTodo structures.TodoOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&createdTodo))
+		todoIds = append(todoIds, createdTodo.Id)
+	}
+	bulkUpdate := func(input structures.TodoBulkInput) structures.TodoBulkOutput {
+		resp := helperDoRequest(t, http.MethodPost, listUrl+"/todo/bulk", tokens.AccessToken, input)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var output structures.TodoBulkOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&output))
+		require.Len(t, output.Results, len(input.TodoIds))
+		return output
+	}
+	getStatus := func(todoId uuid.UUID) string {
+		resp := helperDoRequest(t, http.MethodGet, listUrl+"/todo/"+todoId.String(), tokens.AccessToken, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var todoOutput structures.TodoOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&todoOutput))
+		return todoOutput.Status
+	}
+
+	output := bulkUpdate(structures.TodoBulkInput{Operation: "assign", TodoIds: todoIds})
+	require.Equal(t, 2, output.Succeeded)
+	require.Equal(t, []string{"Ivan"}, output.Results[1].Todo.Assignees)
+
+	missingId := uuid.New()
+	withMissing := append(slices.Clone(todoIds), missingId)
+	output = bulkUpdate(structures.TodoBulkInput{Operation: "change_status", Status: utils.InProgress, TodoIds: withMissing})
+	require.Equal(t, "atomic", output.Mode)
+	require.Equal(t, 3, output.Failed)
+	require.Contains(t, output.Results[0].Error, "error rolled back")
+	require.Contains(t, output.Results[2].Error, "error getting todo")
+	require.Equal(t, utils.Assigned, getStatus(todoIds[0]))
+
+	output = bulkUpdate(structures.TodoBulkInput{Operation: "change_status", Mode: "best_effort", Status: utils.InProgress, TodoIds: withMissing})
+	require.Equal(t, 2, output.Succeeded)
+	require.Equal(t, 1, output.Failed)
+	require.False(t, output.Results[2].Success)
+	require.Equal(t, utils.InProgress, getStatus(todoIds[0]))
+
+	output = bulkUpdate(structures.TodoBulkInput{Operation: "delete", TodoIds: todoIds[1:]})
+	require.Equal(t, 1, output.Succeeded)
+	resp = helperDoRequest(t, http.MethodGet, listUrl+"/todo/"+todoIds[1].String(), tokens.AccessToken, nil)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPost, listUrl+"/todo/bulk", tokens.AccessToken,
+		structures.TodoBulkInput{Operation: "archive", TodoIds: todoIds})
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestSearchWithMemoryStorage(t *testing.T) {
+	baseUrl, tokens := helperStartServer(t)
+	createList := func(token, name string) structures.ListOutput {
+		resp := helperDoRequest(t, http.MethodPost, baseUrl+"/list", token, structures.ListInput{Name: name})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		var listEntity structures.ListOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&listEntity))
+		return listEntity
+	}
+	search := func(token, query string) []structures.SearchOutput {
+		resp := helperDoRequest(t, http.MethodGet, baseUrl+"/search"+query, token, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var results []structures.SearchOutput
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&results))
+		return results
+	}
+
+	listEntity := createList(tokens.AccessToken, "Release checklist")
+	resp := helperDoRequest(t, http.MethodPost, baseUrl+"/list/"+listEntity.Id.String()+"/todo", tokens.AccessToken, structures.TodoInput{
+		Name:        utils.TestTodoName,
+		Description: "Write the release notes",
+		Deadline:    time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC),
+		Priority:    utils.MediumPriority,
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp = helperDoRequest(t, http.MethodPost, baseUrl+"/auth/login", "", structures.LoginInput{Username: "Niki", Password: "example"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var adminTokens structures.TokenOutput
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&adminTokens))
+	hiddenList := createList(adminTokens.AccessToken, "Hidden release")
+
+	results := search(tokens.AccessToken, "?q=RELEASE")
+	require.Len(t, results, 2)
+	require.Equal(t, "list", results[0].Type)
+	require.Equal(t, "<b>Release</b> checklist", results[0].NameHighlight)
+	require.Equal(t, "todo", results[1].Type)
+	require.Equal(t, listEntity.Name, results[1].ListName)
+	require.Equal(t, "Write the <b>release</b> notes", results[1].DescriptionHighlight)
+
+	require.Len(t, search(tokens.AccessToken, "?q=release&limit=1"), 1)
+	require.Empty(t, search(tokens.AccessToken, "?q=hidden"))
+	results = search(adminTokens.AccessToken, "?q=hidden")
+	require.Len(t, results, 1)
+	require.Equal(t, hiddenList.Id, results[0].Id)
+
+	resp = helperDoRequest(t, http.MethodGet, baseUrl+"/search", tokens.AccessToken, nil)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
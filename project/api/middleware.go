@@ -8,6 +8,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"net/http"
 	"project/utils"
+	"strings"
 )
 
 const (
@@ -17,87 +18,81 @@ const (
 	requestId = "requestId"
 	path      = "path"
 	method    = "method"
+
+	authorization = "Authorization"
+	bearerPrefix  = "Bearer "
 )
 
 type AuthenticationMiddleware struct {
-	resolver *ResolverList
+	resolver     *ResolverList
+	userResolver *ResolverUser
+	authResolver *ResolverAuth
 }
 
-func NewAuthenticationMiddleware(resolver *ResolverList) *AuthenticationMiddleware {
+func NewAuthenticationMiddleware(resolver *ResolverList, userResolver *ResolverUser, authResolver *ResolverAuth) *AuthenticationMiddleware {
 	return &AuthenticationMiddleware{
-		resolver: resolver,
+		resolver:     resolver,
+		userResolver: userResolver,
+		authResolver: authResolver,
 	}
 }
 
 func (amw *AuthenticationMiddleware) getRole(r *http.Request) int {
 	username := r.Header.Get(username)
-	return utils.GetUsersRights(username)
+	return (*amw.userResolver).GetUserRights(r.Context(), username)
 }
 
 func (amw *AuthenticationMiddleware) UserExistenceAuthentication(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username := r.Header.Get(username)
-		if utils.GetUsersRights(username) < utils.Role[utils.Reader] {
-			log := r.Context().Value(utils.Logger).(logrus.FieldLogger)
-			log.WithField(utils.Status, http.StatusUnauthorized).Warn(fmt.Sprintf("user %s does not exist", username))
+		log := r.Context().Value(utils.Logger).(logrus.FieldLogger)
+
+		token, found := strings.CutPrefix(r.Header.Get(authorization), bearerPrefix)
+		if !found || token == "" {
+			log.WithField(utils.Status, http.StatusUnauthorized).Warn("missing bearer token")
 
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
 
-		next.ServeHTTP(w, r)
-	})
-}
-
-func (amw *AuthenticationMiddleware) CheckForReaderPermissions(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		role := amw.getRole(r)
-		ctx := r.Context()
-
-		username := r.Header.Get(username)
-		listId, err := utils.ValidateStringID(mux.Vars(r)[listId])
+		username, err := (*amw.authResolver).AuthenticateToken(r.Context(), token)
 		if err != nil {
-			log := ctx.Value(utils.Logger).(logrus.FieldLogger)
-			log.WithField(utils.Status, http.StatusBadRequest).Warn(err.Error())
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-
-		if !(*amw.resolver).IsUserPartOfList(ctx, *listId, username) &&
-			role != utils.Role[utils.Admin] {
-			log := ctx.Value(utils.Logger).(logrus.FieldLogger)
-			log.WithField(utils.Status, http.StatusForbidden).Warn(fmt.Sprintf("%s is not authorized as reader in list: %s", username, listId))
+			log.WithField(utils.Status, http.StatusUnauthorized).Warn(err.Error())
 
-			http.Error(w, "Forbidden", http.StatusForbidden)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
 
-		next.ServeHTTP(w, r)
-	})
-}
+		// Handlers read the caller from this header, so it must only ever hold the authenticated user.
+		r.Header.Set(userId, username)
+		log = log.WithField(userId, username)
 
-func (amw *AuthenticationMiddleware) CheckForWriterPermissions(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		listIdLabel := listId
-		username := r.Header.Get(username)
-		listId := mux.Vars(r)[listId]
 		role := amw.getRole(r)
+		if role < utils.Role[utils.Reader] {
+			log.WithField(utils.Status, http.StatusUnauthorized).Warn(fmt.Sprintf("user %s does not exist", username))
 
-		if role < utils.Role[utils.Writer] {
-			log := r.Context().Value(utils.Logger).(logrus.FieldLogger)
-			log.WithField(utils.Status, http.StatusForbidden).Warn(fmt.Sprintf("%s is not authorized as writer in list: %s", username, listId))
-
-			http.Error(w, "Forbidden", http.StatusForbidden)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), listIdLabel, listId)
+		ctx := context.WithValue(r.Context(), utils.Logger, log)
+		ctx = context.WithValue(ctx, utils.CurrentUser, username)
+		ctx = context.WithValue(ctx, utils.UserRole, role)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-func (amw *AuthenticationMiddleware) CheckForOwnerPermissions(next http.Handler) http.Handler {
+func (amw *AuthenticationMiddleware) getListRole(r *http.Request, listId uuid.UUID) int {
+	if utils.GetRoleFromContext(r.Context()) == utils.Role[utils.Admin] {
+		return utils.ListRole[utils.Owner]
+	}
+
+	username := r.Header.Get(username)
+	return (*amw.resolver).GetUserListRights(r.Context(), listId, username)
+}
+
+func (amw *AuthenticationMiddleware) checkForListPermissions(next http.Handler, permissionLevel string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		listIdLabel := listId
 		ctx := r.Context()
 
 		username := r.Header.Get(username)
@@ -109,27 +104,48 @@ func (amw *AuthenticationMiddleware) CheckForOwnerPermissions(next http.Handler)
 			return
 		}
 
-		if !(*amw.resolver).IsOwnerUserOwnerToListById(ctx, *listId, username) &&
-			amw.getRole(r) != utils.Role[utils.Admin] {
+		role := amw.getListRole(r, *listId)
+		if role < utils.ListRole[permissionLevel] {
 			log := ctx.Value(utils.Logger).(logrus.FieldLogger)
-			log.WithField(utils.Status, http.StatusForbidden).Warn(fmt.Sprintf("%s is not owner nor admin to list: %s", username, listId))
+			log.WithField(utils.Status, http.StatusForbidden).Warn(fmt.Sprintf("%s is not authorized as %s in list: %s", username, permissionLevel, listId))
 
 			http.Error(w, "Forbidden", http.StatusForbidden)
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		ctx = context.WithValue(ctx, listIdLabel, listId.String())
+		ctx = context.WithValue(ctx, utils.UserListRole, role)
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-func (amw *AuthenticationMiddleware) CheckForAdminPermissions(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+func (amw *AuthenticationMiddleware) CheckForReaderPermissions(next http.Handler) http.Handler {
+	return amw.checkForListPermissions(next, utils.Viewer)
+}
+
+func (amw *AuthenticationMiddleware) CheckForUserExistenceInList(next http.Handler) http.Handler {
+	return amw.checkForListPermissions(next, utils.Viewer)
+}
+
+func (amw *AuthenticationMiddleware) CheckForWriterPermissions(next http.Handler) http.Handler {
+	return amw.checkForListPermissions(next, utils.Editor)
+}
 
+func (amw *AuthenticationMiddleware) CheckForManagerPermissions(next http.Handler) http.Handler {
+	return amw.checkForListPermissions(next, utils.Manager)
+}
+
+func (amw *AuthenticationMiddleware) CheckForOwnerPermissions(next http.Handler) http.Handler {
+	return amw.checkForListPermissions(next, utils.Owner)
+}
+
+func (amw *AuthenticationMiddleware) CheckForListCreationPermissions(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		username := r.Header.Get(username)
-		if utils.GetUsersRights(username) != utils.Role[utils.Admin] {
-			log := ctx.Value(utils.Logger).(logrus.FieldLogger)
-			log.WithField(utils.Status, http.StatusForbidden).Warn(fmt.Sprintf("%s is not admin", username))
+
+		if utils.GetRoleFromContext(r.Context()) < utils.Role[utils.Writer] {
+			log := r.Context().Value(utils.Logger).(logrus.FieldLogger)
+			log.WithField(utils.Status, http.StatusForbidden).Warn(fmt.Sprintf("%s is not authorized to create lists", username))
 
 			http.Error(w, "Forbidden", http.StatusForbidden)
 			return
@@ -139,23 +155,15 @@ func (amw *AuthenticationMiddleware) CheckForAdminPermissions(next http.Handler)
 	})
 }
 
-func (amw *AuthenticationMiddleware) CheckForUserExistenceInList(next http.Handler) http.Handler {
+func (amw *AuthenticationMiddleware) CheckForAdminPermissions(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
 		username := r.Header.Get(username)
-		listId, err := utils.GetID(mux.Vars(r), listId)
-		if err != nil {
+		if utils.GetRoleFromContext(ctx) != utils.Role[utils.Admin] {
 			log := ctx.Value(utils.Logger).(logrus.FieldLogger)
-			log.WithField(utils.Status, http.StatusBadRequest).Warn(err.Error())
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
+			log.WithField(utils.Status, http.StatusForbidden).Warn(fmt.Sprintf("%s is not admin", username))
 
-		if !(*amw.resolver).IsUserPartOfList(ctx, *listId, username) &&
-			amw.getRole(r) != utils.Role[utils.Admin] {
-			log := ctx.Value(utils.Logger).(logrus.FieldLogger)
-			log.WithFields(logrus.Fields{utils.Status: http.StatusForbidden})
 			http.Error(w, "Forbidden", http.StatusForbidden)
 			return
 		}
@@ -168,17 +176,17 @@ func LoggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		log := logrus.WithContext(r.Context())
 
+		id := uuid.New().String()
 		log.Data = logrus.Fields{
 			method:       r.Method,
 			path:         r.URL.Path,
-			userId:       r.Header.Get(username),
-			requestId:    uuid.New().String(),
+			requestId:    id,
 			utils.Status: http.StatusText(http.StatusOK),
 		}
 
 		log.Info("Incoming request")
 		ctx := context.WithValue(r.Context(), utils.Logger, log)
-		ctx = context.WithValue(ctx, username, r.Header.Get(username))
+		ctx = context.WithValue(ctx, utils.RequestId, id)
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
@@ -2,6 +2,7 @@ package api_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -20,12 +21,50 @@ const (
 	testList   = "TestList"
 	testUser   = "TestUser"
 	testWriter = "Yosif"
+	testToken  = "test-access-token"
 	userId     = "userId"
 	listId     = "listId"
+
+	authorization = "Authorization"
+	bearerPrefix  = "Bearer "
 )
 
+var testUsersRights = map[string]int{
+	"Niki":  utils.Role[utils.Admin],
+	"Ivan":  utils.Role[utils.Writer],
+	"Miro":  utils.Role[utils.Reader],
+	"Yosif": utils.Role[utils.Writer],
+}
+
+func helperUserResolver() api.ResolverUser {
+	userResolver := &mocks.ResolverUser{}
+	userResolver.EXPECT().GetUserRights(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, username string) int {
+			rights, ok := testUsersRights[username]
+			if !ok {
+				return utils.Role[utils.Unknown]
+			}
+
+			return rights
+		}).
+		Maybe()
+
+	return userResolver
+}
+
+// helperRoleContext adds the global role of the user the way UserExistenceAuthentication does.
+func helperRoleContext(ctx context.Context, username string) context.Context {
+	rights, ok := testUsersRights[username]
+	if !ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, utils.UserRole, rights)
+}
+
 func TestUserExistenceAuthenticationMiddleware(t *testing.T) {
 	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, testWriter, r.Header.Get(userId))
 		w.WriteHeader(http.StatusOK)
 		_, err := w.Write([]byte("Success"))
 		require.NoError(t, err)
@@ -35,19 +74,64 @@ func TestUserExistenceAuthenticationMiddleware(t *testing.T) {
 
 	testCases := []struct {
 		name           string
+		authResolver   func() *mocks.ResolverAuth
 		headers        map[string]string
 		expectedStatus int
 	}{
 		{
-			name: "user exists",
+			name: "valid token of existing user",
+			authResolver: func() *mocks.ResolverAuth {
+				authResolver := &mocks.ResolverAuth{}
+				authResolver.EXPECT().AuthenticateToken(mock.Anything, testToken).Return(testWriter, nil).Once()
+				return authResolver
+			},
 			headers: map[string]string{
-				userId: testWriter,
+				authorization: bearerPrefix + testToken,
 			},
 			expectedStatus: http.StatusOK,
 		}, {
-			name: "user does not exist",
+			name: "valid token overrides spoofed user header",
+			authResolver: func() *mocks.ResolverAuth {
+				authResolver := &mocks.ResolverAuth{}
+				authResolver.EXPECT().AuthenticateToken(mock.Anything, testToken).Return(testWriter, nil).Once()
+				return authResolver
+			},
 			headers: map[string]string{
-				userId: testUser,
+				authorization: bearerPrefix + testToken,
+				userId:        "Niki",
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "valid token of non-existing user",
+			authResolver: func() *mocks.ResolverAuth {
+				authResolver := &mocks.ResolverAuth{}
+				authResolver.EXPECT().AuthenticateToken(mock.Anything, testToken).Return(testUser, nil).Once()
+				return authResolver
+			},
+			headers: map[string]string{
+				authorization: bearerPrefix + testToken,
+			},
+			expectedStatus: http.StatusUnauthorized,
+		}, {
+			name: "invalid token",
+			authResolver: func() *mocks.ResolverAuth {
+				authResolver := &mocks.ResolverAuth{}
+				authResolver.EXPECT().AuthenticateToken(mock.Anything, testToken).
+					Return("", errors.New("error invalid token: token is expired")).
+					Once()
+				return authResolver
+			},
+			headers: map[string]string{
+				authorization: bearerPrefix + testToken,
+			},
+			expectedStatus: http.StatusUnauthorized,
+		}, {
+			name: "missing token",
+			authResolver: func() *mocks.ResolverAuth {
+				return &mocks.ResolverAuth{}
+			},
+			headers: map[string]string{
+				userId: testWriter,
 			},
 			expectedStatus: http.StatusUnauthorized,
 		},
@@ -56,7 +140,9 @@ func TestUserExistenceAuthenticationMiddleware(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			var resolver api.ResolverList = &mocks.ResolverList{}
-			middleware := api.NewAuthenticationMiddleware(&resolver)
+			var authResolver api.ResolverAuth = testCase.authResolver()
+			userResolver := helperUserResolver()
+			middleware := api.NewAuthenticationMiddleware(&resolver, &userResolver, &authResolver)
 
 			handler := middleware.UserExistenceAuthentication(testHandler)
 
@@ -92,10 +178,11 @@ func TestCheckForReaderPermissions(t *testing.T) {
 		expectedStatus int
 	}{
 		{
-			name: "user is not admin but part of list",
+			name: "user is viewer of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsUserPartOfList(mock.Anything, utils.TestListId, "Miro").Return(true).Once()
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, "Miro").
+					Return(utils.ListRole[utils.Viewer]).Once()
 				return resolver
 			},
 			headers: map[string]string{
@@ -105,37 +192,121 @@ func TestCheckForReaderPermissions(t *testing.T) {
 			expectedStatus: http.StatusOK,
 		}, {
 			name: "user is admin but not part of list",
+			resolver: func() *mocks.ResolverList {
+				return &mocks.ResolverList{}
+			},
+			headers: map[string]string{
+				userId: "Niki",
+			},
+			inputParam:     utils.TestListId,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "user is not admin nor part of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsUserPartOfList(mock.Anything, utils.TestListId, "Niki").Return(false).Once()
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, testUser).
+					Return(utils.ListRole[utils.Unknown]).Once()
 				return resolver
 			},
 			headers: map[string]string{
-				userId: "Niki",
+				userId: testUser,
+			},
+			inputParam:     utils.TestListId,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var resolver api.ResolverList = testCase.resolver()
+			var userResolver api.ResolverUser = &mocks.ResolverUser{}
+			var authResolver api.ResolverAuth = &mocks.ResolverAuth{}
+			middleware := api.NewAuthenticationMiddleware(&resolver, &userResolver, &authResolver)
+
+			handler := middleware.CheckForReaderPermissions(testHandler)
+
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testCase.inputParam), nil)
+			req = req.WithContext(helperRoleContext(ctx, testCase.headers[userId]))
+			req = mux.SetURLVars(req, map[string]string{listId: testCase.inputParam.String()})
+			require.NoError(t, err)
+
+			for key, value := range testCase.headers {
+				req.Header.Set(key, value)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestCheckForWriterPermissions(t *testing.T) {
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte("Success"))
+		require.NoError(t, err)
+	})
+
+	ctx := context.WithValue(context.Background(), utils.Logger, logrus.New())
+
+	testCases := []struct {
+		name           string
+		resolver       func() *mocks.ResolverList
+		headers        map[string]string
+		inputParam     uuid.UUID
+		expectedStatus int
+	}{
+		{
+			name: "user is editor of list",
+			resolver: func() *mocks.ResolverList {
+				resolver := &mocks.ResolverList{}
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, "Miro").
+					Return(utils.ListRole[utils.Editor]).Once()
+				return resolver
+			},
+			headers: map[string]string{
+				userId: "Miro",
 			},
 			inputParam:     utils.TestListId,
 			expectedStatus: http.StatusOK,
 		}, {
-			name: "user is admin and part of list",
+			name: "user is manager of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsUserPartOfList(mock.Anything, utils.TestListId, "Niki").Return(true).Once()
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, "Ivan").
+					Return(utils.ListRole[utils.Manager]).Once()
 				return resolver
 			},
 			headers: map[string]string{
-				userId: "Niki",
+				userId: "Ivan",
 			},
 			inputParam:     utils.TestListId,
 			expectedStatus: http.StatusOK,
 		}, {
-			name: "user is not admin and nor part of list",
+			name: "user is viewer of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsUserPartOfList(mock.Anything, utils.TestListId, testUser).Return(false).Once()
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, "Ivan").
+					Return(utils.ListRole[utils.Viewer]).Once()
 				return resolver
 			},
 			headers: map[string]string{
-				userId: testUser,
+				userId: "Ivan",
+			},
+			inputParam:     utils.TestListId,
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name: "user is unknown",
+			resolver: func() *mocks.ResolverList {
+				resolver := &mocks.ResolverList{}
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, "").
+					Return(utils.ListRole[utils.Unknown]).Once()
+				return resolver
+			},
+			headers: map[string]string{
+				userId: "",
 			},
 			inputParam:     utils.TestListId,
 			expectedStatus: http.StatusForbidden,
@@ -145,12 +316,14 @@ func TestCheckForReaderPermissions(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			var resolver api.ResolverList = testCase.resolver()
-			middleware := api.NewAuthenticationMiddleware(&resolver)
+			var userResolver api.ResolverUser = &mocks.ResolverUser{}
+			var authResolver api.ResolverAuth = &mocks.ResolverAuth{}
+			middleware := api.NewAuthenticationMiddleware(&resolver, &userResolver, &authResolver)
 
-			handler := middleware.CheckForReaderPermissions(testHandler)
+			handler := middleware.CheckForWriterPermissions(testHandler)
 
 			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testCase.inputParam), nil)
-			req = req.WithContext(ctx)
+			req = req.WithContext(helperRoleContext(ctx, testCase.headers[userId]))
 			req = mux.SetURLVars(req, map[string]string{listId: testCase.inputParam.String()})
 			require.NoError(t, err)
 
@@ -166,7 +339,7 @@ func TestCheckForReaderPermissions(t *testing.T) {
 	}
 }
 
-func TestCheckForWriterPermissions(t *testing.T) {
+func TestCheckForManagerPermissions(t *testing.T) {
 	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, err := w.Write([]byte("Success"))
@@ -175,12 +348,6 @@ func TestCheckForWriterPermissions(t *testing.T) {
 
 	ctx := context.WithValue(context.Background(), utils.Logger, logrus.New())
 
-	customReq, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testList), nil)
-	customReq = mux.SetURLVars(customReq, map[string]string{"listName": testList})
-	require.NoError(t, err)
-
-	customReq.Header.Set(userId, testUser)
-
 	testCases := []struct {
 		name           string
 		resolver       func() *mocks.ResolverList
@@ -189,35 +356,51 @@ func TestCheckForWriterPermissions(t *testing.T) {
 		expectedStatus int
 	}{
 		{
-			name: "user is writer or above writer",
+			name: "user is manager of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, testUser).
+					Return(utils.ListRole[utils.Manager]).Once()
 				return resolver
 			},
 			headers: map[string]string{
-				userId: "Ivan",
+				userId: testUser,
 			},
 			inputParam:     utils.TestListId,
 			expectedStatus: http.StatusOK,
 		}, {
-			name: "user is reader",
+			name: "user is owner of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, testUser).
+					Return(utils.ListRole[utils.Owner]).Once()
 				return resolver
 			},
 			headers: map[string]string{
-				userId: "Miro",
+				userId: testUser,
 			},
 			inputParam:     utils.TestListId,
-			expectedStatus: http.StatusForbidden,
+			expectedStatus: http.StatusOK,
 		}, {
-			name: "user is unknown",
+			name: "user is admin",
+			resolver: func() *mocks.ResolverList {
+				return &mocks.ResolverList{}
+			},
+			headers: map[string]string{
+				userId: "Niki",
+			},
+			inputParam:     utils.TestListId,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "user is editor of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, testUser).
+					Return(utils.ListRole[utils.Editor]).Once()
 				return resolver
 			},
 			headers: map[string]string{
-				userId: "",
+				userId: testUser,
 			},
 			inputParam:     utils.TestListId,
 			expectedStatus: http.StatusForbidden,
@@ -227,12 +410,14 @@ func TestCheckForWriterPermissions(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			var resolver api.ResolverList = testCase.resolver()
-			middleware := api.NewAuthenticationMiddleware(&resolver)
+			var userResolver api.ResolverUser = &mocks.ResolverUser{}
+			var authResolver api.ResolverAuth = &mocks.ResolverAuth{}
+			middleware := api.NewAuthenticationMiddleware(&resolver, &userResolver, &authResolver)
 
-			handler := middleware.CheckForWriterPermissions(testHandler)
+			handler := middleware.CheckForManagerPermissions(testHandler)
 
 			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testCase.inputParam), nil)
-			req = req.WithContext(ctx)
+			req = req.WithContext(helperRoleContext(ctx, testCase.headers[userId]))
 			req = mux.SetURLVars(req, map[string]string{listId: testCase.inputParam.String()})
 			require.NoError(t, err)
 
@@ -268,7 +453,8 @@ func TestCheckForOwnerPermissions(t *testing.T) {
 			name: "user is owner",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsOwnerUserOwnerToListById(mock.Anything, utils.TestListId, testUser).Return(true).Once()
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, testUser).
+					Return(utils.ListRole[utils.Owner]).Once()
 				return resolver
 			},
 			headers: map[string]string{
@@ -279,9 +465,7 @@ func TestCheckForOwnerPermissions(t *testing.T) {
 		}, {
 			name: "user is admin",
 			resolver: func() *mocks.ResolverList {
-				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsOwnerUserOwnerToListById(mock.Anything, utils.TestListId, "Niki").Return(false).Once()
-				return resolver
+				return &mocks.ResolverList{}
 			},
 			headers: map[string]string{
 				userId: "Niki",
@@ -289,10 +473,11 @@ func TestCheckForOwnerPermissions(t *testing.T) {
 			inputParam:     utils.TestListId,
 			expectedStatus: http.StatusOK,
 		}, {
-			name: "user is not owner nor admin",
+			name: "user is manager but not owner",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsOwnerUserOwnerToListById(mock.Anything, utils.TestListId, testUser).Return(false).Once()
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, testUser).
+					Return(utils.ListRole[utils.Manager]).Once()
 				return resolver
 			},
 			headers: map[string]string{
@@ -306,12 +491,14 @@ func TestCheckForOwnerPermissions(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			var resolver api.ResolverList = testCase.resolver()
-			middleware := api.NewAuthenticationMiddleware(&resolver)
+			var userResolver api.ResolverUser = &mocks.ResolverUser{}
+			var authResolver api.ResolverAuth = &mocks.ResolverAuth{}
+			middleware := api.NewAuthenticationMiddleware(&resolver, &userResolver, &authResolver)
 
 			handler := middleware.CheckForOwnerPermissions(testHandler)
 
 			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testCase.inputParam), nil)
-			req = req.WithContext(ctx)
+			req = req.WithContext(helperRoleContext(ctx, testCase.headers[userId]))
 			req = mux.SetURLVars(req, map[string]string{listId: testCase.inputParam.String()})
 			require.NoError(t, err)
 
@@ -371,12 +558,14 @@ func TestCheckForAdminPermissions(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			var resolver api.ResolverList = testCase.resolver()
-			middleware := api.NewAuthenticationMiddleware(&resolver)
+			var userResolver api.ResolverUser = &mocks.ResolverUser{}
+			var authResolver api.ResolverAuth = &mocks.ResolverAuth{}
+			middleware := api.NewAuthenticationMiddleware(&resolver, &userResolver, &authResolver)
 
 			handler := middleware.CheckForAdminPermissions(testHandler)
 
 			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testCase.inputParam), nil)
-			req = req.WithContext(ctx)
+			req = req.WithContext(helperRoleContext(ctx, testCase.headers[userId]))
 			req = mux.SetURLVars(req, map[string]string{listId: testCase.inputParam.String()})
 			require.NoError(t, err)
 
@@ -412,7 +601,8 @@ func TestCheckForUserExistenceInList(t *testing.T) {
 			name: "user is part of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsUserPartOfList(mock.Anything, utils.TestListId, testUser).Return(true).Once()
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, testUser).
+					Return(utils.ListRole[utils.Viewer]).Once()
 				return resolver
 			},
 			headers: map[string]string{
@@ -424,7 +614,8 @@ func TestCheckForUserExistenceInList(t *testing.T) {
 			name: "user is not part of list",
 			resolver: func() *mocks.ResolverList {
 				resolver := &mocks.ResolverList{}
-				resolver.EXPECT().IsUserPartOfList(mock.Anything, utils.TestListId, testUser).Return(false).Once()
+				resolver.EXPECT().GetUserListRights(mock.Anything, utils.TestListId, testUser).
+					Return(utils.ListRole[utils.Unknown]).Once()
 				return resolver
 			},
 			headers: map[string]string{
@@ -438,12 +629,14 @@ func TestCheckForUserExistenceInList(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			var resolver api.ResolverList = testCase.resolver()
-			middleware := api.NewAuthenticationMiddleware(&resolver)
+			var userResolver api.ResolverUser = &mocks.ResolverUser{}
+			var authResolver api.ResolverAuth = &mocks.ResolverAuth{}
+			middleware := api.NewAuthenticationMiddleware(&resolver, &userResolver, &authResolver)
 
 			handler := middleware.CheckForUserExistenceInList(testHandler)
 
 			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/%s", testCase.inputParam), nil)
-			req = req.WithContext(ctx)
+			req = req.WithContext(helperRoleContext(ctx, testCase.headers[userId]))
 			req = mux.SetURLVars(req, map[string]string{listId: testCase.inputParam.String()})
 			require.NoError(t, err)
 
@@ -458,3 +651,63 @@ func TestCheckForUserExistenceInList(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckForListCreationPermissions(t *testing.T) {
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte("Success"))
+		require.NoError(t, err)
+	})
+
+	ctx := context.WithValue(context.Background(), utils.Logger, logrus.New())
+
+	testCases := []struct {
+		name           string
+		headers        map[string]string
+		expectedStatus int
+	}{
+		{
+			name: "user is writer",
+			headers: map[string]string{
+				userId: testWriter,
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "user is admin",
+			headers: map[string]string{
+				userId: "Niki",
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "user is reader",
+			headers: map[string]string{
+				userId: "Miro",
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var resolver api.ResolverList = &mocks.ResolverList{}
+			var userResolver api.ResolverUser = &mocks.ResolverUser{}
+			var authResolver api.ResolverAuth = &mocks.ResolverAuth{}
+			middleware := api.NewAuthenticationMiddleware(&resolver, &userResolver, &authResolver)
+
+			handler := middleware.CheckForListCreationPermissions(testHandler)
+
+			req, err := http.NewRequest(http.MethodPost, "/list", nil)
+			req = req.WithContext(helperRoleContext(ctx, testCase.headers[userId]))
+			require.NoError(t, err)
+
+			for key, value := range testCase.headers {
+				req.Header.Set(key, value)
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
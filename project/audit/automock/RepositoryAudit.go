@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepositoryAudit is an autogenerated mock type for the RepositoryAudit type
+type RepositoryAudit struct {
+	mock.Mock
+}
+
+type RepositoryAudit_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepositoryAudit) EXPECT() *RepositoryAudit_Expecter {
+	return &RepositoryAudit_Expecter{mock: &_m.Mock}
+}
+
+// AddEntry provides a mock function with given fields: ctx, entry
+func (_m *RepositoryAudit) AddEntry(ctx context.Context, entry structures.AuditEntity) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.AuditEntity) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryAudit_AddEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEntry'
+type RepositoryAudit_AddEntry_Call struct {
+	*mock.Call
+}
+
+// AddEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry structures.AuditEntity
+func (_e *RepositoryAudit_Expecter) AddEntry(ctx interface{}, entry interface{}) *RepositoryAudit_AddEntry_Call {
+	return &RepositoryAudit_AddEntry_Call{Call: _e.mock.On("AddEntry", ctx, entry)}
+}
+
+func (_c *RepositoryAudit_AddEntry_Call) Run(run func(ctx context.Context, entry structures.AuditEntity)) *RepositoryAudit_AddEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.AuditEntity))
+	})
+	return _c
+}
+
+func (_c *RepositoryAudit_AddEntry_Call) Return(_a0 error) *RepositoryAudit_AddEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryAudit_AddEntry_Call) RunAndReturn(run func(context.Context, structures.AuditEntity) error) *RepositoryAudit_AddEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEntries provides a mock function with given fields: ctx, query
+func (_m *RepositoryAudit) GetEntries(ctx context.Context, query structures.AuditQuery) (*structures.AuditPageModel, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntries")
+	}
+
+	var r0 *structures.AuditPageModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.AuditQuery) (*structures.AuditPageModel, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.AuditQuery) *structures.AuditPageModel); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.AuditPageModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.AuditQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryAudit_GetEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntries'
+type RepositoryAudit_GetEntries_Call struct {
+	*mock.Call
+}
+
+// GetEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query structures.AuditQuery
+func (_e *RepositoryAudit_Expecter) GetEntries(ctx interface{}, query interface{}) *RepositoryAudit_GetEntries_Call {
+	return &RepositoryAudit_GetEntries_Call{Call: _e.mock.On("GetEntries", ctx, query)}
+}
+
+func (_c *RepositoryAudit_GetEntries_Call) Run(run func(ctx context.Context, query structures.AuditQuery)) *RepositoryAudit_GetEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.AuditQuery))
+	})
+	return _c
+}
+
+func (_c *RepositoryAudit_GetEntries_Call) Return(_a0 *structures.AuditPageModel, _a1 error) *RepositoryAudit_GetEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryAudit_GetEntries_Call) RunAndReturn(run func(context.Context, structures.AuditQuery) (*structures.AuditPageModel, error)) *RepositoryAudit_GetEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepositoryAudit creates a new instance of RepositoryAudit. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepositoryAudit(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepositoryAudit {
+	mock := &RepositoryAudit{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
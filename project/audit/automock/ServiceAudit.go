@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceAudit is an autogenerated mock type for the ServiceAudit type
+type ServiceAudit struct {
+	mock.Mock
+}
+
+type ServiceAudit_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAudit) EXPECT() *ServiceAudit_Expecter {
+	return &ServiceAudit_Expecter{mock: &_m.Mock}
+}
+
+// GetEntries provides a mock function with given fields: ctx, query
+func (_m *ServiceAudit) GetEntries(ctx context.Context, query structures.AuditQuery) (*structures.AuditPageOutput, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntries")
+	}
+
+	var r0 *structures.AuditPageOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.AuditQuery) (*structures.AuditPageOutput, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.AuditQuery) *structures.AuditPageOutput); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.AuditPageOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.AuditQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAudit_GetEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntries'
+type ServiceAudit_GetEntries_Call struct {
+	*mock.Call
+}
+
+// GetEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query structures.AuditQuery
+func (_e *ServiceAudit_Expecter) GetEntries(ctx interface{}, query interface{}) *ServiceAudit_GetEntries_Call {
+	return &ServiceAudit_GetEntries_Call{Call: _e.mock.On("GetEntries", ctx, query)}
+}
+
+func (_c *ServiceAudit_GetEntries_Call) Run(run func(ctx context.Context, query structures.AuditQuery)) *ServiceAudit_GetEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.AuditQuery))
+	})
+	return _c
+}
+
+func (_c *ServiceAudit_GetEntries_Call) Return(_a0 *structures.AuditPageOutput, _a1 error) *ServiceAudit_GetEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAudit_GetEntries_Call) RunAndReturn(run func(context.Context, structures.AuditQuery) (*structures.AuditPageOutput, error)) *ServiceAudit_GetEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAudit creates a new instance of ServiceAudit. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAudit(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAudit {
+	mock := &ServiceAudit{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
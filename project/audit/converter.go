@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"project/structures"
+)
+
+type ServiceAuditConvertor struct{}
+
+func NewServiceAuditConvertor() *ServiceAuditConvertor {
+	return &ServiceAuditConvertor{}
+}
+
+func (s *ServiceAuditConvertor) ConvertAuditModelToOutput(auditModel *structures.AuditModel) *structures.AuditOutput {
+	auditOutput := structures.AuditOutput{
+		Id:         auditModel.Id,
+		Actor:      auditModel.Actor,
+		Action:     auditModel.Action,
+		EntityType: auditModel.EntityType,
+		EntityId:   auditModel.EntityId,
+		ListId:     auditModel.ListId,
+		TodoId:     auditModel.TodoId,
+		Before:     auditModel.Before,
+		After:      auditModel.After,
+		RequestId:  auditModel.RequestId,
+		CreatedAt:  auditModel.CreatedAt,
+	}
+
+	return &auditOutput
+}
+
+type RepositoryAuditConvertor struct{}
+
+func NewRepositoryAuditConvertor() *RepositoryAuditConvertor {
+	return &RepositoryAuditConvertor{}
+}
+
+func (r *RepositoryAuditConvertor) ConvertEntityToModel(entity structures.AuditEntity) structures.AuditModel {
+	return structures.AuditModel{
+		Id:         entity.Id,
+		Actor:      entity.Actor,
+		Action:     entity.Action,
+		EntityType: entity.EntityType,
+		EntityId:   entity.EntityId,
+		ListId:     entity.ListId,
+		TodoId:     entity.TodoId,
+		Before:     entity.Before,
+		After:      entity.After,
+		RequestId:  entity.RequestId,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
+
+func (r *RepositoryAuditConvertor) ConvertEntitiesToModels(entities []structures.AuditEntity) []structures.AuditModel {
+	models := make([]structures.AuditModel, len(entities))
+	for i, e := range entities {
+		models[i] = r.ConvertEntityToModel(e)
+	}
+
+	return models
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"project/memory"
+	"project/structures"
+	"project/utils"
+	"sort"
+)
+
+type MemoryRepositoryAudit struct {
+	store     *memory.Store
+	converter RepositoryAuditConvertor
+}
+
+func NewMemoryRepositoryAudit(store *memory.Store, convertor RepositoryAuditConvertor) *MemoryRepositoryAudit {
+	return &MemoryRepositoryAudit{store: store, converter: convertor}
+}
+
+func (r *MemoryRepositoryAudit) GetEntries(ctx context.Context, query structures.AuditQuery) (*structures.AuditPageModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := validateAuditQuery(query)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	var matching []structures.AuditEntity
+	r.store.Read(ctx, func() {
+		for _, entry := range r.store.AuditLog {
+			if matchesAuditQuery(entry, query) {
+				matching = append(matching, entry)
+			}
+		}
+	})
+	sort.Slice(matching, func(i, j int) bool {
+		return compareAuditToCursor(matching[i], auditCursor(matching[j])) < 0
+	})
+
+	var entities []structures.AuditEntity
+	for _, entry := range matching {
+		if query.After != nil && compareAuditToCursor(entry, *query.After) <= 0 {
+			continue
+		}
+		if query.Before != nil && compareAuditToCursor(entry, *query.Before) >= 0 {
+			continue
+		}
+		entities = append(entities, entry)
+	}
+
+	entities, pageInfo := utils.PageItems(query.PageQuery, entities, auditCursor)
+	pageInfo.TotalCount = len(matching)
+	return &structures.AuditPageModel{
+		Entries:  r.converter.ConvertEntitiesToModels(entities),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (r *MemoryRepositoryAudit) AddEntry(ctx context.Context, entry structures.AuditEntity) error {
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		memory.Append(r.store, &r.store.AuditLog, entry)
+		return nil
+	})
+}
@@ -0,0 +1,46 @@
+package audit
+
+import (
+	"bytes"
+	"project/structures"
+	"project/utils"
+	"strings"
+)
+
+// cursorTimeLayout keeps the microseconds the database stores, in a form that sorts like the time.
+const cursorTimeLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+func auditCursor(entity structures.AuditEntity) structures.Cursor {
+	return structures.Cursor{
+		SortBy:     utils.SortByCreatedAt,
+		Descending: true,
+		Value:      entity.CreatedAt.UTC().Format(cursorTimeLayout),
+		Id:         entity.Id,
+	}
+}
+
+// compareAuditToCursor orders the entry against the cursor the same way the database orders
+// the (created_at, id) pair, newest first.
+func compareAuditToCursor(entity structures.AuditEntity, cursor structures.Cursor) int {
+	result := strings.Compare(entity.CreatedAt.UTC().Format(cursorTimeLayout), cursor.Value)
+	if result == 0 {
+		result = bytes.Compare(entity.Id[:], cursor.Id[:])
+	}
+
+	return -result
+}
+
+func validateAuditQuery(query structures.AuditQuery) error {
+	return utils.ValidatePageQuery(query.PageQuery, utils.SortByCreatedAt, true)
+}
+
+func matchesAuditQuery(entity structures.AuditEntity, query structures.AuditQuery) bool {
+	if query.ListId != nil && entity.ListId != *query.ListId {
+		return false
+	}
+	if query.TodoId != nil && (entity.TodoId == nil || *entity.TodoId != *query.TodoId) {
+		return false
+	}
+
+	return query.Actor == "" || entity.Actor == query.Actor
+}
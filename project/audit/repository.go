@@ -0,0 +1,140 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"project/structures"
+	"project/uow"
+	"project/utils"
+	"strings"
+)
+
+var (
+	auditTable          = "audit_log"
+	auditTableId        = "id"
+	auditTableListId    = "list_id"
+	auditTableTodoId    = "todo_id"
+	auditTableActor     = "actor"
+	auditTableCreatedAt = "created_at"
+	auditColumns        = []string{"id", "actor", "action", "entity_type", "entity_id", "list_id", "todo_id", "before", "after", "request_id", "created_at"}
+)
+
+type DBRepositoryAudit struct {
+	db        *sqlx.DB
+	converter RepositoryAuditConvertor
+}
+
+func NewDBRepositoryAudit(db *sqlx.DB, convertor RepositoryAuditConvertor) *DBRepositoryAudit {
+	return &DBRepositoryAudit{db: db, converter: convertor}
+}
+
+func (r *DBRepositoryAudit) executor(ctx context.Context) uow.Executor {
+	return uow.GetExecutor(ctx, r.db)
+}
+
+func auditQueryConditions(query structures.AuditQuery) ([]string, []any) {
+	var conds []string
+	var args []any
+	if query.ListId != nil {
+		conds = append(conds, fmt.Sprintf(`%s = ?`, auditTableListId))
+		args = append(args, *query.ListId)
+	}
+	if query.TodoId != nil {
+		conds = append(conds, fmt.Sprintf(`%s = ?`, auditTableTodoId))
+		args = append(args, *query.TodoId)
+	}
+	if query.Actor != "" {
+		conds = append(conds, fmt.Sprintf(`%s = ?`, auditTableActor))
+		args = append(args, query.Actor)
+	}
+
+	return conds, args
+}
+
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(conds, " AND ")
+}
+
+// jsonValue stores a missing state as NULL instead of an empty document.
+func jsonValue(value []byte) any {
+	if value == nil {
+		return nil
+	}
+
+	return string(value)
+}
+
+func (r *DBRepositoryAudit) GetEntries(ctx context.Context, query structures.AuditQuery) (*structures.AuditPageModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := validateAuditQuery(query)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	conds, args := auditQueryConditions(query)
+	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s%s`, auditTableId, auditTable, whereClause(conds))
+	var totalCount int
+	err = r.executor(ctx).Get(&totalCount, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	pageConds, pageArgs, orderBy := utils.KeysetConditions(query.PageQuery, auditTableCreatedAt, auditTableId, true)
+	conds, args = append(conds, pageConds...), append(args, pageArgs...)
+	stmt = fmt.Sprintf(`SELECT %s FROM %s%s %s`, strings.Join(auditColumns, ", "), auditTable, whereClause(conds), orderBy)
+	if rowLimit := utils.RowLimit(query.PageQuery); rowLimit > 0 {
+		stmt += ` LIMIT ?`
+		args = append(args, rowLimit)
+	}
+	var entities []structures.AuditEntity
+	err = r.executor(ctx).Select(&entities, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	utils.ReverseLastPage(query.PageQuery, entities)
+
+	entities, pageInfo := utils.PageItems(query.PageQuery, entities, auditCursor)
+	pageInfo.TotalCount = totalCount
+	return &structures.AuditPageModel{
+		Entries:  r.converter.ConvertEntitiesToModels(entities),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (r *DBRepositoryAudit) AddEntry(ctx context.Context, entry structures.AuditEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, auditTable, strings.Join(auditColumns, ", "))
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, entry.Id, entry.Actor, entry.Action, entry.EntityType, entry.EntityId,
+		entry.ListId, entry.TodoId, jsonValue(entry.Before), jsonValue(entry.After), entry.RequestId, entry.CreatedAt)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affected != 1 {
+		err = errors.New(fmt.Sprintf("error creating audit entry for %s with id: %s", entry.EntityType, entry.EntityId))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
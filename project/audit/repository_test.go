@@ -0,0 +1,173 @@
+package audit_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"project/audit"
+	"project/structures"
+	"project/utils"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var auditColumns = []string{"id", "actor", "action", "entity_type", "entity_id", "list_id", "todo_id", "before", "after", "request_id", "created_at"}
+
+func requireMatchingError(t *testing.T, expectedErr, err error) {
+	if err != nil {
+		require.NotNil(t, expectedErr, err.Error())
+		result, regErr := regexp.MatchString(expectedErr.Error(), err.Error())
+		require.NoError(t, regErr)
+		require.True(t, result, err.Error())
+	} else if expectedErr != nil {
+		t.Error("Expected error but got nil")
+	}
+}
+
+func TestRepositoryGetEntries(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := audit.NewDBRepositoryAudit(db, *audit.NewRepositoryAuditConvertor())
+	ctx := utils.HelperGetContext()
+	createdAt := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	before := structures.Cursor{SortBy: utils.SortByCreatedAt, Descending: true, Value: "2026-01-02T09:00:00.000000Z", Id: utils.TestSubtaskId}
+	todoId := utils.TestTodoId
+
+	testCases := []struct {
+		name        string
+		inputQuery  structures.AuditQuery
+		mock        func()
+		expected    *structures.AuditPageModel
+		expectedErr error
+	}{
+		{
+			name:       "get the entries of a todo",
+			inputQuery: structures.AuditQuery{ListId: &utils.TestListId, TodoId: &todoId, PageQuery: structures.PageQuery{Limit: 1}},
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM audit_log WHERE list_id = \$1 AND todo_id = \$2`).
+					WithArgs(utils.TestListId, utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(1))
+				rows := sqlxmock.NewRows(auditColumns).
+					AddRow(utils.TestCommentId, utils.TestUsername, utils.AuditChangeStatus, utils.AuditEntityTodo, utils.TestTodoId.String(),
+						utils.TestListId, utils.TestTodoId, []byte(`{"status":"Assigned"}`), []byte(`{"status":"In Progress"}`), "request", createdAt)
+				mock.ExpectQuery(`SELECT id, actor, action, entity_type, entity_id, list_id, todo_id, before, after, request_id, created_at `+
+					`FROM audit_log WHERE list_id = \$1 AND todo_id = \$2 ORDER BY created_at DESC, id DESC LIMIT \$3`).
+					WithArgs(utils.TestListId, utils.TestTodoId, 2).
+					WillReturnRows(rows)
+			},
+			expected: &structures.AuditPageModel{
+				Entries: []structures.AuditModel{{
+					Id:         utils.TestCommentId,
+					Actor:      utils.TestUsername,
+					Action:     utils.AuditChangeStatus,
+					EntityType: utils.AuditEntityTodo,
+					EntityId:   utils.TestTodoId.String(),
+					ListId:     utils.TestListId,
+					TodoId:     &todoId,
+					Before:     []byte(`{"status":"Assigned"}`),
+					After:      []byte(`{"status":"In Progress"}`),
+					RequestId:  "request",
+					CreatedAt:  createdAt,
+				}},
+				PageInfo: structures.PageInfo{
+					TotalCount: 1,
+					StartCursor: &structures.Cursor{SortBy: utils.SortByCreatedAt, Descending: true,
+						Value: "2026-01-02T10:00:00.000000Z", Id: utils.TestCommentId},
+					EndCursor: &structures.Cursor{SortBy: utils.SortByCreatedAt, Descending: true,
+						Value: "2026-01-02T10:00:00.000000Z", Id: utils.TestCommentId},
+				},
+			},
+		}, {
+			name:       "get the last entries of the whole log",
+			inputQuery: structures.AuditQuery{PageQuery: structures.PageQuery{Last: 1, Before: &before}},
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM audit_log$`).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(`SELECT .+ FROM audit_log WHERE \(created_at, id\) > \(\$1, \$2\) ORDER BY created_at ASC, id ASC LIMIT \$3`).
+					WithArgs(before.Value, before.Id, 2).
+					WillReturnRows(sqlxmock.NewRows(auditColumns))
+			},
+			expected: &structures.AuditPageModel{
+				Entries:  []structures.AuditModel{},
+				PageInfo: structures.PageInfo{HasNextPage: true},
+			},
+		}, {
+			name:        "cursor of another order",
+			inputQuery:  structures.AuditQuery{PageQuery: structures.PageQuery{Before: &structures.Cursor{SortBy: utils.SortByName}}},
+			mock:        func() {},
+			expectedErr: errors.New(utils.InvalidCursorErrorMsg),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.GetEntries(ctx, testCase.inputQuery)
+			requireMatchingError(t, testCase.expectedErr, err)
+			require.Equal(t, testCase.expected, actual)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryAddEntry(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := audit.NewDBRepositoryAudit(db, *audit.NewRepositoryAuditConvertor())
+	ctx := utils.HelperGetContext()
+	entry := structures.AuditEntity{
+		Id:         utils.TestCommentId,
+		Actor:      utils.TestUsername,
+		Action:     utils.AuditCreateList,
+		EntityType: utils.AuditEntityList,
+		EntityId:   utils.TestListId.String(),
+		ListId:     utils.TestListId,
+		After:      []byte(`{"name":"TestListName"}`),
+		RequestId:  "request",
+		CreatedAt:  time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "add entry without the state before",
+			mock: func() {
+				mock.ExpectExec(`INSERT INTO audit_log\(id, actor, action, entity_type, entity_id, list_id, todo_id, before, after, request_id, created_at\) `+
+					`VALUES\(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)`).
+					WithArgs(entry.Id, entry.Actor, entry.Action, entry.EntityType, entry.EntityId, entry.ListId, nil, nil,
+						`{"name":"TestListName"}`, entry.RequestId, entry.CreatedAt).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+			},
+		}, {
+			name: "entry is not inserted",
+			mock: func() {
+				mock.ExpectExec(`INSERT INTO audit_log`).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+			expectedErr: errors.New("error creating audit entry for list with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := repo.AddEntry(ctx, entry)
+			requireMatchingError(t, testCase.expectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
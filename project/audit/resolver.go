@@ -0,0 +1,125 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/structures"
+	"project/utils"
+	"strings"
+)
+
+const (
+	listId = "listId"
+	todoId = "todoId"
+	userId = "userId"
+
+	listParam  = "list"
+	todoParam  = "todo"
+	actorParam = "actor"
+)
+
+//go:generate mockery --name ServiceAudit --output=automock --with-expecter=true
+type ServiceAudit interface {
+	GetEntries(ctx context.Context, query structures.AuditQuery) (*structures.AuditPageOutput, error)
+}
+
+type ResolverAudit struct {
+	service ServiceAudit
+}
+
+func NewResolverAudit(service ServiceAudit) *ResolverAudit {
+	return &ResolverAudit{
+		service: service,
+	}
+}
+
+func (r *ResolverAudit) GetListEntries(w http.ResponseWriter, req *http.Request) {
+	listId, err := utils.GetID(mux.Vars(req), listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	r.getEntries(w, req, structures.AuditQuery{ListId: listId}, fmt.Sprintf("list with id: %s", listId))
+}
+
+func (r *ResolverAudit) GetTodoEntries(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	r.getEntries(w, req, structures.AuditQuery{ListId: listId, TodoId: todoId}, fmt.Sprintf("todo with id: %s", todoId))
+}
+
+func (r *ResolverAudit) GetUserEntries(w http.ResponseWriter, req *http.Request) {
+	actor := mux.Vars(req)[userId]
+	r.getEntries(w, req, structures.AuditQuery{Actor: actor}, fmt.Sprintf("user %s", actor))
+}
+
+// GetAllEntries serves the admin view of the whole audit log, optionally narrowed by the
+// list, todo and actor query parameters.
+func (r *ResolverAudit) GetAllEntries(w http.ResponseWriter, req *http.Request) {
+	values := req.URL.Query()
+	query := structures.AuditQuery{Actor: values.Get(actorParam)}
+
+	var err error
+	if values.Has(listParam) {
+		query.ListId, err = utils.ValidateStringID(values.Get(listParam))
+	}
+	if err == nil && values.Has(todoParam) {
+		query.TodoId, err = utils.ValidateStringID(values.Get(todoParam))
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	r.getEntries(w, req, query, "all lists")
+}
+
+func (r *ResolverAudit) getEntries(w http.ResponseWriter, req *http.Request, query structures.AuditQuery, subject string) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	page, err := utils.ParsePageQuery(req.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+	query.PageQuery = *page
+
+	result, err := r.service.GetEntries(ctx, query)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.InvalidCursorErrorMsg) || strings.Contains(err.Error(), utils.InvalidPageErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to get audit log of %s", subject)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	utils.SetPageHeaders(w, result.PageInfoOutput)
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success getting audit log of %s", subject))
+	utils.ResponseHandling(req, w, result.Entries)
+}
@@ -0,0 +1,145 @@
+package audit_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"project/audit"
+	mocks "project/audit/automock"
+	"project/structures"
+	"project/utils"
+	"strings"
+	"testing"
+)
+
+func TestResolverGetTodoEntries(t *testing.T) {
+	todoId := utils.TestTodoId
+
+	testCases := []struct {
+		name            string
+		service         func() *mocks.ServiceAudit
+		inputQuery      string
+		expected        string
+		expectedStatus  int
+		expectedHeaders map[string]string
+	}{
+		{
+			name: "get the history of a todo",
+			service: func() *mocks.ServiceAudit {
+				service := &mocks.ServiceAudit{}
+				service.EXPECT().GetEntries(mock.Anything, structures.AuditQuery{
+					ListId:    &utils.TestListId,
+					TodoId:    &todoId,
+					PageQuery: structures.PageQuery{Limit: 1},
+				}).
+					Return(&structures.AuditPageOutput{
+						Entries: []structures.AuditOutput{{
+							Id:     utils.TestCommentId,
+							Actor:  utils.TestUsername,
+							Action: utils.AuditChangeStatus,
+							Before: []byte(`{"status":"Assigned"}`),
+						}},
+						PageInfoOutput: structures.PageInfoOutput{TotalCount: 2, HasNextPage: true},
+					}, nil).
+					Once()
+				return service
+			},
+			inputQuery:     "?limit=1",
+			expected:       `"before":{"status":"Assigned"},"after":null`,
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				utils.TotalCountHeader:  "2",
+				utils.HasNextPageHeader: "true",
+			},
+		}, {
+			name: "get the history with invalid page",
+			service: func() *mocks.ServiceAudit {
+				return nil
+			},
+			inputQuery:     "?limit=1&last=1",
+			expected:       utils.InvalidPageErrorMsg,
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "getting the history failed",
+			service: func() *mocks.ServiceAudit {
+				service := &mocks.ServiceAudit{}
+				service.EXPECT().GetEntries(mock.Anything, structures.AuditQuery{ListId: &utils.TestListId, TodoId: &todoId}).
+					Return(nil, errors.New("connection refused")).
+					Once()
+				return service
+			},
+			expected:       "failed to get audit log of todo",
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := audit.NewResolverAudit(testCase.service())
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet,
+				fmt.Sprintf("/todo/api/list/%s/todo/%s/audit%s", utils.TestListId, utils.TestTodoId, testCase.inputQuery), nil)
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{
+				"listId": utils.TestListId.String(),
+				"todoId": utils.TestTodoId.String(),
+			})
+
+			resolver.GetTodoEntries(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.True(t, strings.Contains(rr.Body.String(), testCase.expected), rr.Body.String())
+			for header, value := range testCase.expectedHeaders {
+				require.Equal(t, value, rr.Header().Get(header))
+			}
+		})
+	}
+}
+
+func TestResolverGetAllEntries(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceAudit
+		inputQuery     string
+		expectedStatus int
+	}{
+		{
+			name: "filter the whole log by list and actor",
+			service: func() *mocks.ServiceAudit {
+				service := &mocks.ServiceAudit{}
+				service.EXPECT().GetEntries(mock.Anything, structures.AuditQuery{ListId: &utils.TestListId, Actor: utils.TestUsername}).
+					Return(&structures.AuditPageOutput{}, nil).
+					Once()
+				return service
+			},
+			inputQuery:     fmt.Sprintf("?list=%s&actor=%s", utils.TestListId, utils.TestUsername),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "filter by invalid todo id",
+			service: func() *mocks.ServiceAudit {
+				return nil
+			},
+			inputQuery:     "?todo=invalid",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := audit.NewResolverAudit(testCase.service())
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, "/todo/api/audit"+testCase.inputQuery, nil)
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+
+			resolver.GetAllEntries(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"context"
+	"project/structures"
+	"project/utils"
+)
+
+//go:generate mockery --name RepositoryAudit --output=automock --with-expecter=true
+type RepositoryAudit interface {
+	GetEntries(ctx context.Context, query structures.AuditQuery) (*structures.AuditPageModel, error)
+	AddEntry(ctx context.Context, entry structures.AuditEntity) error
+}
+
+type ServiceAuditImpl struct {
+	repo      RepositoryAudit
+	convertor ServiceAuditConvertor
+}
+
+func NewServiceAudit(repo RepositoryAudit, convertor ServiceAuditConvertor) *ServiceAuditImpl {
+	return &ServiceAuditImpl{repo: repo, convertor: convertor}
+}
+
+func (s *ServiceAuditImpl) GetEntries(ctx context.Context, query structures.AuditQuery) (*structures.AuditPageOutput, error) {
+	auditPage, err := s.repo.GetEntries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]structures.AuditOutput, len(auditPage.Entries))
+	for i, model := range auditPage.Entries {
+		result[i] = *s.convertor.ConvertAuditModelToOutput(&model)
+	}
+
+	return &structures.AuditPageOutput{
+		Entries:        result,
+		PageInfoOutput: utils.ConvertPageInfoToOutput(auditPage.PageInfo),
+	}, nil
+}
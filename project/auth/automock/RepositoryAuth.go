@@ -0,0 +1,238 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RepositoryAuth is an autogenerated mock type for the RepositoryAuth type
+type RepositoryAuth struct {
+	mock.Mock
+}
+
+type RepositoryAuth_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepositoryAuth) EXPECT() *RepositoryAuth_Expecter {
+	return &RepositoryAuth_Expecter{mock: &_m.Mock}
+}
+
+// CreateSession provides a mock function with given fields: ctx, entity
+func (_m *RepositoryAuth) CreateSession(ctx context.Context, entity structures.SessionEntity) error {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SessionEntity) error); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryAuth_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type RepositoryAuth_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity structures.SessionEntity
+func (_e *RepositoryAuth_Expecter) CreateSession(ctx interface{}, entity interface{}) *RepositoryAuth_CreateSession_Call {
+	return &RepositoryAuth_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, entity)}
+}
+
+func (_c *RepositoryAuth_CreateSession_Call) Run(run func(ctx context.Context, entity structures.SessionEntity)) *RepositoryAuth_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.SessionEntity))
+	})
+	return _c
+}
+
+func (_c *RepositoryAuth_CreateSession_Call) Return(_a0 error) *RepositoryAuth_CreateSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryAuth_CreateSession_Call) RunAndReturn(run func(context.Context, structures.SessionEntity) error) *RepositoryAuth_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveUserPasswordHash provides a mock function with given fields: ctx, username
+func (_m *RepositoryAuth) GetActiveUserPasswordHash(ctx context.Context, username string) (string, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveUserPasswordHash")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryAuth_GetActiveUserPasswordHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveUserPasswordHash'
+type RepositoryAuth_GetActiveUserPasswordHash_Call struct {
+	*mock.Call
+}
+
+// GetActiveUserPasswordHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *RepositoryAuth_Expecter) GetActiveUserPasswordHash(ctx interface{}, username interface{}) *RepositoryAuth_GetActiveUserPasswordHash_Call {
+	return &RepositoryAuth_GetActiveUserPasswordHash_Call{Call: _e.mock.On("GetActiveUserPasswordHash", ctx, username)}
+}
+
+func (_c *RepositoryAuth_GetActiveUserPasswordHash_Call) Run(run func(ctx context.Context, username string)) *RepositoryAuth_GetActiveUserPasswordHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryAuth_GetActiveUserPasswordHash_Call) Return(_a0 string, _a1 error) *RepositoryAuth_GetActiveUserPasswordHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryAuth_GetActiveUserPasswordHash_Call) RunAndReturn(run func(context.Context, string) (string, error)) *RepositoryAuth_GetActiveUserPasswordHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsSessionActive provides a mock function with given fields: ctx, sessionId
+func (_m *RepositoryAuth) IsSessionActive(ctx context.Context, sessionId uuid.UUID) bool {
+	ret := _m.Called(ctx, sessionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSessionActive")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, sessionId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// RepositoryAuth_IsSessionActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSessionActive'
+type RepositoryAuth_IsSessionActive_Call struct {
+	*mock.Call
+}
+
+// IsSessionActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionId uuid.UUID
+func (_e *RepositoryAuth_Expecter) IsSessionActive(ctx interface{}, sessionId interface{}) *RepositoryAuth_IsSessionActive_Call {
+	return &RepositoryAuth_IsSessionActive_Call{Call: _e.mock.On("IsSessionActive", ctx, sessionId)}
+}
+
+func (_c *RepositoryAuth_IsSessionActive_Call) Run(run func(ctx context.Context, sessionId uuid.UUID)) *RepositoryAuth_IsSessionActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryAuth_IsSessionActive_Call) Return(_a0 bool) *RepositoryAuth_IsSessionActive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryAuth_IsSessionActive_Call) RunAndReturn(run func(context.Context, uuid.UUID) bool) *RepositoryAuth_IsSessionActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: ctx, sessionId, username
+func (_m *RepositoryAuth) RevokeSession(ctx context.Context, sessionId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, sessionId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, sessionId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryAuth_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type RepositoryAuth_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionId uuid.UUID
+//   - username string
+func (_e *RepositoryAuth_Expecter) RevokeSession(ctx interface{}, sessionId interface{}, username interface{}) *RepositoryAuth_RevokeSession_Call {
+	return &RepositoryAuth_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, sessionId, username)}
+}
+
+func (_c *RepositoryAuth_RevokeSession_Call) Run(run func(ctx context.Context, sessionId uuid.UUID, username string)) *RepositoryAuth_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryAuth_RevokeSession_Call) Return(_a0 error) *RepositoryAuth_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryAuth_RevokeSession_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *RepositoryAuth_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepositoryAuth creates a new instance of RepositoryAuth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepositoryAuth(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepositoryAuth {
+	mock := &RepositoryAuth{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
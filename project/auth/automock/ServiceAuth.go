@@ -0,0 +1,259 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceAuth is an autogenerated mock type for the ServiceAuth type
+type ServiceAuth struct {
+	mock.Mock
+}
+
+type ServiceAuth_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAuth) EXPECT() *ServiceAuth_Expecter {
+	return &ServiceAuth_Expecter{mock: &_m.Mock}
+}
+
+// AuthenticateToken provides a mock function with given fields: ctx, accessToken
+func (_m *ServiceAuth) AuthenticateToken(ctx context.Context, accessToken string) (string, error) {
+	ret := _m.Called(ctx, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, accessToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, accessToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAuth_AuthenticateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticateToken'
+type ServiceAuth_AuthenticateToken_Call struct {
+	*mock.Call
+}
+
+// AuthenticateToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+func (_e *ServiceAuth_Expecter) AuthenticateToken(ctx interface{}, accessToken interface{}) *ServiceAuth_AuthenticateToken_Call {
+	return &ServiceAuth_AuthenticateToken_Call{Call: _e.mock.On("AuthenticateToken", ctx, accessToken)}
+}
+
+func (_c *ServiceAuth_AuthenticateToken_Call) Run(run func(ctx context.Context, accessToken string)) *ServiceAuth_AuthenticateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAuth_AuthenticateToken_Call) Return(_a0 string, _a1 error) *ServiceAuth_AuthenticateToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAuth_AuthenticateToken_Call) RunAndReturn(run func(context.Context, string) (string, error)) *ServiceAuth_AuthenticateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, input
+func (_m *ServiceAuth) Login(ctx context.Context, input structures.LoginInput) (*structures.TokenOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *structures.TokenOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.LoginInput) (*structures.TokenOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.LoginInput) *structures.TokenOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.TokenOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.LoginInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAuth_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type ServiceAuth_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input structures.LoginInput
+func (_e *ServiceAuth_Expecter) Login(ctx interface{}, input interface{}) *ServiceAuth_Login_Call {
+	return &ServiceAuth_Login_Call{Call: _e.mock.On("Login", ctx, input)}
+}
+
+func (_c *ServiceAuth_Login_Call) Run(run func(ctx context.Context, input structures.LoginInput)) *ServiceAuth_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.LoginInput))
+	})
+	return _c
+}
+
+func (_c *ServiceAuth_Login_Call) Return(_a0 *structures.TokenOutput, _a1 error) *ServiceAuth_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAuth_Login_Call) RunAndReturn(run func(context.Context, structures.LoginInput) (*structures.TokenOutput, error)) *ServiceAuth_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *ServiceAuth) Refresh(ctx context.Context, refreshToken string) (*structures.TokenOutput, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *structures.TokenOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*structures.TokenOutput, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *structures.TokenOutput); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.TokenOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAuth_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type ServiceAuth_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *ServiceAuth_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *ServiceAuth_Refresh_Call {
+	return &ServiceAuth_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *ServiceAuth_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *ServiceAuth_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAuth_Refresh_Call) Return(_a0 *structures.TokenOutput, _a1 error) *ServiceAuth_Refresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAuth_Refresh_Call) RunAndReturn(run func(context.Context, string) (*structures.TokenOutput, error)) *ServiceAuth_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, token
+func (_m *ServiceAuth) Revoke(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAuth_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type ServiceAuth_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *ServiceAuth_Expecter) Revoke(ctx interface{}, token interface{}) *ServiceAuth_Revoke_Call {
+	return &ServiceAuth_Revoke_Call{Call: _e.mock.On("Revoke", ctx, token)}
+}
+
+func (_c *ServiceAuth_Revoke_Call) Run(run func(ctx context.Context, token string)) *ServiceAuth_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAuth_Revoke_Call) Return(_a0 error) *ServiceAuth_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAuth_Revoke_Call) RunAndReturn(run func(context.Context, string) error) *ServiceAuth_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAuth creates a new instance of ServiceAuth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAuth(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAuth {
+	mock := &ServiceAuth{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"project/memory"
+	"project/structures"
+	"project/utils"
+	"time"
+)
+
+type MemoryRepositoryAuth struct {
+	store *memory.Store
+}
+
+func NewMemoryRepositoryAuth(store *memory.Store) *MemoryRepositoryAuth {
+	return &MemoryRepositoryAuth{store: store}
+}
+
+func (r *MemoryRepositoryAuth) GetActiveUserPasswordHash(ctx context.Context, username string) (string, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var userEntity structures.UserAccountEntity
+	var ok bool
+	r.store.Read(ctx, func() {
+		userEntity, ok = r.store.Users[username]
+	})
+	if !ok || !userEntity.IsActive {
+		err := errors.New(fmt.Sprintf("error getting active user with username: %s", username))
+		log.Error(err)
+		return "", err
+	}
+
+	return userEntity.PasswordHash, nil
+}
+
+func (r *MemoryRepositoryAuth) CreateSession(ctx context.Context, entity structures.SessionEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		if _, ok := r.store.Users[entity.Username]; !ok {
+			err := errors.New(fmt.Sprintf("error not found user with username: %s", entity.Username))
+			log.Error(err)
+			return err
+		}
+
+		memory.Put(r.store, r.store.Sessions, entity.Id, entity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryAuth) IsSessionActive(ctx context.Context, sessionId uuid.UUID) bool {
+	var active bool
+	r.store.Read(ctx, func() {
+		session, ok := r.store.Sessions[sessionId]
+		if !ok || session.Revoked || !session.ExpiresAt.After(time.Now()) {
+			return
+		}
+
+		active = r.store.Users[session.Username].IsActive
+	})
+
+	return active
+}
+
+func (r *MemoryRepositoryAuth) RevokeSession(ctx context.Context, sessionId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		session, ok := r.store.Sessions[sessionId]
+		if !ok || session.Username != username {
+			err := errors.New(fmt.Sprintf("error not found session with id: %s", sessionId))
+			log.Error(err)
+			return err
+		}
+
+		session.Revoked = true
+		memory.Put(r.store, r.store.Sessions, sessionId, session)
+		return nil
+	})
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"project/structures"
+	"project/utils"
+	"strings"
+)
+
+var (
+	usersTable                = "users"
+	usersTableUsername        = "username"
+	usersTablePasswordHash    = "password_hash"
+	usersTableIsActive        = "is_active"
+	sessionsTable             = "user_sessions"
+	sessionsTableId           = "id"
+	sessionsTableUsername     = "username"
+	sessionsTableRevoked      = "revoked"
+	sessionsTableExpiresAt    = "expires_at"
+	insertSessionsColumns     = []string{"id", "username", "expires_at"}
+	activeSessionJoinUsername = "user_sessions.username = users.username"
+)
+
+type DBRepositoryAuth struct {
+	db *sqlx.DB
+}
+
+func NewDBRepositoryAuth(db *sqlx.DB) *DBRepositoryAuth {
+	return &DBRepositoryAuth{db: db}
+}
+
+func (r *DBRepositoryAuth) GetActiveUserPasswordHash(ctx context.Context, username string) (string, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = TRUE`, usersTableUsername, usersTableIsActive)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, usersTablePasswordHash, usersTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var passwordHash string
+	err := r.db.Get(&passwordHash, query, username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error getting active user with username: %s", username))
+		}
+
+		log.Error(err)
+		return "", err
+	}
+
+	return passwordHash, nil
+}
+
+func (r *DBRepositoryAuth) CreateSession(ctx context.Context, entity structures.SessionEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?)`, sessionsTable, strings.Join(insertSessionsColumns, ", "))
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := tx.Exec(query, entity.Id, entity.Username, entity.ExpiresAt)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error not found user with username: %s", entity.Username))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error creating session for user %s", entity.Username))
+		log.Error(err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(err)
+	}
+
+	return err
+}
+
+func (r *DBRepositoryAuth) IsSessionActive(ctx context.Context, sessionId uuid.UUID) bool {
+	cond := fmt.Sprintf(`%s.%s = ? AND %s.%s = FALSE AND %s.%s > NOW() AND %s.%s = TRUE`,
+		sessionsTable, sessionsTableId, sessionsTable, sessionsTableRevoked,
+		sessionsTable, sessionsTableExpiresAt, usersTable, usersTableIsActive)
+	stmt := fmt.Sprintf(`SELECT COUNT(%s.%s) FROM %s JOIN %s ON %s WHERE %s`,
+		sessionsTable, sessionsTableId, sessionsTable, usersTable, activeSessionJoinUsername, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var count int
+	err := r.db.Get(&count, query, sessionId)
+	if err != nil {
+		return false
+	}
+
+	return count == 1
+}
+
+func (r *DBRepositoryAuth) RevokeSession(ctx context.Context, sessionId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, sessionsTableId, sessionsTableUsername)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s = TRUE WHERE %s`, sessionsTable, sessionsTableRevoked, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := tx.Exec(query, sessionId, username)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found session with id: %s", sessionId))
+		log.Error(err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(err)
+	}
+
+	return err
+}
@@ -0,0 +1,234 @@
+package auth_test
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"project/auth"
+	"project/structures"
+	"project/utils"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var testSessionId = uuid.UUID{3}
+
+func TestRepositoryGetActiveUserPasswordHash(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := auth.NewDBRepositoryAuth(db)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expected    string
+		expectedErr error
+	}{
+		{
+			name: "active user",
+			mock: func() {
+				rows := sqlxmock.NewRows([]string{"password_hash"}).AddRow("hash")
+				mock.ExpectQuery(`SELECT password_hash FROM users WHERE username = \$1 AND is_active = TRUE`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(rows)
+			},
+			expected: "hash",
+		}, {
+			name: "deactivated or unknown user",
+			mock: func() {
+				mock.ExpectQuery(`SELECT password_hash FROM users WHERE username = \$1 AND is_active = TRUE`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(sqlxmock.NewRows([]string{"password_hash"}))
+			},
+			expectedErr: errors.New("error getting active user with username: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.GetActiveUserPasswordHash(ctx, utils.TestUsername)
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, ok)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRepositoryCreateSession(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := auth.NewDBRepositoryAuth(db)
+	ctx := utils.HelperGetContext()
+	session := structures.SessionEntity{
+		Id:        testSessionId,
+		Username:  utils.TestUsername,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "create new session",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO user_sessions\(id, username, expires_at\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(session.Id, session.Username, session.ExpiresAt).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "create session for non-existing user",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO user_sessions\(id, username, expires_at\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(session.Id, session.Username, session.ExpiresAt).
+					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found user with username: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := repo.CreateSession(ctx, session)
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, ok)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryIsSessionActive(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := auth.NewDBRepositoryAuth(db)
+	ctx := utils.HelperGetContext()
+	query := `SELECT COUNT\(user_sessions.id\) FROM user_sessions JOIN users ON user_sessions.username = users.username ` +
+		`WHERE user_sessions.id = \$1 AND user_sessions.revoked = FALSE AND user_sessions.expires_at > NOW\(\) AND users.is_active = TRUE`
+
+	testCases := []struct {
+		name     string
+		mock     func()
+		expected bool
+	}{
+		{
+			name: "active session",
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(testSessionId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			expected: true,
+		}, {
+			name: "expired, revoked or deactivated session",
+			mock: func() {
+				mock.ExpectQuery(query).
+					WithArgs(testSessionId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+			},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual := repo.IsSessionActive(ctx, testSessionId)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRepositoryRevokeSession(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := auth.NewDBRepositoryAuth(db)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "revoke existing session",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE user_sessions SET revoked = TRUE WHERE id = \$1 AND username = \$2`).
+					WithArgs(testSessionId, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "revoke non-existing session",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE user_sessions SET revoked = TRUE WHERE id = \$1 AND username = \$2`).
+					WithArgs(testSessionId, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found session with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := repo.RevokeSession(ctx, testSessionId, utils.TestUsername)
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, ok)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/structures"
+	"project/utils"
+	"strings"
+)
+
+const (
+	decodingCredentialsMsg = "failed to decode credentials"
+	decodingTokenMsg       = "failed to decode token"
+	missingCredentialsMsg  = "username and password are required"
+	missingTokenMsg        = "token is required"
+)
+
+//go:generate mockery --name ServiceAuth --output=automock --with-expecter=true
+type ServiceAuth interface {
+	Login(ctx context.Context, input structures.LoginInput) (*structures.TokenOutput, error)
+	Refresh(ctx context.Context, refreshToken string) (*structures.TokenOutput, error)
+	Revoke(ctx context.Context, token string) error
+	AuthenticateToken(ctx context.Context, accessToken string) (string, error)
+}
+
+type ResolverAuthImpl struct {
+	service ServiceAuth
+}
+
+func NewResolverAuth(service ServiceAuth) *ResolverAuthImpl {
+	return &ResolverAuthImpl{
+		service: service,
+	}
+}
+
+func (r *ResolverAuthImpl) decodeToken(w http.ResponseWriter, req *http.Request) (string, bool) {
+	var input structures.TokenInput
+	err := json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, decodingTokenMsg)
+		return "", false
+	}
+	if input.Token == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingTokenMsg)
+		return "", false
+	}
+
+	return input.Token, true
+}
+
+func (r *ResolverAuthImpl) Login(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var input structures.LoginInput
+	err := json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, decodingCredentialsMsg)
+		return
+	}
+	if input.Username == "" || input.Password == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingCredentialsMsg)
+		return
+	}
+
+	tokens, err := r.service.Login(ctx, input)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), invalidCredentialsMsg) {
+			w.WriteHeader(http.StatusUnauthorized)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = "failed to log in"
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success logging in user %s", input.Username))
+	utils.ResponseHandling(req, w, tokens)
+}
+
+func (r *ResolverAuthImpl) RefreshToken(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	refreshToken, ok := r.decodeToken(w, req)
+	if !ok {
+		return
+	}
+
+	tokens, err := r.service.Refresh(ctx, refreshToken)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), invalidTokenMsg) {
+			w.WriteHeader(http.StatusUnauthorized)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = "failed to refresh token"
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	utils.ResponseHandling(req, w, tokens)
+}
+
+func (r *ResolverAuthImpl) RevokeToken(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	token, ok := r.decodeToken(w, req)
+	if !ok {
+		return
+	}
+
+	err := r.service.Revoke(ctx, token)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), invalidTokenMsg) {
+			w.WriteHeader(http.StatusUnauthorized)
+		} else if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = "failed to revoke token"
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	msg := "success revoking token"
+	w.WriteHeader(http.StatusOK)
+	log.Info(msg)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverAuthImpl) AuthenticateToken(ctx context.Context, accessToken string) (string, error) {
+	return r.service.AuthenticateToken(ctx, accessToken)
+}
@@ -0,0 +1,196 @@
+package auth_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"project/auth"
+	mocks "project/auth/automock"
+	"project/structures"
+	"project/utils"
+	"testing"
+)
+
+func TestResolverLogin(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceAuth
+		inputBody      []byte
+		expectedStatus int
+	}{
+		{
+			name: "valid credentials",
+			service: func() *mocks.ServiceAuth {
+				srvMock := &mocks.ServiceAuth{}
+				srvMock.EXPECT().Login(mock.Anything, structures.LoginInput{Username: utils.TestUsername, Password: "secret"}).
+					Return(&structures.TokenOutput{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer"}, nil).
+					Once()
+				return srvMock
+			},
+			inputBody:      []byte(`{"username": "TestUser", "password": "secret"}`),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "invalid credentials",
+			service: func() *mocks.ServiceAuth {
+				srvMock := &mocks.ServiceAuth{}
+				srvMock.EXPECT().Login(mock.Anything, mock.Anything).
+					Return(nil, errors.New("error invalid credentials")).
+					Once()
+				return srvMock
+			},
+			inputBody:      []byte(`{"username": "TestUser", "password": "wrong"}`),
+			expectedStatus: http.StatusUnauthorized,
+		}, {
+			name: "missing password",
+			service: func() *mocks.ServiceAuth {
+				return &mocks.ServiceAuth{}
+			},
+			inputBody:      []byte(`{"username": "TestUser"}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "unexpected error",
+			service: func() *mocks.ServiceAuth {
+				srvMock := &mocks.ServiceAuth{}
+				srvMock.EXPECT().Login(mock.Anything, mock.Anything).
+					Return(nil, errors.New("connection refused")).
+					Once()
+				return srvMock
+			},
+			inputBody:      []byte(`{"username": "TestUser", "password": "secret"}`),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := auth.NewResolverAuth(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPost, "/todo/api/auth/login", bytes.NewReader(testCase.inputBody))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+
+			rr := httptest.NewRecorder()
+
+			resolver.Login(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverRefreshToken(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceAuth
+		inputBody      []byte
+		expectedStatus int
+	}{
+		{
+			name: "active refresh token",
+			service: func() *mocks.ServiceAuth {
+				srvMock := &mocks.ServiceAuth{}
+				srvMock.EXPECT().Refresh(mock.Anything, "refresh").
+					Return(&structures.TokenOutput{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer"}, nil).
+					Once()
+				return srvMock
+			},
+			inputBody:      []byte(`{"token": "refresh"}`),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "revoked refresh token",
+			service: func() *mocks.ServiceAuth {
+				srvMock := &mocks.ServiceAuth{}
+				srvMock.EXPECT().Refresh(mock.Anything, "refresh").
+					Return(nil, errors.New("error invalid token: session is expired or revoked")).
+					Once()
+				return srvMock
+			},
+			inputBody:      []byte(`{"token": "refresh"}`),
+			expectedStatus: http.StatusUnauthorized,
+		}, {
+			name: "missing token",
+			service: func() *mocks.ServiceAuth {
+				return &mocks.ServiceAuth{}
+			},
+			inputBody:      []byte(`{}`),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := auth.NewResolverAuth(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPost, "/todo/api/auth/refresh", bytes.NewReader(testCase.inputBody))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+
+			rr := httptest.NewRecorder()
+
+			resolver.RefreshToken(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverRevokeToken(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceAuth
+		inputBody      []byte
+		expectedStatus int
+	}{
+		{
+			name: "revoke active session",
+			service: func() *mocks.ServiceAuth {
+				srvMock := &mocks.ServiceAuth{}
+				srvMock.EXPECT().Revoke(mock.Anything, "refresh").Return(nil).Once()
+				return srvMock
+			},
+			inputBody:      []byte(`{"token": "refresh"}`),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "revoke with forged token",
+			service: func() *mocks.ServiceAuth {
+				srvMock := &mocks.ServiceAuth{}
+				srvMock.EXPECT().Revoke(mock.Anything, "refresh").
+					Return(errors.New("error invalid token: signature is invalid")).
+					Once()
+				return srvMock
+			},
+			inputBody:      []byte(`{"token": "refresh"}`),
+			expectedStatus: http.StatusUnauthorized,
+		}, {
+			name: "revoke non-existing session",
+			service: func() *mocks.ServiceAuth {
+				srvMock := &mocks.ServiceAuth{}
+				srvMock.EXPECT().Revoke(mock.Anything, "refresh").
+					Return(errors.New("error not found session with id: 1")).
+					Once()
+				return srvMock
+			},
+			inputBody:      []byte(`{"token": "refresh"}`),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := auth.NewResolverAuth(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPost, "/todo/api/auth/revoke", bytes.NewReader(testCase.inputBody))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+
+			rr := httptest.NewRecorder()
+
+			resolver.RevokeToken(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"project/structures"
+	"time"
+)
+
+const (
+	bearerTokenType       = "Bearer"
+	invalidCredentialsMsg = "error invalid credentials"
+	invalidTokenMsg       = "error invalid token"
+)
+
+//go:generate mockery --name RepositoryAuth --output=automock --with-expecter=true
+type RepositoryAuth interface {
+	GetActiveUserPasswordHash(ctx context.Context, username string) (string, error)
+	CreateSession(ctx context.Context, entity structures.SessionEntity) error
+	IsSessionActive(ctx context.Context, sessionId uuid.UUID) bool
+	RevokeSession(ctx context.Context, sessionId uuid.UUID, username string) error
+}
+
+type ServiceAuthImpl struct {
+	repo         RepositoryAuth
+	tokenManager TokenManager
+}
+
+func NewServiceAuth(repo RepositoryAuth, tokenManager TokenManager) *ServiceAuthImpl {
+	return &ServiceAuthImpl{repo: repo, tokenManager: tokenManager}
+}
+
+func (s *ServiceAuthImpl) Login(ctx context.Context, input structures.LoginInput) (*structures.TokenOutput, error) {
+	passwordHash, err := s.repo.GetActiveUserPasswordHash(ctx, input.Username)
+	if err != nil {
+		return nil, errors.New(invalidCredentialsMsg)
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(input.Password))
+	if err != nil {
+		return nil, errors.New(invalidCredentialsMsg)
+	}
+
+	session := structures.SessionEntity{
+		Id:        uuid.New(),
+		Username:  input.Username,
+		ExpiresAt: time.Now().Add(s.tokenManager.RefreshTokenTTL()),
+	}
+	err = s.repo.CreateSession(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := s.tokenManager.IssueToken(session.Username, session.Id, RefreshToken, session.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueAccessToken(session.Username, session.Id, refreshToken)
+}
+
+func (s *ServiceAuthImpl) Refresh(ctx context.Context, refreshToken string) (*structures.TokenOutput, error) {
+	claims, err := s.verifyActiveToken(ctx, refreshToken, RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueAccessToken(claims.Username, claims.SessionId, refreshToken)
+}
+
+func (s *ServiceAuthImpl) Revoke(ctx context.Context, token string) error {
+	claims, err := s.tokenManager.VerifyToken(token)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.RevokeSession(ctx, claims.SessionId, claims.Username)
+}
+
+func (s *ServiceAuthImpl) AuthenticateToken(ctx context.Context, accessToken string) (string, error) {
+	claims, err := s.verifyActiveToken(ctx, accessToken, AccessToken)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.Username, nil
+}
+
+func (s *ServiceAuthImpl) verifyActiveToken(ctx context.Context, token, tokenType string) (*structures.TokenClaimsModel, error) {
+	claims, err := s.tokenManager.VerifyToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != tokenType {
+		return nil, errors.New(fmt.Sprintf("%s: expected %s token", invalidTokenMsg, tokenType))
+	}
+	if !s.repo.IsSessionActive(ctx, claims.SessionId) {
+		return nil, errors.New(fmt.Sprintf("%s: session is expired or revoked", invalidTokenMsg))
+	}
+
+	return claims, nil
+}
+
+func (s *ServiceAuthImpl) issueAccessToken(username string, sessionId uuid.UUID, refreshToken string) (*structures.TokenOutput, error) {
+	expiresIn := s.tokenManager.AccessTokenTTL()
+	accessToken, err := s.tokenManager.IssueToken(username, sessionId, AccessToken, time.Now().Add(expiresIn))
+	if err != nil {
+		return nil, err
+	}
+
+	return &structures.TokenOutput{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    bearerTokenType,
+		ExpiresIn:    int64(expiresIn.Seconds()),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"project/structures"
+	"time"
+)
+
+const (
+	AccessToken  = "access"
+	RefreshToken = "refresh"
+	issuer       = "todo-app"
+)
+
+type tokenClaims struct {
+	SessionId string `json:"sid"`
+	TokenType string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+type TokenManager struct {
+	signingKey      []byte
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+}
+
+func NewTokenManager(signingKey []byte, accessTokenTTL, refreshTokenTTL time.Duration) *TokenManager {
+	return &TokenManager{
+		signingKey:      signingKey,
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+	}
+}
+
+func (tm *TokenManager) AccessTokenTTL() time.Duration {
+	return tm.accessTokenTTL
+}
+
+func (tm *TokenManager) RefreshTokenTTL() time.Duration {
+	return tm.refreshTokenTTL
+}
+
+func (tm *TokenManager) IssueToken(username string, sessionId uuid.UUID, tokenType string, expiresAt time.Time) (string, error) {
+	now := time.Now()
+	claims := tokenClaims{
+		SessionId: sessionId.String(),
+		TokenType: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Issuer:    issuer,
+			Subject:   username,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(tm.signingKey)
+}
+
+func (tm *TokenManager) VerifyToken(token string) (*structures.TokenClaimsModel, error) {
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		return tm.signingKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(issuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("error invalid token: %s", err))
+	}
+
+	sessionId, err := uuid.Parse(claims.SessionId)
+	if err != nil || claims.Subject == "" {
+		return nil, errors.New("error invalid token: malformed claims")
+	}
+
+	return &structures.TokenClaimsModel{
+		Username:  claims.Subject,
+		SessionId: sessionId,
+		TokenType: claims.TokenType,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
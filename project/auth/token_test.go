@@ -0,0 +1,66 @@
+package auth_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"project/auth"
+	"project/utils"
+	"testing"
+	"time"
+)
+
+func TestTokenManagerVerifyToken(t *testing.T) {
+	tokenManager := auth.NewTokenManager([]byte("test-signing-key"), time.Minute, time.Hour)
+
+	testCases := []struct {
+		name        string
+		token       func() string
+		expectedErr bool
+	}{
+		{
+			name: "valid access token",
+			token: func() string {
+				token, err := tokenManager.IssueToken(utils.TestUsername, testSessionId, auth.AccessToken, time.Now().Add(time.Minute))
+				require.NoError(t, err)
+				return token
+			},
+		}, {
+			name: "expired token",
+			token: func() string {
+				token, err := tokenManager.IssueToken(utils.TestUsername, testSessionId, auth.AccessToken, time.Now().Add(-time.Minute))
+				require.NoError(t, err)
+				return token
+			},
+			expectedErr: true,
+		}, {
+			name: "token signed with another key",
+			token: func() string {
+				otherManager := auth.NewTokenManager([]byte("other-signing-key"), time.Minute, time.Hour)
+				token, err := otherManager.IssueToken(utils.TestUsername, testSessionId, auth.AccessToken, time.Now().Add(time.Minute))
+				require.NoError(t, err)
+				return token
+			},
+			expectedErr: true,
+		}, {
+			name: "malformed token",
+			token: func() string {
+				return "not-a-token"
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			claims, err := tokenManager.VerifyToken(testCase.token())
+			if testCase.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, utils.TestUsername, claims.Username)
+			require.Equal(t, testSessionId, claims.SessionId)
+			require.Equal(t, auth.AccessToken, claims.TokenType)
+		})
+	}
+}
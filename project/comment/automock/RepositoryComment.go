@@ -0,0 +1,328 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RepositoryComment is an autogenerated mock type for the RepositoryComment type
+type RepositoryComment struct {
+	mock.Mock
+}
+
+type RepositoryComment_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepositoryComment) EXPECT() *RepositoryComment_Expecter {
+	return &RepositoryComment_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: ctx, newComment, listId
+func (_m *RepositoryComment) CreateComment(ctx context.Context, newComment structures.CommentEntity, listId uuid.UUID) error {
+	ret := _m.Called(ctx, newComment, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.CommentEntity, uuid.UUID) error); ok {
+		r0 = rf(ctx, newComment, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryComment_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type RepositoryComment_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newComment structures.CommentEntity
+//   - listId uuid.UUID
+func (_e *RepositoryComment_Expecter) CreateComment(ctx interface{}, newComment interface{}, listId interface{}) *RepositoryComment_CreateComment_Call {
+	return &RepositoryComment_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, newComment, listId)}
+}
+
+func (_c *RepositoryComment_CreateComment_Call) Run(run func(ctx context.Context, newComment structures.CommentEntity, listId uuid.UUID)) *RepositoryComment_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.CommentEntity), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryComment_CreateComment_Call) Return(_a0 error) *RepositoryComment_CreateComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryComment_CreateComment_Call) RunAndReturn(run func(context.Context, structures.CommentEntity, uuid.UUID) error) *RepositoryComment_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, commentId, todoId
+func (_m *RepositoryComment) DeleteComment(ctx context.Context, commentId uuid.UUID, todoId uuid.UUID) (*structures.CommentModel, error) {
+	ret := _m.Called(ctx, commentId, todoId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 *structures.CommentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*structures.CommentModel, error)); ok {
+		return rf(ctx, commentId, todoId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *structures.CommentModel); ok {
+		r0 = rf(ctx, commentId, todoId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.CommentModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, commentId, todoId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryComment_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type RepositoryComment_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentId uuid.UUID
+//   - todoId uuid.UUID
+func (_e *RepositoryComment_Expecter) DeleteComment(ctx interface{}, commentId interface{}, todoId interface{}) *RepositoryComment_DeleteComment_Call {
+	return &RepositoryComment_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, commentId, todoId)}
+}
+
+func (_c *RepositoryComment_DeleteComment_Call) Run(run func(ctx context.Context, commentId uuid.UUID, todoId uuid.UUID)) *RepositoryComment_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryComment_DeleteComment_Call) Return(_a0 *structures.CommentModel, _a1 error) *RepositoryComment_DeleteComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryComment_DeleteComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*structures.CommentModel, error)) *RepositoryComment_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComment provides a mock function with given fields: ctx, commentId, todoId, listId
+func (_m *RepositoryComment) GetComment(ctx context.Context, commentId uuid.UUID, todoId uuid.UUID, listId uuid.UUID) (*structures.CommentModel, error) {
+	ret := _m.Called(ctx, commentId, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComment")
+	}
+
+	var r0 *structures.CommentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*structures.CommentModel, error)); ok {
+		return rf(ctx, commentId, todoId, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) *structures.CommentModel); ok {
+		r0 = rf(ctx, commentId, todoId, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.CommentModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, commentId, todoId, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryComment_GetComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComment'
+type RepositoryComment_GetComment_Call struct {
+	*mock.Call
+}
+
+// GetComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentId uuid.UUID
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositoryComment_Expecter) GetComment(ctx interface{}, commentId interface{}, todoId interface{}, listId interface{}) *RepositoryComment_GetComment_Call {
+	return &RepositoryComment_GetComment_Call{Call: _e.mock.On("GetComment", ctx, commentId, todoId, listId)}
+}
+
+func (_c *RepositoryComment_GetComment_Call) Run(run func(ctx context.Context, commentId uuid.UUID, todoId uuid.UUID, listId uuid.UUID)) *RepositoryComment_GetComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryComment_GetComment_Call) Return(_a0 *structures.CommentModel, _a1 error) *RepositoryComment_GetComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryComment_GetComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*structures.CommentModel, error)) *RepositoryComment_GetComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComments provides a mock function with given fields: ctx, todoId, listId, page
+func (_m *RepositoryComment) GetComments(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, page structures.PageQuery) (*structures.CommentPageModel, error) {
+	ret := _m.Called(ctx, todoId, listId, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComments")
+	}
+
+	var r0 *structures.CommentPageModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, structures.PageQuery) (*structures.CommentPageModel, error)); ok {
+		return rf(ctx, todoId, listId, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, structures.PageQuery) *structures.CommentPageModel); ok {
+		r0 = rf(ctx, todoId, listId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.CommentPageModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, structures.PageQuery) error); ok {
+		r1 = rf(ctx, todoId, listId, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryComment_GetComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComments'
+type RepositoryComment_GetComments_Call struct {
+	*mock.Call
+}
+
+// GetComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - page structures.PageQuery
+func (_e *RepositoryComment_Expecter) GetComments(ctx interface{}, todoId interface{}, listId interface{}, page interface{}) *RepositoryComment_GetComments_Call {
+	return &RepositoryComment_GetComments_Call{Call: _e.mock.On("GetComments", ctx, todoId, listId, page)}
+}
+
+func (_c *RepositoryComment_GetComments_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, page structures.PageQuery)) *RepositoryComment_GetComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(structures.PageQuery))
+	})
+	return _c
+}
+
+func (_c *RepositoryComment_GetComments_Call) Return(_a0 *structures.CommentPageModel, _a1 error) *RepositoryComment_GetComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryComment_GetComments_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, structures.PageQuery) (*structures.CommentPageModel, error)) *RepositoryComment_GetComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function with given fields: ctx, updatedComment
+func (_m *RepositoryComment) UpdateComment(ctx context.Context, updatedComment structures.CommentEntity) (*structures.CommentModel, error) {
+	ret := _m.Called(ctx, updatedComment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 *structures.CommentModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.CommentEntity) (*structures.CommentModel, error)); ok {
+		return rf(ctx, updatedComment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.CommentEntity) *structures.CommentModel); ok {
+		r0 = rf(ctx, updatedComment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.CommentModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.CommentEntity) error); ok {
+		r1 = rf(ctx, updatedComment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryComment_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type RepositoryComment_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updatedComment structures.CommentEntity
+func (_e *RepositoryComment_Expecter) UpdateComment(ctx interface{}, updatedComment interface{}) *RepositoryComment_UpdateComment_Call {
+	return &RepositoryComment_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, updatedComment)}
+}
+
+func (_c *RepositoryComment_UpdateComment_Call) Run(run func(ctx context.Context, updatedComment structures.CommentEntity)) *RepositoryComment_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.CommentEntity))
+	})
+	return _c
+}
+
+func (_c *RepositoryComment_UpdateComment_Call) Return(_a0 *structures.CommentModel, _a1 error) *RepositoryComment_UpdateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryComment_UpdateComment_Call) RunAndReturn(run func(context.Context, structures.CommentEntity) (*structures.CommentModel, error)) *RepositoryComment_UpdateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepositoryComment creates a new instance of RepositoryComment. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepositoryComment(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepositoryComment {
+	mock := &RepositoryComment{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,289 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServiceComment is an autogenerated mock type for the ServiceComment type
+type ServiceComment struct {
+	mock.Mock
+}
+
+type ServiceComment_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceComment) EXPECT() *ServiceComment_Expecter {
+	return &ServiceComment_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: ctx, todoId, listId, author, input
+func (_m *ServiceComment) CreateComment(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, author string, input structures.CommentInput) (*structures.CommentOutput, error) {
+	ret := _m.Called(ctx, todoId, listId, author, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 *structures.CommentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, structures.CommentInput) (*structures.CommentOutput, error)); ok {
+		return rf(ctx, todoId, listId, author, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, structures.CommentInput) *structures.CommentOutput); ok {
+		r0 = rf(ctx, todoId, listId, author, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.CommentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, structures.CommentInput) error); ok {
+		r1 = rf(ctx, todoId, listId, author, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceComment_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type ServiceComment_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - author string
+//   - input structures.CommentInput
+func (_e *ServiceComment_Expecter) CreateComment(ctx interface{}, todoId interface{}, listId interface{}, author interface{}, input interface{}) *ServiceComment_CreateComment_Call {
+	return &ServiceComment_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, todoId, listId, author, input)}
+}
+
+func (_c *ServiceComment_CreateComment_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, author string, input structures.CommentInput)) *ServiceComment_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(structures.CommentInput))
+	})
+	return _c
+}
+
+func (_c *ServiceComment_CreateComment_Call) Return(_a0 *structures.CommentOutput, _a1 error) *ServiceComment_CreateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceComment_CreateComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, structures.CommentInput) (*structures.CommentOutput, error)) *ServiceComment_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, commentId, todoId, listId, username, isListOwner
+func (_m *ServiceComment) DeleteComment(ctx context.Context, commentId uuid.UUID, todoId uuid.UUID, listId uuid.UUID, username string, isListOwner bool) (*structures.CommentOutput, error) {
+	ret := _m.Called(ctx, commentId, todoId, listId, username, isListOwner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 *structures.CommentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, bool) (*structures.CommentOutput, error)); ok {
+		return rf(ctx, commentId, todoId, listId, username, isListOwner)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, bool) *structures.CommentOutput); ok {
+		r0 = rf(ctx, commentId, todoId, listId, username, isListOwner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.CommentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, bool) error); ok {
+		r1 = rf(ctx, commentId, todoId, listId, username, isListOwner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceComment_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type ServiceComment_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentId uuid.UUID
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+//   - isListOwner bool
+func (_e *ServiceComment_Expecter) DeleteComment(ctx interface{}, commentId interface{}, todoId interface{}, listId interface{}, username interface{}, isListOwner interface{}) *ServiceComment_DeleteComment_Call {
+	return &ServiceComment_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, commentId, todoId, listId, username, isListOwner)}
+}
+
+func (_c *ServiceComment_DeleteComment_Call) Run(run func(ctx context.Context, commentId uuid.UUID, todoId uuid.UUID, listId uuid.UUID, username string, isListOwner bool)) *ServiceComment_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *ServiceComment_DeleteComment_Call) Return(_a0 *structures.CommentOutput, _a1 error) *ServiceComment_DeleteComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceComment_DeleteComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, bool) (*structures.CommentOutput, error)) *ServiceComment_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComments provides a mock function with given fields: ctx, todoId, listId, page
+func (_m *ServiceComment) GetComments(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, page structures.PageQuery) (*structures.CommentPageOutput, error) {
+	ret := _m.Called(ctx, todoId, listId, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComments")
+	}
+
+	var r0 *structures.CommentPageOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, structures.PageQuery) (*structures.CommentPageOutput, error)); ok {
+		return rf(ctx, todoId, listId, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, structures.PageQuery) *structures.CommentPageOutput); ok {
+		r0 = rf(ctx, todoId, listId, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.CommentPageOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, structures.PageQuery) error); ok {
+		r1 = rf(ctx, todoId, listId, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceComment_GetComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComments'
+type ServiceComment_GetComments_Call struct {
+	*mock.Call
+}
+
+// GetComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - page structures.PageQuery
+func (_e *ServiceComment_Expecter) GetComments(ctx interface{}, todoId interface{}, listId interface{}, page interface{}) *ServiceComment_GetComments_Call {
+	return &ServiceComment_GetComments_Call{Call: _e.mock.On("GetComments", ctx, todoId, listId, page)}
+}
+
+func (_c *ServiceComment_GetComments_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, page structures.PageQuery)) *ServiceComment_GetComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(structures.PageQuery))
+	})
+	return _c
+}
+
+func (_c *ServiceComment_GetComments_Call) Return(_a0 *structures.CommentPageOutput, _a1 error) *ServiceComment_GetComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceComment_GetComments_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, structures.PageQuery) (*structures.CommentPageOutput, error)) *ServiceComment_GetComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function with given fields: ctx, commentId, todoId, listId, username, isListOwner, input
+func (_m *ServiceComment) UpdateComment(ctx context.Context, commentId uuid.UUID, todoId uuid.UUID, listId uuid.UUID, username string, isListOwner bool, input structures.CommentInput) (*structures.CommentOutput, error) {
+	ret := _m.Called(ctx, commentId, todoId, listId, username, isListOwner, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 *structures.CommentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, bool, structures.CommentInput) (*structures.CommentOutput, error)); ok {
+		return rf(ctx, commentId, todoId, listId, username, isListOwner, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, bool, structures.CommentInput) *structures.CommentOutput); ok {
+		r0 = rf(ctx, commentId, todoId, listId, username, isListOwner, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.CommentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, bool, structures.CommentInput) error); ok {
+		r1 = rf(ctx, commentId, todoId, listId, username, isListOwner, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceComment_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type ServiceComment_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentId uuid.UUID
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+//   - isListOwner bool
+//   - input structures.CommentInput
+func (_e *ServiceComment_Expecter) UpdateComment(ctx interface{}, commentId interface{}, todoId interface{}, listId interface{}, username interface{}, isListOwner interface{}, input interface{}) *ServiceComment_UpdateComment_Call {
+	return &ServiceComment_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, commentId, todoId, listId, username, isListOwner, input)}
+}
+
+func (_c *ServiceComment_UpdateComment_Call) Run(run func(ctx context.Context, commentId uuid.UUID, todoId uuid.UUID, listId uuid.UUID, username string, isListOwner bool, input structures.CommentInput)) *ServiceComment_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string), args[5].(bool), args[6].(structures.CommentInput))
+	})
+	return _c
+}
+
+func (_c *ServiceComment_UpdateComment_Call) Return(_a0 *structures.CommentOutput, _a1 error) *ServiceComment_UpdateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceComment_UpdateComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, bool, structures.CommentInput) (*structures.CommentOutput, error)) *ServiceComment_UpdateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceComment creates a new instance of ServiceComment. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceComment(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceComment {
+	mock := &ServiceComment{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,63 @@
+package comment
+
+import (
+	"project/structures"
+)
+
+type ServiceCommentConvertor struct{}
+
+func NewServiceCommentConvertor() *ServiceCommentConvertor {
+	return &ServiceCommentConvertor{}
+}
+
+func (s *ServiceCommentConvertor) ConvertCommentModelToOutput(commentModel *structures.CommentModel) *structures.CommentOutput {
+	commentOutput := structures.CommentOutput{
+		Id:        commentModel.Id,
+		TodoId:    commentModel.TodoId,
+		Author:    commentModel.Author,
+		Body:      commentModel.Body,
+		CreatedAt: commentModel.CreatedAt,
+		EditedAt:  commentModel.EditedAt,
+	}
+
+	return &commentOutput
+}
+
+func (s *ServiceCommentConvertor) ConvertCommentModelToEntity(commentModel *structures.CommentModel) *structures.CommentEntity {
+	commentEntity := structures.CommentEntity{
+		Id:        commentModel.Id,
+		TodoId:    commentModel.TodoId,
+		Author:    commentModel.Author,
+		Body:      commentModel.Body,
+		CreatedAt: commentModel.CreatedAt,
+		EditedAt:  commentModel.EditedAt,
+	}
+
+	return &commentEntity
+}
+
+type RepositoryCommentConvertor struct{}
+
+func NewRepositoryCommentConvertor() *RepositoryCommentConvertor {
+	return &RepositoryCommentConvertor{}
+}
+
+func (r *RepositoryCommentConvertor) ConvertEntityToModel(entity structures.CommentEntity) structures.CommentModel {
+	return structures.CommentModel{
+		Id:        entity.Id,
+		TodoId:    entity.TodoId,
+		Author:    entity.Author,
+		Body:      entity.Body,
+		CreatedAt: entity.CreatedAt,
+		EditedAt:  entity.EditedAt,
+	}
+}
+
+func (r *RepositoryCommentConvertor) ConvertEntitiesToModels(entities []structures.CommentEntity) []structures.CommentModel {
+	models := make([]structures.CommentModel, len(entities))
+	for i, e := range entities {
+		models[i] = r.ConvertEntityToModel(e)
+	}
+
+	return models
+}
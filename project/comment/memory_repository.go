@@ -0,0 +1,169 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"project/memory"
+	"project/structures"
+	"project/utils"
+	"sort"
+)
+
+type MemoryRepositoryComment struct {
+	store     *memory.Store
+	converter RepositoryCommentConvertor
+}
+
+func NewMemoryRepositoryComment(store *memory.Store, convertor RepositoryCommentConvertor) *MemoryRepositoryComment {
+	return &MemoryRepositoryComment{store: store, converter: convertor}
+}
+
+func (r *MemoryRepositoryComment) GetComments(ctx context.Context, todoId, listId uuid.UUID, page structures.PageQuery) (*structures.CommentPageModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := validateCommentPage(page)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	var comments []structures.CommentEntity
+	r.store.Read(ctx, func() {
+		err = r.findTodo(ctx, todoId, listId)
+		if err == nil {
+			comments = r.commentsOf(todoId)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []structures.CommentEntity
+	for _, commentEntity := range comments {
+		if page.After != nil && compareCommentToCursor(commentEntity, *page.After) <= 0 {
+			continue
+		}
+		if page.Before != nil && compareCommentToCursor(commentEntity, *page.Before) >= 0 {
+			continue
+		}
+		entities = append(entities, commentEntity)
+	}
+
+	entities, pageInfo := utils.PageItems(page, entities, commentCursor)
+	pageInfo.TotalCount = len(comments)
+	return &structures.CommentPageModel{
+		Comments: r.converter.ConvertEntitiesToModels(entities),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (r *MemoryRepositoryComment) GetComment(ctx context.Context, commentId, todoId, listId uuid.UUID) (*structures.CommentModel, error) {
+	var commentEntity *structures.CommentEntity
+	var err error
+	r.store.Read(ctx, func() {
+		err = r.findTodo(ctx, todoId, listId)
+		if err == nil {
+			commentEntity, err = r.findComment(ctx, commentId, todoId)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commentModel := r.converter.ConvertEntityToModel(*commentEntity)
+	return &commentModel, nil
+}
+
+func (r *MemoryRepositoryComment) CreateComment(ctx context.Context, newComment structures.CommentEntity, listId uuid.UUID) error {
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		err := r.findTodo(ctx, newComment.TodoId, listId)
+		if err != nil {
+			return err
+		}
+
+		memory.Put(r.store, r.store.Comments, newComment.Id, newComment)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryComment) UpdateComment(ctx context.Context, updatedComment structures.CommentEntity) (*structures.CommentModel, error) {
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		_, err := r.findComment(ctx, updatedComment.Id, updatedComment.TodoId)
+		if err != nil {
+			return err
+		}
+
+		memory.Put(r.store, r.store.Comments, updatedComment.Id, updatedComment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commentModel := r.converter.ConvertEntityToModel(updatedComment)
+	return &commentModel, nil
+}
+
+func (r *MemoryRepositoryComment) DeleteComment(ctx context.Context, commentId, todoId uuid.UUID) (*structures.CommentModel, error) {
+	var deletedComment *structures.CommentEntity
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deletedComment, err = r.findComment(ctx, commentId, todoId)
+		if err != nil {
+			return err
+		}
+
+		memory.Delete(r.store, r.store.Comments, commentId)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commentModel := r.converter.ConvertEntityToModel(*deletedComment)
+	return &commentModel, nil
+}
+
+func (r *MemoryRepositoryComment) findTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	todoEntity, ok := r.store.Todos[todoId]
+	if !ok || todoEntity.ListId != listId {
+		err := errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *MemoryRepositoryComment) findComment(ctx context.Context, commentId, todoId uuid.UUID) (*structures.CommentEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	commentEntity, ok := r.store.Comments[commentId]
+	if !ok || commentEntity.TodoId != todoId {
+		err := errors.New(fmt.Sprintf("error not found comment with id %s in todo with id: %s", commentId, todoId))
+		log.Error(err)
+		return nil, err
+	}
+
+	return &commentEntity, nil
+}
+
+// commentsOf returns the comments of the todo oldest first, in the order the database pages them.
+func (r *MemoryRepositoryComment) commentsOf(todoId uuid.UUID) []structures.CommentEntity {
+	var entities []structures.CommentEntity
+	for _, commentEntity := range r.store.Comments {
+		if commentEntity.TodoId == todoId {
+			entities = append(entities, commentEntity)
+		}
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		return compareCommentToCursor(entities[i], commentCursor(entities[j])) < 0
+	})
+
+	return entities
+}
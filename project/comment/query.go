@@ -0,0 +1,40 @@
+package comment
+
+import (
+	"bytes"
+	"project/structures"
+	"project/utils"
+	"strings"
+	"time"
+)
+
+// cursorTimeLayout keeps the microseconds the database stores, in a form that sorts like the time.
+const cursorTimeLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+func commentCursor(entity structures.CommentEntity) structures.Cursor {
+	return structures.Cursor{
+		SortBy: utils.SortByCreatedAt,
+		Value:  entity.CreatedAt.UTC().Format(cursorTimeLayout),
+		Id:     entity.Id,
+	}
+}
+
+// compareCommentToCursor orders the comment against the cursor the same way the database
+// orders the (created_at, id) pair.
+func compareCommentToCursor(entity structures.CommentEntity, cursor structures.Cursor) int {
+	result := strings.Compare(entity.CreatedAt.UTC().Format(cursorTimeLayout), cursor.Value)
+	if result == 0 {
+		result = bytes.Compare(entity.Id[:], cursor.Id[:])
+	}
+
+	return result
+}
+
+func validateCommentPage(page structures.PageQuery) error {
+	return utils.ValidatePageQuery(page, utils.SortByCreatedAt, false)
+}
+
+// commentTime is the current time at the precision the database keeps.
+func commentTime() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
@@ -0,0 +1,231 @@
+package comment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"project/structures"
+	"project/uow"
+	"project/utils"
+	"strings"
+)
+
+var (
+	commentTable            = "comment"
+	commentTableId          = "id"
+	commentTableTodoId      = "todo_id"
+	commentTableCreatedAt   = "created_at"
+	commentColumns          = []string{"id", "todo_id", "author", "body", "created_at", "edited_at"}
+	updateSetCommentColumns = []string{"body = ?", "edited_at = ?"}
+)
+
+type DBRepositoryComment struct {
+	db        *sqlx.DB
+	converter RepositoryCommentConvertor
+}
+
+func NewDBRepositoryComment(db *sqlx.DB, convertor RepositoryCommentConvertor) *DBRepositoryComment {
+	return &DBRepositoryComment{db: db, converter: convertor}
+}
+
+func (r *DBRepositoryComment) executor(ctx context.Context) uow.Executor {
+	return uow.GetExecutor(ctx, r.db)
+}
+
+func (r *DBRepositoryComment) checkTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := `SELECT COUNT(id) FROM todo WHERE id = ? AND list_id = ?`
+	var count int
+	err := r.executor(ctx).Get(&count, sqlx.Rebind(sqlx.DOLLAR, stmt), todoId, listId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if count == 0 {
+		err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositoryComment) getComment(ctx context.Context, commentId, todoId uuid.UUID) (*structures.CommentEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, commentTableId, commentTableTodoId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(commentColumns, ", "), commentTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var commentEntity structures.CommentEntity
+	err := r.executor(ctx).Get(&commentEntity, query, commentId, todoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found comment with id %s in todo with id: %s", commentId, todoId))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	return &commentEntity, nil
+}
+
+func (r *DBRepositoryComment) GetComments(ctx context.Context, todoId, listId uuid.UUID, page structures.PageQuery) (*structures.CommentPageModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := validateCommentPage(page)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	err = r.checkTodo(ctx, todoId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	conds := []string{fmt.Sprintf(`%s = ?`, commentTableTodoId)}
+	args := []any{todoId}
+	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s WHERE %s`, commentTableId, commentTable, strings.Join(conds, " AND "))
+	var totalCount int
+	err = r.executor(ctx).Get(&totalCount, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	pageConds, pageArgs, orderBy := utils.KeysetConditions(page, commentTableCreatedAt, commentTableId, false)
+	conds, args = append(conds, pageConds...), append(args, pageArgs...)
+	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s %s`, strings.Join(commentColumns, ", "), commentTable, strings.Join(conds, " AND "), orderBy)
+	if rowLimit := utils.RowLimit(page); rowLimit > 0 {
+		stmt += ` LIMIT ?`
+		args = append(args, rowLimit)
+	}
+	var entities []structures.CommentEntity
+	err = r.executor(ctx).Select(&entities, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	utils.ReverseLastPage(page, entities)
+
+	entities, pageInfo := utils.PageItems(page, entities, commentCursor)
+	pageInfo.TotalCount = totalCount
+	return &structures.CommentPageModel{
+		Comments: r.converter.ConvertEntitiesToModels(entities),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (r *DBRepositoryComment) GetComment(ctx context.Context, commentId, todoId, listId uuid.UUID) (*structures.CommentModel, error) {
+	err := r.checkTodo(ctx, todoId, listId)
+	if err != nil {
+		return nil, err
+	}
+	commentEntity, err := r.getComment(ctx, commentId, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	commentModel := r.converter.ConvertEntityToModel(*commentEntity)
+	return &commentModel, nil
+}
+
+func (r *DBRepositoryComment) CreateComment(ctx context.Context, newComment structures.CommentEntity, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.checkTodo(ctx, newComment.TodoId, listId)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES(?, ?, ?, ?, ?, ?)`, commentTable, strings.Join(commentColumns, ", "))
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, newComment.Id, newComment.TodoId, newComment.Author, newComment.Body,
+		newComment.CreatedAt, newComment.EditedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", newComment.TodoId, listId))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affected != 1 {
+		err = errors.New(fmt.Sprintf("error creating comment in todo with id: %s", newComment.TodoId))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositoryComment) UpdateComment(ctx context.Context, updatedComment structures.CommentEntity) (*structures.CommentModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, commentTableId, commentTableTodoId)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s WHERE %s`, commentTable, strings.Join(updateSetCommentColumns, ", "), cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, updatedComment.Body, updatedComment.EditedAt, updatedComment.Id, updatedComment.TodoId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found comment with id %s in todo with id: %s", updatedComment.Id, updatedComment.TodoId))
+		log.Error(err)
+		return nil, err
+	}
+
+	commentModel := r.converter.ConvertEntityToModel(updatedComment)
+	return &commentModel, nil
+}
+
+func (r *DBRepositoryComment) DeleteComment(ctx context.Context, commentId, todoId uuid.UUID) (*structures.CommentModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	deletedComment, err := r.getComment(ctx, commentId, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := fmt.Sprintf(`%s = ?`, commentTableId)
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, commentTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, commentId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error deleting comment with id: %s", commentId))
+		log.Error(err)
+		return nil, err
+	}
+
+	commentModel := r.converter.ConvertEntityToModel(*deletedComment)
+	return &commentModel, nil
+}
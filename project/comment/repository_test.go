@@ -0,0 +1,232 @@
+package comment_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"project/comment"
+	"project/structures"
+	"project/uow"
+	"project/utils"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var commentColumns = []string{"id", "todo_id", "author", "body", "created_at", "edited_at"}
+
+func expectCheckTodo(mock sqlxmock.Sqlmock, count int) {
+	mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE id = \$1 AND list_id = \$2`).
+		WithArgs(utils.TestTodoId, utils.TestListId).
+		WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+func requireMatchingError(t *testing.T, expectedErr, err error) {
+	if err != nil {
+		require.NotNil(t, expectedErr, err.Error())
+		result, regErr := regexp.MatchString(expectedErr.Error(), err.Error())
+		require.NoError(t, regErr)
+		require.True(t, result, err.Error())
+	} else if expectedErr != nil {
+		t.Error("Expected error but got nil")
+	}
+}
+
+func TestRepositoryGetComments(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := comment.NewDBRepositoryComment(db, *comment.NewRepositoryCommentConvertor())
+	ctx := utils.HelperGetContext()
+	createdAt := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	after := structures.Cursor{SortBy: utils.SortByCreatedAt, Value: "2026-01-02T09:00:00.000000Z", Id: utils.TestSubtaskId}
+
+	testCases := []struct {
+		name        string
+		inputPage   structures.PageQuery
+		mock        func()
+		expected    *structures.CommentPageModel
+		expectedErr error
+	}{
+		{
+			name:      "get the page after a cursor",
+			inputPage: structures.PageQuery{Limit: 1, After: &after},
+			mock: func() {
+				expectCheckTodo(mock, 1)
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM comment WHERE todo_id = \$1`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(3))
+				rows := sqlxmock.NewRows(commentColumns).
+					AddRow(utils.TestCommentId, utils.TestTodoId, utils.TestUsername, utils.TestCommentBody, createdAt, nil).
+					AddRow(utils.TestLabelId, utils.TestTodoId, utils.TestUsername, utils.TestCommentBody, createdAt, nil)
+				mock.ExpectQuery(`SELECT id, todo_id, author, body, created_at, edited_at FROM comment `+
+					`WHERE todo_id = \$1 AND \(created_at, id\) > \(\$2, \$3\) ORDER BY created_at ASC, id ASC LIMIT \$4`).
+					WithArgs(utils.TestTodoId, after.Value, after.Id, 2).
+					WillReturnRows(rows)
+			},
+			expected: &structures.CommentPageModel{
+				Comments: []structures.CommentModel{{
+					Id:        utils.TestCommentId,
+					TodoId:    utils.TestTodoId,
+					Author:    utils.TestUsername,
+					Body:      utils.TestCommentBody,
+					CreatedAt: createdAt,
+				}},
+				PageInfo: structures.PageInfo{
+					TotalCount:      3,
+					HasNextPage:     true,
+					HasPreviousPage: true,
+					StartCursor:     &structures.Cursor{SortBy: utils.SortByCreatedAt, Value: "2026-01-02T10:00:00.000000Z", Id: utils.TestCommentId},
+					EndCursor:       &structures.Cursor{SortBy: utils.SortByCreatedAt, Value: "2026-01-02T10:00:00.000000Z", Id: utils.TestCommentId},
+				},
+			},
+		}, {
+			name:      "todo is not in the list",
+			inputPage: structures.PageQuery{},
+			mock: func() {
+				expectCheckTodo(mock, 0)
+			},
+			expectedErr: errors.New("error not found todo with id .+ in the list with id: .+"),
+		}, {
+			name:        "page with limit and last",
+			inputPage:   structures.PageQuery{Limit: 1, Last: 1},
+			mock:        func() {},
+			expectedErr: errors.New("error .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.GetComments(ctx, utils.TestTodoId, utils.TestListId, testCase.inputPage)
+			requireMatchingError(t, testCase.expectedErr, err)
+			require.Equal(t, testCase.expected, actual)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryCreateComment(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := comment.NewDBRepositoryComment(db, *comment.NewRepositoryCommentConvertor())
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+	newComment := structures.CommentEntity{
+		Id:        utils.TestCommentId,
+		TodoId:    utils.TestTodoId,
+		Author:    utils.TestUsername,
+		Body:      utils.TestCommentBody,
+		CreatedAt: time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "create comment",
+			mock: func() {
+				mock.ExpectBegin()
+				expectCheckTodo(mock, 1)
+				mock.ExpectExec(`INSERT INTO comment\(id, todo_id, author, body, created_at, edited_at\) VALUES\(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+					WithArgs(newComment.Id, newComment.TodoId, newComment.Author, newComment.Body, newComment.CreatedAt, nil).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "create comment in not existing todo",
+			mock: func() {
+				mock.ExpectBegin()
+				expectCheckTodo(mock, 0)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found todo with id .+ in the list with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.CreateComment(ctx, newComment, utils.TestListId)
+			})
+			requireMatchingError(t, testCase.expectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryUpdateComment(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := comment.NewDBRepositoryComment(db, *comment.NewRepositoryCommentConvertor())
+	ctx := utils.HelperGetContext()
+	editedAt := time.Date(2026, 1, 2, 11, 0, 0, 0, time.UTC)
+	updatedComment := structures.CommentEntity{
+		Id:        utils.TestCommentId,
+		TodoId:    utils.TestTodoId,
+		Author:    utils.TestUsername,
+		Body:      utils.TestCommentBody,
+		CreatedAt: time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC),
+		EditedAt:  &editedAt,
+	}
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expected    *structures.CommentModel
+		expectedErr error
+	}{
+		{
+			name: "update comment",
+			mock: func() {
+				mock.ExpectExec(`UPDATE comment SET body = \$1, edited_at = \$2 WHERE id = \$3 AND todo_id = \$4`).
+					WithArgs(utils.TestCommentBody, &editedAt, utils.TestCommentId, utils.TestTodoId).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+			},
+			expected: &structures.CommentModel{
+				Id:        updatedComment.Id,
+				TodoId:    updatedComment.TodoId,
+				Author:    updatedComment.Author,
+				Body:      updatedComment.Body,
+				CreatedAt: updatedComment.CreatedAt,
+				EditedAt:  &editedAt,
+			},
+		}, {
+			name: "update not existing comment",
+			mock: func() {
+				mock.ExpectExec(`UPDATE comment SET body = \$1, edited_at = \$2 WHERE id = \$3 AND todo_id = \$4`).
+					WithArgs(utils.TestCommentBody, &editedAt, utils.TestCommentId, utils.TestTodoId).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+			expectedErr: errors.New("error not found comment with id .+ in todo with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.UpdateComment(ctx, updatedComment)
+			requireMatchingError(t, testCase.expectedErr, err)
+			require.Equal(t, testCase.expected, actual)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
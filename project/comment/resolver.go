@@ -0,0 +1,263 @@
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/structures"
+	"project/utils"
+	"strings"
+)
+
+const (
+	listId    = "listId"
+	todoId    = "todoId"
+	commentId = "commentId"
+	username  = "userId"
+
+	maxBodyLength = 2000
+)
+
+//go:generate mockery --name ServiceComment --output=automock --with-expecter=true
+type ServiceComment interface {
+	GetComments(ctx context.Context, todoId, listId uuid.UUID, page structures.PageQuery) (*structures.CommentPageOutput, error)
+	CreateComment(ctx context.Context, todoId, listId uuid.UUID, author string, input structures.CommentInput) (*structures.CommentOutput, error)
+	UpdateComment(ctx context.Context, commentId, todoId, listId uuid.UUID, username string, isListOwner bool, input structures.CommentInput) (*structures.CommentOutput, error)
+	DeleteComment(ctx context.Context, commentId, todoId, listId uuid.UUID, username string, isListOwner bool) (*structures.CommentOutput, error)
+}
+
+type ResolverComment struct {
+	service ServiceComment
+}
+
+func NewResolverComment(service ServiceComment) *ResolverComment {
+	return &ResolverComment{
+		service: service,
+	}
+}
+
+func (r *ResolverComment) validateComment(input structures.CommentInput) bool {
+	return strings.TrimSpace(input.Body) != "" && len(input.Body) <= maxBodyLength
+}
+
+func isListOwner(ctx context.Context) bool {
+	return utils.GetListRoleFromContext(ctx) >= utils.ListRole[utils.Owner]
+}
+
+// commentErrorStatus maps the errors shared by the comment writes to a response status.
+func commentErrorStatus(err error) int {
+	if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+		return http.StatusNotFound
+	} else if strings.Contains(err.Error(), utils.NotAuthorErrorMsg) {
+		return http.StatusForbidden
+	} else if strings.Contains(err.Error(), utils.CreateErrorMsg) ||
+		strings.Contains(err.Error(), utils.DeletingErrorMsg) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
+
+func (r *ResolverComment) GetComments(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	page, err := utils.ParsePageQuery(req.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	result, err := r.service.GetComments(ctx, *todoId, *listId, *page)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), utils.InvalidCursorErrorMsg) || strings.Contains(err.Error(), utils.InvalidPageErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to get comments of todo with id: %s", todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	utils.SetPageHeaders(w, result.PageInfoOutput)
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success getting comments of todo with id: %s", todoId))
+	utils.ResponseHandling(req, w, result.Comments)
+}
+
+func (r *ResolverComment) CreateComment(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var input structures.CommentInput
+	err := json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := "error decoding comment body"
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+	if !r.validateComment(input) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := "missing or too long comment body"
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	author := req.Header.Get(username)
+	newComment, err := r.service.CreateComment(ctx, *todoId, *listId, author, input)
+	if err != nil {
+		msg := err.Error()
+		status := commentErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to create comment in todo with id: %s", todoId)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	log.Info(fmt.Sprintf("success creating comment with id: %s", newComment.Id))
+	utils.ResponseHandling(req, w, newComment)
+}
+
+func (r *ResolverComment) UpdateComment(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	commentId, err := utils.GetID(vars, commentId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	var input structures.CommentInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := fmt.Sprintf("error decoding comment body with id: %s", commentId)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+	if !r.validateComment(input) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := "missing or too long comment body"
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	user := req.Header.Get(username)
+	updatedComment, err := r.service.UpdateComment(ctx, *commentId, *todoId, *listId, user, isListOwner(ctx), input)
+	if err != nil {
+		msg := err.Error()
+		status := commentErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to update comment with id: %s", commentId)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success updating comment with id: %s", commentId))
+	utils.ResponseHandling(req, w, updatedComment)
+}
+
+func (r *ResolverComment) DeleteComment(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	commentId, err := utils.GetID(vars, commentId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	user := req.Header.Get(username)
+	deletedComment, err := r.service.DeleteComment(ctx, *commentId, *todoId, *listId, user, isListOwner(ctx))
+	if err != nil {
+		msg := err.Error()
+		status := commentErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to delete comment with id: %s", commentId)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success deleting comment with id: %s", commentId))
+	utils.ResponseHandling(req, w, deletedComment)
+}
@@ -0,0 +1,273 @@
+package comment_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"project/comment"
+	mocks "project/comment/automock"
+	"project/structures"
+	"project/utils"
+	"strings"
+	"testing"
+	"time"
+)
+
+func helperCommentRequest(t *testing.T, ctx context.Context, method, query string, body []byte) *http.Request {
+	req, err := http.NewRequest(method, fmt.Sprintf("/todo/api/list/%s/todo/%s/comments%s", utils.TestListId, utils.TestTodoId, query),
+		bytes.NewReader(body))
+	require.NoError(t, err)
+	req = req.WithContext(ctx)
+	req.Header.Set("userId", utils.TestUsername)
+
+	return mux.SetURLVars(req, map[string]string{
+		"listId":    utils.TestListId.String(),
+		"todoId":    utils.TestTodoId.String(),
+		"commentId": utils.TestCommentId.String(),
+	})
+}
+
+func TestResolverGetComments(t *testing.T) {
+	cursor := structures.Cursor{SortBy: utils.SortByCreatedAt, Value: "2026-01-02T10:00:00.000000Z", Id: utils.TestCommentId}
+
+	testCases := []struct {
+		name            string
+		service         func() *mocks.ServiceComment
+		inputQuery      string
+		expected        string
+		expectedStatus  int
+		expectedHeaders map[string]string
+	}{
+		{
+			name: "get a page of comments",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().GetComments(mock.Anything, utils.TestTodoId, utils.TestListId, structures.PageQuery{Limit: 1, After: &cursor}).
+					Return(&structures.CommentPageOutput{
+						Comments: []structures.CommentOutput{{
+							Id:        utils.TestCommentId,
+							TodoId:    utils.TestTodoId,
+							Author:    utils.TestUsername,
+							Body:      utils.TestCommentBody,
+							CreatedAt: time.Date(2026, 1, 2, 11, 0, 0, 0, time.UTC),
+						}},
+						PageInfoOutput: structures.PageInfoOutput{TotalCount: 3, HasNextPage: true, HasPreviousPage: true},
+					}, nil).
+					Once()
+				return service
+			},
+			inputQuery:     "?limit=1&cursor=" + utils.EncodeCursor(cursor),
+			expected:       utils.TestCommentBody,
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				utils.TotalCountHeader:      "3",
+				utils.HasNextPageHeader:     "true",
+				utils.HasPreviousPageHeader: "true",
+			},
+		}, {
+			name: "get comments of not existing todo",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().GetComments(mock.Anything, utils.TestTodoId, utils.TestListId, structures.PageQuery{}).
+					Return(nil, errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", utils.TestTodoId, utils.TestListId))).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "get comments with invalid limit",
+			service: func() *mocks.ServiceComment {
+				return nil
+			},
+			inputQuery:     "?limit=0",
+			expected:       "error invalid limit",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := comment.NewResolverComment(testCase.service())
+			rr := httptest.NewRecorder()
+
+			resolver.GetComments(rr, helperCommentRequest(t, utils.HelperGetContext(), http.MethodGet, testCase.inputQuery, nil))
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.True(t, strings.Contains(rr.Body.String(), testCase.expected))
+			for header, value := range testCase.expectedHeaders {
+				require.Equal(t, value, rr.Header().Get(header))
+			}
+		})
+	}
+}
+
+func TestResolverCreateComment(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceComment
+		inputComment   []byte
+		expectedStatus int
+	}{
+		{
+			name: "create comment",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().CreateComment(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestUsername,
+					structures.CommentInput{Body: utils.TestCommentBody}).
+					Return(&structures.CommentOutput{
+						Id:     utils.TestCommentId,
+						TodoId: utils.TestTodoId,
+						Author: utils.TestUsername,
+						Body:   utils.TestCommentBody,
+					}, nil).
+					Once()
+				return service
+			},
+			inputComment:   []byte(fmt.Sprintf(`{"body": "%s"}`, utils.TestCommentBody)),
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "create comment without body",
+			service: func() *mocks.ServiceComment {
+				return nil
+			},
+			inputComment:   []byte(`{"body": "  "}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "create comment with too long body",
+			service: func() *mocks.ServiceComment {
+				return nil
+			},
+			inputComment:   []byte(fmt.Sprintf(`{"body": "%s"}`, strings.Repeat("a", 2001))),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "create comment in not existing todo",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().CreateComment(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestUsername,
+					structures.CommentInput{Body: utils.TestCommentBody}).
+					Return(nil, errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", utils.TestTodoId, utils.TestListId))).
+					Once()
+				return service
+			},
+			inputComment:   []byte(fmt.Sprintf(`{"body": "%s"}`, utils.TestCommentBody)),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := comment.NewResolverComment(testCase.service())
+			rr := httptest.NewRecorder()
+
+			resolver.CreateComment(rr, helperCommentRequest(t, utils.HelperGetContext(), http.MethodPost, "", testCase.inputComment))
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverUpdateComment(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceComment
+		expectedStatus int
+	}{
+		{
+			name: "author edits the comment",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().UpdateComment(mock.Anything, utils.TestCommentId, utils.TestTodoId, utils.TestListId, utils.TestUsername, false,
+					structures.CommentInput{Body: utils.TestCommentBody}).
+					Return(&structures.CommentOutput{Id: utils.TestCommentId, Body: utils.TestCommentBody}, nil).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "another member edits the comment",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().UpdateComment(mock.Anything, utils.TestCommentId, utils.TestTodoId, utils.TestListId, utils.TestUsername, false,
+					structures.CommentInput{Body: utils.TestCommentBody}).
+					Return(nil, errors.New(fmt.Sprintf("error %s %s with id: %s", utils.TestUsername, utils.NotAuthorErrorMsg, utils.TestCommentId))).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name: "edit not existing comment",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().UpdateComment(mock.Anything, utils.TestCommentId, utils.TestTodoId, utils.TestListId, utils.TestUsername, false,
+					structures.CommentInput{Body: utils.TestCommentBody}).
+					Return(nil, errors.New(fmt.Sprintf("error not found comment with id %s in todo with id: %s", utils.TestCommentId, utils.TestTodoId))).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := comment.NewResolverComment(testCase.service())
+			rr := httptest.NewRecorder()
+			body := []byte(fmt.Sprintf(`{"body": "%s"}`, utils.TestCommentBody))
+
+			resolver.UpdateComment(rr, helperCommentRequest(t, utils.HelperGetContext(), http.MethodPut, "", body))
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverDeleteComment(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceComment
+		listRole       string
+		expectedStatus int
+	}{
+		{
+			name: "list owner deletes the comment",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().DeleteComment(mock.Anything, utils.TestCommentId, utils.TestTodoId, utils.TestListId, utils.TestUsername, true).
+					Return(&structures.CommentOutput{Id: utils.TestCommentId}, nil).
+					Once()
+				return service
+			},
+			listRole:       utils.Owner,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "viewer deletes a comment of another member",
+			service: func() *mocks.ServiceComment {
+				service := &mocks.ServiceComment{}
+				service.EXPECT().DeleteComment(mock.Anything, utils.TestCommentId, utils.TestTodoId, utils.TestListId, utils.TestUsername, false).
+					Return(nil, errors.New(fmt.Sprintf("error %s %s with id: %s", utils.TestUsername, utils.NotAuthorErrorMsg, utils.TestCommentId))).
+					Once()
+				return service
+			},
+			listRole:       utils.Viewer,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := comment.NewResolverComment(testCase.service())
+			rr := httptest.NewRecorder()
+			ctx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[testCase.listRole])
+
+			resolver.DeleteComment(rr, helperCommentRequest(t, ctx, http.MethodDelete, "", nil))
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
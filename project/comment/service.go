@@ -0,0 +1,121 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"project/structures"
+	"project/uow"
+	"project/utils"
+)
+
+//go:generate mockery --name RepositoryComment --output=automock --with-expecter=true
+type RepositoryComment interface {
+	GetComments(ctx context.Context, todoId, listId uuid.UUID, page structures.PageQuery) (*structures.CommentPageModel, error)
+	GetComment(ctx context.Context, commentId, todoId, listId uuid.UUID) (*structures.CommentModel, error)
+	CreateComment(ctx context.Context, newComment structures.CommentEntity, listId uuid.UUID) error
+	UpdateComment(ctx context.Context, updatedComment structures.CommentEntity) (*structures.CommentModel, error)
+	DeleteComment(ctx context.Context, commentId, todoId uuid.UUID) (*structures.CommentModel, error)
+}
+
+type ServiceCommentImpl struct {
+	repo       RepositoryComment
+	convertor  ServiceCommentConvertor
+	unitOfWork uow.UnitOfWork
+}
+
+func NewServiceComment(repo RepositoryComment, convertor ServiceCommentConvertor, unitOfWork uow.UnitOfWork) *ServiceCommentImpl {
+	return &ServiceCommentImpl{repo: repo, convertor: convertor, unitOfWork: unitOfWork}
+}
+
+func (s *ServiceCommentImpl) GetComments(ctx context.Context, todoId, listId uuid.UUID, page structures.PageQuery) (*structures.CommentPageOutput, error) {
+	commentPage, err := s.repo.GetComments(ctx, todoId, listId, page)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]structures.CommentOutput, len(commentPage.Comments))
+	for i, model := range commentPage.Comments {
+		result[i] = *s.convertor.ConvertCommentModelToOutput(&model)
+	}
+
+	return &structures.CommentPageOutput{
+		Comments:       result,
+		PageInfoOutput: utils.ConvertPageInfoToOutput(commentPage.PageInfo),
+	}, nil
+}
+
+func (s *ServiceCommentImpl) CreateComment(ctx context.Context, todoId, listId uuid.UUID, author string, input structures.CommentInput) (*structures.CommentOutput, error) {
+	commentModel := structures.CommentModel{
+		Id:        uuid.New(),
+		TodoId:    todoId,
+		Author:    author,
+		Body:      input.Body,
+		CreatedAt: commentTime(),
+	}
+
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return s.repo.CreateComment(ctx, *s.convertor.ConvertCommentModelToEntity(&commentModel), listId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertor.ConvertCommentModelToOutput(&commentModel), nil
+}
+
+func (s *ServiceCommentImpl) UpdateComment(ctx context.Context, commentId, todoId, listId uuid.UUID, username string, isListOwner bool, input structures.CommentInput) (*structures.CommentOutput, error) {
+	var updatedComment *structures.CommentModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		commentModel, err := s.repo.GetComment(ctx, commentId, todoId, listId)
+		if err != nil {
+			return err
+		}
+		err = checkAuthor(commentModel, username, isListOwner)
+		if err != nil {
+			return err
+		}
+
+		editedAt := commentTime()
+		commentModel.Body, commentModel.EditedAt = input.Body, &editedAt
+		updatedComment, err = s.repo.UpdateComment(ctx, *s.convertor.ConvertCommentModelToEntity(commentModel))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertor.ConvertCommentModelToOutput(updatedComment), nil
+}
+
+func (s *ServiceCommentImpl) DeleteComment(ctx context.Context, commentId, todoId, listId uuid.UUID, username string, isListOwner bool) (*structures.CommentOutput, error) {
+	var deletedComment *structures.CommentModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		commentModel, err := s.repo.GetComment(ctx, commentId, todoId, listId)
+		if err != nil {
+			return err
+		}
+		err = checkAuthor(commentModel, username, isListOwner)
+		if err != nil {
+			return err
+		}
+
+		deletedComment, err = s.repo.DeleteComment(ctx, commentId, todoId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertor.ConvertCommentModelToOutput(deletedComment), nil
+}
+
+// checkAuthor lets only the author of the comment or the owner of its list change it.
+func checkAuthor(commentModel *structures.CommentModel, username string, isListOwner bool) error {
+	if commentModel.Author != username && !isListOwner {
+		return errors.New(fmt.Sprintf("error %s %s with id: %s", username, utils.NotAuthorErrorMsg, commentModel.Id))
+	}
+
+	return nil
+}
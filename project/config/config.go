@@ -0,0 +1,227 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+)
+
+type Config struct {
+	Storage StorageConfig
+	DB      DBConfig
+	REST    ServerConfig
+	GraphQL ServerConfig
+	Gateway GatewayConfig
+	Auth    AuthConfig
+	Log     LogConfig
+}
+
+type StorageConfig struct {
+	Backend string
+}
+
+type DBConfig struct {
+	Host            string
+	Port            int
+	User            string
+	Password        string
+	Name            string
+	SSLMode         string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	AutoMigrate     bool
+}
+
+type ServerConfig struct {
+	Port            int
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+}
+
+type GatewayConfig struct {
+	RestBaseUrl    string
+	RequestTimeout time.Duration
+}
+
+type AuthConfig struct {
+	SigningKey      string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+type LogConfig struct {
+	Level string
+}
+
+func Default() *Config {
+	return &Config{
+		Storage: StorageConfig{
+			Backend: StoragePostgres,
+		},
+		DB: DBConfig{
+			Host:            "localhost",
+			Port:            5433,
+			User:            "postgres",
+			Name:            "postgres",
+			SSLMode:         "disable",
+			MaxOpenConns:    25,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 30 * time.Minute,
+		},
+		REST: ServerConfig{
+			Port:            8080,
+			ReadTimeout:     15 * time.Second,
+			WriteTimeout:    15 * time.Second,
+			IdleTimeout:     60 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		GraphQL: ServerConfig{
+			Port:            8081,
+			ReadTimeout:     15 * time.Second,
+			WriteTimeout:    15 * time.Second,
+			IdleTimeout:     60 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Gateway: GatewayConfig{
+			RestBaseUrl:    "http://localhost:8080",
+			RequestTimeout: 10 * time.Second,
+		},
+		Auth: AuthConfig{
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 7 * 24 * time.Hour,
+		},
+		Log: LogConfig{
+			Level: logrus.InfoLevel.String(),
+		},
+	}
+}
+
+func (c *DBConfig) ConnectionString() string {
+	connectionUrl := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		Path:     c.Name,
+		RawQuery: url.Values{"sslmode": []string{c.SSLMode}}.Encode(),
+	}
+
+	return connectionUrl.String()
+}
+
+func (c *ServerConfig) Address() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+
+	switch c.Storage.Backend {
+	case StoragePostgres:
+		errs = append(errs, c.DB.validate()...)
+	case StorageMemory:
+	default:
+		errs = append(errs, errors.New(fmt.Sprintf("storage.backend must be %q or %q, got: %q", StoragePostgres, StorageMemory, c.Storage.Backend)))
+	}
+
+	errs = append(errs, validateServer("rest", c.REST)...)
+	errs = append(errs, validateServer("graphql", c.GraphQL)...)
+	if c.REST.Port != 0 && c.REST.Port == c.GraphQL.Port {
+		errs = append(errs, errors.New("rest.port and graphql.port must differ"))
+	}
+
+	baseUrl, err := url.Parse(c.Gateway.RestBaseUrl)
+	if err != nil || baseUrl.Scheme == "" || baseUrl.Host == "" {
+		errs = append(errs, errors.New(fmt.Sprintf("gateway.rest_base_url must be an absolute URL, got: %q", c.Gateway.RestBaseUrl)))
+	}
+	errs = append(errs, validatePositive("gateway.request_timeout", c.Gateway.RequestTimeout))
+
+	errs = append(errs, validatePositive("auth.access_token_ttl", c.Auth.AccessTokenTTL))
+	errs = append(errs, validatePositive("auth.refresh_token_ttl", c.Auth.RefreshTokenTTL))
+
+	_, err = logrus.ParseLevel(c.Log.Level)
+	if err != nil {
+		errs = append(errs, errors.New(fmt.Sprintf("log.level is invalid: %s", err)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *DBConfig) validate() []error {
+	var errs []error
+
+	if c.Host == "" {
+		errs = append(errs, errors.New("db.host is required"))
+	}
+	if c.User == "" {
+		errs = append(errs, errors.New("db.user is required"))
+	}
+	if c.Name == "" {
+		errs = append(errs, errors.New("db.name is required"))
+	}
+	errs = append(errs, validatePort("db.port", c.Port))
+	if c.MaxOpenConns < 0 {
+		errs = append(errs, errors.New("db.max_open_conns cannot be negative"))
+	}
+	if c.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("db.max_idle_conns cannot be negative"))
+	}
+	if c.MaxOpenConns > 0 && c.MaxIdleConns > c.MaxOpenConns {
+		errs = append(errs, errors.New("db.max_idle_conns cannot be greater than db.max_open_conns"))
+	}
+	if c.ConnMaxLifetime < 0 {
+		errs = append(errs, errors.New("db.conn_max_lifetime cannot be negative"))
+	}
+
+	return errs
+}
+
+func (c *AuthConfig) ValidateSigningKey() error {
+	if c.SigningKey == "" {
+		return errors.New("auth.signing_key must be set to sign authentication tokens")
+	}
+
+	return nil
+}
+
+func validateServer(prefix string, server ServerConfig) []error {
+	var portErr error
+	if server.Port != 0 {
+		portErr = validatePort(prefix+".port", server.Port)
+	}
+
+	return []error{
+		portErr,
+		validatePositive(prefix+".read_timeout", server.ReadTimeout),
+		validatePositive(prefix+".write_timeout", server.WriteTimeout),
+		validatePositive(prefix+".idle_timeout", server.IdleTimeout),
+		validatePositive(prefix+".shutdown_timeout", server.ShutdownTimeout),
+	}
+}
+
+func validatePort(key string, port int) error {
+	if port < 1 || port > 65535 {
+		return errors.New(fmt.Sprintf("%s must be between 1 and 65535, got: %d", key, port))
+	}
+
+	return nil
+}
+
+func validatePositive(key string, duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New(fmt.Sprintf("%s must be positive, got: %s", key, duration))
+	}
+
+	return nil
+}
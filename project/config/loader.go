@@ -0,0 +1,217 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	configFileEnv  = "CONFIG_FILE"
+	configFileFlag = "config"
+)
+
+type setting struct {
+	key   string
+	env   string
+	usage string
+	field func(cfg *Config) any
+}
+
+var settings = []setting{
+	{"storage.backend", "STORAGE_BACKEND", "storage backend, postgres or memory", func(c *Config) any { return &c.Storage.Backend }},
+	{"db.host", "DB_HOST", "database host", func(c *Config) any { return &c.DB.Host }},
+	{"db.port", "DB_PORT", "database port", func(c *Config) any { return &c.DB.Port }},
+	{"db.user", "DB_USER", "database user", func(c *Config) any { return &c.DB.User }},
+	{"db.password", "DB_PWD", "database password", func(c *Config) any { return &c.DB.Password }},
+	{"db.name", "DB_NAME", "database name", func(c *Config) any { return &c.DB.Name }},
+	{"db.sslmode", "DB_SSL_MODE", "database SSL mode", func(c *Config) any { return &c.DB.SSLMode }},
+	{"db.max_open_conns", "DB_MAX_OPEN_CONNS", "maximum open database connections, 0 for unlimited", func(c *Config) any { return &c.DB.MaxOpenConns }},
+	{"db.max_idle_conns", "DB_MAX_IDLE_CONNS", "maximum idle database connections", func(c *Config) any { return &c.DB.MaxIdleConns }},
+	{"db.conn_max_lifetime", "DB_CONN_MAX_LIFETIME", "maximum lifetime of a database connection, 0 for unlimited", func(c *Config) any { return &c.DB.ConnMaxLifetime }},
+	{"db.auto_migrate", "DB_AUTO_MIGRATE", "apply pending migrations on startup", func(c *Config) any { return &c.DB.AutoMigrate }},
+	{"rest.port", "REST_PORT", "REST server port, 0 picks a random port", func(c *Config) any { return &c.REST.Port }},
+	{"rest.read_timeout", "REST_READ_TIMEOUT", "REST server read timeout", func(c *Config) any { return &c.REST.ReadTimeout }},
+	{"rest.write_timeout", "REST_WRITE_TIMEOUT", "REST server write timeout", func(c *Config) any { return &c.REST.WriteTimeout }},
+	{"rest.idle_timeout", "REST_IDLE_TIMEOUT", "REST server idle timeout", func(c *Config) any { return &c.REST.IdleTimeout }},
+	{"rest.shutdown_timeout", "REST_SHUTDOWN_TIMEOUT", "time to drain in-flight REST requests on shutdown", func(c *Config) any { return &c.REST.ShutdownTimeout }},
+	{"graphql.port", "GRAPHQL_PORT", "GraphQL server port, 0 picks a random port", func(c *Config) any { return &c.GraphQL.Port }},
+	{"graphql.read_timeout", "GRAPHQL_READ_TIMEOUT", "GraphQL server read timeout", func(c *Config) any { return &c.GraphQL.ReadTimeout }},
+	{"graphql.write_timeout", "GRAPHQL_WRITE_TIMEOUT", "GraphQL server write timeout", func(c *Config) any { return &c.GraphQL.WriteTimeout }},
+	{"graphql.idle_timeout", "GRAPHQL_IDLE_TIMEOUT", "GraphQL server idle timeout", func(c *Config) any { return &c.GraphQL.IdleTimeout }},
+	{"graphql.shutdown_timeout", "GRAPHQL_SHUTDOWN_TIMEOUT", "time to drain in-flight GraphQL requests on shutdown", func(c *Config) any { return &c.GraphQL.ShutdownTimeout }},
+	{"gateway.rest_base_url", "GATEWAY_REST_BASE_URL", "base URL of the REST server used by the GraphQL gateway", func(c *Config) any { return &c.Gateway.RestBaseUrl }},
+	{"gateway.request_timeout", "GATEWAY_REQUEST_TIMEOUT", "timeout of requests from the GraphQL gateway to the REST server", func(c *Config) any { return &c.Gateway.RequestTimeout }},
+	{"auth.signing_key", "AUTH_SIGNING_KEY", "key used to sign authentication tokens", func(c *Config) any { return &c.Auth.SigningKey }},
+	{"auth.access_token_ttl", "AUTH_ACCESS_TOKEN_TTL", "lifetime of access tokens", func(c *Config) any { return &c.Auth.AccessTokenTTL }},
+	{"auth.refresh_token_ttl", "AUTH_REFRESH_TOKEN_TTL", "lifetime of refresh tokens", func(c *Config) any { return &c.Auth.RefreshTokenTTL }},
+	{"log.level", "LOG_LEVEL", "log level", func(c *Config) any { return &c.Log.Level }},
+}
+
+// Load builds the configuration from defaults, then the optional config file,
+// then environment variables and finally command line flags, each overriding the previous.
+func Load(flags *flag.FlagSet, args []string) (*Config, error) {
+	cfg := Default()
+
+	configFile := flags.String(configFileFlag, os.Getenv(configFileEnv), "path to a YAML or TOML config file")
+	flagValues := make(map[string]string)
+	for _, s := range settings {
+		key := s.key
+		flags.Func(flagName(key), fmt.Sprintf("%s (env %s)", s.usage, s.env), func(value string) error {
+			flagValues[key] = value
+			return nil
+		})
+	}
+
+	err := flags.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if *configFile != "" {
+		err = loadFile(cfg, *configFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range settings {
+		value, ok := os.LookupEnv(s.env)
+		if !ok {
+			continue
+		}
+
+		err = setValue(s.field(cfg), value)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid value of %s: %s", s.env, err))
+		}
+	}
+
+	for _, s := range settings {
+		value, ok := flagValues[s.key]
+		if !ok {
+			continue
+		}
+
+		err = setValue(s.field(cfg), value)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid value of -%s: %s", flagName(s.key), err))
+		}
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func loadFile(cfg *Config, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return errors.New(fmt.Sprintf("error reading config file: %s", err))
+	}
+
+	values := make(map[string]any)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &values)
+	case ".toml":
+		err = toml.Unmarshal(content, &values)
+	default:
+		return errors.New(fmt.Sprintf("error unsupported config file format: %s", path))
+	}
+	if err != nil {
+		return errors.New(fmt.Sprintf("error parsing config file %s: %s", path, err))
+	}
+
+	flatValues := make(map[string]string)
+	flatten("", values, flatValues)
+
+	var unknownKeys []string
+	for key := range flatValues {
+		if findSetting(key) == nil {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+	if len(unknownKeys) > 0 {
+		sort.Strings(unknownKeys)
+		return errors.New(fmt.Sprintf("error unknown keys in config file %s: %s", path, strings.Join(unknownKeys, ", ")))
+	}
+
+	for key, value := range flatValues {
+		err = setValue(findSetting(key).field(cfg), value)
+		if err != nil {
+			return errors.New(fmt.Sprintf("invalid value of %s in config file: %s", key, err))
+		}
+	}
+
+	return nil
+}
+
+func flatten(prefix string, values map[string]any, result map[string]string) {
+	for key, value := range values {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			flatten(key, nested, result)
+			continue
+		}
+
+		result[key] = fmt.Sprint(value)
+	}
+}
+
+func findSetting(key string) *setting {
+	for i := range settings {
+		if settings[i].key == key {
+			return &settings[i]
+		}
+	}
+
+	return nil
+}
+
+func flagName(key string) string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(key)
+}
+
+func setValue(field any, value string) error {
+	switch target := field.(type) {
+	case *string:
+		*target = value
+	case *int:
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return errors.New(fmt.Sprintf("expected an integer, got: %q", value))
+		}
+		*target = parsed
+	case *bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return errors.New(fmt.Sprintf("expected a boolean, got: %q", value))
+		}
+		*target = parsed
+	case *time.Duration:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return errors.New(fmt.Sprintf("expected a duration such as 30s or 5m, got: %q", value))
+		}
+		*target = parsed
+	default:
+		return errors.New(fmt.Sprintf("unsupported config field type %T", field))
+	}
+
+	return nil
+}
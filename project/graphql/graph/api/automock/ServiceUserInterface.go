@@ -0,0 +1,155 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceUserInterface is an autogenerated mock type for the ServiceUserInterface type
+type ServiceUserInterface struct {
+	mock.Mock
+}
+
+type ServiceUserInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceUserInterface) EXPECT() *ServiceUserInterface_Expecter {
+	return &ServiceUserInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetCurrentUser provides a mock function with given fields: ctx, requestToken
+func (_m *ServiceUserInterface) GetCurrentUser(ctx context.Context, requestToken string) (*structures.UserAccountOutput, error) {
+	ret := _m.Called(ctx, requestToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentUser")
+	}
+
+	var r0 *structures.UserAccountOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*structures.UserAccountOutput, error)); ok {
+		return rf(ctx, requestToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *structures.UserAccountOutput); ok {
+		r0 = rf(ctx, requestToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUserInterface_GetCurrentUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentUser'
+type ServiceUserInterface_GetCurrentUser_Call struct {
+	*mock.Call
+}
+
+// GetCurrentUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestToken string
+func (_e *ServiceUserInterface_Expecter) GetCurrentUser(ctx interface{}, requestToken interface{}) *ServiceUserInterface_GetCurrentUser_Call {
+	return &ServiceUserInterface_GetCurrentUser_Call{Call: _e.mock.On("GetCurrentUser", ctx, requestToken)}
+}
+
+func (_c *ServiceUserInterface_GetCurrentUser_Call) Run(run func(ctx context.Context, requestToken string)) *ServiceUserInterface_GetCurrentUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUserInterface_GetCurrentUser_Call) Return(_a0 *structures.UserAccountOutput, _a1 error) *ServiceUserInterface_GetCurrentUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUserInterface_GetCurrentUser_Call) RunAndReturn(run func(context.Context, string) (*structures.UserAccountOutput, error)) *ServiceUserInterface_GetCurrentUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrentUserLists provides a mock function with given fields: ctx, requestToken
+func (_m *ServiceUserInterface) GetCurrentUserLists(ctx context.Context, requestToken string) ([]*structures.UserOutput, error) {
+	ret := _m.Called(ctx, requestToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentUserLists")
+	}
+
+	var r0 []*structures.UserOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*structures.UserOutput, error)); ok {
+		return rf(ctx, requestToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*structures.UserOutput); ok {
+		r0 = rf(ctx, requestToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*structures.UserOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUserInterface_GetCurrentUserLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentUserLists'
+type ServiceUserInterface_GetCurrentUserLists_Call struct {
+	*mock.Call
+}
+
+// GetCurrentUserLists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestToken string
+func (_e *ServiceUserInterface_Expecter) GetCurrentUserLists(ctx interface{}, requestToken interface{}) *ServiceUserInterface_GetCurrentUserLists_Call {
+	return &ServiceUserInterface_GetCurrentUserLists_Call{Call: _e.mock.On("GetCurrentUserLists", ctx, requestToken)}
+}
+
+func (_c *ServiceUserInterface_GetCurrentUserLists_Call) Run(run func(ctx context.Context, requestToken string)) *ServiceUserInterface_GetCurrentUserLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUserInterface_GetCurrentUserLists_Call) Return(_a0 []*structures.UserOutput, _a1 error) *ServiceUserInterface_GetCurrentUserLists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUserInterface_GetCurrentUserLists_Call) RunAndReturn(run func(context.Context, string) ([]*structures.UserOutput, error)) *ServiceUserInterface_GetCurrentUserLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceUserInterface creates a new instance of ServiceUserInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceUserInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceUserInterface {
+	mock := &ServiceUserInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
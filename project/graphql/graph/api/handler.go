@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
@@ -8,45 +9,83 @@ import (
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
 	"github.com/vektah/gqlparser/v2/ast"
-	"net/http"
+	"os/signal"
+	"project/config"
 	"project/graphql/graph"
+	"project/graphql/graph/audit"
+	"project/graphql/graph/comment"
 	"project/graphql/graph/list"
+	"project/graphql/graph/search"
+	"project/graphql/graph/subtask"
 	"project/graphql/graph/todo"
+	"project/graphql/graph/user"
 	"project/graphql/graph/utils"
+	"project/server"
+	"syscall"
 )
 
-func ServerHandler() {
-	requestSender := utils.NewRequestSender()
+func ServerHandler(cfg *config.Config) {
+	srv := NewServer(cfg)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	err := srv.Run(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func NewServer(cfg *config.Config) *server.Server {
+	requestSender := utils.NewRequestSender(cfg.Gateway)
 	listConverter := list.NewListConverter()
 	var listReqSender list.RequestSenderInterface = requestSender
 	listService := list.NewServiceList(listConverter, &listReqSender)
 	todoConverter := todo.NewTodoConverter()
 	var todoReqSender todo.RequestSenderInterface = requestSender
 	todoService := todo.NewServiceTodo(todoConverter, &todoReqSender)
+	subtaskConverter := subtask.NewSubtaskConverter()
+	var subtaskReqSender subtask.RequestSenderInterface = requestSender
+	subtaskService := subtask.NewServiceSubtask(subtaskConverter, &subtaskReqSender)
+	commentConverter := comment.NewCommentConverter()
+	var commentReqSender comment.RequestSenderInterface = requestSender
+	commentService := comment.NewServiceComment(commentConverter, &commentReqSender)
+	auditConverter := audit.NewAuditConverter()
+	var auditReqSender audit.RequestSenderInterface = requestSender
+	auditService := audit.NewServiceAudit(auditConverter, &auditReqSender)
+	searchConverter := search.NewSearchConverter()
+	var searchReqSender search.RequestSenderInterface = requestSender
+	searchService := search.NewServiceSearch(searchConverter, &searchReqSender)
+	userConverter := user.NewUserConverter()
+	var userReqSender user.RequestSenderInterface = requestSender
+	userService := user.NewServiceUser(userConverter, &userReqSender)
 
-	resolver := graph.NewResolver(listService, todoService)
-	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
+	resolver := graph.NewResolver(listService, todoService, subtaskService, commentService, auditService, searchService)
+	gqlHandler := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
 
-	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	gqlHandler.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
-	srv.AddTransport(transport.Options{})
-	srv.AddTransport(transport.GET{})
-	srv.AddTransport(transport.POST{})
+	gqlHandler.AddTransport(transport.Options{})
+	gqlHandler.AddTransport(transport.GET{})
+	gqlHandler.AddTransport(transport.POST{})
 
-	srv.Use(extension.Introspection{})
-	srv.Use(extension.AutomaticPersistedQuery{
+	gqlHandler.Use(extension.Introspection{})
+	gqlHandler.Use(extension.AutomaticPersistedQuery{
 		Cache: lru.New[string](100),
 	})
 
-	gqlMiddleware := NewGraphQLMiddleware(resolver)
+	gqlMiddleware := NewGraphQLMiddleware(resolver, userService)
 
 	router := mux.NewRouter()
-	router.Use(gqlMiddleware.SetUserInformationToContext)
 	router.Use(gqlMiddleware.LoggingMiddleware)
-	router.Handle(utils.BasePath, srv)
+	router.Use(gqlMiddleware.SetUserInformationToContext)
+	router.Handle(utils.BasePath, gqlHandler)
 
-	err := http.ListenAndServe(":8081", router)
-	if err != nil {
-		log.Fatal(err)
-	}
+	srv := server.New("GraphQL", cfg.GraphQL, router)
+	srv.OnStop(func(_ context.Context) error {
+		requestSender.CloseIdleConnections()
+		return nil
+	})
+
+	return srv
 }
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"project/graphql/graph"
 	"project/graphql/graph/utils"
+	restStructures "project/structures"
+	"strings"
 )
 
 const (
@@ -15,13 +17,21 @@ const (
 	requestId = "requestId"
 )
 
+//go:generate mockery --name ServiceUserInterface --output=automock --with-expecter=true
+type ServiceUserInterface interface {
+	GetCurrentUser(ctx context.Context, requestToken string) (*restStructures.UserAccountOutput, error)
+	GetCurrentUserLists(ctx context.Context, requestToken string) ([]*restStructures.UserOutput, error)
+}
+
 type GraphQLMiddleware struct {
-	resolver *graph.Resolver
+	resolver    *graph.Resolver
+	userService ServiceUserInterface
 }
 
-func NewGraphQLMiddleware(resolver *graph.Resolver) *GraphQLMiddleware {
+func NewGraphQLMiddleware(resolver *graph.Resolver, userService ServiceUserInterface) *GraphQLMiddleware {
 	return &GraphQLMiddleware{
-		resolver: resolver,
+		resolver:    resolver,
+		userService: userService,
 	}
 }
 
@@ -30,11 +40,10 @@ func (gqlM *GraphQLMiddleware) LoggingMiddleware(next http.Handler) http.Handler
 		log := logrus.WithContext(r.Context())
 
 		log.Data = logrus.Fields{
-			method:         r.Method,
-			path:           r.URL.Path,
-			utils.Username: r.Header.Get(utils.Username),
-			requestId:      uuid.New().String(),
-			utils.Status:   http.StatusText(http.StatusOK),
+			method:       r.Method,
+			path:         r.URL.Path,
+			requestId:    uuid.New().String(),
+			utils.Status: http.StatusText(http.StatusOK),
 		}
 
 		log.Info("Incoming GraphQL Request")
@@ -48,23 +57,42 @@ func (gqlM *GraphQLMiddleware) LoggingMiddleware(next http.Handler) http.Handler
 func (gqlM *GraphQLMiddleware) SetUserInformationToContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		username := r.Header.Get(utils.Username)
-		role := utils.GetUserRole(username)
+		token, found := strings.CutPrefix(r.Header.Get(utils.Authorization), utils.BearerPrefix)
+		var currentUser *restStructures.UserAccountOutput
+		var memberships []*restStructures.UserOutput
+		var err error
+		if found && token != "" {
+			currentUser, _ = gqlM.userService.GetCurrentUser(ctx, token)
+		}
+		if currentUser != nil {
+			memberships, err = gqlM.userService.GetCurrentUserLists(ctx, token)
+		}
 
-		if username == "" || role == utils.Unknown {
+		if currentUser == nil || currentUser.Username == "" || currentUser.Role == "" || err != nil {
 			logrus.Error("missing valuable information about the user")
+			w.WriteHeader(http.StatusUnauthorized)
 			_, err := w.Write([]byte("missing valuable information about the user"))
 			if err != nil {
 				logrus.Error(err)
-				w.WriteHeader(http.StatusInternalServerError)
-				return
 			}
-			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
 
-		ctx = context.WithValue(ctx, utils.Username, username)
-		ctx = context.WithValue(ctx, utils.Role, role)
+		log := ctx.Value(utils.Logger).(logrus.FieldLogger)
+		ctx = context.WithValue(ctx, utils.Logger, log.WithField(utils.Username, currentUser.Username))
+		ctx = context.WithValue(ctx, utils.Username, currentUser.Username)
+		ctx = context.WithValue(ctx, utils.Role, currentUser.Role)
+		ctx = context.WithValue(ctx, utils.Token, token)
+		ctx = context.WithValue(ctx, utils.ListRoles, getListRoles(memberships))
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+func getListRoles(memberships []*restStructures.UserOutput) map[string]string {
+	listRoles := make(map[string]string, len(memberships))
+	for _, membership := range memberships {
+		listRoles[membership.ListId.String()] = membership.Role
+	}
+
+	return listRoles
+}
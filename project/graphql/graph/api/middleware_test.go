@@ -2,12 +2,16 @@ package api_test
 
 import (
 	"context"
+	"errors"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
 	"project/graphql/graph/api"
+	mocks "project/graphql/graph/api/automock"
 	"project/graphql/graph/utils"
+	restStructures "project/structures"
 	"testing"
 )
 
@@ -18,35 +22,96 @@ func TestSetUserInformationToContext(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	testMiddleware := api.NewGraphQLMiddleware(nil)
-
 	ctx := context.WithValue(context.Background(), utils.Logger, logrus.New())
 
 	testCases := []struct {
-		name     string
-		headers  map[string]string
-		expected []byte
+		name           string
+		userService    func() *mocks.ServiceUserInterface
+		headers        map[string]string
+		expected       []byte
+		expectedStatus int
 	}{
 		{
 			name: "successfully set user information",
+			userService: func() *mocks.ServiceUserInterface {
+				userService := &mocks.ServiceUserInterface{}
+				userService.EXPECT().GetCurrentUser(mock.Anything, utils.TestToken).
+					Return(&restStructures.UserAccountOutput{Username: "Miro", Role: utils.Reader, IsActive: true}, nil).
+					Once()
+				userService.EXPECT().GetCurrentUserLists(mock.Anything, utils.TestToken).
+					Return([]*restStructures.UserOutput{{ListId: utils.TestListId, Username: "Miro", Role: utils.Editor}}, nil).
+					Once()
+				return userService
+			},
+			headers: map[string]string{
+				utils.Authorization: utils.BearerPrefix + utils.TestToken,
+			},
+			expected:       []byte("Success"),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "fail to set user information, because lists of the user are not fetched",
+			userService: func() *mocks.ServiceUserInterface {
+				userService := &mocks.ServiceUserInterface{}
+				userService.EXPECT().GetCurrentUser(mock.Anything, utils.TestToken).
+					Return(&restStructures.UserAccountOutput{Username: "Miro", Role: utils.Reader, IsActive: true}, nil).
+					Once()
+				userService.EXPECT().GetCurrentUserLists(mock.Anything, utils.TestToken).
+					Return(nil, errors.New("Internal Server Error")).
+					Once()
+				return userService
+			},
+			headers: map[string]string{
+				utils.Authorization: utils.BearerPrefix + utils.TestToken,
+			},
+			expected:       []byte("missing valuable information about the user"),
+			expectedStatus: http.StatusUnauthorized,
+		}, {
+			name: "fail to set user information, because token is rejected",
+			userService: func() *mocks.ServiceUserInterface {
+				userService := &mocks.ServiceUserInterface{}
+				userService.EXPECT().GetCurrentUser(mock.Anything, utils.TestToken).
+					Return(nil, errors.New("Unauthorized")).
+					Once()
+				return userService
+			},
+			headers: map[string]string{
+				utils.Authorization: utils.BearerPrefix + utils.TestToken,
+			},
+			expected:       []byte("missing valuable information about the user"),
+			expectedStatus: http.StatusUnauthorized,
+		}, {
+			name: "fail to set user information, because of missing bearer prefix",
+			userService: func() *mocks.ServiceUserInterface {
+				return &mocks.ServiceUserInterface{}
+			},
 			headers: map[string]string{
-				utils.Username: "Miro",
+				utils.Authorization: utils.TestToken,
 			},
-			expected: []byte("Success"),
+			expected:       []byte("missing valuable information about the user"),
+			expectedStatus: http.StatusUnauthorized,
 		}, {
-			name: "fail to set user information, because of empty username",
+			name: "fail to set user information, because of spoofed username header",
+			userService: func() *mocks.ServiceUserInterface {
+				return &mocks.ServiceUserInterface{}
+			},
 			headers: map[string]string{
-				utils.Username: "",
+				utils.Username: "Niki",
 			},
-			expected: []byte("missing valuable information about the user"),
+			expected:       []byte("missing valuable information about the user"),
+			expectedStatus: http.StatusUnauthorized,
 		}, {
-			name:     "fail to set user information, because of empty header",
-			expected: []byte("missing valuable information about the user"),
+			name: "fail to set user information, because of empty header",
+			userService: func() *mocks.ServiceUserInterface {
+				return &mocks.ServiceUserInterface{}
+			},
+			expected:       []byte("missing valuable information about the user"),
+			expectedStatus: http.StatusUnauthorized,
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			testMiddleware := api.NewGraphQLMiddleware(nil, testCase.userService())
 			handler := testMiddleware.SetUserInformationToContext(testHandler)
 
 			req, err := http.NewRequest(http.MethodGet, "/", nil)
@@ -61,7 +126,7 @@ func TestSetUserInformationToContext(t *testing.T) {
 
 			actual := rr.Body.Bytes()
 			require.Equal(t, testCase.expected, actual)
-			
+			require.Equal(t, testCase.expectedStatus, rr.Code)
 		})
 	}
 }
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "project/graphql/graph/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceConverterAudit is an autogenerated mock type for the ServiceConverterAudit type
+type ServiceConverterAudit struct {
+	mock.Mock
+}
+
+type ServiceConverterAudit_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceConverterAudit) EXPECT() *ServiceConverterAudit_Expecter {
+	return &ServiceConverterAudit_Expecter{mock: &_m.Mock}
+}
+
+// ConvertResponseToAuditEntries provides a mock function with given fields: response
+func (_m *ServiceConverterAudit) ConvertResponseToAuditEntries(response []byte) ([]*model.AuditEntry, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToAuditEntries")
+	}
+
+	var r0 []*model.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]*model.AuditEntry, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []*model.AuditEntry); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterAudit_ConvertResponseToAuditEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToAuditEntries'
+type ServiceConverterAudit_ConvertResponseToAuditEntries_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToAuditEntries is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterAudit_Expecter) ConvertResponseToAuditEntries(response interface{}) *ServiceConverterAudit_ConvertResponseToAuditEntries_Call {
+	return &ServiceConverterAudit_ConvertResponseToAuditEntries_Call{Call: _e.mock.On("ConvertResponseToAuditEntries", response)}
+}
+
+func (_c *ServiceConverterAudit_ConvertResponseToAuditEntries_Call) Run(run func(response []byte)) *ServiceConverterAudit_ConvertResponseToAuditEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterAudit_ConvertResponseToAuditEntries_Call) Return(_a0 []*model.AuditEntry, _a1 error) *ServiceConverterAudit_ConvertResponseToAuditEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterAudit_ConvertResponseToAuditEntries_Call) RunAndReturn(run func([]byte) ([]*model.AuditEntry, error)) *ServiceConverterAudit_ConvertResponseToAuditEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceConverterAudit creates a new instance of ServiceConverterAudit. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceConverterAudit(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceConverterAudit {
+	mock := &ServiceConverterAudit{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+package audit
+
+import (
+	"encoding/json"
+	"project/graphql/graph/model"
+	restStructures "project/structures"
+)
+
+type ConverterAudit struct{}
+
+func NewAuditConverter() *ConverterAudit {
+	return &ConverterAudit{}
+}
+
+func (ac *ConverterAudit) ConvertResponseToAuditEntries(response []byte) ([]*model.AuditEntry, error) {
+	var auditOutputsResponse []restStructures.AuditOutput
+	err := json.Unmarshal(response, &auditOutputsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	auditEntries := make([]*model.AuditEntry, len(auditOutputsResponse))
+	for i, outputResponse := range auditOutputsResponse {
+		auditEntries[i], err = convertAuditOutput(outputResponse)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return auditEntries, nil
+}
+
+func convertAuditOutput(outputResponse restStructures.AuditOutput) (*model.AuditEntry, error) {
+	before, err := convertAuditState(outputResponse.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := convertAuditState(outputResponse.After)
+	if err != nil {
+		return nil, err
+	}
+
+	var todoId *string
+	if outputResponse.TodoId != nil {
+		id := outputResponse.TodoId.String()
+		todoId = &id
+	}
+
+	return &model.AuditEntry{
+		ID:         outputResponse.Id.String(),
+		Actor:      outputResponse.Actor,
+		Action:     outputResponse.Action,
+		EntityType: outputResponse.EntityType,
+		EntityID:   outputResponse.EntityId,
+		ListID:     outputResponse.ListId.String(),
+		TodoID:     todoId,
+		Before:     before,
+		After:      after,
+		RequestID:  outputResponse.RequestId,
+		CreatedAt:  outputResponse.CreatedAt,
+	}, nil
+}
+
+// convertAuditState turns the changed fields into a map, a missing state stays nil.
+func convertAuditState(state json.RawMessage) (map[string]any, error) {
+	if len(state) == 0 {
+		return nil, nil
+	}
+
+	var fields map[string]any
+	err := json.Unmarshal(state, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,104 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/config"
+	"project/graphql/graph/model"
+	"project/graphql/graph/utils"
+)
+
+//go:generate mockery --name ServiceConverterAudit --output=automock --with-expecter=true
+type ServiceConverterAudit interface {
+	ConvertResponseToAuditEntries(response []byte) ([]*model.AuditEntry, error)
+}
+
+//go:generate mockery --name RequestSenderInterface --output=automock --with-expecter=true
+type RequestSenderInterface interface {
+	SendRequest(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, error, int)
+	SendRequestWithHeaders(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, http.Header, error, int)
+}
+
+type ServiceAudit struct {
+	requestSender RequestSenderInterface
+	converter     ServiceConverterAudit
+}
+
+func NewServiceAudit(converter ServiceConverterAudit, requestSender *RequestSenderInterface) *ServiceAudit {
+	if requestSender == nil {
+		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender(config.Default().Gateway)
+		requestSender = &reqSenderInterface
+	}
+
+	return &ServiceAudit{
+		requestSender: *requestSender,
+		converter:     converter,
+	}
+}
+
+func (sa *ServiceAudit) GetListAudit(ctx context.Context, first *int32, after *string, last *int32, before *string, listId, requestToken string) (*model.AuditConnection, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/audit", listId)
+	query := utils.GetPageQuery(first, after, last, before)
+	return sa.getEntries(ctx, url, query.Encode(), requestToken, "list with id: "+listId)
+}
+
+func (sa *ServiceAudit) GetTodoAudit(ctx context.Context, first *int32, after *string, last *int32, before *string, listId, todoId, requestToken string) (*model.AuditConnection, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/audit", listId, todoId)
+	query := utils.GetPageQuery(first, after, last, before)
+	return sa.getEntries(ctx, url, query.Encode(), requestToken, "todo with id: "+todoId)
+}
+
+func (sa *ServiceAudit) GetUserAudit(ctx context.Context, first *int32, after *string, last *int32, before *string, userId, requestToken string) (*model.AuditConnection, error) {
+	url := fmt.Sprintf(utils.BasePath+"/users/%s/audit", userId)
+	query := utils.GetPageQuery(first, after, last, before)
+	return sa.getEntries(ctx, url, query.Encode(), requestToken, "user: "+userId)
+}
+
+func (sa *ServiceAudit) GetAuditLog(ctx context.Context, first *int32, after *string, last *int32, before *string, filter *model.AuditFilter, requestToken string) (*model.AuditConnection, error) {
+	query := utils.GetPageQuery(first, after, last, before)
+	if filter != nil {
+		if filter.ListID != nil {
+			query.Set("list", *filter.ListID)
+		}
+		if filter.TodoID != nil {
+			query.Set("todo", *filter.TodoID)
+		}
+		if filter.Actor != nil {
+			query.Set("actor", *filter.Actor)
+		}
+	}
+
+	return sa.getEntries(ctx, utils.BasePath+"/audit", query.Encode(), requestToken, "all lists")
+}
+
+func (sa *ServiceAudit) getEntries(ctx context.Context, url, query, requestToken, subject string) (*model.AuditConnection, error) {
+	if query != "" {
+		url += "?" + query
+	}
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, responseHeaders, err, status := sa.requestSender.SendRequestWithHeaders(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	auditEntries, err := sa.converter.ConvertResponseToAuditEntries(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	pageInfo, totalCount := utils.GetPageInfo(responseHeaders, len(auditEntries))
+	auditConnection := &model.AuditConnection{
+		TotalCount: &totalCount,
+		Entries:    auditEntries,
+		PageInfo:   pageInfo,
+	}
+
+	log.WithField(utils.Status, status).Info(fmt.Sprintf("got %d audit entries of %s", len(auditEntries), subject))
+	return auditConnection, nil
+}
@@ -0,0 +1,154 @@
+package audit_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"project/graphql/graph/audit"
+	mocks "project/graphql/graph/audit/automock"
+	"project/graphql/graph/model"
+	"project/graphql/graph/utils"
+	"testing"
+)
+
+var authorizationHeaders = map[string]string{
+	utils.Authorization: utils.BearerPrefix + utils.TestToken,
+}
+
+func newTestService(converter *mocks.ServiceConverterAudit, requestSender *mocks.RequestSenderInterface) *audit.ServiceAudit {
+	var srvConverter audit.ServiceConverterAudit = converter
+	var reqSender audit.RequestSenderInterface = requestSender
+	return audit.NewServiceAudit(srvConverter, &reqSender)
+}
+
+func TestGetAudit(t *testing.T) {
+	listAuditUrl := fmt.Sprintf(utils.BasePath+"/list/%s/audit", utils.TestListId)
+	auditEntries := []*model.AuditEntry{{ID: utils.TestAuditId.String(), Actor: utils.TestUsername}}
+	first := int32(1)
+	after := "previous-cursor"
+	actor := utils.TestUsername
+	listId := utils.TestListId.String()
+	endCursor := "end-cursor"
+	totalCount := int32(2)
+
+	testCases := []struct {
+		name          string
+		url           string
+		call          func(service *audit.ServiceAudit) (*model.AuditConnection, error)
+		sendErr       error
+		convertErr    error
+		expected      *model.AuditConnection
+		expectedError error
+	}{
+		{
+			name: "successfully get a page of the list audit",
+			url:  listAuditUrl + "?cursor=previous-cursor&limit=1",
+			call: func(service *audit.ServiceAudit) (*model.AuditConnection, error) {
+				return service.GetListAudit(utils.GetTestingContext(), &first, &after, nil, nil, listId, utils.TestToken)
+			},
+			expected: &model.AuditConnection{
+				TotalCount: &totalCount,
+				Entries:    auditEntries,
+				PageInfo: &model.PageInfo{
+					EndCursor:   &endCursor,
+					HasNextPage: true,
+				},
+			},
+		}, {
+			name: "successfully get the todo audit",
+			url:  fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/audit", utils.TestListId, utils.TestTodoId),
+			call: func(service *audit.ServiceAudit) (*model.AuditConnection, error) {
+				return service.GetTodoAudit(utils.GetTestingContext(), nil, nil, nil, nil, listId, utils.TestTodoId.String(), utils.TestToken)
+			},
+			expected: &model.AuditConnection{
+				TotalCount: &totalCount,
+				Entries:    auditEntries,
+				PageInfo: &model.PageInfo{
+					EndCursor:   &endCursor,
+					HasNextPage: true,
+				},
+			},
+		}, {
+			name: "successfully get the user audit",
+			url:  fmt.Sprintf(utils.BasePath+"/users/%s/audit", utils.TestUsername),
+			call: func(service *audit.ServiceAudit) (*model.AuditConnection, error) {
+				return service.GetUserAudit(utils.GetTestingContext(), nil, nil, nil, nil, utils.TestUsername, utils.TestToken)
+			},
+			expected: &model.AuditConnection{
+				TotalCount: &totalCount,
+				Entries:    auditEntries,
+				PageInfo: &model.PageInfo{
+					EndCursor:   &endCursor,
+					HasNextPage: true,
+				},
+			},
+		}, {
+			name: "successfully get the filtered audit log",
+			url:  utils.BasePath + "/audit?actor=" + utils.TestUsername + "&limit=1&list=" + listId,
+			call: func(service *audit.ServiceAudit) (*model.AuditConnection, error) {
+				return service.GetAuditLog(utils.GetTestingContext(), &first, nil, nil, nil,
+					&model.AuditFilter{ListID: &listId, Actor: &actor}, utils.TestToken)
+			},
+			expected: &model.AuditConnection{
+				TotalCount: &totalCount,
+				Entries:    auditEntries,
+				PageInfo: &model.PageInfo{
+					EndCursor:   &endCursor,
+					HasNextPage: true,
+				},
+			},
+		}, {
+			name: "sending request failed",
+			url:  utils.BasePath + "/audit",
+			call: func(service *audit.ServiceAudit) (*model.AuditConnection, error) {
+				return service.GetAuditLog(utils.GetTestingContext(), nil, nil, nil, nil, nil, utils.TestToken)
+			},
+			sendErr:       errors.New("executing request have failed"),
+			expectedError: errors.New("executing request have failed"),
+		}, {
+			name: "converting to AuditEntry failed",
+			url:  listAuditUrl,
+			call: func(service *audit.ServiceAudit) (*model.AuditConnection, error) {
+				return service.GetListAudit(utils.GetTestingContext(), nil, nil, nil, nil, listId, utils.TestToken)
+			},
+			convertErr:    errors.New("converting response failed"),
+			expectedError: errors.New("converting response failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			converterMock := &mocks.ServiceConverterAudit{}
+			if testCase.sendErr != nil {
+				reqSenderMock.EXPECT().SendRequestWithHeaders(http.MethodGet, testCase.url, nil, authorizationHeaders, http.StatusOK).
+					Return(nil, nil, testCase.sendErr, http.StatusForbidden).
+					Once()
+			} else {
+				reqSenderMock.EXPECT().SendRequestWithHeaders(http.MethodGet, testCase.url, nil, authorizationHeaders, http.StatusOK).
+					Return([]byte("Returned entries"), http.Header{
+						utils.TotalCountHeader:  []string{"2"},
+						utils.HasNextPageHeader: []string{"true"},
+						utils.EndCursorHeader:   []string{endCursor},
+					}, nil, http.StatusOK).
+					Once()
+				if testCase.convertErr != nil {
+					converterMock.EXPECT().ConvertResponseToAuditEntries([]byte("Returned entries")).
+						Return(nil, testCase.convertErr).
+						Once()
+				} else {
+					converterMock.EXPECT().ConvertResponseToAuditEntries([]byte("Returned entries")).
+						Return(auditEntries, nil).
+						Once()
+				}
+			}
+
+			actual, err := testCase.call(newTestService(converterMock, reqSenderMock))
+			require.Equal(t, testCase.expectedError, err)
+			require.Equal(t, testCase.expected, actual)
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "project/graphql/graph/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceConverterComment is an autogenerated mock type for the ServiceConverterComment type
+type ServiceConverterComment struct {
+	mock.Mock
+}
+
+type ServiceConverterComment_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceConverterComment) EXPECT() *ServiceConverterComment_Expecter {
+	return &ServiceConverterComment_Expecter{mock: &_m.Mock}
+}
+
+// ConvertResponseToCommentOutput provides a mock function with given fields: response
+func (_m *ServiceConverterComment) ConvertResponseToCommentOutput(response []byte) (*model.CommentOutput, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToCommentOutput")
+	}
+
+	var r0 *model.CommentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (*model.CommentOutput, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) *model.CommentOutput); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CommentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterComment_ConvertResponseToCommentOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToCommentOutput'
+type ServiceConverterComment_ConvertResponseToCommentOutput_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToCommentOutput is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterComment_Expecter) ConvertResponseToCommentOutput(response interface{}) *ServiceConverterComment_ConvertResponseToCommentOutput_Call {
+	return &ServiceConverterComment_ConvertResponseToCommentOutput_Call{Call: _e.mock.On("ConvertResponseToCommentOutput", response)}
+}
+
+func (_c *ServiceConverterComment_ConvertResponseToCommentOutput_Call) Run(run func(response []byte)) *ServiceConverterComment_ConvertResponseToCommentOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterComment_ConvertResponseToCommentOutput_Call) Return(_a0 *model.CommentOutput, _a1 error) *ServiceConverterComment_ConvertResponseToCommentOutput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterComment_ConvertResponseToCommentOutput_Call) RunAndReturn(run func([]byte) (*model.CommentOutput, error)) *ServiceConverterComment_ConvertResponseToCommentOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertResponseToCommentsOutputs provides a mock function with given fields: response
+func (_m *ServiceConverterComment) ConvertResponseToCommentsOutputs(response []byte) ([]*model.CommentOutput, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToCommentsOutputs")
+	}
+
+	var r0 []*model.CommentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]*model.CommentOutput, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []*model.CommentOutput); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CommentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterComment_ConvertResponseToCommentsOutputs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToCommentsOutputs'
+type ServiceConverterComment_ConvertResponseToCommentsOutputs_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToCommentsOutputs is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterComment_Expecter) ConvertResponseToCommentsOutputs(response interface{}) *ServiceConverterComment_ConvertResponseToCommentsOutputs_Call {
+	return &ServiceConverterComment_ConvertResponseToCommentsOutputs_Call{Call: _e.mock.On("ConvertResponseToCommentsOutputs", response)}
+}
+
+func (_c *ServiceConverterComment_ConvertResponseToCommentsOutputs_Call) Run(run func(response []byte)) *ServiceConverterComment_ConvertResponseToCommentsOutputs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterComment_ConvertResponseToCommentsOutputs_Call) Return(_a0 []*model.CommentOutput, _a1 error) *ServiceConverterComment_ConvertResponseToCommentsOutputs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterComment_ConvertResponseToCommentsOutputs_Call) RunAndReturn(run func([]byte) ([]*model.CommentOutput, error)) *ServiceConverterComment_ConvertResponseToCommentsOutputs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceConverterComment creates a new instance of ServiceConverterComment. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceConverterComment(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceConverterComment {
+	mock := &ServiceConverterComment{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,49 @@
+package comment
+
+import (
+	"encoding/json"
+	"project/graphql/graph/model"
+	restStructures "project/structures"
+)
+
+type ConverterComment struct{}
+
+func NewCommentConverter() *ConverterComment {
+	return &ConverterComment{}
+}
+
+func (cc *ConverterComment) ConvertResponseToCommentOutput(response []byte) (*model.CommentOutput, error) {
+	var commentOutputResponse restStructures.CommentOutput
+	err := json.Unmarshal(response, &commentOutputResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertCommentOutput(commentOutputResponse), nil
+}
+
+func (cc *ConverterComment) ConvertResponseToCommentsOutputs(response []byte) ([]*model.CommentOutput, error) {
+	var commentsOutputsResponse []restStructures.CommentOutput
+	err := json.Unmarshal(response, &commentsOutputsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	commentsOutputs := make([]*model.CommentOutput, len(commentsOutputsResponse))
+	for i, outputResponse := range commentsOutputsResponse {
+		commentsOutputs[i] = convertCommentOutput(outputResponse)
+	}
+
+	return commentsOutputs, nil
+}
+
+func convertCommentOutput(outputResponse restStructures.CommentOutput) *model.CommentOutput {
+	return &model.CommentOutput{
+		ID:        outputResponse.Id.String(),
+		TodoID:    outputResponse.TodoId.String(),
+		Author:    outputResponse.Author,
+		Body:      outputResponse.Body,
+		CreatedAt: outputResponse.CreatedAt,
+		EditedAt:  outputResponse.EditedAt,
+	}
+}
@@ -0,0 +1,106 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/config"
+	"project/graphql/graph/model"
+	"project/graphql/graph/utils"
+)
+
+//go:generate mockery --name ServiceConverterComment --output=automock --with-expecter=true
+type ServiceConverterComment interface {
+	ConvertResponseToCommentOutput(response []byte) (*model.CommentOutput, error)
+	ConvertResponseToCommentsOutputs(response []byte) ([]*model.CommentOutput, error)
+}
+
+//go:generate mockery --name RequestSenderInterface --output=automock --with-expecter=true
+type RequestSenderInterface interface {
+	SendRequest(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, error, int)
+	SendRequestWithHeaders(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, http.Header, error, int)
+}
+
+type ServiceComment struct {
+	requestSender RequestSenderInterface
+	converter     ServiceConverterComment
+}
+
+func NewServiceComment(converter ServiceConverterComment, requestSender *RequestSenderInterface) *ServiceComment {
+	if requestSender == nil {
+		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender(config.Default().Gateway)
+		requestSender = &reqSenderInterface
+	}
+
+	return &ServiceComment{
+		requestSender: *requestSender,
+		converter:     converter,
+	}
+}
+
+func (sc *ServiceComment) GetComments(ctx context.Context, first *int32, after *string, last *int32, before *string, listId, todoId, requestToken string) (*model.CommentConnection, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/comments", listId, todoId)
+	if query := utils.GetPageQuery(first, after, last, before).Encode(); query != "" {
+		url += "?" + query
+	}
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, responseHeaders, err, status := sc.requestSender.SendRequestWithHeaders(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	commentsOutputs, err := sc.converter.ConvertResponseToCommentsOutputs(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	pageInfo, totalCount := utils.GetPageInfo(responseHeaders, len(commentsOutputs))
+	commentConnection := &model.CommentConnection{
+		TotalCount: &totalCount,
+		Comments:   commentsOutputs,
+		PageInfo:   pageInfo,
+	}
+
+	log.WithField(utils.Status, status).Info(fmt.Sprintf("got %d comments of todo with id: %s", len(commentsOutputs), todoId))
+	return commentConnection, nil
+}
+
+func (sc *ServiceComment) AddComment(ctx context.Context, listId, todoId, requestToken string, comment model.CommentInput) (*model.CommentOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/comments", listId, todoId)
+	return sc.sendCommentRequest(ctx, http.MethodPost, url, requestToken, comment, http.StatusCreated)
+}
+
+func (sc *ServiceComment) EditComment(ctx context.Context, listId, todoId, commentId, requestToken string, comment model.CommentInput) (*model.CommentOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/comments/%s", listId, todoId, commentId)
+	return sc.sendCommentRequest(ctx, http.MethodPut, url, requestToken, comment, http.StatusOK)
+}
+
+func (sc *ServiceComment) DeleteComment(ctx context.Context, listId, todoId, commentId, requestToken string) (*model.CommentOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/comments/%s", listId, todoId, commentId)
+	return sc.sendCommentRequest(ctx, http.MethodDelete, url, requestToken, nil, http.StatusOK)
+}
+
+func (sc *ServiceComment) sendCommentRequest(ctx context.Context, method, url, requestToken string, body any, expectedStatus int) (*model.CommentOutput, error) {
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := sc.requestSender.SendRequest(method, url, body, headers, expectedStatus)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	commentOutput, err := sc.converter.ConvertResponseToCommentOutput(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	log.WithField(utils.Status, status).Info(*commentOutput)
+	return commentOutput, nil
+}
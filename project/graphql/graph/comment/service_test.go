@@ -0,0 +1,214 @@
+package comment_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"project/graphql/graph/comment"
+	mocks "project/graphql/graph/comment/automock"
+	"project/graphql/graph/model"
+	"project/graphql/graph/utils"
+	"testing"
+)
+
+var authorizationHeaders = map[string]string{
+	utils.Authorization: utils.BearerPrefix + utils.TestToken,
+}
+
+func newTestService(converter *mocks.ServiceConverterComment, requestSender *mocks.RequestSenderInterface) *comment.ServiceComment {
+	var srvConverter comment.ServiceConverterComment = converter
+	var reqSender comment.RequestSenderInterface = requestSender
+	return comment.NewServiceComment(srvConverter, &reqSender)
+}
+
+func TestGetComments(t *testing.T) {
+	commentsUrl := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/comments", utils.TestListId, utils.TestTodoId)
+	commentsOutputs := []*model.CommentOutput{{ID: utils.TestCommentId.String(), Body: utils.TestCommentBody}}
+	first := int32(1)
+	after := "previous-cursor"
+	endCursor := "end-cursor"
+	totalCount := int32(3)
+
+	testCases := []struct {
+		name          string
+		first         *int32
+		after         *string
+		requestSender func() *mocks.RequestSenderInterface
+		converter     func() *mocks.ServiceConverterComment
+		expected      *model.CommentConnection
+		expectedError error
+	}{
+		{
+			name:  "successfully get a page of comments",
+			first: &first,
+			after: &after,
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, commentsUrl+"?cursor=previous-cursor&limit=1", nil,
+					authorizationHeaders, http.StatusOK).
+					Return([]byte("Returned comments"), http.Header{
+						utils.TotalCountHeader:      []string{"3"},
+						utils.HasNextPageHeader:     []string{"true"},
+						utils.HasPreviousPageHeader: []string{"true"},
+						utils.EndCursorHeader:       []string{endCursor},
+					}, nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterComment {
+				srvConverter := &mocks.ServiceConverterComment{}
+				srvConverter.EXPECT().ConvertResponseToCommentsOutputs([]byte("Returned comments")).
+					Return(commentsOutputs, nil).
+					Once()
+
+				return srvConverter
+			},
+			expected: &model.CommentConnection{
+				TotalCount: &totalCount,
+				Comments:   commentsOutputs,
+				PageInfo: &model.PageInfo{
+					EndCursor:       &endCursor,
+					HasNextPage:     true,
+					HasPreviousPage: true,
+				},
+			},
+		}, {
+			name: "sending request failed",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, commentsUrl, nil, authorizationHeaders, http.StatusOK).
+					Return(nil, nil, errors.New("executing request have failed"), http.StatusNotFound).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterComment {
+				return &mocks.ServiceConverterComment{}
+			},
+			expectedError: errors.New("executing request have failed"),
+		}, {
+			name: "converting to CommentOutput failed",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, commentsUrl, nil, authorizationHeaders, http.StatusOK).
+					Return([]byte("Returned comments"), http.Header{}, nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterComment {
+				srvConverter := &mocks.ServiceConverterComment{}
+				srvConverter.EXPECT().ConvertResponseToCommentsOutputs([]byte("Returned comments")).
+					Return(nil, errors.New("converting response failed")).
+					Once()
+
+				return srvConverter
+			},
+			expectedError: errors.New("converting response failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			converterMock := testCase.converter()
+			reqSenderMock := testCase.requestSender()
+			service := newTestService(converterMock, reqSenderMock)
+
+			actual, err := service.GetComments(utils.GetTestingContext(), testCase.first, testCase.after, nil, nil,
+				utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken)
+			require.Equal(t, testCase.expectedError, err)
+			require.Equal(t, testCase.expected, actual)
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentMutations(t *testing.T) {
+	commentsUrl := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/comments", utils.TestListId, utils.TestTodoId)
+	commentUrl := fmt.Sprintf("%s/%s", commentsUrl, utils.TestCommentId)
+	input := model.CommentInput{Body: utils.TestCommentBody}
+	output := &model.CommentOutput{ID: utils.TestCommentId.String(), Body: utils.TestCommentBody}
+
+	testCases := []struct {
+		name           string
+		method         string
+		url            string
+		body           any
+		expectedStatus int
+		call           func(service *comment.ServiceComment) (*model.CommentOutput, error)
+		sendErr        error
+		expectedError  error
+	}{
+		{
+			name:           "add comment",
+			method:         http.MethodPost,
+			url:            commentsUrl,
+			body:           input,
+			expectedStatus: http.StatusCreated,
+			call: func(service *comment.ServiceComment) (*model.CommentOutput, error) {
+				return service.AddComment(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken, input)
+			},
+		}, {
+			name:           "edit comment",
+			method:         http.MethodPut,
+			url:            commentUrl,
+			body:           input,
+			expectedStatus: http.StatusOK,
+			call: func(service *comment.ServiceComment) (*model.CommentOutput, error) {
+				return service.EditComment(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(),
+					utils.TestCommentId.String(), utils.TestToken, input)
+			},
+		}, {
+			name:           "delete comment",
+			method:         http.MethodDelete,
+			url:            commentUrl,
+			expectedStatus: http.StatusOK,
+			call: func(service *comment.ServiceComment) (*model.CommentOutput, error) {
+				return service.DeleteComment(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(),
+					utils.TestCommentId.String(), utils.TestToken)
+			},
+		}, {
+			name:           "edit comment of another member",
+			method:         http.MethodPut,
+			url:            commentUrl,
+			body:           input,
+			expectedStatus: http.StatusOK,
+			call: func(service *comment.ServiceComment) (*model.CommentOutput, error) {
+				return service.EditComment(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(),
+					utils.TestCommentId.String(), utils.TestToken, input)
+			},
+			sendErr:       errors.New("executing request have failed"),
+			expectedError: errors.New("executing request have failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			converterMock := &mocks.ServiceConverterComment{}
+			if testCase.sendErr != nil {
+				reqSenderMock.EXPECT().SendRequest(testCase.method, testCase.url, testCase.body, authorizationHeaders, testCase.expectedStatus).
+					Return(nil, testCase.sendErr, http.StatusForbidden).
+					Once()
+			} else {
+				reqSenderMock.EXPECT().SendRequest(testCase.method, testCase.url, testCase.body, authorizationHeaders, testCase.expectedStatus).
+					Return([]byte("Returned comment"), nil, testCase.expectedStatus).
+					Once()
+				converterMock.EXPECT().ConvertResponseToCommentOutput([]byte("Returned comment")).
+					Return(output, nil).
+					Once()
+			}
+
+			actual, err := testCase.call(newTestService(converterMock, reqSenderMock))
+			require.Equal(t, testCase.expectedError, err)
+			if testCase.expectedError == nil {
+				require.Equal(t, output, actual)
+			}
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
@@ -1,8 +1,12 @@
-// Code generated by mockery v2.51.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // RequestSenderInterface is an autogenerated mock type for the RequestSenderInterface type
 type RequestSenderInterface struct {
@@ -86,6 +90,84 @@ func (_c *RequestSenderInterface_SendRequest_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// SendRequestWithHeaders provides a mock function with given fields: requestType, route, body, headerData, expectedStatus
+func (_m *RequestSenderInterface) SendRequestWithHeaders(requestType string, route string, body interface{}, headerData map[string]string, expectedStatus int) ([]byte, http.Header, error, int) {
+	ret := _m.Called(requestType, route, body, headerData, expectedStatus)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRequestWithHeaders")
+	}
+
+	var r0 []byte
+	var r1 http.Header
+	var r2 error
+	var r3 int
+	if rf, ok := ret.Get(0).(func(string, string, interface{}, map[string]string, int) ([]byte, http.Header, error, int)); ok {
+		return rf(requestType, route, body, headerData, expectedStatus)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, interface{}, map[string]string, int) []byte); ok {
+		r0 = rf(requestType, route, body, headerData, expectedStatus)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, interface{}, map[string]string, int) http.Header); ok {
+		r1 = rf(requestType, route, body, headerData, expectedStatus)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(http.Header)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, interface{}, map[string]string, int) error); ok {
+		r2 = rf(requestType, route, body, headerData, expectedStatus)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	if rf, ok := ret.Get(3).(func(string, string, interface{}, map[string]string, int) int); ok {
+		r3 = rf(requestType, route, body, headerData, expectedStatus)
+	} else {
+		r3 = ret.Get(3).(int)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// RequestSenderInterface_SendRequestWithHeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendRequestWithHeaders'
+type RequestSenderInterface_SendRequestWithHeaders_Call struct {
+	*mock.Call
+}
+
+// SendRequestWithHeaders is a helper method to define mock.On call
+//   - requestType string
+//   - route string
+//   - body interface{}
+//   - headerData map[string]string
+//   - expectedStatus int
+func (_e *RequestSenderInterface_Expecter) SendRequestWithHeaders(requestType interface{}, route interface{}, body interface{}, headerData interface{}, expectedStatus interface{}) *RequestSenderInterface_SendRequestWithHeaders_Call {
+	return &RequestSenderInterface_SendRequestWithHeaders_Call{Call: _e.mock.On("SendRequestWithHeaders", requestType, route, body, headerData, expectedStatus)}
+}
+
+func (_c *RequestSenderInterface_SendRequestWithHeaders_Call) Run(run func(requestType string, route string, body interface{}, headerData map[string]string, expectedStatus int)) *RequestSenderInterface_SendRequestWithHeaders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(interface{}), args[3].(map[string]string), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *RequestSenderInterface_SendRequestWithHeaders_Call) Return(_a0 []byte, _a1 http.Header, _a2 error, _a3 int) *RequestSenderInterface_SendRequestWithHeaders_Call {
+	_c.Call.Return(_a0, _a1, _a2, _a3)
+	return _c
+}
+
+func (_c *RequestSenderInterface_SendRequestWithHeaders_Call) RunAndReturn(run func(string, string, interface{}, map[string]string, int) ([]byte, http.Header, error, int)) *RequestSenderInterface_SendRequestWithHeaders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRequestSenderInterface creates a new instance of RequestSenderInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRequestSenderInterface(t interface {
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.51.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -137,6 +137,122 @@ func (_c *ServiceConverterList_ConvertResponseToListsOutputs_Call) RunAndReturn(
 	return _c
 }
 
+// ConvertResponseToTemplate provides a mock function with given fields: response
+func (_m *ServiceConverterList) ConvertResponseToTemplate(response []byte) (*model.Template, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToTemplate")
+	}
+
+	var r0 *model.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (*model.Template, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) *model.Template); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterList_ConvertResponseToTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToTemplate'
+type ServiceConverterList_ConvertResponseToTemplate_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToTemplate is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterList_Expecter) ConvertResponseToTemplate(response interface{}) *ServiceConverterList_ConvertResponseToTemplate_Call {
+	return &ServiceConverterList_ConvertResponseToTemplate_Call{Call: _e.mock.On("ConvertResponseToTemplate", response)}
+}
+
+func (_c *ServiceConverterList_ConvertResponseToTemplate_Call) Run(run func(response []byte)) *ServiceConverterList_ConvertResponseToTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterList_ConvertResponseToTemplate_Call) Return(_a0 *model.Template, _a1 error) *ServiceConverterList_ConvertResponseToTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterList_ConvertResponseToTemplate_Call) RunAndReturn(run func([]byte) (*model.Template, error)) *ServiceConverterList_ConvertResponseToTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertResponseToTemplates provides a mock function with given fields: response
+func (_m *ServiceConverterList) ConvertResponseToTemplates(response []byte) ([]*model.Template, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToTemplates")
+	}
+
+	var r0 []*model.Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]*model.Template, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []*model.Template); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterList_ConvertResponseToTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToTemplates'
+type ServiceConverterList_ConvertResponseToTemplates_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToTemplates is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterList_Expecter) ConvertResponseToTemplates(response interface{}) *ServiceConverterList_ConvertResponseToTemplates_Call {
+	return &ServiceConverterList_ConvertResponseToTemplates_Call{Call: _e.mock.On("ConvertResponseToTemplates", response)}
+}
+
+func (_c *ServiceConverterList_ConvertResponseToTemplates_Call) Run(run func(response []byte)) *ServiceConverterList_ConvertResponseToTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterList_ConvertResponseToTemplates_Call) Return(_a0 []*model.Template, _a1 error) *ServiceConverterList_ConvertResponseToTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterList_ConvertResponseToTemplates_Call) RunAndReturn(run func([]byte) ([]*model.Template, error)) *ServiceConverterList_ConvertResponseToTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConvertResponseToTodosOutputs provides a mock function with given fields: response
 func (_m *ServiceConverterList) ConvertResponseToTodosOutputs(response []byte) ([]*model.TodoOutput, error) {
 	ret := _m.Called(response)
@@ -253,6 +369,64 @@ func (_c *ServiceConverterList_ConvertResponseToUserOutput_Call) RunAndReturn(ru
 	return _c
 }
 
+// ConvertResponseToWorkflow provides a mock function with given fields: response
+func (_m *ServiceConverterList) ConvertResponseToWorkflow(response []byte) (*model.Workflow, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToWorkflow")
+	}
+
+	var r0 *model.Workflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (*model.Workflow, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) *model.Workflow); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Workflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterList_ConvertResponseToWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToWorkflow'
+type ServiceConverterList_ConvertResponseToWorkflow_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToWorkflow is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterList_Expecter) ConvertResponseToWorkflow(response interface{}) *ServiceConverterList_ConvertResponseToWorkflow_Call {
+	return &ServiceConverterList_ConvertResponseToWorkflow_Call{Call: _e.mock.On("ConvertResponseToWorkflow", response)}
+}
+
+func (_c *ServiceConverterList_ConvertResponseToWorkflow_Call) Run(run func(response []byte)) *ServiceConverterList_ConvertResponseToWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterList_ConvertResponseToWorkflow_Call) Return(_a0 *model.Workflow, _a1 error) *ServiceConverterList_ConvertResponseToWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterList_ConvertResponseToWorkflow_Call) RunAndReturn(run func([]byte) (*model.Workflow, error)) *ServiceConverterList_ConvertResponseToWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewServiceConverterList creates a new instance of ServiceConverterList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewServiceConverterList(t interface {
@@ -33,6 +33,7 @@ func (cl *ConverterList) ConvertResponseToUserOutput(response []byte) (*model.Us
 		ListID:   userOutputResponse.ListId.String(),
 		ListName: userOutputResponse.ListName,
 		Username: userOutputResponse.Username,
+		Role:     userOutputResponse.Role,
 		IsOwner:  userOutputResponse.IsOwner,
 	}
 	return userOutput, nil
@@ -63,10 +64,94 @@ func (cl *ConverterList) ConvertResponseToTodosOutputs(response []byte) ([]*mode
 			Name:        outputResponse.Name,
 			Description: outputResponse.Description,
 			Deadline:    outputResponse.Deadline,
-			Assignee:    outputResponse.Assignee,
+			Assignees:   append([]string{}, outputResponse.Assignees...),
+			Watchers:    append([]string{}, outputResponse.Watchers...),
 			Status:      outputResponse.Status,
+			Done:        outputResponse.Done,
 			Priority:    outputResponse.Priority,
 		}
 	}
 	return todosOutputs, nil
 }
+
+func (cl *ConverterList) ConvertResponseToWorkflow(response []byte) (*model.Workflow, error) {
+	var workflowResponse restStructures.WorkflowOutput
+	err := json.Unmarshal(response, &workflowResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow := &model.Workflow{
+		ListID:      workflowResponse.ListId.String(),
+		States:      make([]*model.WorkflowState, len(workflowResponse.States)),
+		Transitions: make([]*model.WorkflowTransition, len(workflowResponse.Transitions)),
+	}
+	for i, state := range workflowResponse.States {
+		workflow.States[i] = &model.WorkflowState{Name: state.Name, Done: state.Done}
+	}
+	for i, transition := range workflowResponse.Transitions {
+		workflow.Transitions[i] = &model.WorkflowTransition{From: transition.From, To: transition.To}
+	}
+
+	return workflow, nil
+}
+
+func (cl *ConverterList) ConvertResponseToTemplate(response []byte) (*model.Template, error) {
+	var templateResponse restStructures.TemplateOutput
+	err := json.Unmarshal(response, &templateResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertTemplateOutput(templateResponse), nil
+}
+
+func (cl *ConverterList) ConvertResponseToTemplates(response []byte) ([]*model.Template, error) {
+	var templatesResponse []restStructures.TemplateOutput
+	err := json.Unmarshal(response, &templatesResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*model.Template, len(templatesResponse))
+	for i, templateResponse := range templatesResponse {
+		templates[i] = convertTemplateOutput(templateResponse)
+	}
+
+	return templates, nil
+}
+
+func convertTemplateOutput(templateResponse restStructures.TemplateOutput) *model.Template {
+	template := &model.Template{
+		ID:          templateResponse.Id.String(),
+		Name:        templateResponse.Name,
+		Owner:       templateResponse.Owner,
+		CreatedAt:   templateResponse.CreatedAt,
+		States:      make([]*model.WorkflowState, len(templateResponse.States)),
+		Transitions: make([]*model.WorkflowTransition, len(templateResponse.Transitions)),
+		Labels:      make([]*model.TemplateLabel, len(templateResponse.Labels)),
+		Todos:       make([]*model.TemplateTodo, len(templateResponse.Todos)),
+	}
+	for i, state := range templateResponse.States {
+		template.States[i] = &model.WorkflowState{Name: state.Name, Done: state.Done}
+	}
+	for i, transition := range templateResponse.Transitions {
+		template.Transitions[i] = &model.WorkflowTransition{From: transition.From, To: transition.To}
+	}
+	for i, label := range templateResponse.Labels {
+		template.Labels[i] = &model.TemplateLabel{Name: label.Name, Color: label.Color}
+	}
+	for i, todo := range templateResponse.Todos {
+		template.Todos[i] = &model.TemplateTodo{
+			Name:           todo.Name,
+			Description:    todo.Description,
+			Priority:       todo.Priority,
+			Recurrence:     todo.Recurrence,
+			DeadlineOffset: int32(todo.DeadlineOffset),
+			Labels:         append([]string{}, todo.Labels...),
+			Subtasks:       append([]string{}, todo.Subtasks...),
+		}
+	}
+
+	return template
+}
@@ -6,39 +6,42 @@ import (
 	"fmt"
 	"github.com/sirupsen/logrus"
 	"net/http"
+	"project/config"
 	"project/graphql/graph/model"
 	"project/graphql/graph/utils"
+	restStructures "project/structures"
 	"strings"
 )
 
 const (
-	firstList          = 0
 	notFoundListErrMsg = "error not found list with id:"
 )
 
 //go:generate mockery --name ServiceConverterList --output=automock --with-expecter=true
 type ServiceConverterList interface {
 	ConvertResponseToListOutput(response []byte) (*model.ListOutput, error)
-	ConvertListOutputToGQLListOutput(response *model.ListOutput) (*model.ListOutput, error)
 	ConvertResponseToUserOutput(response []byte) (*model.UserOutput, error)
 	ConvertResponseToListsOutputs(response []byte) ([]*model.ListOutput, error)
 	ConvertResponseToTodosOutputs(response []byte) ([]*model.TodoOutput, error)
+	ConvertResponseToWorkflow(response []byte) (*model.Workflow, error)
+	ConvertResponseToTemplate(response []byte) (*model.Template, error)
+	ConvertResponseToTemplates(response []byte) ([]*model.Template, error)
 }
 
 //go:generate mockery --name RequestSenderInterface --output=automock --with-expecter=true
 type RequestSenderInterface interface {
 	SendRequest(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, error, int)
+	SendRequestWithHeaders(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, http.Header, error, int)
 }
 
 type ServiceList struct {
 	requestSender RequestSenderInterface
 	converter     ServiceConverterList
-	pageInfo      model.PageInfo
 }
 
 func NewServiceList(converter ServiceConverterList, requestSender *RequestSenderInterface) *ServiceList {
 	if requestSender == nil {
-		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender()
+		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender(config.Default().Gateway)
 		requestSender = &reqSenderInterface
 	}
 
@@ -48,11 +51,9 @@ func NewServiceList(converter ServiceConverterList, requestSender *RequestSender
 	}
 }
 
-func (sl *ServiceList) CreateList(ctx context.Context, list model.List, requestCreator string) (*model.ListOutput, error) {
-	url := utils.BaseUrl + utils.BasePath + "/list"
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (sl *ServiceList) CreateList(ctx context.Context, list model.List, requestToken string) (*model.ListOutput, error) {
+	url := utils.BasePath + "/list"
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := sl.requestSender.SendRequest(http.MethodPost, url, list, headers, http.StatusCreated)
@@ -71,11 +72,9 @@ func (sl *ServiceList) CreateList(ctx context.Context, list model.List, requestC
 	return listOutput, nil
 }
 
-func (sl *ServiceList) AddUserToList(ctx context.Context, listId, requestCreator string, newUser model.User) (string, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/users", listId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (sl *ServiceList) AddUserToList(ctx context.Context, listId, requestToken string, newUser model.User) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/users", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := sl.requestSender.SendRequest(http.MethodPost, url, newUser, headers, http.StatusOK)
@@ -89,11 +88,9 @@ func (sl *ServiceList) AddUserToList(ctx context.Context, listId, requestCreator
 	return strResult, nil
 }
 
-func (sl *ServiceList) UpdateListName(ctx context.Context, listId, requestCreator string, listUpdate model.List) (*model.ListOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s", listId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (sl *ServiceList) UpdateListName(ctx context.Context, listId, requestToken string, listUpdate model.List) (*model.ListOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := sl.requestSender.SendRequest(http.MethodPut, url, listUpdate, headers, http.StatusOK)
@@ -112,11 +109,9 @@ func (sl *ServiceList) UpdateListName(ctx context.Context, listId, requestCreato
 	return listOutput, nil
 }
 
-func (sl *ServiceList) DeleteList(ctx context.Context, listId, requestCreator string) (*model.ListOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s", listId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (sl *ServiceList) DeleteList(ctx context.Context, listId, requestToken string) (*model.ListOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := sl.requestSender.SendRequest(http.MethodDelete, url, nil, headers, http.StatusOK)
@@ -142,11 +137,9 @@ func (sl *ServiceList) DeleteList(ctx context.Context, listId, requestCreator st
 	return listOutput, nil
 }
 
-func (sl *ServiceList) RemoveUserFromList(ctx context.Context, listId, user, requestCreator string) (*model.UserOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/users/%s", listId, user)
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (sl *ServiceList) RemoveUserFromList(ctx context.Context, listId, user, requestToken string) (*model.UserOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/users/%s", listId, user)
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := sl.requestSender.SendRequest(http.MethodDelete, url, nil, headers, http.StatusOK)
@@ -165,11 +158,9 @@ func (sl *ServiceList) RemoveUserFromList(ctx context.Context, listId, user, req
 	return userOutput, nil
 }
 
-func (sl *ServiceList) GetList(ctx context.Context, listId, requestCreator string) (*model.ListOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s", listId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (sl *ServiceList) GetList(ctx context.Context, listId, requestToken string) (*model.ListOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := sl.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
@@ -184,7 +175,7 @@ func (sl *ServiceList) GetList(ctx context.Context, listId, requestCreator strin
 		return nil, err
 	}
 
-	url = fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todos", listId)
+	url = fmt.Sprintf(utils.BasePath+"/list/%s/todos", listId)
 	result, err, status = sl.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
 	if err != nil {
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
@@ -201,14 +192,15 @@ func (sl *ServiceList) GetList(ctx context.Context, listId, requestCreator strin
 	return listOutput, nil
 }
 
-func (sl *ServiceList) GetLists(ctx context.Context, first *int32, after *string, requestCreator string) (*model.ListConnection, error) {
-	url := utils.BaseUrl + utils.BasePath + "/list"
-	headers := map[string]string{
-		utils.Username: requestCreator,
+func (sl *ServiceList) GetLists(ctx context.Context, first *int32, after *string, last *int32, before *string, requestToken string) (*model.ListConnection, error) {
+	url := utils.BasePath + "/list"
+	if query := utils.GetPageQuery(first, after, last, before).Encode(); query != "" {
+		url += "?" + query
 	}
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
-	result, err, status := sl.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	result, responseHeaders, err, status := sl.requestSender.SendRequestWithHeaders(http.MethodGet, url, nil, headers, http.StatusOK)
 	if err != nil {
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
 		return nil, err
@@ -219,76 +211,128 @@ func (sl *ServiceList) GetLists(ctx context.Context, first *int32, after *string
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
 		return nil, err
 	}
-	totalCount := int32(len(listsOutputs))
 
-	if first == nil && after == nil {
-		sl.pageInfo = *new(model.PageInfo)
-		return &model.ListConnection{
-			TotalCount: &totalCount,
-			Lists:      listsOutputs,
-			PageInfo:   &sl.pageInfo,
-		}, nil
+	pageInfo, totalCount := utils.GetPageInfo(responseHeaders, len(listsOutputs))
+	listConnection := &model.ListConnection{
+		TotalCount: &totalCount,
+		Lists:      listsOutputs,
+		PageInfo:   pageInfo,
 	}
 
-	if first == nil {
-		first = &totalCount
+	log.WithField(utils.Status, status).Info("lists are successfully retrieved")
+	return listConnection, nil
+}
+
+func (sl *ServiceList) GetUserFromList(ctx context.Context, listId, user, requestToken string) (*model.UserOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/users/%s", listId, user)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := sl.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
 	}
 
-	var startPos int
-	if after != nil || sl.pageInfo.EndCursor != nil {
-		var afterList *string
-		if after != nil {
-			afterList = after
-		} else {
-			afterList = sl.pageInfo.EndCursor
-		}
+	userOutput, err := sl.converter.ConvertResponseToUserOutput(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
+	}
 
-		startPos, err = utils.GetListPosition(*afterList, listsOutputs)
-		if err != nil {
-			log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
-			sl.pageInfo = *new(model.PageInfo)
-			return nil, err
-		} else if startPos == int(totalCount)-1 {
-			err = errors.New("lists is out of range")
-			log.WithField(utils.Status, http.StatusResetContent).Error(err)
-			sl.pageInfo = *new(model.PageInfo)
-			return nil, err
-		}
+	log.WithField(utils.Status, status).Info(*userOutput)
+	return userOutput, nil
+}
 
-		startPos++
-	} else {
-		startPos = 0
+func (sl *ServiceList) GetUsersFromList(ctx context.Context, listId, requestToken string) (*model.ListOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/users", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := sl.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
 	}
 
-	pageScope := startPos + int(*first)
-	if pageScope > int(totalCount) {
-		pageScope = int(totalCount)
+	listOutput, err := sl.converter.ConvertResponseToListOutput(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
 	}
-	pagedLists := listsOutputs[startPos:pageScope]
-	sl.pageInfo.StartCursor = &pagedLists[firstList].ID
-	sl.pageInfo.EndCursor = &pagedLists[len(pagedLists)-1].ID
-	if pageScope < int(totalCount) {
-		sl.pageInfo.HasNextPage = true
-	} else {
-		sl.pageInfo.HasNextPage = false
+
+	log.WithField(utils.Status, status).Info(*listOutput)
+	return listOutput, nil
+}
+
+func (sl *ServiceList) GetWorkflow(ctx context.Context, listId, requestToken string) (*model.Workflow, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/workflow", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := sl.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
 	}
 
-	listConnection := &model.ListConnection{
-		TotalCount: &totalCount,
-		Lists:      pagedLists,
-		PageInfo:   &sl.pageInfo,
+	workflow, err := sl.converter.ConvertResponseToWorkflow(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
 	}
 
-	log.WithField(utils.Status, status).Info("lists are successfully retrieved")
-	return listConnection, nil
+	log.WithField(utils.Status, status).Info(*workflow)
+	return workflow, nil
 }
 
-func (sl *ServiceList) GetUserFromList(ctx context.Context, listId, user, requestCreator string) (*model.UserOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/users/%s", listId, user)
-	headers := map[string]string{
-		utils.Username: requestCreator,
+func (sl *ServiceList) UpdateWorkflow(ctx context.Context, listId, requestToken string, workflowInput model.WorkflowInput) (*model.Workflow, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/workflow", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := sl.requestSender.SendRequest(http.MethodPut, url, workflowInput, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
+	}
+
+	workflow, err := sl.converter.ConvertResponseToWorkflow(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
+	}
+
+	log.WithField(utils.Status, status).Info(*workflow)
+	return workflow, nil
+}
+
+func (sl *ServiceList) DuplicateList(ctx context.Context, listId, requestToken string, input model.DuplicateListInput) (*model.ListOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/duplicate", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	body := restStructures.ListDuplicateInput{Name: input.Name, IncludeMembers: input.IncludeMembers != nil && *input.IncludeMembers}
+	result, err, status := sl.requestSender.SendRequest(http.MethodPost, url, body, headers, http.StatusCreated)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
+	}
+
+	listOutput, err := sl.converter.ConvertResponseToListOutput(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
 	}
 
+	log.WithField(utils.Status, status).Info(*listOutput)
+	return listOutput, nil
+}
+
+func (sl *ServiceList) GetTemplates(ctx context.Context, requestToken string) ([]*model.Template, error) {
+	url := utils.BasePath + "/templates"
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := sl.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
 	if err != nil {
@@ -296,24 +340,62 @@ func (sl *ServiceList) GetUserFromList(ctx context.Context, listId, user, reques
 		return nil, err
 	}
 
-	userOutput, err := sl.converter.ConvertResponseToUserOutput(result)
+	templates, err := sl.converter.ConvertResponseToTemplates(result)
 	if err != nil {
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
 		return nil, err
 	}
 
-	log.WithField(utils.Status, status).Info(*userOutput)
-	return userOutput, nil
+	log.WithField(utils.Status, status).Info(fmt.Sprintf("got %d templates", len(templates)))
+	return templates, nil
+}
+
+func (sl *ServiceList) GetTemplate(ctx context.Context, templateId, requestToken string) (*model.Template, error) {
+	url := fmt.Sprintf(utils.BasePath+"/templates/%s", templateId)
+
+	return sl.sendTemplateRequest(ctx, http.MethodGet, url, nil, requestToken, http.StatusOK)
+}
+
+func (sl *ServiceList) SaveListAsTemplate(ctx context.Context, listId, requestToken string, templateInput model.TemplateInput) (*model.Template, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/template", listId)
+	body := restStructures.TemplateInput{Name: templateInput.Name, StartDate: templateInput.StartDate}
+
+	return sl.sendTemplateRequest(ctx, http.MethodPost, url, body, requestToken, http.StatusCreated)
+}
+
+func (sl *ServiceList) DeleteTemplate(ctx context.Context, templateId, requestToken string) (*model.Template, error) {
+	url := fmt.Sprintf(utils.BasePath+"/templates/%s", templateId)
+
+	return sl.sendTemplateRequest(ctx, http.MethodDelete, url, nil, requestToken, http.StatusOK)
 }
 
-func (sl *ServiceList) GetUsersFromList(ctx context.Context, listId, requestCreator string) (*model.ListOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/users", listId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
+func (sl *ServiceList) sendTemplateRequest(ctx context.Context, method, url string, body any, requestToken string, expectedStatus int) (*model.Template, error) {
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := sl.requestSender.SendRequest(method, url, body, headers, expectedStatus)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
+	}
+
+	template, err := sl.converter.ConvertResponseToTemplate(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
+		return nil, err
 	}
 
+	log.WithField(utils.Status, status).Info(*template)
+	return template, nil
+}
+
+func (sl *ServiceList) InstantiateTemplate(ctx context.Context, templateId, requestToken string, input model.TemplateInstanceInput) (*model.ListOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/templates/%s/instantiate", templateId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
-	result, err, status := sl.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	body := restStructures.TemplateInstanceInput{Name: input.Name, StartDate: input.StartDate}
+	result, err, status := sl.requestSender.SendRequest(http.MethodPost, url, body, headers, http.StatusCreated)
 	if err != nil {
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
 		return nil, err
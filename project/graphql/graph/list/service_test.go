@@ -10,20 +10,21 @@ import (
 	mocks "project/graphql/graph/list/automock"
 	"project/graphql/graph/model"
 	"project/graphql/graph/utils"
+	restStructures "project/structures"
 	"testing"
 )
 
 func TestCreateList(t *testing.T) {
-	url := utils.BaseUrl + utils.BasePath + "/list"
+	url := utils.BasePath + "/list"
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputList           model.List
-		inputRequestCreator string
-		expected            model.ListOutput
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputList         model.List
+		inputRequestToken string
+		expected          model.ListOutput
+		expectedError     error
 	}{
 		{
 			name: "successfully create list",
@@ -33,7 +34,7 @@ func TestCreateList(t *testing.T) {
 					model.List{
 						Name: utils.TestListName,
 					}, map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusCreated).
 					Return([]byte("Returned new list"), nil, http.StatusCreated).
 					Once()
@@ -54,7 +55,7 @@ func TestCreateList(t *testing.T) {
 			inputList: model.List{
 				Name: utils.TestListName,
 			},
-			inputRequestCreator: utils.TestUsername,
+			inputRequestToken: utils.TestToken,
 			expected: model.ListOutput{
 				ID:   utils.TestListId.String(),
 				Name: utils.TestListName,
@@ -66,7 +67,7 @@ func TestCreateList(t *testing.T) {
 				reqSender.EXPECT().SendRequest(http.MethodPost, url, model.List{
 					Name: utils.TestListName,
 				}, map[string]string{
-					utils.Username: utils.TestUsername,
+					utils.Authorization: utils.BearerPrefix + utils.TestToken,
 				}, http.StatusCreated).
 					Return(nil, errors.New("executing request have failed"), http.StatusBadRequest).
 					Once()
@@ -79,8 +80,8 @@ func TestCreateList(t *testing.T) {
 			inputList: model.List{
 				Name: utils.TestListName,
 			},
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to ListOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
@@ -88,7 +89,7 @@ func TestCreateList(t *testing.T) {
 				reqSender.EXPECT().SendRequest(http.MethodPost, url, model.List{
 					Name: utils.TestListName,
 				}, map[string]string{
-					utils.Username: utils.TestUsername,
+					utils.Authorization: utils.BearerPrefix + utils.TestToken,
 				}, http.StatusCreated).
 					Return([]byte("Returned new list"), nil, http.StatusCreated).
 					Once()
@@ -107,8 +108,8 @@ func TestCreateList(t *testing.T) {
 			inputList: model.List{
 				Name: utils.TestListName,
 			},
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -120,7 +121,7 @@ func TestCreateList(t *testing.T) {
 			var reqSender list.RequestSenderInterface = reqSenderMock
 			service := list.NewServiceList(converter, &reqSender)
 
-			actual, err := service.CreateList(utils.GetTestingContext(), testCase.inputList, testCase.inputRequestCreator)
+			actual, err := service.CreateList(utils.GetTestingContext(), testCase.inputList, testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -136,16 +137,16 @@ func TestCreateList(t *testing.T) {
 }
 
 func TestAddUserToList(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/users", utils.TestListId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/users", utils.TestListId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		inputListId         uuid.UUID
-		inputRequestCreator string
-		inputNewUser        model.User
-		expected            string
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		inputListId       uuid.UUID
+		inputRequestToken string
+		inputNewUser      model.User
+		expected          string
+		expectedError     error
 	}{
 		{
 			name: "successfully added user to list",
@@ -155,15 +156,15 @@ func TestAddUserToList(t *testing.T) {
 					model.User{
 						Username: utils.TestUsername + "_new",
 					}, map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("user added"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
 			inputNewUser: model.User{
 				Username: utils.TestUsername + "_new",
 			},
@@ -176,7 +177,7 @@ func TestAddUserToList(t *testing.T) {
 					model.User{
 						Username: utils.TestUsername + "_new",
 					}, map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil,
 						errors.New("executing request have failed"),
@@ -185,8 +186,8 @@ func TestAddUserToList(t *testing.T) {
 
 				return reqSender
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
 			inputNewUser: model.User{
 				Username: utils.TestUsername + "_new",
 			},
@@ -202,7 +203,7 @@ func TestAddUserToList(t *testing.T) {
 			service := list.NewServiceList(converter, &reqSender)
 
 			actual, err := service.AddUserToList(utils.GetTestingContext(), testCase.inputListId.String(),
-				testCase.inputRequestCreator, testCase.inputNewUser)
+				testCase.inputRequestToken, testCase.inputNewUser)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				reqSenderMock.AssertExpectations(t)
@@ -216,17 +217,17 @@ func TestAddUserToList(t *testing.T) {
 }
 
 func TestUpdateListName(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s", utils.TestListId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s", utils.TestListId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputListId         uuid.UUID
-		inputRequestCreator string
-		inputListUpdate     model.List
-		expected            string
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputListId       uuid.UUID
+		inputRequestToken string
+		inputListUpdate   model.List
+		expected          string
+		expectedError     error
 	}{
 		{
 			name: "successfully updated list",
@@ -236,7 +237,7 @@ func TestUpdateListName(t *testing.T) {
 					model.List{
 						Name: utils.TestListName,
 					}, map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("list updated"), nil, http.StatusOK).
 					Once()
@@ -254,8 +255,8 @@ func TestUpdateListName(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
 			inputListUpdate: model.List{
 				Name: utils.TestListName,
 			},
@@ -268,7 +269,7 @@ func TestUpdateListName(t *testing.T) {
 					model.List{
 						Name: utils.TestListName,
 					}, map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil,
 						errors.New("executing request have failed"),
@@ -280,8 +281,8 @@ func TestUpdateListName(t *testing.T) {
 			converter: func() *mocks.ServiceConverterList {
 				return &mocks.ServiceConverterList{}
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
 			inputListUpdate: model.List{
 				Name: utils.TestListName,
 			},
@@ -293,7 +294,7 @@ func TestUpdateListName(t *testing.T) {
 				reqSender.EXPECT().SendRequest(http.MethodPut, url, model.List{
 					Name: utils.TestListName,
 				}, map[string]string{
-					utils.Username: utils.TestUsername,
+					utils.Authorization: utils.BearerPrefix + utils.TestToken,
 				}, http.StatusOK).
 					Return([]byte("Returned updated list"), nil, http.StatusOK).
 					Once()
@@ -309,8 +310,8 @@ func TestUpdateListName(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
 			inputListUpdate: model.List{
 				Name: utils.TestListName,
 			},
@@ -327,7 +328,7 @@ func TestUpdateListName(t *testing.T) {
 			service := list.NewServiceList(converter, &reqSender)
 
 			actual, err := service.UpdateListName(utils.GetTestingContext(), testCase.inputListId.String(),
-				testCase.inputRequestCreator, testCase.inputListUpdate)
+				testCase.inputRequestToken, testCase.inputListUpdate)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -343,16 +344,16 @@ func TestUpdateListName(t *testing.T) {
 }
 
 func TestDeleteList(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s", utils.TestListId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s", utils.TestListId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputListId         uuid.UUID
-		inputRequestCreator string
-		expected            string
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputListId       uuid.UUID
+		inputRequestToken string
+		expected          string
+		expectedError     error
 	}{
 		{
 			name: "successfully deleted list",
@@ -360,7 +361,7 @@ func TestDeleteList(t *testing.T) {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("list deleted"), nil, http.StatusOK).
 					Once()
@@ -378,16 +379,16 @@ func TestDeleteList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			expected:            utils.TestListName,
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			expected:          utils.TestListName,
 		}, {
 			name: "failed to delete list",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil,
 						errors.New("executing request have failed"),
@@ -399,16 +400,16 @@ func TestDeleteList(t *testing.T) {
 			converter: func() *mocks.ServiceConverterList {
 				return &mocks.ServiceConverterList{}
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to ListOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned deleted list"), nil, http.StatusOK).
 					Once()
@@ -424,9 +425,9 @@ func TestDeleteList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -438,7 +439,7 @@ func TestDeleteList(t *testing.T) {
 			var reqSender list.RequestSenderInterface = reqSenderMock
 			service := list.NewServiceList(converter, &reqSender)
 
-			actual, err := service.DeleteList(utils.GetTestingContext(), testCase.inputListId.String(), testCase.inputRequestCreator)
+			actual, err := service.DeleteList(utils.GetTestingContext(), testCase.inputListId.String(), testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -454,17 +455,17 @@ func TestDeleteList(t *testing.T) {
 }
 
 func TestRemoveUserFromList(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/users/%s", utils.TestListId, utils.TestUsername)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/users/%s", utils.TestListId, utils.TestUsername)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputListId         uuid.UUID
-		inputRequestCreator string
-		inputRemoveUser     string
-		expected            string
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputListId       uuid.UUID
+		inputRequestToken string
+		inputRemoveUser   string
+		expected          string
+		expectedError     error
 	}{
 		{
 			name: "successfully removed user from list",
@@ -472,7 +473,7 @@ func TestRemoveUserFromList(t *testing.T) {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("removed user from list"), nil, http.StatusOK).
 					Once()
@@ -491,17 +492,17 @@ func TestRemoveUserFromList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			inputRemoveUser:     utils.TestUsername,
-			expected:            utils.TestUsername,
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			inputRemoveUser:   utils.TestUsername,
+			expected:          utils.TestUsername,
 		}, {
 			name: "failed to remove user from list",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil,
 						errors.New("executing request have failed"),
@@ -513,17 +514,17 @@ func TestRemoveUserFromList(t *testing.T) {
 			converter: func() *mocks.ServiceConverterList {
 				return &mocks.ServiceConverterList{}
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			inputRemoveUser:     utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			inputRemoveUser:   utils.TestUsername,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to UserOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned removed user from list"), nil, http.StatusOK).
 					Once()
@@ -539,10 +540,10 @@ func TestRemoveUserFromList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			inputRemoveUser:     utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			inputRemoveUser:   utils.TestUsername,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -555,7 +556,7 @@ func TestRemoveUserFromList(t *testing.T) {
 			service := list.NewServiceList(converter, &reqSender)
 
 			actual, err := service.RemoveUserFromList(utils.GetTestingContext(), testCase.inputListId.String(),
-				testCase.inputRemoveUser, testCase.inputRequestCreator)
+				testCase.inputRemoveUser, testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -571,16 +572,16 @@ func TestRemoveUserFromList(t *testing.T) {
 }
 
 func TestGetList(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s", utils.TestListId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s", utils.TestListId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputListId         uuid.UUID
-		inputRequestCreator string
-		expected            string
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputListId       uuid.UUID
+		inputRequestToken string
+		expected          string
+		expectedError     error
 	}{
 		{
 			name: "successfully got list by id",
@@ -588,7 +589,7 @@ func TestGetList(t *testing.T) {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("returned list"), nil, http.StatusOK).
 					Once()
@@ -596,7 +597,7 @@ func TestGetList(t *testing.T) {
 				todoUrl := url + "/todos"
 				reqSender.EXPECT().SendRequest(http.MethodGet, todoUrl, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned requested todos"), nil, http.StatusOK).
 					Once()
@@ -623,16 +624,16 @@ func TestGetList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			expected:            utils.TestListName,
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			expected:          utils.TestListName,
 		}, {
 			name: "failed to get list",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil,
 						errors.New("executing request have failed"),
@@ -644,16 +645,16 @@ func TestGetList(t *testing.T) {
 			converter: func() *mocks.ServiceConverterList {
 				return &mocks.ServiceConverterList{}
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to ListOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned requested list"), nil, http.StatusOK).
 					Once()
@@ -669,9 +670,9 @@ func TestGetList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId,
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputListId:       utils.TestListId,
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -683,7 +684,7 @@ func TestGetList(t *testing.T) {
 			var reqSender list.RequestSenderInterface = reqSenderMock
 			service := list.NewServiceList(converter, &reqSender)
 
-			actual, err := service.GetList(utils.GetTestingContext(), testCase.inputListId.String(), testCase.inputRequestCreator)
+			actual, err := service.GetList(utils.GetTestingContext(), testCase.inputListId.String(), testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -699,25 +700,25 @@ func TestGetList(t *testing.T) {
 }
 
 func TestGetLists(t *testing.T) {
-	url := utils.BaseUrl + utils.BasePath + "/list"
+	url := utils.BasePath + "/list"
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputRequestCreator string
-		expected            []string
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputRequestToken string
+		expected          []string
+		expectedError     error
 	}{
 		{
 			name: "successfully got all lists",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("returned list"), nil, http.StatusOK).
+					Return([]byte("returned list"), http.Header{}, nil, http.StatusOK).
 					Once()
 
 				return reqSender
@@ -735,7 +736,7 @@ func TestGetLists(t *testing.T) {
 
 				return srvConverter
 			},
-			inputRequestCreator: utils.TestUsername,
+			inputRequestToken: utils.TestToken,
 			expected: []string{
 				utils.TestListName + "1",
 				utils.TestListName + "2",
@@ -744,11 +745,11 @@ func TestGetLists(t *testing.T) {
 			name: "failed to get all lists",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return(nil,
+					Return(nil, nil,
 						errors.New("executing request have failed"),
 						http.StatusOK).
 					Once()
@@ -758,17 +759,17 @@ func TestGetLists(t *testing.T) {
 			converter: func() *mocks.ServiceConverterList {
 				return &mocks.ServiceConverterList{}
 			},
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to ListsOutputs failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("Returned requested lists"), nil, http.StatusOK).
+					Return([]byte("Returned requested lists"), http.Header{}, nil, http.StatusOK).
 					Once()
 
 				return reqSender
@@ -782,8 +783,8 @@ func TestGetLists(t *testing.T) {
 
 				return srvConverter
 			},
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -795,7 +796,7 @@ func TestGetLists(t *testing.T) {
 			var reqSender list.RequestSenderInterface = reqSenderMock
 			service := list.NewServiceList(converter, &reqSender)
 
-			actual, err := service.GetLists(utils.GetTestingContext(), nil, nil, testCase.inputRequestCreator)
+			actual, err := service.GetLists(utils.GetTestingContext(), nil, nil, nil, nil, testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -815,145 +816,205 @@ func TestGetLists(t *testing.T) {
 }
 
 func TestGetListsPagination(t *testing.T) {
-	url := utils.BaseUrl + utils.BasePath + "/list"
+	url := utils.BasePath + "/list"
+	listsOutputs := []*model.ListOutput{
+		&model.ListOutput{
+			ID:   uuid.UUID{1}.String(),
+			Name: utils.TestListName + "1",
+		}, &model.ListOutput{
+			ID:   uuid.UUID{2}.String(),
+			Name: utils.TestListName + "2",
+		},
+	}
+	first := int32(2)
+	last := int32(1)
+	after := "after-cursor"
+	before := "before-cursor"
+	startCursor := "start-cursor"
+	endCursor := "end-cursor"
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputRequestCreator string
-		inputFirst          int32
-		inputAfter          string
-		expected            []string
-		expectedError       error
+		name               string
+		inputFirst         *int32
+		inputAfter         *string
+		inputLast          *int32
+		inputBefore        *string
+		expectedQuery      string
+		responseHeaders    http.Header
+		expectedTotalCount int32
+		expectedPageInfo   model.PageInfo
 	}{
 		{
-			name: "successfully got all lists",
-			requestSender: func() *mocks.RequestSenderInterface {
-				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
-					map[string]string{
-						utils.Username: utils.TestUsername,
-					}, http.StatusOK).
-					Return([]byte("returned list"), nil, http.StatusOK).
-					Once()
-
-				return reqSender
-			},
-			converter: func() *mocks.ServiceConverterList {
-				srvConverter := &mocks.ServiceConverterList{}
-				srvConverter.EXPECT().ConvertResponseToListsOutputs([]byte("returned list")).
-					Return([]*model.ListOutput{
-						&model.ListOutput{
-							Name: utils.TestListName + "1",
-						}, &model.ListOutput{
-							Name: utils.TestListName + "2",
-						}}, nil).
-					Once()
-
-				return srvConverter
-			},
-			inputFirst:          int32(2),
-			inputRequestCreator: utils.TestUsername,
-			expected: []string{
-				utils.TestListName + "1",
-				utils.TestListName + "2",
+			name:               "all lists without headers",
+			expectedTotalCount: 2,
+		}, {
+			name:          "first lists after a cursor",
+			inputFirst:    &first,
+			inputAfter:    &after,
+			expectedQuery: "?cursor=after-cursor&limit=2",
+			responseHeaders: http.Header{
+				utils.TotalCountHeader:      {"5"},
+				utils.StartCursorHeader:     {startCursor},
+				utils.EndCursorHeader:       {endCursor},
+				utils.HasNextPageHeader:     {"true"},
+				utils.HasPreviousPageHeader: {"true"},
+			},
+			expectedTotalCount: 5,
+			expectedPageInfo: model.PageInfo{
+				StartCursor:     &startCursor,
+				EndCursor:       &endCursor,
+				HasNextPage:     true,
+				HasPreviousPage: true,
 			},
 		}, {
-			name: "successfully got only the first list",
+			name:          "last lists before a cursor",
+			inputLast:     &last,
+			inputBefore:   &before,
+			expectedQuery: "?before=before-cursor&last=1",
+			responseHeaders: http.Header{
+				utils.TotalCountHeader:      {"5"},
+				utils.StartCursorHeader:     {startCursor},
+				utils.EndCursorHeader:       {startCursor},
+				utils.HasNextPageHeader:     {"true"},
+				utils.HasPreviousPageHeader: {"false"},
+			},
+			expectedTotalCount: 5,
+			expectedPageInfo: model.PageInfo{
+				StartCursor: &startCursor,
+				EndCursor:   &startCursor,
+				HasNextPage: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			reqSenderMock.EXPECT().SendRequestWithHeaders(http.MethodGet, url+testCase.expectedQuery, nil,
+				map[string]string{
+					utils.Authorization: utils.BearerPrefix + utils.TestToken,
+				}, http.StatusOK).
+				Return([]byte("returned lists"), testCase.responseHeaders, nil, http.StatusOK).
+				Once()
+			converterMock := &mocks.ServiceConverterList{}
+			converterMock.EXPECT().ConvertResponseToListsOutputs([]byte("returned lists")).
+				Return(listsOutputs, nil).
+				Once()
+
+			var converter list.ServiceConverterList = converterMock
+			var reqSender list.RequestSenderInterface = reqSenderMock
+			service := list.NewServiceList(converter, &reqSender)
+
+			actual, err := service.GetLists(utils.GetTestingContext(),
+				testCase.inputFirst,
+				testCase.inputAfter,
+				testCase.inputLast,
+				testCase.inputBefore,
+				utils.TestToken)
+			require.NoError(t, err)
+
+			require.Equal(t, listsOutputs, actual.Lists)
+			require.Equal(t, testCase.expectedTotalCount, *actual.TotalCount)
+			require.Equal(t, testCase.expectedPageInfo, *actual.PageInfo)
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetUserFromList(t *testing.T) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/users/%s", utils.TestListId, utils.TestUsername)
+
+	testCases := []struct {
+		name               string
+		requestSender      func() *mocks.RequestSenderInterface
+		converter          func() *mocks.ServiceConverterList
+		inputRequestListId string
+		inputRequestedUser string
+		inputRequestToken  string
+		expected           model.UserOutput
+		expectedError      error
+	}{
+		{
+			name: "successfully got requested user",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("returned list"), nil, http.StatusOK).
+					Return([]byte("returned user"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
 			converter: func() *mocks.ServiceConverterList {
 				srvConverter := &mocks.ServiceConverterList{}
-				srvConverter.EXPECT().ConvertResponseToListsOutputs([]byte("returned list")).
-					Return([]*model.ListOutput{
-						&model.ListOutput{
-							Name: utils.TestListName + "1",
-						}, &model.ListOutput{
-							Name: utils.TestListName + "2",
-						}}, nil).
-					Once()
+				srvConverter.EXPECT().ConvertResponseToUserOutput([]byte("returned user")).
+					Return(&model.UserOutput{
+						ListID:   utils.TestListId.String(),
+						ListName: utils.TestListName,
+						Username: utils.TestUsername,
+					}, nil).Once()
 
 				return srvConverter
 			},
-			inputFirst:          int32(1),
-			inputRequestCreator: utils.TestUsername,
-			expected: []string{
-				utils.TestListName + "1",
+			inputRequestToken:  utils.TestToken,
+			inputRequestedUser: utils.TestUsername,
+			inputRequestListId: utils.TestListId.String(),
+			expected: model.UserOutput{
+				ListID:   utils.TestListId.String(),
+				ListName: utils.TestListName,
+				Username: utils.TestUsername,
 			},
 		}, {
-			name: "skip the first list and get the other",
+			name: "failed to get the user",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("returned list"), nil, http.StatusOK).
+					Return(nil,
+						errors.New("executing request have failed"),
+						http.StatusOK).
 					Once()
 
 				return reqSender
 			},
 			converter: func() *mocks.ServiceConverterList {
-				srvConverter := &mocks.ServiceConverterList{}
-				srvConverter.EXPECT().ConvertResponseToListsOutputs([]byte("returned list")).
-					Return([]*model.ListOutput{
-						&model.ListOutput{
-							ID:   uuid.UUID{1}.String(),
-							Name: utils.TestListName + "1",
-						}, &model.ListOutput{
-							ID:   uuid.UUID{2}.String(),
-							Name: utils.TestListName + "2",
-						}}, nil).
-					Once()
-
-				return srvConverter
-			},
-			inputFirst:          int32(100),
-			inputAfter:          uuid.UUID{1}.String(),
-			inputRequestCreator: utils.TestUsername,
-			expected: []string{
-				utils.TestListName + "2",
+				return &mocks.ServiceConverterList{}
 			},
+			inputRequestToken:  utils.TestToken,
+			inputRequestedUser: utils.TestUsername,
+			inputRequestListId: utils.TestListId.String(),
+			expectedError:      errors.New("executing request have failed"),
 		}, {
-			name: "try to get out of range lists",
+			name: "converting to UserOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("returned list"), nil, http.StatusOK).
+					Return([]byte("Returned requested user"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
 			converter: func() *mocks.ServiceConverterList {
 				srvConverter := &mocks.ServiceConverterList{}
-				srvConverter.EXPECT().ConvertResponseToListsOutputs([]byte("returned list")).
-					Return([]*model.ListOutput{
-						&model.ListOutput{
-							Name: utils.TestListName + "1",
-						}, &model.ListOutput{
-							ID:   uuid.UUID{2}.String(),
-							Name: utils.TestListName + "2",
-						}}, nil).
+				srvConverter.EXPECT().ConvertResponseToUserOutput([]byte("Returned requested user")).
+					Return(nil,
+						errors.New("converting response failed")).
 					Once()
 
 				return srvConverter
 			},
-			inputAfter:          uuid.UUID{2}.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("lists is out of range"),
+			inputRequestToken:  utils.TestToken,
+			inputRequestedUser: utils.TestUsername,
+			inputRequestListId: utils.TestListId.String(),
+			expectedError:      errors.New("converting response failed"),
 		},
 	}
 
@@ -965,13 +1026,8 @@ func TestGetListsPagination(t *testing.T) {
 			var reqSender list.RequestSenderInterface = reqSenderMock
 			service := list.NewServiceList(converter, &reqSender)
 
-			firstParam := &testCase.inputFirst
-			var afterParam *string = nil
-			if testCase.inputAfter != "" {
-				afterParam = &testCase.inputAfter
-			}
-
-			actual, err := service.GetLists(utils.GetTestingContext(), firstParam, afterParam, testCase.inputRequestCreator)
+			actual, err := service.GetUserFromList(utils.GetTestingContext(), testCase.inputRequestListId,
+				testCase.inputRequestedUser, testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -979,69 +1035,63 @@ func TestGetListsPagination(t *testing.T) {
 				return
 			}
 
-			actualOnlyNames := make([]string, len(actual.Lists))
-			for i, list := range actual.Lists {
-				actualOnlyNames[i] = list.Name
-			}
-			require.Equal(t, testCase.expected, actualOnlyNames)
+			require.Equal(t, testCase.expected, *actual)
 			converterMock.AssertExpectations(t)
 			reqSenderMock.AssertExpectations(t)
 		})
 	}
 }
 
-func TestGetUserFromList(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/users/%s", utils.TestListId, utils.TestUsername)
+func TestGetUsersFromList(t *testing.T) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/users", utils.TestListId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputRequestListId  string
-		inputRequestedUser  string
-		inputRequestCreator string
-		expected            model.UserOutput
-		expectedError       error
+		name               string
+		requestSender      func() *mocks.RequestSenderInterface
+		converter          func() *mocks.ServiceConverterList
+		inputRequestListId string
+		inputRequestToken  string
+		expected           model.ListOutput
+		expectedError      error
 	}{
 		{
-			name: "successfully got requested user",
+			name: "successfully got all users from list",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("returned user"), nil, http.StatusOK).
+					Return([]byte("returned users"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
 			converter: func() *mocks.ServiceConverterList {
 				srvConverter := &mocks.ServiceConverterList{}
-				srvConverter.EXPECT().ConvertResponseToUserOutput([]byte("returned user")).
-					Return(&model.UserOutput{
-						ListID:   utils.TestListId.String(),
-						ListName: utils.TestListName,
-						Username: utils.TestUsername,
+				srvConverter.EXPECT().ConvertResponseToListOutput([]byte("returned users")).
+					Return(&model.ListOutput{
+						ID:    utils.TestListId.String(),
+						Name:  utils.TestListName,
+						Owner: utils.TestUsername,
 					}, nil).Once()
 
 				return srvConverter
 			},
-			inputRequestCreator: utils.TestUsername,
-			inputRequestedUser:  utils.TestUsername,
-			inputRequestListId:  utils.TestListId.String(),
-			expected: model.UserOutput{
-				ListID:   utils.TestListId.String(),
-				ListName: utils.TestListName,
-				Username: utils.TestUsername,
+			inputRequestToken:  utils.TestToken,
+			inputRequestListId: utils.TestListId.String(),
+			expected: model.ListOutput{
+				ID:    utils.TestListId.String(),
+				Name:  utils.TestListName,
+				Owner: utils.TestUsername,
 			},
 		}, {
-			name: "failed to get the user",
+			name: "failed to get the users",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil,
 						errors.New("executing request have failed"),
@@ -1053,36 +1103,34 @@ func TestGetUserFromList(t *testing.T) {
 			converter: func() *mocks.ServiceConverterList {
 				return &mocks.ServiceConverterList{}
 			},
-			inputRequestCreator: utils.TestUsername,
-			inputRequestedUser:  utils.TestUsername,
-			inputRequestListId:  utils.TestListId.String(),
-			expectedError:       errors.New("executing request have failed"),
+			inputRequestToken:  utils.TestToken,
+			inputRequestListId: utils.TestListId.String(),
+			expectedError:      errors.New("executing request have failed"),
 		}, {
-			name: "converting to UserOutput failed",
+			name: "converting to ListOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("Returned requested user"), nil, http.StatusOK).
+					Return([]byte("Returned requested users"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
 			converter: func() *mocks.ServiceConverterList {
 				srvConverter := &mocks.ServiceConverterList{}
-				srvConverter.EXPECT().ConvertResponseToUserOutput([]byte("Returned requested user")).
+				srvConverter.EXPECT().ConvertResponseToListOutput([]byte("Returned requested users")).
 					Return(nil,
 						errors.New("converting response failed")).
 					Once()
 
 				return srvConverter
 			},
-			inputRequestCreator: utils.TestUsername,
-			inputRequestedUser:  utils.TestUsername,
-			inputRequestListId:  utils.TestListId.String(),
-			expectedError:       errors.New("converting response failed"),
+			inputRequestToken:  utils.TestToken,
+			inputRequestListId: utils.TestListId.String(),
+			expectedError:      errors.New("converting response failed"),
 		},
 	}
 
@@ -1094,8 +1142,9 @@ func TestGetUserFromList(t *testing.T) {
 			var reqSender list.RequestSenderInterface = reqSenderMock
 			service := list.NewServiceList(converter, &reqSender)
 
-			actual, err := service.GetUserFromList(utils.GetTestingContext(), testCase.inputRequestListId,
-				testCase.inputRequestedUser, testCase.inputRequestCreator)
+			actual, err := service.GetUsersFromList(utils.GetTestingContext(),
+				testCase.inputRequestListId,
+				testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -1110,60 +1159,54 @@ func TestGetUserFromList(t *testing.T) {
 	}
 }
 
-func TestGetUsersFromList(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/users", utils.TestListId)
+func TestGetWorkflow(t *testing.T) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/workflow", utils.TestListId)
+	workflow := model.Workflow{
+		ListID:      utils.TestListId.String(),
+		States:      []*model.WorkflowState{{Name: utils.TestTodoStatus, Done: true}},
+		Transitions: []*model.WorkflowTransition{},
+	}
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterList
-		inputRequestListId  string
-		inputRequestCreator string
-		expected            model.ListOutput
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputRequestToken string
+		expected          model.Workflow
+		expectedError     error
 	}{
 		{
-			name: "successfully got all users from list",
+			name: "successfully got workflow",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("returned users"), nil, http.StatusOK).
+					Return([]byte("returned workflow"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
 			converter: func() *mocks.ServiceConverterList {
 				srvConverter := &mocks.ServiceConverterList{}
-				srvConverter.EXPECT().ConvertResponseToListOutput([]byte("returned users")).
-					Return(&model.ListOutput{
-						ID:    utils.TestListId.String(),
-						Name:  utils.TestListName,
-						Owner: utils.TestUsername,
-					}, nil).Once()
+				srvConverter.EXPECT().ConvertResponseToWorkflow([]byte("returned workflow")).
+					Return(&workflow, nil).
+					Once()
 
 				return srvConverter
 			},
-			inputRequestCreator: utils.TestUsername,
-			inputRequestListId:  utils.TestListId.String(),
-			expected: model.ListOutput{
-				ID:    utils.TestListId.String(),
-				Name:  utils.TestListName,
-				Owner: utils.TestUsername,
-			},
+			inputRequestToken: utils.TestToken,
+			expected:          workflow,
 		}, {
-			name: "failed to get the users",
+			name: "failed to get the workflow",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return(nil,
-						errors.New("executing request have failed"),
-						http.StatusOK).
+					Return(nil, errors.New("executing request have failed"), http.StatusNotFound).
 					Once()
 
 				return reqSender
@@ -1171,34 +1214,118 @@ func TestGetUsersFromList(t *testing.T) {
 			converter: func() *mocks.ServiceConverterList {
 				return &mocks.ServiceConverterList{}
 			},
-			inputRequestCreator: utils.TestUsername,
-			inputRequestListId:  utils.TestListId.String(),
-			expectedError:       errors.New("executing request have failed"),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			converterMock := testCase.converter()
+			var converter list.ServiceConverterList = converterMock
+			reqSenderMock := testCase.requestSender()
+			var reqSender list.RequestSenderInterface = reqSenderMock
+			service := list.NewServiceList(converter, &reqSender)
+
+			actual, err := service.GetWorkflow(utils.GetTestingContext(), utils.TestListId.String(), testCase.inputRequestToken)
+			if err != nil {
+				require.Equal(t, testCase.expectedError, err)
+				converterMock.AssertExpectations(t)
+				reqSenderMock.AssertExpectations(t)
+				return
+			}
+
+			require.Equal(t, testCase.expected, *actual)
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateWorkflow(t *testing.T) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/workflow", utils.TestListId)
+	workflowInput := model.WorkflowInput{
+		States:      []*model.WorkflowStateInput{{Name: utils.TestTodoStatus}},
+		Transitions: []*model.WorkflowTransitionInput{},
+	}
+	workflow := model.Workflow{
+		ListID:      utils.TestListId.String(),
+		States:      []*model.WorkflowState{{Name: utils.TestTodoStatus, Done: true}},
+		Transitions: []*model.WorkflowTransition{},
+	}
+
+	testCases := []struct {
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputRequestToken string
+		expected          model.Workflow
+		expectedError     error
+	}{
+		{
+			name: "successfully updated workflow",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodPut, url, workflowInput,
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return([]byte("updated workflow"), nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterList {
+				srvConverter := &mocks.ServiceConverterList{}
+				srvConverter.EXPECT().ConvertResponseToWorkflow([]byte("updated workflow")).
+					Return(&workflow, nil).
+					Once()
+
+				return srvConverter
+			},
+			inputRequestToken: utils.TestToken,
+			expected:          workflow,
 		}, {
-			name: "converting to ListOutput failed",
+			name: "workflow rejected",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+				reqSender.EXPECT().SendRequest(http.MethodPut, url, workflowInput,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("Returned requested users"), nil, http.StatusOK).
+					Return(nil, errors.New("workflow must have at least one done state"), http.StatusBadRequest).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterList {
+				return &mocks.ServiceConverterList{}
+			},
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("workflow must have at least one done state"),
+		}, {
+			name: "converting to Workflow failed",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodPut, url, workflowInput,
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return([]byte("updated workflow"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
 			converter: func() *mocks.ServiceConverterList {
 				srvConverter := &mocks.ServiceConverterList{}
-				srvConverter.EXPECT().ConvertResponseToListOutput([]byte("Returned requested users")).
-					Return(nil,
-						errors.New("converting response failed")).
+				srvConverter.EXPECT().ConvertResponseToWorkflow([]byte("updated workflow")).
+					Return(nil, errors.New("converting response failed")).
 					Once()
 
 				return srvConverter
 			},
-			inputRequestCreator: utils.TestUsername,
-			inputRequestListId:  utils.TestListId.String(),
-			expectedError:       errors.New("converting response failed"),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -1210,9 +1337,7 @@ func TestGetUsersFromList(t *testing.T) {
 			var reqSender list.RequestSenderInterface = reqSenderMock
 			service := list.NewServiceList(converter, &reqSender)
 
-			actual, err := service.GetUsersFromList(utils.GetTestingContext(),
-				testCase.inputRequestListId,
-				testCase.inputRequestCreator)
+			actual, err := service.UpdateWorkflow(utils.GetTestingContext(), utils.TestListId.String(), testCase.inputRequestToken, workflowInput)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -1226,3 +1351,117 @@ func TestGetUsersFromList(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveListAsTemplate(t *testing.T) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/template", utils.TestListId)
+	templateInput := model.TemplateInput{Name: utils.TestListName}
+	template := model.Template{
+		ID:    uuid.UUID{4}.String(),
+		Name:  utils.TestListName,
+		Owner: utils.TestUsername,
+		Todos: []*model.TemplateTodo{{Name: utils.TestTodoName, DeadlineOffset: 2}},
+	}
+
+	testCases := []struct {
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterList
+		inputRequestToken string
+		expected          model.Template
+		expectedError     error
+	}{
+		{
+			name: "successfully saved list as template",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodPost, url, restStructures.TemplateInput{Name: utils.TestListName},
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusCreated).
+					Return([]byte("created template"), nil, http.StatusCreated).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterList {
+				srvConverter := &mocks.ServiceConverterList{}
+				srvConverter.EXPECT().ConvertResponseToTemplate([]byte("created template")).
+					Return(&template, nil).
+					Once()
+
+				return srvConverter
+			},
+			inputRequestToken: utils.TestToken,
+			expected:          template,
+		}, {
+			name: "template name taken",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodPost, url, restStructures.TemplateInput{Name: utils.TestListName},
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusCreated).
+					Return(nil, errors.New("error already exists template with this name"), http.StatusConflict).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterList {
+				return &mocks.ServiceConverterList{}
+			},
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("error already exists template with this name"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			converterMock := testCase.converter()
+			var converter list.ServiceConverterList = converterMock
+			reqSenderMock := testCase.requestSender()
+			var reqSender list.RequestSenderInterface = reqSenderMock
+			service := list.NewServiceList(converter, &reqSender)
+
+			actual, err := service.SaveListAsTemplate(utils.GetTestingContext(), utils.TestListId.String(), testCase.inputRequestToken, templateInput)
+			if err != nil {
+				require.Equal(t, testCase.expectedError, err)
+				converterMock.AssertExpectations(t)
+				reqSenderMock.AssertExpectations(t)
+				return
+			}
+
+			require.Equal(t, testCase.expected, *actual)
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDuplicateList(t *testing.T) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/duplicate", utils.TestListId)
+	includeMembers := true
+	duplicateInput := model.DuplicateListInput{Name: utils.TestListName, IncludeMembers: &includeMembers}
+	listOutput := model.ListOutput{ID: uuid.UUID{5}.String(), Name: utils.TestListName, Owner: utils.TestUsername}
+
+	reqSender := &mocks.RequestSenderInterface{}
+	reqSender.EXPECT().SendRequest(http.MethodPost, url, restStructures.ListDuplicateInput{Name: utils.TestListName, IncludeMembers: true},
+		map[string]string{
+			utils.Authorization: utils.BearerPrefix + utils.TestToken,
+		}, http.StatusCreated).
+		Return([]byte("duplicated list"), nil, http.StatusCreated).
+		Once()
+	srvConverter := &mocks.ServiceConverterList{}
+	srvConverter.EXPECT().ConvertResponseToListOutput([]byte("duplicated list")).
+		Return(&listOutput, nil).
+		Once()
+
+	var converter list.ServiceConverterList = srvConverter
+	var sender list.RequestSenderInterface = reqSender
+	service := list.NewServiceList(converter, &sender)
+
+	actual, err := service.DuplicateList(utils.GetTestingContext(), utils.TestListId.String(), utils.TestToken, duplicateInput)
+	require.NoError(t, err)
+	require.Equal(t, listOutput, *actual)
+	srvConverter.AssertExpectations(t)
+	reqSender.AssertExpectations(t)
+}
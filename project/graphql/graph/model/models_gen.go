@@ -3,9 +3,96 @@
 package model
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
+type AuditConnection struct {
+	TotalCount *int32        `json:"totalCount,omitempty"`
+	Entries    []*AuditEntry `json:"entries,omitempty"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+}
+
+type AuditEntry struct {
+	ID         string         `json:"id"`
+	Actor      string         `json:"actor"`
+	Action     string         `json:"action"`
+	EntityType string         `json:"entityType"`
+	EntityID   string         `json:"entityId"`
+	ListID     string         `json:"listId"`
+	TodoID     *string        `json:"todoId,omitempty"`
+	Before     map[string]any `json:"before,omitempty"`
+	After      map[string]any `json:"after,omitempty"`
+	RequestID  string         `json:"requestId"`
+	CreatedAt  time.Time      `json:"createdAt"`
+}
+
+type AuditFilter struct {
+	ListID *string `json:"listId,omitempty"`
+	TodoID *string `json:"todoId,omitempty"`
+	Actor  *string `json:"actor,omitempty"`
+}
+
+type BulkTodoInput struct {
+	Operation BulkTodoOperation `json:"operation"`
+	Mode      *BulkMode         `json:"mode,omitempty"`
+	TodoIds   []string          `json:"todoIds"`
+	Status    *string           `json:"status,omitempty"`
+	Priority  *string           `json:"priority,omitempty"`
+	Username  *string           `json:"username,omitempty"`
+	LabelID   *string           `json:"labelId,omitempty"`
+	ListID    *string           `json:"listId,omitempty"`
+	Conflict  *string           `json:"conflict,omitempty"`
+}
+
+type BulkTodoOutput struct {
+	Operation string            `json:"operation"`
+	Mode      string            `json:"mode"`
+	Succeeded int32             `json:"succeeded"`
+	Failed    int32             `json:"failed"`
+	Results   []*BulkTodoResult `json:"results"`
+}
+
+type BulkTodoResult struct {
+	TodoID  string      `json:"todoId"`
+	Success bool        `json:"success"`
+	Error   *string     `json:"error,omitempty"`
+	Todo    *TodoOutput `json:"todo,omitempty"`
+}
+
+type CommentConnection struct {
+	TotalCount *int32           `json:"totalCount,omitempty"`
+	Comments   []*CommentOutput `json:"comments,omitempty"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
+}
+
+type CommentInput struct {
+	Body string `json:"body"`
+}
+
+type CommentOutput struct {
+	ID        string     `json:"id"`
+	TodoID    string     `json:"todoId"`
+	Author    string     `json:"author"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"createdAt"`
+	EditedAt  *time.Time `json:"editedAt,omitempty"`
+}
+
+type DuplicateListInput struct {
+	Name           string `json:"name"`
+	IncludeMembers *bool  `json:"includeMembers,omitempty"`
+}
+
+type LabelOutput struct {
+	ID     string `json:"id"`
+	ListID string `json:"listId"`
+	Name   string `json:"name"`
+	Color  string `json:"color"`
+}
+
 type List struct {
 	Name string `json:"name"`
 }
@@ -28,19 +115,85 @@ type Mutation struct {
 }
 
 type PageInfo struct {
-	StartCursor *string `json:"startCursor,omitempty"`
-	EndCursor   *string `json:"endCursor,omitempty"`
-	HasNextPage bool    `json:"hasNextPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
 }
 
 type Query struct {
 }
 
+type SearchResult struct {
+	Type                 string  `json:"type"`
+	ID                   string  `json:"id"`
+	ListID               string  `json:"listId"`
+	ListName             string  `json:"listName"`
+	Name                 string  `json:"name"`
+	Description          string  `json:"description"`
+	Rank                 float64 `json:"rank"`
+	NameHighlight        string  `json:"nameHighlight"`
+	DescriptionHighlight string  `json:"descriptionHighlight"`
+}
+
+type SubtaskInput struct {
+	Title    string  `json:"title"`
+	Done     *bool   `json:"done,omitempty"`
+	Assignee *string `json:"assignee,omitempty"`
+	Position *int32  `json:"position,omitempty"`
+}
+
+type SubtaskOutput struct {
+	ID       string `json:"id"`
+	TodoID   string `json:"todoId"`
+	Title    string `json:"title"`
+	Done     bool   `json:"done"`
+	Assignee string `json:"assignee"`
+	Position int32  `json:"position"`
+}
+
+type Template struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Owner       string                `json:"owner"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	States      []*WorkflowState      `json:"states"`
+	Transitions []*WorkflowTransition `json:"transitions"`
+	Labels      []*TemplateLabel      `json:"labels"`
+	Todos       []*TemplateTodo       `json:"todos"`
+}
+
+type TemplateInput struct {
+	Name      string     `json:"name"`
+	StartDate *time.Time `json:"startDate,omitempty"`
+}
+
+type TemplateInstanceInput struct {
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"startDate"`
+}
+
+type TemplateLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type TemplateTodo struct {
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	Priority       string   `json:"priority"`
+	Recurrence     string   `json:"recurrence"`
+	DeadlineOffset int32    `json:"deadlineOffset"`
+	Labels         []string `json:"labels"`
+	Subtasks       []string `json:"subtasks"`
+}
+
 type Todo struct {
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
 	Deadline    time.Time `json:"deadline"`
 	Priority    string    `json:"priority"`
+	Recurrence  *string   `json:"recurrence,omitempty"`
 }
 
 type TodoConnection struct {
@@ -49,15 +202,57 @@ type TodoConnection struct {
 	PageInfo   *PageInfo     `json:"pageInfo"`
 }
 
+type TodoDependency struct {
+	ID     string `json:"id"`
+	ListID string `json:"listId"`
+	Name   string `json:"name"`
+	Done   bool   `json:"done"`
+}
+
+type TodoDependencyInput struct {
+	TodoID string  `json:"todoId"`
+	ListID *string `json:"listId,omitempty"`
+}
+
+type TodoFilter struct {
+	Status       *string    `json:"status,omitempty"`
+	Priority     *string    `json:"priority,omitempty"`
+	Assignee     *string    `json:"assignee,omitempty"`
+	DeadlineFrom *time.Time `json:"deadlineFrom,omitempty"`
+	DeadlineTo   *time.Time `json:"deadlineTo,omitempty"`
+	Text         *string    `json:"text,omitempty"`
+	Label        *string    `json:"label,omitempty"`
+}
+
+type TodoOrder struct {
+	Field     TodoSortField  `json:"field"`
+	Direction *SortDirection `json:"direction,omitempty"`
+}
+
 type TodoOutput struct {
-	ID          string    `json:"id"`
-	ListID      string    `json:"listId"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Deadline    time.Time `json:"deadline"`
-	Assignee    string    `json:"assignee"`
-	Status      string    `json:"status"`
-	Priority    string    `json:"priority"`
+	ID          string            `json:"id"`
+	ListID      string            `json:"listId"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Deadline    time.Time         `json:"deadline"`
+	Assignees   []string          `json:"assignees"`
+	Watchers    []string          `json:"watchers"`
+	Status      string            `json:"status"`
+	Done        bool              `json:"done"`
+	Priority    string            `json:"priority"`
+	Progress    int32             `json:"progress"`
+	Labels      []*LabelOutput    `json:"labels"`
+	Recurrence  string            `json:"recurrence"`
+	SeriesID    *string           `json:"seriesId,omitempty"`
+	Blocked     bool              `json:"blocked"`
+	BlockedBy   []*TodoDependency `json:"blockedBy"`
+	Blocks      []*TodoDependency `json:"blocks"`
+}
+
+type TodoRecurrence struct {
+	Rule     string       `json:"rule"`
+	SeriesID string       `json:"seriesId"`
+	Upcoming []*time.Time `json:"upcoming"`
 }
 
 type UpdateTodoInput struct {
@@ -68,12 +263,223 @@ type UpdateTodoInput struct {
 }
 
 type User struct {
-	Username string `json:"username"`
+	Username string  `json:"username"`
+	Role     *string `json:"role,omitempty"`
 }
 
 type UserOutput struct {
 	ListID   string `json:"listId"`
 	ListName string `json:"listName"`
 	Username string `json:"username"`
+	Role     string `json:"role"`
 	IsOwner  bool   `json:"isOwner"`
 }
+
+type Workflow struct {
+	ListID      string                `json:"listId"`
+	States      []*WorkflowState      `json:"states"`
+	Transitions []*WorkflowTransition `json:"transitions"`
+}
+
+type WorkflowInput struct {
+	States      []*WorkflowStateInput      `json:"states"`
+	Transitions []*WorkflowTransitionInput `json:"transitions"`
+}
+
+type WorkflowState struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+type WorkflowStateInput struct {
+	Name string `json:"name"`
+	Done *bool  `json:"done,omitempty"`
+}
+
+type WorkflowTransition struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type WorkflowTransitionInput struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type BulkMode string
+
+const (
+	BulkModeAtomic     BulkMode = "ATOMIC"
+	BulkModeBestEffort BulkMode = "BEST_EFFORT"
+)
+
+var AllBulkMode = []BulkMode{
+	BulkModeAtomic,
+	BulkModeBestEffort,
+}
+
+func (e BulkMode) IsValid() bool {
+	switch e {
+	case BulkModeAtomic, BulkModeBestEffort:
+		return true
+	}
+	return false
+}
+
+func (e BulkMode) String() string {
+	return string(e)
+}
+
+func (e *BulkMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BulkMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BulkMode", str)
+	}
+	return nil
+}
+
+func (e BulkMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type BulkTodoOperation string
+
+const (
+	BulkTodoOperationChangeStatus BulkTodoOperation = "CHANGE_STATUS"
+	BulkTodoOperationSetPriority  BulkTodoOperation = "SET_PRIORITY"
+	BulkTodoOperationAssign       BulkTodoOperation = "ASSIGN"
+	BulkTodoOperationAddLabel     BulkTodoOperation = "ADD_LABEL"
+	BulkTodoOperationDelete       BulkTodoOperation = "DELETE"
+	BulkTodoOperationMove         BulkTodoOperation = "MOVE"
+)
+
+var AllBulkTodoOperation = []BulkTodoOperation{
+	BulkTodoOperationChangeStatus,
+	BulkTodoOperationSetPriority,
+	BulkTodoOperationAssign,
+	BulkTodoOperationAddLabel,
+	BulkTodoOperationDelete,
+	BulkTodoOperationMove,
+}
+
+func (e BulkTodoOperation) IsValid() bool {
+	switch e {
+	case BulkTodoOperationChangeStatus, BulkTodoOperationSetPriority, BulkTodoOperationAssign, BulkTodoOperationAddLabel, BulkTodoOperationDelete, BulkTodoOperationMove:
+		return true
+	}
+	return false
+}
+
+func (e BulkTodoOperation) String() string {
+	return string(e)
+}
+
+func (e *BulkTodoOperation) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BulkTodoOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BulkTodoOperation", str)
+	}
+	return nil
+}
+
+func (e BulkTodoOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoSortField string
+
+const (
+	TodoSortFieldName      TodoSortField = "NAME"
+	TodoSortFieldDeadline  TodoSortField = "DEADLINE"
+	TodoSortFieldCreatedAt TodoSortField = "CREATED_AT"
+	TodoSortFieldPriority  TodoSortField = "PRIORITY"
+	TodoSortFieldStatus    TodoSortField = "STATUS"
+)
+
+var AllTodoSortField = []TodoSortField{
+	TodoSortFieldName,
+	TodoSortFieldDeadline,
+	TodoSortFieldCreatedAt,
+	TodoSortFieldPriority,
+	TodoSortFieldStatus,
+}
+
+func (e TodoSortField) IsValid() bool {
+	switch e {
+	case TodoSortFieldName, TodoSortFieldDeadline, TodoSortFieldCreatedAt, TodoSortFieldPriority, TodoSortFieldStatus:
+		return true
+	}
+	return false
+}
+
+func (e TodoSortField) String() string {
+	return string(e)
+}
+
+func (e *TodoSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoSortField", str)
+	}
+	return nil
+}
+
+func (e TodoSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
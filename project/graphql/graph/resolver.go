@@ -12,37 +12,115 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type ServiceListInterface interface {
-	CreateList(ctx context.Context, list model.List, requestCreator string) (*model.ListOutput, error)
-	AddUserToList(ctx context.Context, listId, requestCreator string, newUser model.User) (string, error)
-	UpdateListName(ctx context.Context, listId, requestCreator string, listUpdate model.List) (*model.ListOutput, error)
-	DeleteList(ctx context.Context, listId, requestCreator string) (*model.ListOutput, error)
-	RemoveUserFromList(ctx context.Context, listId, user, requestCreator string) (*model.UserOutput, error)
-	GetList(ctx context.Context, listId, requestCreator string) (*model.ListOutput, error)
-	GetLists(ctx context.Context, first *int32, after *string, requestCreator string) (*model.ListConnection, error)
-	GetUserFromList(ctx context.Context, listId, user, requestCreator string) (*model.UserOutput, error)
-	GetUsersFromList(ctx context.Context, listId, requestCreator string) (*model.ListOutput, error)
+	CreateList(ctx context.Context, list model.List, requestToken string) (*model.ListOutput, error)
+	AddUserToList(ctx context.Context, listId, requestToken string, newUser model.User) (string, error)
+	UpdateListName(ctx context.Context, listId, requestToken string, listUpdate model.List) (*model.ListOutput, error)
+	DeleteList(ctx context.Context, listId, requestToken string) (*model.ListOutput, error)
+	RemoveUserFromList(ctx context.Context, listId, user, requestToken string) (*model.UserOutput, error)
+	GetList(ctx context.Context, listId, requestToken string) (*model.ListOutput, error)
+	GetLists(ctx context.Context, first *int32, after *string, last *int32, before *string, requestToken string) (*model.ListConnection, error)
+	GetUserFromList(ctx context.Context, listId, user, requestToken string) (*model.UserOutput, error)
+	GetUsersFromList(ctx context.Context, listId, requestToken string) (*model.ListOutput, error)
+	GetWorkflow(ctx context.Context, listId, requestToken string) (*model.Workflow, error)
+	UpdateWorkflow(ctx context.Context, listId, requestToken string, workflowInput model.WorkflowInput) (*model.Workflow, error)
+	DuplicateList(ctx context.Context, listId, requestToken string, input model.DuplicateListInput) (*model.ListOutput, error)
+	GetTemplates(ctx context.Context, requestToken string) ([]*model.Template, error)
+	GetTemplate(ctx context.Context, templateId, requestToken string) (*model.Template, error)
+	SaveListAsTemplate(ctx context.Context, listId, requestToken string, templateInput model.TemplateInput) (*model.Template, error)
+	DeleteTemplate(ctx context.Context, templateId, requestToken string) (*model.Template, error)
+	InstantiateTemplate(ctx context.Context, templateId, requestToken string, input model.TemplateInstanceInput) (*model.ListOutput, error)
 }
 
 type ServiceTodoInterface interface {
-	CreateTodo(ctx context.Context, listId, requestCreator string, todo *model.Todo) (*model.TodoOutput, error)
-	UpdateTodo(ctx context.Context, listId, todoId, requestCreator string, todoUpdate *model.UpdateTodoInput) (*model.TodoOutput, error)
-	DeleteTodo(ctx context.Context, listId, todoId, requestCreator string) (*model.TodoOutput, error)
-	AssignUserToTodo(ctx context.Context, listId, todoId, requestCreator string) (string, error)
-	ChangeTodoStatus(ctx context.Context, listId, todoId, requestCreator string) (string, error)
-	GetTodoFromList(ctx context.Context, listId, todoId, requestCreator string) (*model.TodoOutput, error)
-	GetTodosFromList(ctx context.Context, first *int32, after *string, listId, requestCreator string) (*model.TodoConnection, error)
+	CreateTodo(ctx context.Context, listId, requestToken string, todo *model.Todo) (*model.TodoOutput, error)
+	UpdateTodo(ctx context.Context, listId, todoId, requestToken string, todoUpdate *model.UpdateTodoInput) (*model.TodoOutput, error)
+	DeleteTodo(ctx context.Context, listId, todoId, requestToken string) (*model.TodoOutput, error)
+	AssignUserToTodo(ctx context.Context, listId, todoId string, username *string, requestToken string) (string, error)
+	ChangeTodoStatus(ctx context.Context, listId, todoId, status, requestToken string) (string, error)
+	ReopenTodo(ctx context.Context, listId, todoId, requestToken string) (string, error)
+	StepBackTodo(ctx context.Context, listId, todoId, requestToken string) (string, error)
+	UnassignTodo(ctx context.Context, listId, todoId, requestToken string) (string, error)
+	ReassignTodo(ctx context.Context, listId, todoId, username, requestToken string) (string, error)
+	AddTodoAssignee(ctx context.Context, listId, todoId, username, requestToken string) (string, error)
+	RemoveTodoAssignee(ctx context.Context, listId, todoId, username, requestToken string) (string, error)
+	AddTodoWatcher(ctx context.Context, listId, todoId, username, requestToken string) (string, error)
+	RemoveTodoWatcher(ctx context.Context, listId, todoId, username, requestToken string) (string, error)
+	SetTodoRecurrence(ctx context.Context, listId, todoId, rule, requestToken string) (string, error)
+	StopTodoRecurrence(ctx context.Context, listId, todoId, requestToken string) (string, error)
+	GetTodoRecurrence(ctx context.Context, listId, todoId string, limit *int32, requestToken string) (*model.TodoRecurrence, error)
+	AddTodoDependency(ctx context.Context, listId, todoId, requestToken string, dependency model.TodoDependencyInput) (string, error)
+	RemoveTodoDependency(ctx context.Context, listId, todoId, dependencyId, requestToken string) (string, error)
+	BulkUpdateTodos(ctx context.Context, listId, requestToken string, input model.BulkTodoInput) (*model.BulkTodoOutput, error)
+	GetTodoFromList(ctx context.Context, listId, todoId, requestToken string) (*model.TodoOutput, error)
+	GetTodosFromList(ctx context.Context, first *int32, after *string, last *int32, before *string, filter *model.TodoFilter, orderBy *model.TodoOrder, listId, requestToken string) (*model.TodoConnection, error)
+}
+
+type ServiceSubtaskInterface interface {
+	GetSubtasks(ctx context.Context, listId, todoId, requestToken string) ([]*model.SubtaskOutput, error)
+	CreateSubtask(ctx context.Context, listId, todoId, requestToken string, subtask model.SubtaskInput) (*model.SubtaskOutput, error)
+	UpdateSubtask(ctx context.Context, listId, todoId, subtaskId, requestToken string, subtask model.SubtaskInput) (*model.SubtaskOutput, error)
+	DeleteSubtask(ctx context.Context, listId, todoId, subtaskId, requestToken string) (*model.SubtaskOutput, error)
+}
+
+type ServiceCommentInterface interface {
+	GetComments(ctx context.Context, first *int32, after *string, last *int32, before *string, listId, todoId, requestToken string) (*model.CommentConnection, error)
+	AddComment(ctx context.Context, listId, todoId, requestToken string, comment model.CommentInput) (*model.CommentOutput, error)
+	EditComment(ctx context.Context, listId, todoId, commentId, requestToken string, comment model.CommentInput) (*model.CommentOutput, error)
+	DeleteComment(ctx context.Context, listId, todoId, commentId, requestToken string) (*model.CommentOutput, error)
+}
+
+type ServiceAuditInterface interface {
+	GetListAudit(ctx context.Context, first *int32, after *string, last *int32, before *string, listId, requestToken string) (*model.AuditConnection, error)
+	GetTodoAudit(ctx context.Context, first *int32, after *string, last *int32, before *string, listId, todoId, requestToken string) (*model.AuditConnection, error)
+	GetUserAudit(ctx context.Context, first *int32, after *string, last *int32, before *string, userId, requestToken string) (*model.AuditConnection, error)
+	GetAuditLog(ctx context.Context, first *int32, after *string, last *int32, before *string, filter *model.AuditFilter, requestToken string) (*model.AuditConnection, error)
+}
+
+type ServiceSearchInterface interface {
+	Search(ctx context.Context, text string, limit *int32, requestToken string) ([]*model.SearchResult, error)
 }
 
 type Resolver struct {
-	listService ServiceListInterface
-	todoService ServiceTodoInterface
+	listService    ServiceListInterface
+	todoService    ServiceTodoInterface
+	subtaskService ServiceSubtaskInterface
+	commentService ServiceCommentInterface
+	auditService   ServiceAuditInterface
+	searchService  ServiceSearchInterface
 }
 
-func NewResolver(listService ServiceListInterface, todoService ServiceTodoInterface) *Resolver {
+func NewResolver(listService ServiceListInterface, todoService ServiceTodoInterface, subtaskService ServiceSubtaskInterface, commentService ServiceCommentInterface, auditService ServiceAuditInterface, searchService ServiceSearchInterface) *Resolver {
 	return &Resolver{
-		listService: listService,
-		todoService: todoService,
+		listService:    listService,
+		todoService:    todoService,
+		subtaskService: subtaskService,
+		commentService: commentService,
+		auditService:   auditService,
+		searchService:  searchService,
+	}
+}
+
+func getListId(ctx context.Context, obj any) (string, bool) {
+	if list, ok := obj.(*model.ListOutput); ok && list != nil {
+		return list.ID, true
+	}
+
+	fieldCtx := graphql.GetFieldContext(ctx)
+	if fieldCtx == nil {
+		return "", false
+	}
+
+	listId, ok := fieldCtx.Args["listId"].(string)
+	return listId, ok
+}
+
+func validateListPermission(ctx context.Context, obj any, listPermission, fallbackPermission string) error {
+	listId, ok := getListId(ctx, obj)
+	if !ok {
+		return utils.ValidatePermission(ctx, fallbackPermission)
 	}
+
+	return utils.ValidateListPermission(ctx, listId, listPermission)
 }
 
 func HasAdminPermissionDirective(ctx context.Context, _ any, next graphql.Resolver) (res any, err error) {
@@ -54,8 +132,26 @@ func HasAdminPermissionDirective(ctx context.Context, _ any, next graphql.Resolv
 	return next(ctx)
 }
 
-func HasWriterPermissionDirective(ctx context.Context, _ any, next graphql.Resolver) (res any, err error) {
-	err = utils.ValidatePermission(ctx, utils.Writer)
+func HasOwnerPermissionDirective(ctx context.Context, obj any, next graphql.Resolver) (res any, err error) {
+	err = validateListPermission(ctx, obj, utils.Owner, utils.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	return next(ctx)
+}
+
+func HasManagerPermissionDirective(ctx context.Context, obj any, next graphql.Resolver) (res any, err error) {
+	err = validateListPermission(ctx, obj, utils.Manager, utils.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	return next(ctx)
+}
+
+func HasWriterPermissionDirective(ctx context.Context, obj any, next graphql.Resolver) (res any, err error) {
+	err = validateListPermission(ctx, obj, utils.Editor, utils.Writer)
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +159,8 @@ func HasWriterPermissionDirective(ctx context.Context, _ any, next graphql.Resol
 	return next(ctx)
 }
 
-func HasReaderPermissionDirective(ctx context.Context, _ any, next graphql.Resolver) (res any, err error) {
-	err = utils.ValidatePermission(ctx, utils.Reader)
+func HasReaderPermissionDirective(ctx context.Context, obj any, next graphql.Resolver) (res any, err error) {
+	err = validateListPermission(ctx, obj, utils.Viewer, utils.Reader)
 	if err != nil {
 		return nil, err
 	}
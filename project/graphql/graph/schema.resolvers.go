@@ -12,98 +12,308 @@ import (
 
 // CreateList is the resolver for the createList field.
 func (r *mutationResolver) CreateList(ctx context.Context, list model.List) (*model.ListOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.CreateList(ctx, list, requestCreator)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.CreateList(ctx, list, requestToken)
 }
 
 // AddUserToList is the resolver for the addUser field.
 func (r *mutationResolver) AddUserToList(ctx context.Context, listID string, user model.User) (string, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.AddUserToList(ctx, listID, requestCreator, user)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.AddUserToList(ctx, listID, requestToken, user)
 }
 
 // CreateTodo is the resolver for the createTodo field.
 func (r *mutationResolver) CreateTodo(ctx context.Context, listID string, todo *model.Todo) (*model.TodoOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.todoService.CreateTodo(ctx, listID, requestCreator, todo)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.CreateTodo(ctx, listID, requestToken, todo)
 }
 
 // UpdateListName is the resolver for the updateListName field.
 func (r *mutationResolver) UpdateListName(ctx context.Context, listID string, input *model.List) (*model.ListOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.UpdateListName(ctx, listID, requestCreator, *input)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.UpdateListName(ctx, listID, requestToken, *input)
 }
 
 // UpdateTodo is the resolver for the updateTodo field.
 func (r *mutationResolver) UpdateTodo(ctx context.Context, listID string, todoID string, todo *model.UpdateTodoInput) (*model.TodoOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.todoService.UpdateTodo(ctx, listID, todoID, requestCreator, todo)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.UpdateTodo(ctx, listID, todoID, requestToken, todo)
 }
 
 // DeleteList is the resolver for the deleteList field.
 func (r *mutationResolver) DeleteList(ctx context.Context, listID string) (*model.ListOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.DeleteList(ctx, listID, requestCreator)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.DeleteList(ctx, listID, requestToken)
 }
 
 // RemoveUserFromList is the resolver for the removeUser field.
 func (r *mutationResolver) RemoveUserFromList(ctx context.Context, listID string, userID string) (*model.UserOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.RemoveUserFromList(ctx, listID, userID, requestCreator)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.RemoveUserFromList(ctx, listID, userID, requestToken)
 }
 
 // DeleteTodo is the resolver for the deleteTodo field.
 func (r *mutationResolver) DeleteTodo(ctx context.Context, listID string, todoID string) (*model.TodoOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.todoService.DeleteTodo(ctx, listID, todoID, requestCreator)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.DeleteTodo(ctx, listID, todoID, requestToken)
 }
 
 // AssignUserToTodo is the resolver for the assignUserToTodo field.
-func (r *mutationResolver) AssignUserToTodo(ctx context.Context, listID string, todoID string) (string, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.todoService.AssignUserToTodo(ctx, listID, todoID, requestCreator)
+func (r *mutationResolver) AssignUserToTodo(ctx context.Context, listID string, todoID string, username *string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.AssignUserToTodo(ctx, listID, todoID, username, requestToken)
 }
 
 // ChangeTodoStatus is the resolver for the changeTodoStatus field.
-func (r *mutationResolver) ChangeTodoStatus(ctx context.Context, listID string, todoID string) (string, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.todoService.ChangeTodoStatus(ctx, listID, todoID, requestCreator)
+func (r *mutationResolver) ChangeTodoStatus(ctx context.Context, listID string, todoID string, status string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.ChangeTodoStatus(ctx, listID, todoID, status, requestToken)
+}
+
+// ReopenTodo is the resolver for the reopenTodo field.
+func (r *mutationResolver) ReopenTodo(ctx context.Context, listID string, todoID string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.ReopenTodo(ctx, listID, todoID, requestToken)
+}
+
+// StepBackTodo is the resolver for the stepBackTodo field.
+func (r *mutationResolver) StepBackTodo(ctx context.Context, listID string, todoID string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.StepBackTodo(ctx, listID, todoID, requestToken)
+}
+
+// UnassignTodo is the resolver for the unassignTodo field.
+func (r *mutationResolver) UnassignTodo(ctx context.Context, listID string, todoID string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.UnassignTodo(ctx, listID, todoID, requestToken)
+}
+
+// ReassignTodo is the resolver for the reassignTodo field.
+func (r *mutationResolver) ReassignTodo(ctx context.Context, listID string, todoID string, username string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.ReassignTodo(ctx, listID, todoID, username, requestToken)
+}
+
+// AddTodoAssignee is the resolver for the addTodoAssignee field.
+func (r *mutationResolver) AddTodoAssignee(ctx context.Context, listID string, todoID string, username string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.AddTodoAssignee(ctx, listID, todoID, username, requestToken)
+}
+
+// RemoveTodoAssignee is the resolver for the removeTodoAssignee field.
+func (r *mutationResolver) RemoveTodoAssignee(ctx context.Context, listID string, todoID string, username string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.RemoveTodoAssignee(ctx, listID, todoID, username, requestToken)
+}
+
+// AddTodoWatcher is the resolver for the addTodoWatcher field.
+func (r *mutationResolver) AddTodoWatcher(ctx context.Context, listID string, todoID string, username string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.AddTodoWatcher(ctx, listID, todoID, username, requestToken)
+}
+
+// RemoveTodoWatcher is the resolver for the removeTodoWatcher field.
+func (r *mutationResolver) RemoveTodoWatcher(ctx context.Context, listID string, todoID string, username string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.RemoveTodoWatcher(ctx, listID, todoID, username, requestToken)
+}
+
+// SetTodoRecurrence is the resolver for the setTodoRecurrence field.
+func (r *mutationResolver) SetTodoRecurrence(ctx context.Context, listID string, todoID string, rule string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.SetTodoRecurrence(ctx, listID, todoID, rule, requestToken)
+}
+
+// StopTodoRecurrence is the resolver for the stopTodoRecurrence field.
+func (r *mutationResolver) StopTodoRecurrence(ctx context.Context, listID string, todoID string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.StopTodoRecurrence(ctx, listID, todoID, requestToken)
+}
+
+// AddTodoDependency is the resolver for the addTodoDependency field.
+func (r *mutationResolver) AddTodoDependency(ctx context.Context, listID string, todoID string, dependency model.TodoDependencyInput) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.AddTodoDependency(ctx, listID, todoID, requestToken, dependency)
+}
+
+// RemoveTodoDependency is the resolver for the removeTodoDependency field.
+func (r *mutationResolver) RemoveTodoDependency(ctx context.Context, listID string, todoID string, dependencyID string) (string, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.RemoveTodoDependency(ctx, listID, todoID, dependencyID, requestToken)
+}
+
+// BulkUpdateTodos is the resolver for the bulkUpdateTodos field.
+func (r *mutationResolver) BulkUpdateTodos(ctx context.Context, listID string, input model.BulkTodoInput) (*model.BulkTodoOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.BulkUpdateTodos(ctx, listID, requestToken, input)
+}
+
+// UpdateWorkflow is the resolver for the updateWorkflow field.
+func (r *mutationResolver) UpdateWorkflow(ctx context.Context, listID string, workflow model.WorkflowInput) (*model.Workflow, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.UpdateWorkflow(ctx, listID, requestToken, workflow)
+}
+
+// CreateSubtask is the resolver for the createSubtask field.
+func (r *mutationResolver) CreateSubtask(ctx context.Context, listID string, todoID string, subtask model.SubtaskInput) (*model.SubtaskOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.subtaskService.CreateSubtask(ctx, listID, todoID, requestToken, subtask)
+}
+
+// UpdateSubtask is the resolver for the updateSubtask field.
+func (r *mutationResolver) UpdateSubtask(ctx context.Context, listID string, todoID string, subtaskID string, subtask model.SubtaskInput) (*model.SubtaskOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.subtaskService.UpdateSubtask(ctx, listID, todoID, subtaskID, requestToken, subtask)
+}
+
+// DeleteSubtask is the resolver for the deleteSubtask field.
+func (r *mutationResolver) DeleteSubtask(ctx context.Context, listID string, todoID string, subtaskID string) (*model.SubtaskOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.subtaskService.DeleteSubtask(ctx, listID, todoID, subtaskID, requestToken)
+}
+
+// AddComment is the resolver for the addComment field.
+func (r *mutationResolver) AddComment(ctx context.Context, listID string, todoID string, comment model.CommentInput) (*model.CommentOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.commentService.AddComment(ctx, listID, todoID, requestToken, comment)
+}
+
+// EditComment is the resolver for the editComment field.
+func (r *mutationResolver) EditComment(ctx context.Context, listID string, todoID string, commentID string, comment model.CommentInput) (*model.CommentOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.commentService.EditComment(ctx, listID, todoID, commentID, requestToken, comment)
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, listID string, todoID string, commentID string) (*model.CommentOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.commentService.DeleteComment(ctx, listID, todoID, commentID, requestToken)
+}
+
+// DuplicateList is the resolver for the duplicateList field.
+func (r *mutationResolver) DuplicateList(ctx context.Context, listID string, input model.DuplicateListInput) (*model.ListOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.DuplicateList(ctx, listID, requestToken, input)
+}
+
+// SaveListAsTemplate is the resolver for the saveListAsTemplate field.
+func (r *mutationResolver) SaveListAsTemplate(ctx context.Context, listID string, template model.TemplateInput) (*model.Template, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.SaveListAsTemplate(ctx, listID, requestToken, template)
+}
+
+// InstantiateTemplate is the resolver for the instantiateTemplate field.
+func (r *mutationResolver) InstantiateTemplate(ctx context.Context, templateID string, input model.TemplateInstanceInput) (*model.ListOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.InstantiateTemplate(ctx, templateID, requestToken, input)
+}
+
+// DeleteTemplate is the resolver for the deleteTemplate field.
+func (r *mutationResolver) DeleteTemplate(ctx context.Context, templateID string) (*model.Template, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.DeleteTemplate(ctx, templateID, requestToken)
 }
 
 // List is the resolver for the list field.
 func (r *queryResolver) List(ctx context.Context, listID string) (*model.ListOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.GetList(ctx, listID, requestCreator)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.GetList(ctx, listID, requestToken)
 }
 
 // Lists is the resolver for the lists field.
-func (r *queryResolver) Lists(ctx context.Context, first *int32, after *string) (*model.ListConnection, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.GetLists(ctx, first, after, requestCreator)
+func (r *queryResolver) Lists(ctx context.Context, first *int32, after *string, last *int32, before *string) (*model.ListConnection, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.GetLists(ctx, first, after, last, before, requestToken)
 }
 
 // User is the resolver for the user field.
 func (r *queryResolver) User(ctx context.Context, listID string, userID string) (*model.UserOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.GetUserFromList(ctx, listID, userID, requestCreator)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.GetUserFromList(ctx, listID, userID, requestToken)
 }
 
 // Users is the resolver for the users field.
 func (r *queryResolver) Users(ctx context.Context, listID string) (*model.ListOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.listService.GetUsersFromList(ctx, listID, requestCreator)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.GetUsersFromList(ctx, listID, requestToken)
 }
 
 // Todo is the resolver for the todo field.
 func (r *queryResolver) Todo(ctx context.Context, listID string, todoID string) (*model.TodoOutput, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.todoService.GetTodoFromList(ctx, listID, todoID, requestCreator)
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.GetTodoFromList(ctx, listID, todoID, requestToken)
 }
 
 // Todos is the resolver for the todos field.
-func (r *queryResolver) Todos(ctx context.Context, listID string, first *int32, after *string) (*model.TodoConnection, error) {
-	requestCreator := ctx.Value(utils.Username).(string)
-	return r.todoService.GetTodosFromList(ctx, first, after, listID, requestCreator)
+func (r *queryResolver) Todos(ctx context.Context, listID string, first *int32, after *string, last *int32, before *string, filter *model.TodoFilter, orderBy *model.TodoOrder) (*model.TodoConnection, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.GetTodosFromList(ctx, first, after, last, before, filter, orderBy, listID, requestToken)
+}
+
+// TodoRecurrence is the resolver for the todoRecurrence field.
+func (r *queryResolver) TodoRecurrence(ctx context.Context, listID string, todoID string, limit *int32) (*model.TodoRecurrence, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.todoService.GetTodoRecurrence(ctx, listID, todoID, limit, requestToken)
+}
+
+// Subtasks is the resolver for the subtasks field.
+func (r *queryResolver) Subtasks(ctx context.Context, listID string, todoID string) ([]*model.SubtaskOutput, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.subtaskService.GetSubtasks(ctx, listID, todoID, requestToken)
+}
+
+// Workflow is the resolver for the workflow field.
+func (r *queryResolver) Workflow(ctx context.Context, listID string) (*model.Workflow, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.GetWorkflow(ctx, listID, requestToken)
+}
+
+// Comments is the resolver for the comments field.
+func (r *queryResolver) Comments(ctx context.Context, listID string, todoID string, first *int32, after *string, last *int32, before *string) (*model.CommentConnection, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.commentService.GetComments(ctx, first, after, last, before, listID, todoID, requestToken)
+}
+
+// ListAudit is the resolver for the listAudit field.
+func (r *queryResolver) ListAudit(ctx context.Context, listID string, first *int32, after *string, last *int32, before *string) (*model.AuditConnection, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.auditService.GetListAudit(ctx, first, after, last, before, listID, requestToken)
+}
+
+// TodoAudit is the resolver for the todoAudit field.
+func (r *queryResolver) TodoAudit(ctx context.Context, listID string, todoID string, first *int32, after *string, last *int32, before *string) (*model.AuditConnection, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.auditService.GetTodoAudit(ctx, first, after, last, before, listID, todoID, requestToken)
+}
+
+// UserAudit is the resolver for the userAudit field.
+func (r *queryResolver) UserAudit(ctx context.Context, userID string, first *int32, after *string, last *int32, before *string) (*model.AuditConnection, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.auditService.GetUserAudit(ctx, first, after, last, before, userID, requestToken)
+}
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, filter *model.AuditFilter, first *int32, after *string, last *int32, before *string) (*model.AuditConnection, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.auditService.GetAuditLog(ctx, first, after, last, before, filter, requestToken)
+}
+
+// Templates is the resolver for the templates field.
+func (r *queryResolver) Templates(ctx context.Context) ([]*model.Template, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.GetTemplates(ctx, requestToken)
+}
+
+// Template is the resolver for the template field.
+func (r *queryResolver) Template(ctx context.Context, templateID string) (*model.Template, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.listService.GetTemplate(ctx, templateID, requestToken)
+}
+
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, text string, limit *int32) ([]*model.SearchResult, error) {
+	requestToken := ctx.Value(utils.Token).(string)
+	return r.searchService.Search(ctx, text, limit, requestToken)
 }
 
 // Mutation returns MutationResolver implementation.
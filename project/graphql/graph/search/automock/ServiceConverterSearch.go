@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "project/graphql/graph/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceConverterSearch is an autogenerated mock type for the ServiceConverterSearch type
+type ServiceConverterSearch struct {
+	mock.Mock
+}
+
+type ServiceConverterSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceConverterSearch) EXPECT() *ServiceConverterSearch_Expecter {
+	return &ServiceConverterSearch_Expecter{mock: &_m.Mock}
+}
+
+// ConvertResponseToSearchResults provides a mock function with given fields: response
+func (_m *ServiceConverterSearch) ConvertResponseToSearchResults(response []byte) ([]*model.SearchResult, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToSearchResults")
+	}
+
+	var r0 []*model.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]*model.SearchResult, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []*model.SearchResult); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterSearch_ConvertResponseToSearchResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToSearchResults'
+type ServiceConverterSearch_ConvertResponseToSearchResults_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToSearchResults is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterSearch_Expecter) ConvertResponseToSearchResults(response interface{}) *ServiceConverterSearch_ConvertResponseToSearchResults_Call {
+	return &ServiceConverterSearch_ConvertResponseToSearchResults_Call{Call: _e.mock.On("ConvertResponseToSearchResults", response)}
+}
+
+func (_c *ServiceConverterSearch_ConvertResponseToSearchResults_Call) Run(run func(response []byte)) *ServiceConverterSearch_ConvertResponseToSearchResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterSearch_ConvertResponseToSearchResults_Call) Return(_a0 []*model.SearchResult, _a1 error) *ServiceConverterSearch_ConvertResponseToSearchResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterSearch_ConvertResponseToSearchResults_Call) RunAndReturn(run func([]byte) ([]*model.SearchResult, error)) *ServiceConverterSearch_ConvertResponseToSearchResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceConverterSearch creates a new instance of ServiceConverterSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceConverterSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceConverterSearch {
+	mock := &ServiceConverterSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,38 @@
+package search
+
+import (
+	"encoding/json"
+	"project/graphql/graph/model"
+	restStructures "project/structures"
+)
+
+type ConverterSearch struct{}
+
+func NewSearchConverter() *ConverterSearch {
+	return &ConverterSearch{}
+}
+
+func (sc *ConverterSearch) ConvertResponseToSearchResults(response []byte) ([]*model.SearchResult, error) {
+	var searchOutputsResponse []restStructures.SearchOutput
+	err := json.Unmarshal(response, &searchOutputsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	searchResults := make([]*model.SearchResult, len(searchOutputsResponse))
+	for i, outputResponse := range searchOutputsResponse {
+		searchResults[i] = &model.SearchResult{
+			Type:                 outputResponse.Type,
+			ID:                   outputResponse.Id.String(),
+			ListID:               outputResponse.ListId.String(),
+			ListName:             outputResponse.ListName,
+			Name:                 outputResponse.Name,
+			Description:          outputResponse.Description,
+			Rank:                 outputResponse.Rank,
+			NameHighlight:        outputResponse.NameHighlight,
+			DescriptionHighlight: outputResponse.DescriptionHighlight,
+		}
+	}
+
+	return searchResults, nil
+}
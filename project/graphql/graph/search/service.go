@@ -0,0 +1,70 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"net/url"
+	"project/config"
+	"project/graphql/graph/model"
+	"project/graphql/graph/utils"
+	"strconv"
+)
+
+const (
+	textParam  = "q"
+	limitParam = "limit"
+)
+
+//go:generate mockery --name ServiceConverterSearch --output=automock --with-expecter=true
+type ServiceConverterSearch interface {
+	ConvertResponseToSearchResults(response []byte) ([]*model.SearchResult, error)
+}
+
+//go:generate mockery --name RequestSenderInterface --output=automock --with-expecter=true
+type RequestSenderInterface interface {
+	SendRequest(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, error, int)
+}
+
+type ServiceSearch struct {
+	requestSender RequestSenderInterface
+	converter     ServiceConverterSearch
+}
+
+func NewServiceSearch(converter ServiceConverterSearch, requestSender *RequestSenderInterface) *ServiceSearch {
+	if requestSender == nil {
+		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender(config.Default().Gateway)
+		requestSender = &reqSenderInterface
+	}
+
+	return &ServiceSearch{
+		requestSender: *requestSender,
+		converter:     converter,
+	}
+}
+
+func (ss *ServiceSearch) Search(ctx context.Context, text string, limit *int32, requestToken string) ([]*model.SearchResult, error) {
+	query := url.Values{}
+	query.Set(textParam, text)
+	if limit != nil {
+		query.Set(limitParam, strconv.Itoa(int(*limit)))
+	}
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := ss.requestSender.SendRequest(http.MethodGet, utils.BasePath+"/search?"+query.Encode(), nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	searchResults, err := ss.converter.ConvertResponseToSearchResults(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	log.WithField(utils.Status, status).Info(fmt.Sprintf("found %d results for %s", len(searchResults), text))
+	return searchResults, nil
+}
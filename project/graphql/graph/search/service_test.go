@@ -0,0 +1,91 @@
+package search_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"project/graphql/graph/model"
+	"project/graphql/graph/search"
+	mocks "project/graphql/graph/search/automock"
+	"project/graphql/graph/utils"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	headers := map[string]string{
+		utils.Authorization: utils.BearerPrefix + utils.TestToken,
+	}
+	limit := int32(5)
+
+	testCases := []struct {
+		name          string
+		inputText     string
+		inputLimit    *int32
+		url           string
+		response      []byte
+		responseError error
+		expected      []*model.SearchResult
+		expectedError error
+	}{
+		{
+			name:       "successfully search with limit",
+			inputText:  "release plan",
+			inputLimit: &limit,
+			url:        utils.BasePath + "/search?limit=5&q=release+plan",
+			response: []byte(fmt.Sprintf(`[{"type": "todo", "id": "%s", "list_id": "%s", "list_name": "Release", "name": "Plan",
+				"description": "Plan the release", "rank": 0.4, "name_highlight": "<b>Plan</b>", "description_highlight": "<b>Plan</b> the <b>release</b>"}]`,
+				utils.TestTodoId, utils.TestListId)),
+			expected: []*model.SearchResult{{
+				Type:                 "todo",
+				ID:                   utils.TestTodoId.String(),
+				ListID:               utils.TestListId.String(),
+				ListName:             "Release",
+				Name:                 "Plan",
+				Description:          "Plan the release",
+				Rank:                 0.4,
+				NameHighlight:        "<b>Plan</b>",
+				DescriptionHighlight: "<b>Plan</b> the <b>release</b>",
+			}},
+		}, {
+			name:      "successfully search without results",
+			inputText: "release",
+			url:       utils.BasePath + "/search?q=release",
+			response:  []byte(`[]`),
+			expected:  []*model.SearchResult{},
+		}, {
+			name:          "sending request failed",
+			inputText:     "release",
+			url:           utils.BasePath + "/search?q=release",
+			responseError: errors.New("executing request have failed"),
+			expectedError: errors.New("executing request have failed"),
+		}, {
+			name:          "converting response failed",
+			inputText:     "release",
+			url:           utils.BasePath + "/search?q=release",
+			response:      []byte(`{"type": "todo"}`),
+			expectedError: errors.New("json: cannot unmarshal object into Go value of type []structures.SearchOutput"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			reqSenderMock.EXPECT().SendRequest(http.MethodGet, testCase.url, nil, headers, http.StatusOK).
+				Return(testCase.response, testCase.responseError, http.StatusOK).
+				Once()
+			var converter search.ServiceConverterSearch = search.NewSearchConverter()
+			var reqSender search.RequestSenderInterface = reqSenderMock
+			service := search.NewServiceSearch(converter, &reqSender)
+
+			actual, err := service.Search(utils.GetTestingContext(), testCase.inputText, testCase.inputLimit, utils.TestToken)
+			if testCase.expectedError != nil {
+				require.EqualError(t, err, testCase.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expected, actual)
+			}
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "project/graphql/graph/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceConverterSubtask is an autogenerated mock type for the ServiceConverterSubtask type
+type ServiceConverterSubtask struct {
+	mock.Mock
+}
+
+type ServiceConverterSubtask_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceConverterSubtask) EXPECT() *ServiceConverterSubtask_Expecter {
+	return &ServiceConverterSubtask_Expecter{mock: &_m.Mock}
+}
+
+// ConvertResponseToSubtaskOutput provides a mock function with given fields: response
+func (_m *ServiceConverterSubtask) ConvertResponseToSubtaskOutput(response []byte) (*model.SubtaskOutput, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToSubtaskOutput")
+	}
+
+	var r0 *model.SubtaskOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (*model.SubtaskOutput, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) *model.SubtaskOutput); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SubtaskOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToSubtaskOutput'
+type ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToSubtaskOutput is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterSubtask_Expecter) ConvertResponseToSubtaskOutput(response interface{}) *ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call {
+	return &ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call{Call: _e.mock.On("ConvertResponseToSubtaskOutput", response)}
+}
+
+func (_c *ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call) Run(run func(response []byte)) *ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call) Return(_a0 *model.SubtaskOutput, _a1 error) *ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call) RunAndReturn(run func([]byte) (*model.SubtaskOutput, error)) *ServiceConverterSubtask_ConvertResponseToSubtaskOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertResponseToSubtasksOutputs provides a mock function with given fields: response
+func (_m *ServiceConverterSubtask) ConvertResponseToSubtasksOutputs(response []byte) ([]*model.SubtaskOutput, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToSubtasksOutputs")
+	}
+
+	var r0 []*model.SubtaskOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]*model.SubtaskOutput, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []*model.SubtaskOutput); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SubtaskOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToSubtasksOutputs'
+type ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToSubtasksOutputs is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterSubtask_Expecter) ConvertResponseToSubtasksOutputs(response interface{}) *ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call {
+	return &ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call{Call: _e.mock.On("ConvertResponseToSubtasksOutputs", response)}
+}
+
+func (_c *ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call) Run(run func(response []byte)) *ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call) Return(_a0 []*model.SubtaskOutput, _a1 error) *ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call) RunAndReturn(run func([]byte) ([]*model.SubtaskOutput, error)) *ServiceConverterSubtask_ConvertResponseToSubtasksOutputs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceConverterSubtask creates a new instance of ServiceConverterSubtask. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceConverterSubtask(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceConverterSubtask {
+	mock := &ServiceConverterSubtask{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
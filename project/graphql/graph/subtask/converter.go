@@ -0,0 +1,49 @@
+package subtask
+
+import (
+	"encoding/json"
+	"project/graphql/graph/model"
+	restStructures "project/structures"
+)
+
+type ConverterSubtask struct{}
+
+func NewSubtaskConverter() *ConverterSubtask {
+	return &ConverterSubtask{}
+}
+
+func (cs *ConverterSubtask) ConvertResponseToSubtaskOutput(response []byte) (*model.SubtaskOutput, error) {
+	var subtaskOutputResponse restStructures.SubtaskOutput
+	err := json.Unmarshal(response, &subtaskOutputResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSubtaskOutput(subtaskOutputResponse), nil
+}
+
+func (cs *ConverterSubtask) ConvertResponseToSubtasksOutputs(response []byte) ([]*model.SubtaskOutput, error) {
+	var subtasksOutputsResponse []restStructures.SubtaskOutput
+	err := json.Unmarshal(response, &subtasksOutputsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	subtasksOutputs := make([]*model.SubtaskOutput, len(subtasksOutputsResponse))
+	for i, outputResponse := range subtasksOutputsResponse {
+		subtasksOutputs[i] = convertSubtaskOutput(outputResponse)
+	}
+
+	return subtasksOutputs, nil
+}
+
+func convertSubtaskOutput(outputResponse restStructures.SubtaskOutput) *model.SubtaskOutput {
+	return &model.SubtaskOutput{
+		ID:       outputResponse.Id.String(),
+		TodoID:   outputResponse.TodoId.String(),
+		Title:    outputResponse.Title,
+		Done:     outputResponse.Done,
+		Assignee: outputResponse.Assignee,
+		Position: int32(outputResponse.Position),
+	}
+}
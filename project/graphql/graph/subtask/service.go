@@ -0,0 +1,95 @@
+package subtask
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/config"
+	"project/graphql/graph/model"
+	"project/graphql/graph/utils"
+)
+
+//go:generate mockery --name ServiceConverterSubtask --output=automock --with-expecter=true
+type ServiceConverterSubtask interface {
+	ConvertResponseToSubtaskOutput(response []byte) (*model.SubtaskOutput, error)
+	ConvertResponseToSubtasksOutputs(response []byte) ([]*model.SubtaskOutput, error)
+}
+
+//go:generate mockery --name RequestSenderInterface --output=automock --with-expecter=true
+type RequestSenderInterface interface {
+	SendRequest(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, error, int)
+}
+
+type ServiceSubtask struct {
+	requestSender RequestSenderInterface
+	converter     ServiceConverterSubtask
+}
+
+func NewServiceSubtask(converter ServiceConverterSubtask, requestSender *RequestSenderInterface) *ServiceSubtask {
+	if requestSender == nil {
+		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender(config.Default().Gateway)
+		requestSender = &reqSenderInterface
+	}
+
+	return &ServiceSubtask{
+		requestSender: *requestSender,
+		converter:     converter,
+	}
+}
+
+func (ss *ServiceSubtask) GetSubtasks(ctx context.Context, listId, todoId, requestToken string) ([]*model.SubtaskOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/subtasks", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := ss.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	subtasksOutputs, err := ss.converter.ConvertResponseToSubtasksOutputs(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	log.WithField(utils.Status, status).Info(fmt.Sprintf("got %d subtasks of todo with id: %s", len(subtasksOutputs), todoId))
+	return subtasksOutputs, nil
+}
+
+func (ss *ServiceSubtask) CreateSubtask(ctx context.Context, listId, todoId, requestToken string, subtask model.SubtaskInput) (*model.SubtaskOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/subtasks", listId, todoId)
+	return ss.sendSubtaskRequest(ctx, http.MethodPost, url, requestToken, subtask, http.StatusCreated)
+}
+
+func (ss *ServiceSubtask) UpdateSubtask(ctx context.Context, listId, todoId, subtaskId, requestToken string, subtask model.SubtaskInput) (*model.SubtaskOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/subtasks/%s", listId, todoId, subtaskId)
+	return ss.sendSubtaskRequest(ctx, http.MethodPut, url, requestToken, subtask, http.StatusOK)
+}
+
+func (ss *ServiceSubtask) DeleteSubtask(ctx context.Context, listId, todoId, subtaskId, requestToken string) (*model.SubtaskOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/subtasks/%s", listId, todoId, subtaskId)
+	return ss.sendSubtaskRequest(ctx, http.MethodDelete, url, requestToken, nil, http.StatusOK)
+}
+
+func (ss *ServiceSubtask) sendSubtaskRequest(ctx context.Context, method, url, requestToken string, body any, expectedStatus int) (*model.SubtaskOutput, error) {
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := ss.requestSender.SendRequest(method, url, body, headers, expectedStatus)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	subtaskOutput, err := ss.converter.ConvertResponseToSubtaskOutput(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	log.WithField(utils.Status, status).Info(*subtaskOutput)
+	return subtaskOutput, nil
+}
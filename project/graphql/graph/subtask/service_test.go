@@ -0,0 +1,189 @@
+package subtask_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"project/graphql/graph/model"
+	"project/graphql/graph/subtask"
+	mocks "project/graphql/graph/subtask/automock"
+	"project/graphql/graph/utils"
+	"testing"
+)
+
+var authorizationHeaders = map[string]string{
+	utils.Authorization: utils.BearerPrefix + utils.TestToken,
+}
+
+func newTestService(converter *mocks.ServiceConverterSubtask, requestSender *mocks.RequestSenderInterface) *subtask.ServiceSubtask {
+	var srvConverter subtask.ServiceConverterSubtask = converter
+	var reqSender subtask.RequestSenderInterface = requestSender
+	return subtask.NewServiceSubtask(srvConverter, &reqSender)
+}
+
+func TestGetSubtasks(t *testing.T) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/subtasks", utils.TestListId, utils.TestTodoId)
+
+	testCases := []struct {
+		name          string
+		requestSender func() *mocks.RequestSenderInterface
+		converter     func() *mocks.ServiceConverterSubtask
+		expected      []*model.SubtaskOutput
+		expectedError error
+	}{
+		{
+			name: "successfully get subtasks",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil, authorizationHeaders, http.StatusOK).
+					Return([]byte("Returned subtasks"), nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterSubtask {
+				srvConverter := &mocks.ServiceConverterSubtask{}
+				srvConverter.EXPECT().ConvertResponseToSubtasksOutputs([]byte("Returned subtasks")).
+					Return([]*model.SubtaskOutput{{ID: utils.TestSubtaskId.String(), Title: utils.TestSubtaskTitle}}, nil).
+					Once()
+
+				return srvConverter
+			},
+			expected: []*model.SubtaskOutput{{ID: utils.TestSubtaskId.String(), Title: utils.TestSubtaskTitle}},
+		}, {
+			name: "sending request failed",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil, authorizationHeaders, http.StatusOK).
+					Return(nil, errors.New("executing request have failed"), http.StatusNotFound).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterSubtask {
+				return &mocks.ServiceConverterSubtask{}
+			},
+			expectedError: errors.New("executing request have failed"),
+		}, {
+			name: "converting to SubtaskOutput failed",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil, authorizationHeaders, http.StatusOK).
+					Return([]byte("Returned subtasks"), nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterSubtask {
+				srvConverter := &mocks.ServiceConverterSubtask{}
+				srvConverter.EXPECT().ConvertResponseToSubtasksOutputs([]byte("Returned subtasks")).
+					Return(nil, errors.New("converting response failed")).
+					Once()
+
+				return srvConverter
+			},
+			expectedError: errors.New("converting response failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			converterMock := testCase.converter()
+			reqSenderMock := testCase.requestSender()
+			service := newTestService(converterMock, reqSenderMock)
+
+			actual, err := service.GetSubtasks(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken)
+			require.Equal(t, testCase.expectedError, err)
+			require.Equal(t, testCase.expected, actual)
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSubtaskMutations(t *testing.T) {
+	subtasksUrl := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/subtasks", utils.TestListId, utils.TestTodoId)
+	subtaskUrl := fmt.Sprintf("%s/%s", subtasksUrl, utils.TestSubtaskId)
+	input := model.SubtaskInput{Title: utils.TestSubtaskTitle}
+	output := &model.SubtaskOutput{ID: utils.TestSubtaskId.String(), Title: utils.TestSubtaskTitle}
+
+	testCases := []struct {
+		name           string
+		method         string
+		url            string
+		body           any
+		expectedStatus int
+		call           func(service *subtask.ServiceSubtask) (*model.SubtaskOutput, error)
+		sendErr        error
+		expectedError  error
+	}{
+		{
+			name:           "create subtask",
+			method:         http.MethodPost,
+			url:            subtasksUrl,
+			body:           input,
+			expectedStatus: http.StatusCreated,
+			call: func(service *subtask.ServiceSubtask) (*model.SubtaskOutput, error) {
+				return service.CreateSubtask(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken, input)
+			},
+		}, {
+			name:           "update subtask",
+			method:         http.MethodPut,
+			url:            subtaskUrl,
+			body:           input,
+			expectedStatus: http.StatusOK,
+			call: func(service *subtask.ServiceSubtask) (*model.SubtaskOutput, error) {
+				return service.UpdateSubtask(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(),
+					utils.TestSubtaskId.String(), utils.TestToken, input)
+			},
+		}, {
+			name:           "delete subtask",
+			method:         http.MethodDelete,
+			url:            subtaskUrl,
+			expectedStatus: http.StatusOK,
+			call: func(service *subtask.ServiceSubtask) (*model.SubtaskOutput, error) {
+				return service.DeleteSubtask(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(),
+					utils.TestSubtaskId.String(), utils.TestToken)
+			},
+		}, {
+			name:           "delete subtask request failed",
+			method:         http.MethodDelete,
+			url:            subtaskUrl,
+			expectedStatus: http.StatusOK,
+			call: func(service *subtask.ServiceSubtask) (*model.SubtaskOutput, error) {
+				return service.DeleteSubtask(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(),
+					utils.TestSubtaskId.String(), utils.TestToken)
+			},
+			sendErr:       errors.New("executing request have failed"),
+			expectedError: errors.New("executing request have failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			converterMock := &mocks.ServiceConverterSubtask{}
+			if testCase.sendErr != nil {
+				reqSenderMock.EXPECT().SendRequest(testCase.method, testCase.url, testCase.body, authorizationHeaders, testCase.expectedStatus).
+					Return(nil, testCase.sendErr, http.StatusNotFound).
+					Once()
+			} else {
+				reqSenderMock.EXPECT().SendRequest(testCase.method, testCase.url, testCase.body, authorizationHeaders, testCase.expectedStatus).
+					Return([]byte("Returned subtask"), nil, testCase.expectedStatus).
+					Once()
+				converterMock.EXPECT().ConvertResponseToSubtaskOutput([]byte("Returned subtask")).
+					Return(output, nil).
+					Once()
+			}
+
+			actual, err := testCase.call(newTestService(converterMock, reqSenderMock))
+			require.Equal(t, testCase.expectedError, err)
+			if testCase.expectedError == nil {
+				require.Equal(t, output, actual)
+			}
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.51.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -21,6 +21,64 @@ func (_m *ServiceConverterTodo) EXPECT() *ServiceConverterTodo_Expecter {
 	return &ServiceConverterTodo_Expecter{mock: &_m.Mock}
 }
 
+// ConvertResponseToBulkTodoOutput provides a mock function with given fields: response
+func (_m *ServiceConverterTodo) ConvertResponseToBulkTodoOutput(response []byte) (*model.BulkTodoOutput, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToBulkTodoOutput")
+	}
+
+	var r0 *model.BulkTodoOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (*model.BulkTodoOutput, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) *model.BulkTodoOutput); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BulkTodoOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToBulkTodoOutput'
+type ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToBulkTodoOutput is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterTodo_Expecter) ConvertResponseToBulkTodoOutput(response interface{}) *ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call {
+	return &ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call{Call: _e.mock.On("ConvertResponseToBulkTodoOutput", response)}
+}
+
+func (_c *ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call) Run(run func(response []byte)) *ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call) Return(_a0 *model.BulkTodoOutput, _a1 error) *ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call) RunAndReturn(run func([]byte) (*model.BulkTodoOutput, error)) *ServiceConverterTodo_ConvertResponseToBulkTodoOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConvertResponseToTodoOutput provides a mock function with given fields: response
 func (_m *ServiceConverterTodo) ConvertResponseToTodoOutput(response []byte) (*model.TodoOutput, error) {
 	ret := _m.Called(response)
@@ -79,6 +137,64 @@ func (_c *ServiceConverterTodo_ConvertResponseToTodoOutput_Call) RunAndReturn(ru
 	return _c
 }
 
+// ConvertResponseToTodoRecurrence provides a mock function with given fields: response
+func (_m *ServiceConverterTodo) ConvertResponseToTodoRecurrence(response []byte) (*model.TodoRecurrence, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToTodoRecurrence")
+	}
+
+	var r0 *model.TodoRecurrence
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (*model.TodoRecurrence, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) *model.TodoRecurrence); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TodoRecurrence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToTodoRecurrence'
+type ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToTodoRecurrence is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterTodo_Expecter) ConvertResponseToTodoRecurrence(response interface{}) *ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call {
+	return &ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call{Call: _e.mock.On("ConvertResponseToTodoRecurrence", response)}
+}
+
+func (_c *ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call) Run(run func(response []byte)) *ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call) Return(_a0 *model.TodoRecurrence, _a1 error) *ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call) RunAndReturn(run func([]byte) (*model.TodoRecurrence, error)) *ServiceConverterTodo_ConvertResponseToTodoRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConvertResponseToTodosOutputs provides a mock function with given fields: response
 func (_m *ServiceConverterTodo) ConvertResponseToTodosOutputs(response []byte) ([]*model.TodoOutput, error) {
 	ret := _m.Called(response)
@@ -2,8 +2,10 @@ package todo
 
 import (
 	"encoding/json"
+	"github.com/google/uuid"
 	"project/graphql/graph/model"
 	restStructures "project/structures"
+	"time"
 )
 
 type ConverterTodo struct{}
@@ -19,18 +21,7 @@ func (ct *ConverterTodo) ConvertResponseToTodoOutput(response []byte) (*model.To
 		return nil, err
 	}
 
-	todoOutput := &model.TodoOutput{
-		ID:          todoOutputResponse.Id.String(),
-		ListID:      todoOutputResponse.ListId.String(),
-		Name:        todoOutputResponse.Name,
-		Description: todoOutputResponse.Description,
-		Deadline:    todoOutputResponse.Deadline,
-		Assignee:    todoOutputResponse.Assignee,
-		Status:      todoOutputResponse.Status,
-		Priority:    todoOutputResponse.Priority,
-	}
-
-	return todoOutput, nil
+	return convertTodoOutput(todoOutputResponse), nil
 }
 
 func (ct *ConverterTodo) ConvertResponseToTodosOutputs(response []byte) ([]*model.TodoOutput, error) {
@@ -42,16 +33,116 @@ func (ct *ConverterTodo) ConvertResponseToTodosOutputs(response []byte) ([]*mode
 
 	todosOutputs := make([]*model.TodoOutput, len(todosOutputsResponse))
 	for i, outputResponse := range todosOutputsResponse {
-		todosOutputs[i] = &model.TodoOutput{
-			ID:          outputResponse.Id.String(),
-			ListID:      outputResponse.ListId.String(),
-			Name:        outputResponse.Name,
-			Description: outputResponse.Description,
-			Deadline:    outputResponse.Deadline,
-			Assignee:    outputResponse.Assignee,
-			Status:      outputResponse.Status,
-			Priority:    outputResponse.Priority,
-		}
+		todosOutputs[i] = convertTodoOutput(*outputResponse)
 	}
 	return todosOutputs, nil
 }
+
+func (ct *ConverterTodo) ConvertResponseToBulkTodoOutput(response []byte) (*model.BulkTodoOutput, error) {
+	var bulkResponse restStructures.TodoBulkOutput
+	err := json.Unmarshal(response, &bulkResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	bulkOutput := &model.BulkTodoOutput{
+		Operation: bulkResponse.Operation,
+		Mode:      bulkResponse.Mode,
+		Succeeded: int32(bulkResponse.Succeeded),
+		Failed:    int32(bulkResponse.Failed),
+		Results:   make([]*model.BulkTodoResult, len(bulkResponse.Results)),
+	}
+	for i, resultResponse := range bulkResponse.Results {
+		result := &model.BulkTodoResult{
+			TodoID:  resultResponse.TodoId.String(),
+			Success: resultResponse.Success,
+		}
+		if resultResponse.Error != "" {
+			result.Error = &resultResponse.Error
+		}
+		if resultResponse.Todo != nil {
+			result.Todo = convertTodoOutput(*resultResponse.Todo)
+		}
+		bulkOutput.Results[i] = result
+	}
+
+	return bulkOutput, nil
+}
+
+func convertTodoOutput(outputResponse restStructures.TodoOutput) *model.TodoOutput {
+	return &model.TodoOutput{
+		ID:          outputResponse.Id.String(),
+		ListID:      outputResponse.ListId.String(),
+		Name:        outputResponse.Name,
+		Description: outputResponse.Description,
+		Deadline:    outputResponse.Deadline,
+		Assignees:   append([]string{}, outputResponse.Assignees...),
+		Watchers:    append([]string{}, outputResponse.Watchers...),
+		Status:      outputResponse.Status,
+		Done:        outputResponse.Done,
+		Priority:    outputResponse.Priority,
+		Progress:    int32(outputResponse.Progress),
+		Labels:      convertLabels(outputResponse.Labels),
+		Recurrence:  outputResponse.Recurrence,
+		SeriesID:    convertSeriesId(outputResponse.SeriesId),
+		Blocked:     outputResponse.Blocked,
+		BlockedBy:   convertDependencies(outputResponse.BlockedBy),
+		Blocks:      convertDependencies(outputResponse.Blocks),
+	}
+}
+
+func (ct *ConverterTodo) ConvertResponseToTodoRecurrence(response []byte) (*model.TodoRecurrence, error) {
+	var recurrenceResponse restStructures.TodoRecurrenceOutput
+	err := json.Unmarshal(response, &recurrenceResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrence := &model.TodoRecurrence{
+		Rule:     recurrenceResponse.Rule,
+		SeriesID: recurrenceResponse.SeriesId.String(),
+		Upcoming: make([]*time.Time, len(recurrenceResponse.Upcoming)),
+	}
+	for i := range recurrenceResponse.Upcoming {
+		recurrence.Upcoming[i] = &recurrenceResponse.Upcoming[i]
+	}
+
+	return recurrence, nil
+}
+
+func convertSeriesId(seriesId *uuid.UUID) *string {
+	if seriesId == nil {
+		return nil
+	}
+
+	id := seriesId.String()
+	return &id
+}
+
+func convertDependencies(dependenciesResponse []restStructures.TodoDependencyOutput) []*model.TodoDependency {
+	dependencies := make([]*model.TodoDependency, len(dependenciesResponse))
+	for i, dependencyResponse := range dependenciesResponse {
+		dependencies[i] = &model.TodoDependency{
+			ID:     dependencyResponse.Id.String(),
+			ListID: dependencyResponse.ListId.String(),
+			Name:   dependencyResponse.Name,
+			Done:   dependencyResponse.Done,
+		}
+	}
+
+	return dependencies
+}
+
+func convertLabels(labelsResponse []restStructures.LabelOutput) []*model.LabelOutput {
+	labels := make([]*model.LabelOutput, len(labelsResponse))
+	for i, labelResponse := range labelsResponse {
+		labels[i] = &model.LabelOutput{
+			ID:     labelResponse.Id.String(),
+			ListID: labelResponse.ListId.String(),
+			Name:   labelResponse.Name,
+			Color:  labelResponse.Color,
+		}
+	}
+
+	return labels
+}
@@ -4,38 +4,54 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"net/http"
+	"net/url"
+	"project/config"
 	"project/graphql/graph/model"
 	"project/graphql/graph/utils"
+	restStructures "project/structures"
 	"strings"
+	"time"
 )
 
 const (
-	firstTodo                = 0
 	failedToDeleteTodoErrMsg = "failed to delete todo"
+	statusParam              = "status"
+	priorityParam            = "priority"
+	assigneeParam            = "assignee"
+	deadlineFromParam        = "deadline_from"
+	deadlineToParam          = "deadline_to"
+	textParam                = "q"
+	labelParam               = "label"
+	sortParam                = "sort"
+	orderParam               = "order"
+	limitParam               = "limit"
 )
 
 //go:generate mockery --name ServiceConverterTodo --output=automock --with-expecter=true
 type ServiceConverterTodo interface {
 	ConvertResponseToTodoOutput(response []byte) (*model.TodoOutput, error)
 	ConvertResponseToTodosOutputs(response []byte) ([]*model.TodoOutput, error)
+	ConvertResponseToTodoRecurrence(response []byte) (*model.TodoRecurrence, error)
+	ConvertResponseToBulkTodoOutput(response []byte) (*model.BulkTodoOutput, error)
 }
 
 //go:generate mockery --name RequestSenderInterface --output=automock --with-expecter=true
 type RequestSenderInterface interface {
 	SendRequest(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, error, int)
+	SendRequestWithHeaders(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, http.Header, error, int)
 }
 
 type ServiceTodo struct {
 	requestSender RequestSenderInterface
 	converter     ServiceConverterTodo
-	pageInfo      model.PageInfo
 }
 
 func NewServiceTodo(converter ServiceConverterTodo, requestSender *RequestSenderInterface) *ServiceTodo {
 	if requestSender == nil {
-		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender()
+		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender(config.Default().Gateway)
 		requestSender = &reqSenderInterface
 	}
 
@@ -45,11 +61,9 @@ func NewServiceTodo(converter ServiceConverterTodo, requestSender *RequestSender
 	}
 }
 
-func (st *ServiceTodo) CreateTodo(ctx context.Context, listId, requestCreator string, todo *model.Todo) (*model.TodoOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo", listId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (st *ServiceTodo) CreateTodo(ctx context.Context, listId, requestToken string, todo *model.Todo) (*model.TodoOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := st.requestSender.SendRequest(http.MethodPost, url, todo, headers, http.StatusCreated)
@@ -68,11 +82,9 @@ func (st *ServiceTodo) CreateTodo(ctx context.Context, listId, requestCreator st
 	return todoOutput, nil
 }
 
-func (st *ServiceTodo) UpdateTodo(ctx context.Context, listId, todoId, requestCreator string, todoUpdate *model.UpdateTodoInput) (*model.TodoOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s", listId, todoId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (st *ServiceTodo) UpdateTodo(ctx context.Context, listId, todoId, requestToken string, todoUpdate *model.UpdateTodoInput) (*model.TodoOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := st.requestSender.SendRequest(http.MethodPut, url, todoUpdate, headers, http.StatusOK)
@@ -91,11 +103,9 @@ func (st *ServiceTodo) UpdateTodo(ctx context.Context, listId, todoId, requestCr
 	return todoOutput, nil
 }
 
-func (st *ServiceTodo) DeleteTodo(ctx context.Context, listId, todoId, requestCreator string) (*model.TodoOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s", listId, todoId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
-	}
+func (st *ServiceTodo) DeleteTodo(ctx context.Context, listId, todoId, requestToken string) (*model.TodoOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := st.requestSender.SendRequest(http.MethodDelete, url, nil, headers, http.StatusOK)
@@ -120,14 +130,16 @@ func (st *ServiceTodo) DeleteTodo(ctx context.Context, listId, todoId, requestCr
 	return todoOutput, nil
 }
 
-func (st *ServiceTodo) AssignUserToTodo(ctx context.Context, listId, todoId, requestCreator string) (string, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s", listId, todoId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
+func (st *ServiceTodo) AssignUserToTodo(ctx context.Context, listId, todoId string, username *string, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+	var assigneeInput any
+	if username != nil {
+		assigneeInput = restStructures.TodoAssigneeInput{Username: *username}
 	}
 
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
-	result, err, status := st.requestSender.SendRequest(http.MethodPatch, url, nil, headers, http.StatusOK)
+	result, err, status := st.requestSender.SendRequest(http.MethodPatch, url, assigneeInput, headers, http.StatusOK)
 	if err != nil {
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
 		return "", err
@@ -138,12 +150,27 @@ func (st *ServiceTodo) AssignUserToTodo(ctx context.Context, listId, todoId, req
 	return strResult, nil
 }
 
-func (st *ServiceTodo) ChangeTodoStatus(ctx context.Context, listId, todoId, requestCreator string) (string, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s/status", listId, todoId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
+func (st *ServiceTodo) ChangeTodoStatus(ctx context.Context, listId, todoId, todoStatus, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/status", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+	statusInput := restStructures.TodoStatusInput{Status: todoStatus}
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodPatch, url, statusInput, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
 	}
 
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) ReopenTodo(ctx context.Context, listId, todoId, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/reopen", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := st.requestSender.SendRequest(http.MethodPatch, url, nil, headers, http.StatusOK)
 	if err != nil {
@@ -156,35 +183,158 @@ func (st *ServiceTodo) ChangeTodoStatus(ctx context.Context, listId, todoId, req
 	return strResult, nil
 }
 
-func (st *ServiceTodo) GetTodoFromList(ctx context.Context, listId, todoId, requestCreator string) (*model.TodoOutput, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s", listId, todoId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
+func (st *ServiceTodo) StepBackTodo(ctx context.Context, listId, todoId, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/step-back", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodPatch, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
 	}
 
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) UnassignTodo(ctx context.Context, listId, todoId, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/assignee", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
-	result, err, status := st.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	result, err, status := st.requestSender.SendRequest(http.MethodDelete, url, nil, headers, http.StatusOK)
 	if err != nil {
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
-		return nil, err
+		return "", err
 	}
 
-	todoOutput, err := st.converter.ConvertResponseToTodoOutput(result)
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) ReassignTodo(ctx context.Context, listId, todoId, username, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/assignee", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+	assigneeInput := restStructures.TodoAssigneeInput{Username: username}
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodPut, url, assigneeInput, headers, http.StatusOK)
 	if err != nil {
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
-		return nil, err
+		return "", err
 	}
 
-	log.WithField(utils.Status, status).Info(*todoOutput)
-	return todoOutput, nil
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
 }
 
-func (st *ServiceTodo) GetTodosFromList(ctx context.Context, first *int32, after *string, listId, requestCreator string) (*model.TodoConnection, error) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todos", listId)
-	headers := map[string]string{
-		utils.Username: requestCreator,
+func (st *ServiceTodo) AddTodoAssignee(ctx context.Context, listId, todoId, username, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/assignees/%s", listId, todoId, url.PathEscape(username))
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodPut, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
 	}
 
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) RemoveTodoAssignee(ctx context.Context, listId, todoId, username, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/assignees/%s", listId, todoId, url.PathEscape(username))
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodDelete, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
+	}
+
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) AddTodoWatcher(ctx context.Context, listId, todoId, username, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/watchers/%s", listId, todoId, url.PathEscape(username))
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodPut, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
+	}
+
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) RemoveTodoWatcher(ctx context.Context, listId, todoId, username, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/watchers/%s", listId, todoId, url.PathEscape(username))
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodDelete, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
+	}
+
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) SetTodoRecurrence(ctx context.Context, listId, todoId, rule, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/recurrence", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodPut, url, restStructures.TodoRecurrenceInput{Rule: rule}, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
+	}
+
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) StopTodoRecurrence(ctx context.Context, listId, todoId, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/recurrence", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodDelete, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
+	}
+
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) GetTodoRecurrence(ctx context.Context, listId, todoId string, limit *int32, requestToken string) (*model.TodoRecurrence, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/recurrence", listId, todoId)
+	if limit != nil {
+		url += fmt.Sprintf("?%s=%d", limitParam, *limit)
+	}
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 	result, err, status := st.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
 	if err != nil {
@@ -192,71 +342,224 @@ func (st *ServiceTodo) GetTodosFromList(ctx context.Context, first *int32, after
 		return nil, err
 	}
 
-	todosOutputs, err := st.converter.ConvertResponseToTodosOutputs(result)
+	recurrence, err := st.converter.ConvertResponseToTodoRecurrence(result)
 	if err != nil {
 		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
 		return nil, err
 	}
-	totalCount := int32(len(todosOutputs))
 
-	if first == nil && after == nil {
-		st.pageInfo = *new(model.PageInfo)
-		return &model.TodoConnection{
-			TotalCount: &totalCount,
-			Todos:      todosOutputs,
-			PageInfo:   &st.pageInfo,
-		}, nil
+	log.WithField(utils.Status, status).Info(*recurrence)
+	return recurrence, nil
+}
+
+func (st *ServiceTodo) AddTodoDependency(ctx context.Context, listId, todoId, requestToken string, dependency model.TodoDependencyInput) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/dependencies", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	body, err := convertDependencyInput(dependency)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusBadRequest).Error(err)
+		return "", err
+	}
+	result, err, status := st.requestSender.SendRequest(http.MethodPost, url, body, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
+	}
+
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+// convertDependencyInput reads the ids of the dependency, leaving the list empty so the todo's own list is used.
+func convertDependencyInput(dependency model.TodoDependencyInput) (restStructures.TodoDependencyInput, error) {
+	var input restStructures.TodoDependencyInput
+	var err error
+	input.TodoId, err = uuid.Parse(dependency.TodoID)
+	if err != nil {
+		return input, errors.New(fmt.Sprintf("invalid todo id %s of dependency", dependency.TodoID))
+	}
+	if dependency.ListID != nil {
+		input.ListId, err = uuid.Parse(*dependency.ListID)
+		if err != nil {
+			return input, errors.New(fmt.Sprintf("invalid list id %s of dependency", *dependency.ListID))
+		}
+	}
+
+	return input, nil
+}
+
+func (st *ServiceTodo) RemoveTodoDependency(ctx context.Context, listId, todoId, dependencyId, requestToken string) (string, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/dependencies/%s", listId, todoId, dependencyId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodDelete, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return "", err
+	}
+
+	strResult := string(result)
+	log.WithField(utils.Status, status).Info(strResult)
+	return strResult, nil
+}
+
+func (st *ServiceTodo) BulkUpdateTodos(ctx context.Context, listId, requestToken string, input model.BulkTodoInput) (*model.BulkTodoOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/bulk", listId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	body, err := convertBulkInput(input)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusBadRequest).Error(err)
+		return nil, err
+	}
+	result, err, status := st.requestSender.SendRequest(http.MethodPost, url, body, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
 	}
 
-	if first == nil {
-		first = &totalCount
+	bulkOutput, err := st.converter.ConvertResponseToBulkTodoOutput(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
 	}
 
-	var startPos int
-	if after != nil || st.pageInfo.EndCursor != nil {
-		var afterList *string
-		if after != nil {
-			afterList = after
-		} else {
-			afterList = st.pageInfo.EndCursor
+	log.WithField(utils.Status, status).Info(*bulkOutput)
+	return bulkOutput, nil
+}
+
+// convertBulkInput reads the ids of the bulk operation and turns its enums into the names the REST API uses.
+func convertBulkInput(input model.BulkTodoInput) (restStructures.TodoBulkInput, error) {
+	bulkInput := restStructures.TodoBulkInput{
+		Operation: strings.ToLower(input.Operation.String()),
+		TodoIds:   make([]uuid.UUID, len(input.TodoIds)),
+	}
+	if input.Mode != nil {
+		bulkInput.Mode = strings.ToLower(input.Mode.String())
+	}
+	for i, todoId := range input.TodoIds {
+		id, err := uuid.Parse(todoId)
+		if err != nil {
+			return bulkInput, errors.New(fmt.Sprintf("invalid todo id %s of bulk operation", todoId))
 		}
+		bulkInput.TodoIds[i] = id
+	}
 
-		startPos, err = utils.GetTodoPosition(*afterList, todosOutputs)
+	var err error
+	if input.LabelID != nil {
+		bulkInput.LabelId, err = uuid.Parse(*input.LabelID)
+		if err != nil {
+			return bulkInput, errors.New(fmt.Sprintf("invalid label id %s of bulk operation", *input.LabelID))
+		}
+	}
+	if input.ListID != nil {
+		bulkInput.ListId, err = uuid.Parse(*input.ListID)
 		if err != nil {
-			log.WithField(utils.Status, http.StatusInternalServerError).Error(err.Error())
-			st.pageInfo = *new(model.PageInfo)
-			return nil, err
-		} else if startPos == int(totalCount)-1 {
-			err = errors.New("todo is out of range")
-			log.WithField(utils.Status, http.StatusResetContent).Error(err)
-			st.pageInfo = *new(model.PageInfo)
-			return nil, err
+			return bulkInput, errors.New(fmt.Sprintf("invalid list id %s of bulk operation", *input.ListID))
 		}
+	}
+	setBulkArgument(&bulkInput.Status, input.Status)
+	setBulkArgument(&bulkInput.Priority, input.Priority)
+	setBulkArgument(&bulkInput.Username, input.Username)
+	setBulkArgument(&bulkInput.Conflict, input.Conflict)
+
+	return bulkInput, nil
+}
+
+func setBulkArgument(argument *string, value *string) {
+	if value != nil {
+		*argument = *value
+	}
+}
+
+func (st *ServiceTodo) GetTodoFromList(ctx context.Context, listId, todoId, requestToken string) (*model.TodoOutput, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", listId, todoId)
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := st.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	todoOutput, err := st.converter.ConvertResponseToTodoOutput(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	log.WithField(utils.Status, status).Info(*todoOutput)
+	return todoOutput, nil
+}
 
-		startPos++
-	} else {
-		startPos = 0
+func (st *ServiceTodo) GetTodosFromList(ctx context.Context, first *int32, after *string, last *int32, before *string, filter *model.TodoFilter, orderBy *model.TodoOrder, listId, requestToken string) (*model.TodoConnection, error) {
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todos", listId)
+	if query := getTodosQuery(first, after, last, before, filter, orderBy).Encode(); query != "" {
+		url += "?" + query
 	}
+	headers := utils.GetAuthorizationHeaders(requestToken)
 
-	pageScope := startPos + int(*first)
-	if pageScope > int(totalCount) {
-		pageScope = int(totalCount)
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, responseHeaders, err, status := st.requestSender.SendRequestWithHeaders(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
 	}
-	pagedTodos := todosOutputs[startPos:pageScope]
-	st.pageInfo.StartCursor = &pagedTodos[firstTodo].ID
-	st.pageInfo.EndCursor = &pagedTodos[len(pagedTodos)-1].ID
-	if pageScope < int(totalCount) {
-		st.pageInfo.HasNextPage = true
-	} else {
-		st.pageInfo.HasNextPage = false
+
+	todosOutputs, err := st.converter.ConvertResponseToTodosOutputs(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
 	}
 
+	pageInfo, totalCount := utils.GetPageInfo(responseHeaders, len(todosOutputs))
 	todoConnection := &model.TodoConnection{
 		TotalCount: &totalCount,
-		Todos:      pagedTodos,
-		PageInfo:   &st.pageInfo,
+		Todos:      todosOutputs,
+		PageInfo:   pageInfo,
 	}
 
 	log.WithField(utils.Status, status).Info("todos are successfully registered")
 	return todoConnection, nil
 }
+
+// getTodosQuery translates the connection arguments to the query parameters of the todos route,
+// so filtering, sorting and paging happen in the database.
+func getTodosQuery(first *int32, after *string, last *int32, before *string, filter *model.TodoFilter, orderBy *model.TodoOrder) url.Values {
+	query := utils.GetPageQuery(first, after, last, before)
+
+	if filter != nil {
+		setQueryParam(query, statusParam, filter.Status)
+		setQueryParam(query, priorityParam, filter.Priority)
+		setQueryParam(query, assigneeParam, filter.Assignee)
+		setQueryParam(query, textParam, filter.Text)
+		setQueryParam(query, labelParam, filter.Label)
+		if filter.DeadlineFrom != nil {
+			query.Set(deadlineFromParam, filter.DeadlineFrom.Format(time.RFC3339))
+		}
+		if filter.DeadlineTo != nil {
+			query.Set(deadlineToParam, filter.DeadlineTo.Format(time.RFC3339))
+		}
+	}
+
+	if orderBy != nil {
+		query.Set(sortParam, strings.ToLower(orderBy.Field.String()))
+		if orderBy.Direction != nil {
+			query.Set(orderParam, strings.ToLower(orderBy.Direction.String()))
+		}
+	}
+
+	return query
+}
+
+func setQueryParam(query url.Values, param string, value *string) {
+	if value != nil {
+		query.Set(param, *value)
+	}
+}
@@ -10,21 +10,23 @@ import (
 	"project/graphql/graph/todo"
 	mocks "project/graphql/graph/todo/automock"
 	"project/graphql/graph/utils"
+	restStructures "project/structures"
 	"testing"
+	"time"
 )
 
 func TestCreateTodo(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo", utils.TestListId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo", utils.TestListId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterTodo
-		inputListId         string
-		inputTodo           model.Todo
-		inputRequestCreator string
-		expected            model.TodoOutput
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterTodo
+		inputListId       string
+		inputTodo         model.Todo
+		inputRequestToken string
+		expected          model.TodoOutput
+		expectedError     error
 	}{
 		{
 			name: "successfully create todo",
@@ -34,7 +36,7 @@ func TestCreateTodo(t *testing.T) {
 					&model.Todo{
 						Name: utils.TestTodoName,
 					}, map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusCreated).
 					Return([]byte("Returned new todo"), nil, http.StatusCreated).
 					Once()
@@ -56,7 +58,7 @@ func TestCreateTodo(t *testing.T) {
 			inputTodo: model.Todo{
 				Name: utils.TestTodoName,
 			},
-			inputRequestCreator: utils.TestUsername,
+			inputRequestToken: utils.TestToken,
 			expected: model.TodoOutput{
 				ID:   utils.TestTodoId.String(),
 				Name: utils.TestTodoName,
@@ -69,7 +71,7 @@ func TestCreateTodo(t *testing.T) {
 					&model.Todo{
 						Name: utils.TestTodoName,
 					}, map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusCreated).
 					Return(nil, errors.New("executing request have failed"), http.StatusBadRequest).
 					Once()
@@ -83,8 +85,8 @@ func TestCreateTodo(t *testing.T) {
 			inputTodo: model.Todo{
 				Name: utils.TestTodoName,
 			},
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to TodoOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
@@ -93,7 +95,7 @@ func TestCreateTodo(t *testing.T) {
 					&model.Todo{
 						Name: utils.TestTodoName,
 					}, map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusCreated).
 					Return([]byte("Returned new todo"), nil, http.StatusCreated).
 					Once()
@@ -113,8 +115,8 @@ func TestCreateTodo(t *testing.T) {
 			inputTodo: model.Todo{
 				Name: utils.TestTodoName,
 			},
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -127,7 +129,7 @@ func TestCreateTodo(t *testing.T) {
 			service := todo.NewServiceTodo(converter, &reqSender)
 
 			actual, err := service.CreateTodo(utils.GetTestingContext(), testCase.inputListId,
-				testCase.inputRequestCreator, &testCase.inputTodo)
+				testCase.inputRequestToken, &testCase.inputTodo)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -143,18 +145,18 @@ func TestCreateTodo(t *testing.T) {
 }
 
 func TestUpdateTodo(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
 	testNewName := utils.TestTodoName
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterTodo
-		inputListId         string
-		inputTodoId         string
-		inputRequestCreator string
-		expected            model.TodoOutput
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterTodo
+		inputListId       string
+		inputTodoId       string
+		inputRequestToken string
+		expected          model.TodoOutput
+		expectedError     error
 	}{
 		{
 			name: "successfully update todo",
@@ -165,7 +167,7 @@ func TestUpdateTodo(t *testing.T) {
 				}
 				reqSender.EXPECT().SendRequest(http.MethodPut, url, &todoUpdate,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned updated todo"), nil, http.StatusOK).
 					Once()
@@ -183,9 +185,9 @@ func TestUpdateTodo(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
 			expected: model.TodoOutput{
 				ID:   utils.TestTodoId.String(),
 				Name: utils.TestTodoName,
@@ -199,7 +201,7 @@ func TestUpdateTodo(t *testing.T) {
 				}
 				reqSender.EXPECT().SendRequest(http.MethodPut, url, &todoUpdate,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil, errors.New("executing request have failed"), http.StatusBadRequest).
 					Once()
@@ -209,10 +211,10 @@ func TestUpdateTodo(t *testing.T) {
 			converter: func() *mocks.ServiceConverterTodo {
 				return &mocks.ServiceConverterTodo{}
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to TodoOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
@@ -222,7 +224,7 @@ func TestUpdateTodo(t *testing.T) {
 				}
 				reqSender.EXPECT().SendRequest(http.MethodPut, url, &todoUpdate,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned updated todo"), nil, http.StatusOK).
 					Once()
@@ -238,10 +240,10 @@ func TestUpdateTodo(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -258,7 +260,7 @@ func TestUpdateTodo(t *testing.T) {
 			}
 
 			actual, err := service.UpdateTodo(utils.GetTestingContext(), testCase.inputListId,
-				testCase.inputTodoId, testCase.inputRequestCreator, &todoUpdate)
+				testCase.inputTodoId, testCase.inputRequestToken, &todoUpdate)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -274,17 +276,17 @@ func TestUpdateTodo(t *testing.T) {
 }
 
 func TestDeleteTodo(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterTodo
-		inputListId         string
-		inputTodoId         string
-		inputRequestCreator string
-		expected            model.TodoOutput
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterTodo
+		inputListId       string
+		inputTodoId       string
+		inputRequestToken string
+		expected          model.TodoOutput
+		expectedError     error
 	}{
 		{
 			name: "successfully delete todo",
@@ -292,7 +294,7 @@ func TestDeleteTodo(t *testing.T) {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned deleted todo"), nil, http.StatusOK).
 					Once()
@@ -310,9 +312,9 @@ func TestDeleteTodo(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
 			expected: model.TodoOutput{
 				ID:   utils.TestTodoId.String(),
 				Name: utils.TestTodoName,
@@ -323,7 +325,7 @@ func TestDeleteTodo(t *testing.T) {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil, errors.New("executing request have failed"), http.StatusBadRequest).
 					Once()
@@ -333,17 +335,17 @@ func TestDeleteTodo(t *testing.T) {
 			converter: func() *mocks.ServiceConverterTodo {
 				return &mocks.ServiceConverterTodo{}
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to TodoOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodDelete, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned deleted todo"), nil, http.StatusOK).
 					Once()
@@ -359,10 +361,10 @@ func TestDeleteTodo(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -375,7 +377,7 @@ func TestDeleteTodo(t *testing.T) {
 			service := todo.NewServiceTodo(converter, &reqSender)
 
 			actual, err := service.DeleteTodo(utils.GetTestingContext(), testCase.inputListId,
-				testCase.inputTodoId, testCase.inputRequestCreator)
+				testCase.inputTodoId, testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -391,16 +393,18 @@ func TestDeleteTodo(t *testing.T) {
 }
 
 func TestAssignUserToTodo(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
+	username := utils.TestUsername
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		inputListId         string
-		inputTodoId         string
-		inputRequestCreator string
-		expected            string
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		inputListId       string
+		inputTodoId       string
+		inputUsername     *string
+		inputRequestToken string
+		expected          string
+		expectedError     error
 	}{
 		{
 			name: "successfully assign user to todo",
@@ -408,34 +412,52 @@ func TestAssignUserToTodo(t *testing.T) {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodPatch, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned assign user to todo message"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expected:            "Returned assign user to todo message",
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expected:          "Returned assign user to todo message",
+		}, {
+			name: "successfully assign another user to todo",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodPatch, url, restStructures.TodoAssigneeInput{Username: utils.TestUsername},
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return([]byte("Returned assign user to todo message"), nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputUsername:     &username,
+			inputRequestToken: utils.TestToken,
+			expected:          "Returned assign user to todo message",
 		}, {
 			name: "sending request failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodPatch, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil, errors.New("executing request have failed"), http.StatusBadRequest).
 					Once()
 
 				return reqSender
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		},
 	}
 
@@ -447,7 +469,7 @@ func TestAssignUserToTodo(t *testing.T) {
 			service := todo.NewServiceTodo(converter, &reqSender)
 
 			actual, err := service.AssignUserToTodo(utils.GetTestingContext(), testCase.inputListId,
-				testCase.inputTodoId, testCase.inputRequestCreator)
+				testCase.inputTodoId, testCase.inputUsername, testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				reqSenderMock.AssertExpectations(t)
@@ -461,51 +483,51 @@ func TestAssignUserToTodo(t *testing.T) {
 }
 
 func TestChangeTodoStatus(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s/status", utils.TestListId, utils.TestTodoId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/status", utils.TestListId, utils.TestTodoId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		inputListId         string
-		inputTodoId         string
-		inputRequestCreator string
-		expected            string
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		inputListId       string
+		inputTodoId       string
+		inputRequestToken string
+		expected          string
+		expectedError     error
 	}{
 		{
 			name: "successfully change todo status",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodPatch, url, nil,
+				reqSender.EXPECT().SendRequest(http.MethodPatch, url, restStructures.TodoStatusInput{Status: utils.TestTodoStatus},
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned todo with changed status"), nil, http.StatusOK).
 					Once()
 
 				return reqSender
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expected:            "Returned todo with changed status",
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expected:          "Returned todo with changed status",
 		}, {
 			name: "sending request failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodPatch, url, nil,
+				reqSender.EXPECT().SendRequest(http.MethodPatch, url, restStructures.TodoStatusInput{Status: utils.TestTodoStatus},
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil, errors.New("executing request have failed"), http.StatusBadRequest).
 					Once()
 
 				return reqSender
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		},
 	}
 
@@ -517,7 +539,7 @@ func TestChangeTodoStatus(t *testing.T) {
 			service := todo.NewServiceTodo(converter, &reqSender)
 
 			actual, err := service.ChangeTodoStatus(utils.GetTestingContext(), testCase.inputListId,
-				testCase.inputTodoId, testCase.inputRequestCreator)
+				testCase.inputTodoId, utils.TestTodoStatus, testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				reqSenderMock.AssertExpectations(t)
@@ -530,18 +552,460 @@ func TestChangeTodoStatus(t *testing.T) {
 	}
 }
 
+func TestMoveTodoBackwards(t *testing.T) {
+	todoUrl := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
+	headers := map[string]string{
+		utils.Authorization: utils.BearerPrefix + utils.TestToken,
+	}
+
+	testCases := []struct {
+		name          string
+		method        string
+		url           string
+		body          any
+		change        func(service *todo.ServiceTodo) (string, error)
+		response      []byte
+		responseError error
+	}{
+		{
+			name:   "successfully reopen todo",
+			method: http.MethodPatch,
+			url:    todoUrl + "/reopen",
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.ReopenTodo(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken)
+			},
+			response: []byte("Reopened todo"),
+		}, {
+			name:   "successfully step back todo",
+			method: http.MethodPatch,
+			url:    todoUrl + "/step-back",
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.StepBackTodo(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken)
+			},
+			response: []byte("Stepped back todo"),
+		}, {
+			name:   "successfully unassign todo",
+			method: http.MethodDelete,
+			url:    todoUrl + "/assignee",
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.UnassignTodo(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken)
+			},
+			response: []byte("Unassigned todo"),
+		}, {
+			name:   "successfully reassign todo",
+			method: http.MethodPut,
+			url:    todoUrl + "/assignee",
+			body:   restStructures.TodoAssigneeInput{Username: utils.TestUsername},
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.ReassignTodo(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestUsername, utils.TestToken)
+			},
+			response: []byte("Reassigned todo"),
+		}, {
+			name:   "sending request failed",
+			method: http.MethodPatch,
+			url:    todoUrl + "/reopen",
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.ReopenTodo(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken)
+			},
+			responseError: errors.New("executing request have failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			reqSenderMock.EXPECT().SendRequest(testCase.method, testCase.url, testCase.body, headers, http.StatusOK).
+				Return(testCase.response, testCase.responseError, http.StatusOK).
+				Once()
+			var converter todo.ServiceConverterTodo = todo.NewTodoConverter()
+			var reqSender todo.RequestSenderInterface = reqSenderMock
+			service := todo.NewServiceTodo(converter, &reqSender)
+
+			actual, err := testCase.change(service)
+			if testCase.responseError != nil {
+				require.Equal(t, testCase.responseError, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, string(testCase.response), actual)
+			}
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoUsers(t *testing.T) {
+	todoUrl := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
+	headers := map[string]string{
+		utils.Authorization: utils.BearerPrefix + utils.TestToken,
+	}
+
+	testCases := []struct {
+		name          string
+		method        string
+		url           string
+		change        func(service *todo.ServiceTodo) (string, error)
+		response      []byte
+		responseError error
+	}{
+		{
+			name:   "successfully add assignee",
+			method: http.MethodPut,
+			url:    todoUrl + "/assignees/" + utils.TestUsername,
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.AddTodoAssignee(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestUsername, utils.TestToken)
+			},
+			response: []byte("Added assignee"),
+		}, {
+			name:   "successfully remove assignee",
+			method: http.MethodDelete,
+			url:    todoUrl + "/assignees/" + utils.TestUsername,
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.RemoveTodoAssignee(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestUsername, utils.TestToken)
+			},
+			response: []byte("Removed assignee"),
+		}, {
+			name:   "successfully add watcher",
+			method: http.MethodPut,
+			url:    todoUrl + "/watchers/" + utils.TestUsername,
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.AddTodoWatcher(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestUsername, utils.TestToken)
+			},
+			response: []byte("Added watcher"),
+		}, {
+			name:   "successfully remove watcher with escaped username",
+			method: http.MethodDelete,
+			url:    todoUrl + "/watchers/Test%20User",
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.RemoveTodoWatcher(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), "Test User", utils.TestToken)
+			},
+			response: []byte("Removed watcher"),
+		}, {
+			name:   "sending request failed",
+			method: http.MethodPut,
+			url:    todoUrl + "/watchers/" + utils.TestUsername,
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.AddTodoWatcher(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestUsername, utils.TestToken)
+			},
+			responseError: errors.New("executing request have failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			reqSenderMock.EXPECT().SendRequest(testCase.method, testCase.url, nil, headers, http.StatusOK).
+				Return(testCase.response, testCase.responseError, http.StatusOK).
+				Once()
+			var converter todo.ServiceConverterTodo = todo.NewTodoConverter()
+			var reqSender todo.RequestSenderInterface = reqSenderMock
+			service := todo.NewServiceTodo(converter, &reqSender)
+
+			actual, err := testCase.change(service)
+			if testCase.responseError != nil {
+				require.Equal(t, testCase.responseError, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, string(testCase.response), actual)
+			}
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoRecurrence(t *testing.T) {
+	recurrenceUrl := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/recurrence", utils.TestListId, utils.TestTodoId)
+	headers := map[string]string{
+		utils.Authorization: utils.BearerPrefix + utils.TestToken,
+	}
+	upcoming := time.Date(2026, 2, 5, 0, 0, 0, 0, time.UTC)
+	limit := int32(1)
+
+	testCases := []struct {
+		name          string
+		method        string
+		url           string
+		body          any
+		change        func(service *todo.ServiceTodo) (any, error)
+		response      []byte
+		responseError error
+		expected      any
+	}{
+		{
+			name:   "successfully set recurrence",
+			method: http.MethodPut,
+			url:    recurrenceUrl,
+			body:   restStructures.TodoRecurrenceInput{Rule: "weekly"},
+			change: func(service *todo.ServiceTodo) (any, error) {
+				return service.SetTodoRecurrence(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), "weekly", utils.TestToken)
+			},
+			response: []byte("Set recurrence"),
+			expected: "Set recurrence",
+		}, {
+			name:   "successfully stop recurrence",
+			method: http.MethodDelete,
+			url:    recurrenceUrl,
+			change: func(service *todo.ServiceTodo) (any, error) {
+				return service.StopTodoRecurrence(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken)
+			},
+			response: []byte("Stopped recurrence"),
+			expected: "Stopped recurrence",
+		}, {
+			name:   "successfully get recurrence",
+			method: http.MethodGet,
+			url:    recurrenceUrl + "?limit=1",
+			change: func(service *todo.ServiceTodo) (any, error) {
+				return service.GetTodoRecurrence(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), &limit, utils.TestToken)
+			},
+			response: []byte(fmt.Sprintf(`{"rule":"FREQ=WEEKLY","series_id":"%s","upcoming":["2026-02-05T00:00:00Z"]}`, utils.TestTodoId)),
+			expected: &model.TodoRecurrence{
+				Rule:     "FREQ=WEEKLY",
+				SeriesID: utils.TestTodoId.String(),
+				Upcoming: []*time.Time{&upcoming},
+			},
+		}, {
+			name:   "sending request failed",
+			method: http.MethodGet,
+			url:    recurrenceUrl,
+			change: func(service *todo.ServiceTodo) (any, error) {
+				return service.GetTodoRecurrence(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), nil, utils.TestToken)
+			},
+			responseError: errors.New("executing request have failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			reqSenderMock.EXPECT().SendRequest(testCase.method, testCase.url, testCase.body, headers, http.StatusOK).
+				Return(testCase.response, testCase.responseError, http.StatusOK).
+				Once()
+			var converter todo.ServiceConverterTodo = todo.NewTodoConverter()
+			var reqSender todo.RequestSenderInterface = reqSenderMock
+			service := todo.NewServiceTodo(converter, &reqSender)
+
+			actual, err := testCase.change(service)
+			if testCase.responseError != nil {
+				require.Equal(t, testCase.responseError, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expected, actual)
+			}
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTodoDependencies(t *testing.T) {
+	dependenciesUrl := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s/dependencies", utils.TestListId, utils.TestTodoId)
+	headers := map[string]string{
+		utils.Authorization: utils.BearerPrefix + utils.TestToken,
+	}
+	blockerId := uuid.New()
+	blockerListId := utils.TestListId.String()
+
+	testCases := []struct {
+		name          string
+		method        string
+		url           string
+		body          any
+		change        func(service *todo.ServiceTodo) (string, error)
+		response      []byte
+		responseError error
+		expected      string
+		expectedError error
+	}{
+		{
+			name:   "successfully add dependency",
+			method: http.MethodPost,
+			url:    dependenciesUrl,
+			body:   restStructures.TodoDependencyInput{TodoId: blockerId},
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.AddTodoDependency(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken,
+					model.TodoDependencyInput{TodoID: blockerId.String()})
+			},
+			response: []byte("Added dependency"),
+			expected: "Added dependency",
+		}, {
+			name:   "successfully add dependency from another list",
+			method: http.MethodPost,
+			url:    dependenciesUrl,
+			body:   restStructures.TodoDependencyInput{TodoId: blockerId, ListId: utils.TestListId},
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.AddTodoDependency(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken,
+					model.TodoDependencyInput{TodoID: blockerId.String(), ListID: &blockerListId})
+			},
+			response: []byte("Added dependency"),
+			expected: "Added dependency",
+		}, {
+			name: "invalid dependency id",
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.AddTodoDependency(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), utils.TestToken,
+					model.TodoDependencyInput{TodoID: "invalid"})
+			},
+			expectedError: errors.New("invalid todo id invalid of dependency"),
+		}, {
+			name:   "successfully remove dependency",
+			method: http.MethodDelete,
+			url:    dependenciesUrl + "/" + blockerId.String(),
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.RemoveTodoDependency(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), blockerId.String(), utils.TestToken)
+			},
+			response: []byte("Removed dependency"),
+			expected: "Removed dependency",
+		}, {
+			name:   "sending request failed",
+			method: http.MethodDelete,
+			url:    dependenciesUrl + "/" + blockerId.String(),
+			change: func(service *todo.ServiceTodo) (string, error) {
+				return service.RemoveTodoDependency(utils.GetTestingContext(), utils.TestListId.String(), utils.TestTodoId.String(), blockerId.String(), utils.TestToken)
+			},
+			responseError: errors.New("executing request have failed"),
+			expectedError: errors.New("executing request have failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			if testCase.method != "" {
+				reqSenderMock.EXPECT().SendRequest(testCase.method, testCase.url, testCase.body, headers, http.StatusOK).
+					Return(testCase.response, testCase.responseError, http.StatusOK).
+					Once()
+			}
+			var converter todo.ServiceConverterTodo = todo.NewTodoConverter()
+			var reqSender todo.RequestSenderInterface = reqSenderMock
+			service := todo.NewServiceTodo(converter, &reqSender)
+
+			actual, err := testCase.change(service)
+			if testCase.expectedError != nil {
+				require.Equal(t, testCase.expectedError, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expected, actual)
+			}
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBulkUpdateTodos(t *testing.T) {
+	bulkUrl := fmt.Sprintf(utils.BasePath+"/list/%s/todo/bulk", utils.TestListId)
+	headers := map[string]string{
+		utils.Authorization: utils.BearerPrefix + utils.TestToken,
+	}
+	otherTodoId := uuid.New()
+	priority := "High"
+	bestEffort := model.BulkModeBestEffort
+	failure := fmt.Sprintf("error getting todo with id: %s", otherTodoId)
+
+	testCases := []struct {
+		name          string
+		input         model.BulkTodoInput
+		body          any
+		response      []byte
+		responseError error
+		expected      *model.BulkTodoOutput
+		expectedError error
+	}{
+		{
+			name: "successfully set priority on best effort",
+			input: model.BulkTodoInput{
+				Operation: model.BulkTodoOperationSetPriority,
+				Mode:      &bestEffort,
+				TodoIds:   []string{utils.TestTodoId.String(), otherTodoId.String()},
+				Priority:  &priority,
+			},
+			body: restStructures.TodoBulkInput{
+				Operation: "set_priority",
+				Mode:      "best_effort",
+				TodoIds:   []uuid.UUID{utils.TestTodoId, otherTodoId},
+				Priority:  priority,
+			},
+			response: []byte(fmt.Sprintf(`{"operation": "set_priority", "mode": "best_effort", "succeeded": 1, "failed": 1, "results": [
+				{"todo_id": "%s", "success": true, "error": "", "todo": {"id": "%s", "list_id": "%s", "priority": "High"}},
+				{"todo_id": "%s", "success": false, "error": "%s", "todo": null}]}`,
+				utils.TestTodoId, utils.TestTodoId, utils.TestListId, otherTodoId, failure)),
+			expected: &model.BulkTodoOutput{
+				Operation: "set_priority",
+				Mode:      "best_effort",
+				Succeeded: 1,
+				Failed:    1,
+				Results: []*model.BulkTodoResult{
+					{
+						TodoID:  utils.TestTodoId.String(),
+						Success: true,
+						Todo: &model.TodoOutput{
+							ID:        utils.TestTodoId.String(),
+							ListID:    utils.TestListId.String(),
+							Priority:  priority,
+							Assignees: []string{},
+							Watchers:  []string{},
+							Labels:    []*model.LabelOutput{},
+							BlockedBy: []*model.TodoDependency{},
+							Blocks:    []*model.TodoDependency{},
+						},
+					},
+					{TodoID: otherTodoId.String(), Error: &failure},
+				},
+			},
+		}, {
+			name: "invalid todo id",
+			input: model.BulkTodoInput{
+				Operation: model.BulkTodoOperationDelete,
+				TodoIds:   []string{"invalid"},
+			},
+			expectedError: errors.New("invalid todo id invalid of bulk operation"),
+		}, {
+			name: "sending request failed",
+			input: model.BulkTodoInput{
+				Operation: model.BulkTodoOperationDelete,
+				TodoIds:   []string{utils.TestTodoId.String()},
+			},
+			body: restStructures.TodoBulkInput{
+				Operation: "delete",
+				TodoIds:   []uuid.UUID{utils.TestTodoId},
+			},
+			responseError: errors.New("executing request have failed"),
+			expectedError: errors.New("executing request have failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			if testCase.body != nil {
+				reqSenderMock.EXPECT().SendRequest(http.MethodPost, bulkUrl, testCase.body, headers, http.StatusOK).
+					Return(testCase.response, testCase.responseError, http.StatusOK).
+					Once()
+			}
+			var converter todo.ServiceConverterTodo = todo.NewTodoConverter()
+			var reqSender todo.RequestSenderInterface = reqSenderMock
+			service := todo.NewServiceTodo(converter, &reqSender)
+
+			actual, err := service.BulkUpdateTodos(utils.GetTestingContext(), utils.TestListId.String(), utils.TestToken, testCase.input)
+			if testCase.expectedError != nil {
+				require.Equal(t, testCase.expectedError, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.expected, actual)
+			}
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
 func TestGetTodoFromList(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todo/%s", utils.TestListId, utils.TestTodoId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterTodo
-		inputListId         string
-		inputTodoId         string
-		inputRequestCreator string
-		expected            model.TodoOutput
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterTodo
+		inputListId       string
+		inputTodoId       string
+		inputRequestToken string
+		expected          model.TodoOutput
+		expectedError     error
 	}{
 		{
 			name: "successfully get todo",
@@ -549,7 +1013,7 @@ func TestGetTodoFromList(t *testing.T) {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned requested todo"), nil, http.StatusOK).
 					Once()
@@ -567,9 +1031,9 @@ func TestGetTodoFromList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
 			expected: model.TodoOutput{
 				ID:   utils.TestTodoId.String(),
 				Name: utils.TestTodoName,
@@ -580,7 +1044,7 @@ func TestGetTodoFromList(t *testing.T) {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return(nil, errors.New("executing request have failed"), http.StatusBadRequest).
 					Once()
@@ -590,17 +1054,17 @@ func TestGetTodoFromList(t *testing.T) {
 			converter: func() *mocks.ServiceConverterTodo {
 				return &mocks.ServiceConverterTodo{}
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to TodoOutput failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
 				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
 					Return([]byte("Returned requested todo"), nil, http.StatusOK).
 					Once()
@@ -616,10 +1080,10 @@ func TestGetTodoFromList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId.String(),
-			inputTodoId:         utils.TestTodoId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputListId:       utils.TestListId.String(),
+			inputTodoId:       utils.TestTodoId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -632,7 +1096,7 @@ func TestGetTodoFromList(t *testing.T) {
 			service := todo.NewServiceTodo(converter, &reqSender)
 
 			actual, err := service.GetTodoFromList(utils.GetTestingContext(), testCase.inputListId,
-				testCase.inputTodoId, testCase.inputRequestCreator)
+				testCase.inputTodoId, testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -648,26 +1112,26 @@ func TestGetTodoFromList(t *testing.T) {
 }
 
 func TestGetTodosFromList(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todos", utils.TestListId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todos", utils.TestListId)
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterTodo
-		inputListId         string
-		inputRequestCreator string
-		expected            []*model.TodoOutput
-		expectedError       error
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterTodo
+		inputListId       string
+		inputRequestToken string
+		expected          []*model.TodoOutput
+		expectedError     error
 	}{
 		{
 			name: "successfully get todo",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("Returned requested todos"), nil, http.StatusOK).
+					Return([]byte("Returned requested todos"), http.Header{}, nil, http.StatusOK).
 					Once()
 
 				return reqSender
@@ -685,8 +1149,8 @@ func TestGetTodosFromList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId.String(),
-			inputRequestCreator: utils.TestUsername,
+			inputListId:       utils.TestListId.String(),
+			inputRequestToken: utils.TestToken,
 			expected: []*model.TodoOutput{
 				&model.TodoOutput{
 					ID:   utils.TestTodoId.String(),
@@ -697,11 +1161,11 @@ func TestGetTodosFromList(t *testing.T) {
 			name: "sending request failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return(nil, errors.New("executing request have failed"), http.StatusBadRequest).
+					Return(nil, nil, errors.New("executing request have failed"), http.StatusBadRequest).
 					Once()
 
 				return reqSender
@@ -709,18 +1173,18 @@ func TestGetTodosFromList(t *testing.T) {
 			converter: func() *mocks.ServiceConverterTodo {
 				return &mocks.ServiceConverterTodo{}
 			},
-			inputListId:         utils.TestListId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("executing request have failed"),
+			inputListId:       utils.TestListId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("executing request have failed"),
 		}, {
 			name: "converting to TodosOutputs failed",
 			requestSender: func() *mocks.RequestSenderInterface {
 				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+				reqSender.EXPECT().SendRequestWithHeaders(http.MethodGet, url, nil,
 					map[string]string{
-						utils.Username: utils.TestUsername,
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
 					}, http.StatusOK).
-					Return([]byte("Returned requested todos"), nil, http.StatusOK).
+					Return([]byte("Returned requested todos"), http.Header{}, nil, http.StatusOK).
 					Once()
 
 				return reqSender
@@ -734,9 +1198,9 @@ func TestGetTodosFromList(t *testing.T) {
 
 				return srvConverter
 			},
-			inputListId:         utils.TestListId.String(),
-			inputRequestCreator: utils.TestUsername,
-			expectedError:       errors.New("converting response failed"),
+			inputListId:       utils.TestListId.String(),
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
 		},
 	}
 
@@ -749,10 +1213,14 @@ func TestGetTodosFromList(t *testing.T) {
 			service := todo.NewServiceTodo(converter, &reqSender)
 
 			actual, err := service.GetTodosFromList(utils.GetTestingContext(),
+				nil,
+				nil,
+				nil,
+				nil,
 				nil,
 				nil,
 				testCase.inputListId,
-				testCase.inputRequestCreator)
+				testCase.inputRequestToken)
 			if err != nil {
 				require.Equal(t, testCase.expectedError, err)
 				converterMock.AssertExpectations(t)
@@ -768,200 +1236,136 @@ func TestGetTodosFromList(t *testing.T) {
 }
 
 func TestGetTodosFromListPagination(t *testing.T) {
-	url := fmt.Sprintf(utils.BaseUrl+utils.BasePath+"/list/%s/todos", utils.TestListId)
+	url := fmt.Sprintf(utils.BasePath+"/list/%s/todos", utils.TestListId)
+	todosOutputs := []*model.TodoOutput{
+		&model.TodoOutput{
+			ID:   uuid.UUID{1}.String(),
+			Name: utils.TestTodoName + "1",
+		}, &model.TodoOutput{
+			ID:   uuid.UUID{2}.String(),
+			Name: utils.TestTodoName + "2",
+		},
+	}
+	deadline := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	status := "Assigned"
+	text := "docs"
+	label := "bug"
+	after := "previous-cursor"
+	before := "next-cursor"
+	startCursor := "start-cursor"
+	endCursor := "end-cursor"
+	first := int32(2)
+	last := int32(3)
+	descending := model.SortDirectionDesc
 
 	testCases := []struct {
-		name                string
-		requestSender       func() *mocks.RequestSenderInterface
-		converter           func() *mocks.ServiceConverterTodo
-		inputListId         string
-		inputRequestCreator string
-		inputFirst          int32
-		inputAfter          string
-		expected            []*model.TodoOutput
-		expectedError       error
+		name               string
+		inputFirst         *int32
+		inputAfter         *string
+		inputLast          *int32
+		inputBefore        *string
+		inputFilter        *model.TodoFilter
+		inputOrderBy       *model.TodoOrder
+		expectedQuery      string
+		responseHeaders    http.Header
+		expectedTotalCount int32
+		expectedPageInfo   model.PageInfo
 	}{
 		{
-			name: "successfully list all todos in one page",
-			requestSender: func() *mocks.RequestSenderInterface {
-				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
-					map[string]string{
-						utils.Username: utils.TestUsername,
-					}, http.StatusOK).
-					Return([]byte("Returned requested todos"), nil, http.StatusOK).
-					Once()
-
-				return reqSender
-			},
-			converter: func() *mocks.ServiceConverterTodo {
-				srvConverter := &mocks.ServiceConverterTodo{}
-				srvConverter.EXPECT().ConvertResponseToTodosOutputs([]byte("Returned requested todos")).
-					Return([]*model.TodoOutput{
-						&model.TodoOutput{
-							ID:   uuid.UUID{1}.String(),
-							Name: utils.TestTodoName + "1",
-						}, &model.TodoOutput{
-							ID:   uuid.UUID{2}.String(),
-							Name: utils.TestTodoName + "2",
-						},
-					}, nil).
-					Once()
-
-				return srvConverter
-			},
-			inputListId:         utils.TestListId.String(),
-			inputRequestCreator: utils.TestUsername,
-			inputFirst:          2,
-			expected: []*model.TodoOutput{
-				&model.TodoOutput{
-					ID:   uuid.UUID{1}.String(),
-					Name: utils.TestTodoName + "1",
-				}, &model.TodoOutput{
-					ID:   uuid.UUID{2}.String(),
-					Name: utils.TestTodoName + "2",
-				},
-			},
+			name:               "last page without headers",
+			expectedTotalCount: 2,
 		}, {
-			name: "successfully get only the first todo",
-			requestSender: func() *mocks.RequestSenderInterface {
-				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
-					map[string]string{
-						utils.Username: utils.TestUsername,
-					}, http.StatusOK).
-					Return([]byte("Returned requested todos"), nil, http.StatusOK).
-					Once()
-
-				return reqSender
+			name:       "first page of a filtered and ordered list",
+			inputFirst: &first,
+			inputFilter: &model.TodoFilter{
+				Status:       &status,
+				Text:         &text,
+				Label:        &label,
+				DeadlineFrom: &deadline,
 			},
-			converter: func() *mocks.ServiceConverterTodo {
-				srvConverter := &mocks.ServiceConverterTodo{}
-				srvConverter.EXPECT().ConvertResponseToTodosOutputs([]byte("Returned requested todos")).
-					Return([]*model.TodoOutput{
-						&model.TodoOutput{
-							ID:   uuid.UUID{1}.String(),
-							Name: utils.TestTodoName + "1",
-						}, &model.TodoOutput{
-							ID:   uuid.UUID{2}.String(),
-							Name: utils.TestTodoName + "2",
-						},
-					}, nil).
-					Once()
-
-				return srvConverter
+			inputOrderBy: &model.TodoOrder{
+				Field:     model.TodoSortFieldCreatedAt,
+				Direction: &descending,
 			},
-			inputListId:         utils.TestListId.String(),
-			inputRequestCreator: utils.TestUsername,
-			inputFirst:          1,
-			expected: []*model.TodoOutput{
-				&model.TodoOutput{
-					ID:   uuid.UUID{1}.String(),
-					Name: utils.TestTodoName + "1",
-				},
-			},
-		}, {
-			name: "successfully get all todos without the first one",
-			requestSender: func() *mocks.RequestSenderInterface {
-				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
-					map[string]string{
-						utils.Username: utils.TestUsername,
-					}, http.StatusOK).
-					Return([]byte("Returned requested todos"), nil, http.StatusOK).
-					Once()
-
-				return reqSender
+			expectedQuery: "?deadline_from=2026-01-02T00%3A00%3A00Z&label=bug&limit=2&order=desc&q=docs&sort=created_at&status=Assigned",
+			responseHeaders: http.Header{
+				utils.TotalCountHeader:      {"5"},
+				utils.StartCursorHeader:     {startCursor},
+				utils.EndCursorHeader:       {endCursor},
+				utils.HasNextPageHeader:     {"true"},
+				utils.HasPreviousPageHeader: {"false"},
 			},
-			converter: func() *mocks.ServiceConverterTodo {
-				srvConverter := &mocks.ServiceConverterTodo{}
-				srvConverter.EXPECT().ConvertResponseToTodosOutputs([]byte("Returned requested todos")).
-					Return([]*model.TodoOutput{
-						&model.TodoOutput{
-							ID:   uuid.UUID{1}.String(),
-							Name: utils.TestTodoName + "1",
-						}, &model.TodoOutput{
-							ID:   uuid.UUID{2}.String(),
-							Name: utils.TestTodoName + "2",
-						},
-					}, nil).
-					Once()
-
-				return srvConverter
+			expectedTotalCount: 5,
+			expectedPageInfo: model.PageInfo{
+				StartCursor: &startCursor,
+				EndCursor:   &endCursor,
+				HasNextPage: true,
 			},
-			inputListId:         utils.TestListId.String(),
-			inputRequestCreator: utils.TestUsername,
-			inputFirst:          100,
-			inputAfter:          uuid.UUID{1}.String(),
-			expected: []*model.TodoOutput{
-				&model.TodoOutput{
-					ID:   uuid.UUID{2}.String(),
-					Name: utils.TestTodoName + "2",
-				},
+		}, {
+			name:          "page after a cursor",
+			inputAfter:    &after,
+			inputOrderBy:  &model.TodoOrder{Field: model.TodoSortFieldPriority},
+			expectedQuery: "?cursor=previous-cursor&sort=priority",
+			responseHeaders: http.Header{
+				utils.TotalCountHeader:      {"4"},
+				utils.HasPreviousPageHeader: {"true"},
 			},
+			expectedTotalCount: 4,
+			expectedPageInfo:   model.PageInfo{HasPreviousPage: true},
 		}, {
-			name: "try to get out of range todos",
-			requestSender: func() *mocks.RequestSenderInterface {
-				reqSender := &mocks.RequestSenderInterface{}
-				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
-					map[string]string{
-						utils.Username: utils.TestUsername,
-					}, http.StatusOK).
-					Return([]byte("Returned requested todos"), nil, http.StatusOK).
-					Once()
-
-				return reqSender
+			name:          "last page before a cursor",
+			inputLast:     &last,
+			inputBefore:   &before,
+			expectedQuery: "?before=next-cursor&last=3",
+			responseHeaders: http.Header{
+				utils.TotalCountHeader:      {"4"},
+				utils.StartCursorHeader:     {startCursor},
+				utils.EndCursorHeader:       {endCursor},
+				utils.HasNextPageHeader:     {"true"},
+				utils.HasPreviousPageHeader: {"true"},
 			},
-			converter: func() *mocks.ServiceConverterTodo {
-				srvConverter := &mocks.ServiceConverterTodo{}
-				srvConverter.EXPECT().ConvertResponseToTodosOutputs([]byte("Returned requested todos")).
-					Return([]*model.TodoOutput{
-						&model.TodoOutput{
-							ID:   uuid.UUID{1}.String(),
-							Name: utils.TestTodoName + "1",
-						}, &model.TodoOutput{
-							ID:   uuid.UUID{2}.String(),
-							Name: utils.TestTodoName + "2",
-						},
-					}, nil).
-					Once()
-
-				return srvConverter
+			expectedTotalCount: 4,
+			expectedPageInfo: model.PageInfo{
+				StartCursor:     &startCursor,
+				EndCursor:       &endCursor,
+				HasNextPage:     true,
+				HasPreviousPage: true,
 			},
-			inputListId:         utils.TestListId.String(),
-			inputRequestCreator: utils.TestUsername,
-			inputAfter:          uuid.UUID{2}.String(),
-			expectedError:       errors.New("todo is out of range"),
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			converterMock := testCase.converter()
+			reqSenderMock := &mocks.RequestSenderInterface{}
+			reqSenderMock.EXPECT().SendRequestWithHeaders(http.MethodGet, url+testCase.expectedQuery, nil,
+				map[string]string{
+					utils.Authorization: utils.BearerPrefix + utils.TestToken,
+				}, http.StatusOK).
+				Return([]byte("Returned requested todos"), testCase.responseHeaders, nil, http.StatusOK).
+				Once()
+			converterMock := &mocks.ServiceConverterTodo{}
+			converterMock.EXPECT().ConvertResponseToTodosOutputs([]byte("Returned requested todos")).
+				Return(todosOutputs, nil).
+				Once()
+
 			var converter todo.ServiceConverterTodo = converterMock
-			reqSenderMock := testCase.requestSender()
 			var reqSender todo.RequestSenderInterface = reqSenderMock
 			service := todo.NewServiceTodo(converter, &reqSender)
 
-			firstParam := &testCase.inputFirst
-			var afterParam *string = nil
-			if testCase.inputAfter != "" {
-				afterParam = &testCase.inputAfter
-			}
-
 			actual, err := service.GetTodosFromList(utils.GetTestingContext(),
-				firstParam,
-				afterParam,
-				testCase.inputListId,
-				testCase.inputRequestCreator)
-			if err != nil {
-				require.Equal(t, testCase.expectedError, err)
-				converterMock.AssertExpectations(t)
-				reqSenderMock.AssertExpectations(t)
-				return
-			}
-
-			require.Equal(t, testCase.expected, actual.Todos)
+				testCase.inputFirst,
+				testCase.inputAfter,
+				testCase.inputLast,
+				testCase.inputBefore,
+				testCase.inputFilter,
+				testCase.inputOrderBy,
+				utils.TestListId.String(),
+				utils.TestToken)
+			require.NoError(t, err)
+
+			require.Equal(t, todosOutputs, actual.Todos)
+			require.Equal(t, testCase.expectedTotalCount, *actual.TotalCount)
+			require.Equal(t, testCase.expectedPageInfo, *actual.PageInfo)
 			converterMock.AssertExpectations(t)
 			reqSenderMock.AssertExpectations(t)
 		})
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceConverterUser is an autogenerated mock type for the ServiceConverterUser type
+type ServiceConverterUser struct {
+	mock.Mock
+}
+
+type ServiceConverterUser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceConverterUser) EXPECT() *ServiceConverterUser_Expecter {
+	return &ServiceConverterUser_Expecter{mock: &_m.Mock}
+}
+
+// ConvertResponseToUserAccountOutput provides a mock function with given fields: response
+func (_m *ServiceConverterUser) ConvertResponseToUserAccountOutput(response []byte) (*structures.UserAccountOutput, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToUserAccountOutput")
+	}
+
+	var r0 *structures.UserAccountOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (*structures.UserAccountOutput, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) *structures.UserAccountOutput); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterUser_ConvertResponseToUserAccountOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToUserAccountOutput'
+type ServiceConverterUser_ConvertResponseToUserAccountOutput_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToUserAccountOutput is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterUser_Expecter) ConvertResponseToUserAccountOutput(response interface{}) *ServiceConverterUser_ConvertResponseToUserAccountOutput_Call {
+	return &ServiceConverterUser_ConvertResponseToUserAccountOutput_Call{Call: _e.mock.On("ConvertResponseToUserAccountOutput", response)}
+}
+
+func (_c *ServiceConverterUser_ConvertResponseToUserAccountOutput_Call) Run(run func(response []byte)) *ServiceConverterUser_ConvertResponseToUserAccountOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterUser_ConvertResponseToUserAccountOutput_Call) Return(_a0 *structures.UserAccountOutput, _a1 error) *ServiceConverterUser_ConvertResponseToUserAccountOutput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterUser_ConvertResponseToUserAccountOutput_Call) RunAndReturn(run func([]byte) (*structures.UserAccountOutput, error)) *ServiceConverterUser_ConvertResponseToUserAccountOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertResponseToUserOutputs provides a mock function with given fields: response
+func (_m *ServiceConverterUser) ConvertResponseToUserOutputs(response []byte) ([]*structures.UserOutput, error) {
+	ret := _m.Called(response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertResponseToUserOutputs")
+	}
+
+	var r0 []*structures.UserOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]*structures.UserOutput, error)); ok {
+		return rf(response)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []*structures.UserOutput); ok {
+		r0 = rf(response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*structures.UserOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceConverterUser_ConvertResponseToUserOutputs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertResponseToUserOutputs'
+type ServiceConverterUser_ConvertResponseToUserOutputs_Call struct {
+	*mock.Call
+}
+
+// ConvertResponseToUserOutputs is a helper method to define mock.On call
+//   - response []byte
+func (_e *ServiceConverterUser_Expecter) ConvertResponseToUserOutputs(response interface{}) *ServiceConverterUser_ConvertResponseToUserOutputs_Call {
+	return &ServiceConverterUser_ConvertResponseToUserOutputs_Call{Call: _e.mock.On("ConvertResponseToUserOutputs", response)}
+}
+
+func (_c *ServiceConverterUser_ConvertResponseToUserOutputs_Call) Run(run func(response []byte)) *ServiceConverterUser_ConvertResponseToUserOutputs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *ServiceConverterUser_ConvertResponseToUserOutputs_Call) Return(_a0 []*structures.UserOutput, _a1 error) *ServiceConverterUser_ConvertResponseToUserOutputs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceConverterUser_ConvertResponseToUserOutputs_Call) RunAndReturn(run func([]byte) ([]*structures.UserOutput, error)) *ServiceConverterUser_ConvertResponseToUserOutputs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceConverterUser creates a new instance of ServiceConverterUser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceConverterUser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceConverterUser {
+	mock := &ServiceConverterUser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"encoding/json"
+	restStructures "project/structures"
+)
+
+type ConverterUser struct{}
+
+func NewUserConverter() *ConverterUser {
+	return &ConverterUser{}
+}
+
+func (cu *ConverterUser) ConvertResponseToUserAccountOutput(response []byte) (*restStructures.UserAccountOutput, error) {
+	var userOutputResponse restStructures.UserAccountOutput
+	err := json.Unmarshal(response, &userOutputResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userOutputResponse, nil
+}
+
+func (cu *ConverterUser) ConvertResponseToUserOutputs(response []byte) ([]*restStructures.UserOutput, error) {
+	var userOutputsResponse []*restStructures.UserOutput
+	err := json.Unmarshal(response, &userOutputsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return userOutputsResponse, nil
+}
@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/config"
+	"project/graphql/graph/utils"
+	restStructures "project/structures"
+)
+
+//go:generate mockery --name ServiceConverterUser --output=automock --with-expecter=true
+type ServiceConverterUser interface {
+	ConvertResponseToUserAccountOutput(response []byte) (*restStructures.UserAccountOutput, error)
+	ConvertResponseToUserOutputs(response []byte) ([]*restStructures.UserOutput, error)
+}
+
+//go:generate mockery --name RequestSenderInterface --output=automock --with-expecter=true
+type RequestSenderInterface interface {
+	SendRequest(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, error, int)
+}
+
+type ServiceUser struct {
+	requestSender RequestSenderInterface
+	converter     ServiceConverterUser
+}
+
+func NewServiceUser(converter ServiceConverterUser, requestSender *RequestSenderInterface) *ServiceUser {
+	if requestSender == nil {
+		var reqSenderInterface RequestSenderInterface = utils.NewRequestSender(config.Default().Gateway)
+		requestSender = &reqSenderInterface
+	}
+
+	return &ServiceUser{
+		requestSender: *requestSender,
+		converter:     converter,
+	}
+}
+
+func (su *ServiceUser) GetCurrentUser(ctx context.Context, requestToken string) (*restStructures.UserAccountOutput, error) {
+	url := utils.BasePath + "/me"
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := su.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, status).Error(err)
+		return nil, err
+	}
+
+	currentUser, err := su.converter.ConvertResponseToUserAccountOutput(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	return currentUser, nil
+}
+
+func (su *ServiceUser) GetCurrentUserLists(ctx context.Context, requestToken string) ([]*restStructures.UserOutput, error) {
+	url := utils.BasePath + "/me/lists"
+	headers := utils.GetAuthorizationHeaders(requestToken)
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+	result, err, status := su.requestSender.SendRequest(http.MethodGet, url, nil, headers, http.StatusOK)
+	if err != nil {
+		log.WithField(utils.Status, status).Error(err)
+		return nil, err
+	}
+
+	memberships, err := su.converter.ConvertResponseToUserOutputs(result)
+	if err != nil {
+		log.WithField(utils.Status, http.StatusInternalServerError).Error(err)
+		return nil, err
+	}
+
+	return memberships, nil
+}
@@ -0,0 +1,206 @@
+package user_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"project/graphql/graph/user"
+	mocks "project/graphql/graph/user/automock"
+	"project/graphql/graph/utils"
+	restStructures "project/structures"
+	"testing"
+)
+
+func TestGetCurrentUser(t *testing.T) {
+	url := utils.BasePath + "/me"
+
+	testCases := []struct {
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterUser
+		inputRequestToken string
+		expected          *restStructures.UserAccountOutput
+		expectedError     error
+	}{
+		{
+			name: "successfully get current user",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return([]byte("Returned user"), nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterUser {
+				srvConverter := &mocks.ServiceConverterUser{}
+				srvConverter.EXPECT().ConvertResponseToUserAccountOutput([]byte("Returned user")).
+					Return(&restStructures.UserAccountOutput{Username: utils.TestUsername, Role: utils.Writer, IsActive: true}, nil).
+					Once()
+
+				return srvConverter
+			},
+			inputRequestToken: utils.TestToken,
+			expected:          &restStructures.UserAccountOutput{Username: utils.TestUsername, Role: utils.Writer, IsActive: true},
+		}, {
+			name: "token is rejected",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return(nil, errors.New("Unauthorized"), http.StatusUnauthorized).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterUser {
+				return &mocks.ServiceConverterUser{}
+			},
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("Unauthorized"),
+		}, {
+			name: "converting response failed",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return([]byte("Returned user"), nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterUser {
+				srvConverter := &mocks.ServiceConverterUser{}
+				srvConverter.EXPECT().ConvertResponseToUserAccountOutput([]byte("Returned user")).
+					Return(nil, errors.New("converting response failed")).
+					Once()
+
+				return srvConverter
+			},
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			converterMock := testCase.converter()
+			var converter user.ServiceConverterUser = converterMock
+			reqSenderMock := testCase.requestSender()
+			var reqSender user.RequestSenderInterface = reqSenderMock
+			service := user.NewServiceUser(converter, &reqSender)
+
+			actual, err := service.GetCurrentUser(utils.GetTestingContext(), testCase.inputRequestToken)
+			require.Equal(t, testCase.expectedError, err)
+			require.Equal(t, testCase.expected, actual)
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetCurrentUserLists(t *testing.T) {
+	url := utils.BasePath + "/me/lists"
+
+	testCases := []struct {
+		name              string
+		requestSender     func() *mocks.RequestSenderInterface
+		converter         func() *mocks.ServiceConverterUser
+		inputRequestToken string
+		expected          []*restStructures.UserOutput
+		expectedError     error
+	}{
+		{
+			name: "successfully get current user lists",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return([]byte("Returned lists"), nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterUser {
+				srvConverter := &mocks.ServiceConverterUser{}
+				srvConverter.EXPECT().ConvertResponseToUserOutputs([]byte("Returned lists")).
+					Return([]*restStructures.UserOutput{
+						{ListId: utils.TestListId, ListName: utils.TestListName, Username: utils.TestUsername, Role: utils.Editor},
+					}, nil).
+					Once()
+
+				return srvConverter
+			},
+			inputRequestToken: utils.TestToken,
+			expected: []*restStructures.UserOutput{
+				{ListId: utils.TestListId, ListName: utils.TestListName, Username: utils.TestUsername, Role: utils.Editor},
+			},
+		}, {
+			name: "token is rejected",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return(nil, errors.New("Unauthorized"), http.StatusUnauthorized).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterUser {
+				return &mocks.ServiceConverterUser{}
+			},
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("Unauthorized"),
+		}, {
+			name: "converting response failed",
+			requestSender: func() *mocks.RequestSenderInterface {
+				reqSender := &mocks.RequestSenderInterface{}
+				reqSender.EXPECT().SendRequest(http.MethodGet, url, nil,
+					map[string]string{
+						utils.Authorization: utils.BearerPrefix + utils.TestToken,
+					}, http.StatusOK).
+					Return([]byte("Returned lists"), nil, http.StatusOK).
+					Once()
+
+				return reqSender
+			},
+			converter: func() *mocks.ServiceConverterUser {
+				srvConverter := &mocks.ServiceConverterUser{}
+				srvConverter.EXPECT().ConvertResponseToUserOutputs([]byte("Returned lists")).
+					Return(nil, errors.New("converting response failed")).
+					Once()
+
+				return srvConverter
+			},
+			inputRequestToken: utils.TestToken,
+			expectedError:     errors.New("converting response failed"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			converterMock := testCase.converter()
+			var converter user.ServiceConverterUser = converterMock
+			reqSenderMock := testCase.requestSender()
+			var reqSender user.RequestSenderInterface = reqSenderMock
+			service := user.NewServiceUser(converter, &reqSender)
+
+			actual, err := service.GetCurrentUserLists(utils.GetTestingContext(), testCase.inputRequestToken)
+			require.Equal(t, testCase.expectedError, err)
+			require.Equal(t, testCase.expected, actual)
+			converterMock.AssertExpectations(t)
+			reqSenderMock.AssertExpectations(t)
+		})
+	}
+}
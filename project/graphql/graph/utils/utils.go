@@ -10,19 +10,28 @@ import (
 	"github.com/sirupsen/logrus"
 	"io"
 	"net/http"
+	"net/url"
+	"project/config"
 	"project/graphql/graph/model"
-	"time"
+	"strconv"
+	"strings"
 )
 
 const (
 	Username                  = "userId"
 	Role                      = "role"
+	Token                     = "token"
+	ListRoles                 = "listRoles"
+	Authorization             = "Authorization"
+	BearerPrefix              = "Bearer "
 	Unknown                   = "unknown"
 	Reader                    = "reader"
 	Writer                    = "writer"
+	Viewer                    = "viewer"
+	Editor                    = "editor"
+	Manager                   = "manager"
 	Owner                     = "owner"
 	Admin                     = "admin"
-	BaseUrl                   = "http://localhost:8080"
 	BasePath                  = "/todo/api"
 	contentTypeKey            = "Content-Type"
 	contentTypeValue          = "application/json"
@@ -30,21 +39,36 @@ const (
 	Status                    = "status"
 	userDoesNotHavePermission = "user is %s and does not have %s permission"
 	emptyRoleErrorMsg         = "providing role is required"
+	userDoesNotHaveListAccess = "user is %s in list %s and does not have %s permission"
+	TotalCountHeader          = "X-Total-Count"
+	StartCursorHeader         = "X-Start-Cursor"
+	EndCursorHeader           = "X-End-Cursor"
+	HasNextPageHeader         = "X-Has-Next-Page"
+	HasPreviousPageHeader     = "X-Has-Previous-Page"
+	limitParam                = "limit"
+	cursorParam               = "cursor"
+	lastParam                 = "last"
+	beforeParam               = "before"
 )
 
 const (
-	TestUsername = "TestUsername"
-	TestListName = "TestListName"
-	TestTodoName = "TestTodoName"
+	TestUsername     = "TestUsername"
+	TestToken        = "TestToken"
+	TestListName     = "TestListName"
+	TestTodoName     = "TestTodoName"
+	TestSubtaskTitle = "TestSubtaskTitle"
+	TestCommentBody  = "TestCommentBody"
+	TestTodoStatus   = "In Review"
 )
 
 var (
-	TestListId = uuid.UUID{1}
-	TestTodoId = uuid.UUID{2}
+	TestListId    = uuid.UUID{1}
+	TestTodoId    = uuid.UUID{2}
+	TestSubtaskId = uuid.UUID{3}
+	TestCommentId = uuid.UUID{5}
+	TestAuditId   = uuid.UUID{6}
 )
 
-var Client = &http.Client{Timeout: 10 * time.Second}
-
 var RoleType = map[string]int{
 	Unknown: -1,
 	Reader:  1,
@@ -53,20 +77,12 @@ var RoleType = map[string]int{
 	Admin:   4,
 }
 
-var users = map[string]string{
-	"Niki":  Admin,
-	"Ivan":  Writer,
-	"Miro":  Reader,
-	"Yosif": Writer,
-}
-
-func GetUserRole(name string) string {
-	rank, exists := users[name]
-	if !exists {
-		return Unknown
-	}
-
-	return rank
+var ListRoleType = map[string]int{
+	Unknown: -1,
+	Viewer:  1,
+	Editor:  2,
+	Manager: 3,
+	Owner:   4,
 }
 
 func CheckIfUserHasPermission(userRole string, permissionLevel string) bool {
@@ -83,21 +99,33 @@ func CheckIfUserHasPermission(userRole string, permissionLevel string) bool {
 	return permission <= user
 }
 
-type RequestSender struct{}
+type RequestSender struct {
+	client  *http.Client
+	baseUrl string
+}
 
-func NewRequestSender() *RequestSender {
-	return &RequestSender{}
+func NewRequestSender(cfg config.GatewayConfig) *RequestSender {
+	return &RequestSender{
+		client:  &http.Client{Timeout: cfg.RequestTimeout},
+		baseUrl: strings.TrimSuffix(cfg.RestBaseUrl, "/"),
+	}
 }
 
 func (rs *RequestSender) SendRequest(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, error, int) {
+	result, _, err, status := rs.SendRequestWithHeaders(requestType, route, body, headerData, expectedStatus)
+	return result, err, status
+}
+
+// SendRequestWithHeaders is SendRequest for routes that also report data in the response headers.
+func (rs *RequestSender) SendRequestWithHeaders(requestType, route string, body any, headerData map[string]string, expectedStatus int) ([]byte, http.Header, error, int) {
 	reqBody, err := json.Marshal(body)
 	if err != nil {
-		return nil, err, http.StatusInternalServerError
+		return nil, nil, err, http.StatusInternalServerError
 	}
 
-	req, err := http.NewRequest(requestType, route, bytes.NewReader(reqBody))
+	req, err := http.NewRequest(requestType, rs.baseUrl+route, bytes.NewReader(reqBody))
 	if err != nil {
-		return nil, err, http.StatusInternalServerError
+		return nil, nil, err, http.StatusInternalServerError
 
 	}
 
@@ -106,22 +134,32 @@ func (rs *RequestSender) SendRequest(requestType, route string, body any, header
 		req.Header.Set(k, v)
 	}
 
-	resp, err := Client.Do(req)
+	resp, err := rs.client.Do(req)
 	if err != nil {
-		return nil, err, http.StatusInternalServerError
+		return nil, nil, err, http.StatusInternalServerError
 	}
 	defer resp.Body.Close()
 
 	result, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err, http.StatusInternalServerError
+		return nil, nil, err, http.StatusInternalServerError
 	}
 
 	if resp.StatusCode != expectedStatus {
-		return nil, errors.New(string(result)), resp.StatusCode
+		return nil, nil, errors.New(string(result)), resp.StatusCode
 	}
 
-	return result, nil, resp.StatusCode
+	return result, resp.Header, nil, resp.StatusCode
+}
+
+func (rs *RequestSender) CloseIdleConnections() {
+	rs.client.CloseIdleConnections()
+}
+
+func GetAuthorizationHeaders(requestToken string) map[string]string {
+	return map[string]string{
+		Authorization: BearerPrefix + requestToken,
+	}
 }
 
 func GetTestingContext() context.Context {
@@ -131,24 +169,45 @@ func GetTestingContext() context.Context {
 	return ctx
 }
 
-func GetListPosition(listId string, lists []*model.ListOutput) (int, error) {
-	for pos, list := range lists {
-		if list.ID == listId {
-			return pos, nil
-		}
+// GetPageQuery translates the Relay connection arguments to the paging parameters of the REST api.
+func GetPageQuery(first *int32, after *string, last *int32, before *string) url.Values {
+	query := url.Values{}
+	if first != nil {
+		query.Set(limitParam, strconv.Itoa(int(*first)))
+	}
+	if after != nil {
+		query.Set(cursorParam, *after)
+	}
+	if last != nil {
+		query.Set(lastParam, strconv.Itoa(int(*last)))
+	}
+	if before != nil {
+		query.Set(beforeParam, *before)
 	}
 
-	return 0, errors.New("list not found")
+	return query
 }
 
-func GetTodoPosition(todoId string, todos []*model.TodoOutput) (int, error) {
-	for pos, todo := range todos {
-		if todo.ID == todoId {
-			return pos, nil
-		}
+// GetPageInfo reads the page the REST api describes in the response headers.
+// The total count falls back to the number of returned items when the header is missing.
+func GetPageInfo(headers http.Header, itemsCount int) (*model.PageInfo, int32) {
+	pageInfo := &model.PageInfo{
+		HasNextPage:     headers.Get(HasNextPageHeader) == "true",
+		HasPreviousPage: headers.Get(HasPreviousPageHeader) == "true",
+	}
+	if startCursor := headers.Get(StartCursorHeader); startCursor != "" {
+		pageInfo.StartCursor = &startCursor
+	}
+	if endCursor := headers.Get(EndCursorHeader); endCursor != "" {
+		pageInfo.EndCursor = &endCursor
+	}
+
+	totalCount, err := strconv.Atoi(headers.Get(TotalCountHeader))
+	if err != nil {
+		totalCount = itemsCount
 	}
 
-	return 0, errors.New("todo not found")
+	return pageInfo, int32(totalCount)
 }
 
 func ValidatePermission(ctx context.Context, permissionLevel string) error {
@@ -162,3 +221,20 @@ func ValidatePermission(ctx context.Context, permissionLevel string) error {
 
 	return nil
 }
+
+func ValidateListPermission(ctx context.Context, listId, permissionLevel string) error {
+	if ValidatePermission(ctx, Admin) == nil {
+		return nil
+	}
+
+	listRoles, _ := ctx.Value(ListRoles).(map[string]string)
+	listRole, ok := listRoles[listId]
+	if !ok {
+		listRole = Unknown
+	}
+	if ListRoleType[listRole] < ListRoleType[permissionLevel] {
+		return fmt.Errorf(userDoesNotHaveListAccess, listRole, listId, permissionLevel)
+	}
+
+	return nil
+}
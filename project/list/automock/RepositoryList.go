@@ -1,4 +1,4 @@
-// Code generated by mockery v2.51.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -9,6 +9,8 @@ import (
 
 	structures "project/structures"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -167,6 +169,53 @@ func (_c *RepositoryList_ContainsUserInList_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// CreateLabel provides a mock function with given fields: ctx, entityLabel
+func (_m *RepositoryList) CreateLabel(ctx context.Context, entityLabel structures.LabelEntity) error {
+	ret := _m.Called(ctx, entityLabel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.LabelEntity) error); ok {
+		r0 = rf(ctx, entityLabel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryList_CreateLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLabel'
+type RepositoryList_CreateLabel_Call struct {
+	*mock.Call
+}
+
+// CreateLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityLabel structures.LabelEntity
+func (_e *RepositoryList_Expecter) CreateLabel(ctx interface{}, entityLabel interface{}) *RepositoryList_CreateLabel_Call {
+	return &RepositoryList_CreateLabel_Call{Call: _e.mock.On("CreateLabel", ctx, entityLabel)}
+}
+
+func (_c *RepositoryList_CreateLabel_Call) Run(run func(ctx context.Context, entityLabel structures.LabelEntity)) *RepositoryList_CreateLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.LabelEntity))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_CreateLabel_Call) Return(_a0 error) *RepositoryList_CreateLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryList_CreateLabel_Call) RunAndReturn(run func(context.Context, structures.LabelEntity) error) *RepositoryList_CreateLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateList provides a mock function with given fields: ctx, entityList, entityUser
 func (_m *RepositoryList) CreateList(ctx context.Context, entityList structures.ListEntity, entityUser structures.ListUserEntity) error {
 	ret := _m.Called(ctx, entityList, entityUser)
@@ -215,29 +264,29 @@ func (_c *RepositoryList_CreateList_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// DeleteList provides a mock function with given fields: ctx, listId
-func (_m *RepositoryList) DeleteList(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
-	ret := _m.Called(ctx, listId)
+// CreateTemplate provides a mock function with given fields: ctx, listId, entityTemplate, startDate
+func (_m *RepositoryList) CreateTemplate(ctx context.Context, listId uuid.UUID, entityTemplate structures.TemplateEntity, startDate *time.Time) (*structures.TemplateModel, error) {
+	ret := _m.Called(ctx, listId, entityTemplate, startDate)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteList")
+		panic("no return value specified for CreateTemplate")
 	}
 
-	var r0 *structures.ListModel
+	var r0 *structures.TemplateModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.ListModel, error)); ok {
-		return rf(ctx, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TemplateEntity, *time.Time) (*structures.TemplateModel, error)); ok {
+		return rf(ctx, listId, entityTemplate, startDate)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.ListModel); ok {
-		r0 = rf(ctx, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TemplateEntity, *time.Time) *structures.TemplateModel); ok {
+		r0 = rf(ctx, listId, entityTemplate, startDate)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*structures.ListModel)
+			r0 = ret.Get(0).(*structures.TemplateModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, listId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.TemplateEntity, *time.Time) error); ok {
+		r1 = rf(ctx, listId, entityTemplate, startDate)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -245,89 +294,103 @@ func (_m *RepositoryList) DeleteList(ctx context.Context, listId uuid.UUID) (*st
 	return r0, r1
 }
 
-// RepositoryList_DeleteList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteList'
-type RepositoryList_DeleteList_Call struct {
+// RepositoryList_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type RepositoryList_CreateTemplate_Call struct {
 	*mock.Call
 }
 
-// DeleteList is a helper method to define mock.On call
+// CreateTemplate is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-func (_e *RepositoryList_Expecter) DeleteList(ctx interface{}, listId interface{}) *RepositoryList_DeleteList_Call {
-	return &RepositoryList_DeleteList_Call{Call: _e.mock.On("DeleteList", ctx, listId)}
+//   - entityTemplate structures.TemplateEntity
+//   - startDate *time.Time
+func (_e *RepositoryList_Expecter) CreateTemplate(ctx interface{}, listId interface{}, entityTemplate interface{}, startDate interface{}) *RepositoryList_CreateTemplate_Call {
+	return &RepositoryList_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", ctx, listId, entityTemplate, startDate)}
 }
 
-func (_c *RepositoryList_DeleteList_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryList_DeleteList_Call {
+func (_c *RepositoryList_CreateTemplate_Call) Run(run func(ctx context.Context, listId uuid.UUID, entityTemplate structures.TemplateEntity, startDate *time.Time)) *RepositoryList_CreateTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.TemplateEntity), args[3].(*time.Time))
 	})
 	return _c
 }
 
-func (_c *RepositoryList_DeleteList_Call) Return(_a0 *structures.ListModel, _a1 error) *RepositoryList_DeleteList_Call {
+func (_c *RepositoryList_CreateTemplate_Call) Return(_a0 *structures.TemplateModel, _a1 error) *RepositoryList_CreateTemplate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RepositoryList_DeleteList_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListModel, error)) *RepositoryList_DeleteList_Call {
+func (_c *RepositoryList_CreateTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.TemplateEntity, *time.Time) (*structures.TemplateModel, error)) *RepositoryList_CreateTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllLists provides a mock function with given fields: ctx
-func (_m *RepositoryList) GetAllLists(ctx context.Context) []*structures.ListModel {
-	ret := _m.Called(ctx)
+// DeleteLabel provides a mock function with given fields: ctx, labelId, listId
+func (_m *RepositoryList) DeleteLabel(ctx context.Context, labelId uuid.UUID, listId uuid.UUID) (*structures.LabelModel, error) {
+	ret := _m.Called(ctx, labelId, listId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllLists")
+		panic("no return value specified for DeleteLabel")
 	}
 
-	var r0 []*structures.ListModel
-	if rf, ok := ret.Get(0).(func(context.Context) []*structures.ListModel); ok {
-		r0 = rf(ctx)
+	var r0 *structures.LabelModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*structures.LabelModel, error)); ok {
+		return rf(ctx, labelId, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *structures.LabelModel); ok {
+		r0 = rf(ctx, labelId, listId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*structures.ListModel)
+			r0 = ret.Get(0).(*structures.LabelModel)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, labelId, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// RepositoryList_GetAllLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllLists'
-type RepositoryList_GetAllLists_Call struct {
+// RepositoryList_DeleteLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLabel'
+type RepositoryList_DeleteLabel_Call struct {
 	*mock.Call
 }
 
-// GetAllLists is a helper method to define mock.On call
+// DeleteLabel is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *RepositoryList_Expecter) GetAllLists(ctx interface{}) *RepositoryList_GetAllLists_Call {
-	return &RepositoryList_GetAllLists_Call{Call: _e.mock.On("GetAllLists", ctx)}
+//   - labelId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositoryList_Expecter) DeleteLabel(ctx interface{}, labelId interface{}, listId interface{}) *RepositoryList_DeleteLabel_Call {
+	return &RepositoryList_DeleteLabel_Call{Call: _e.mock.On("DeleteLabel", ctx, labelId, listId)}
 }
 
-func (_c *RepositoryList_GetAllLists_Call) Run(run func(ctx context.Context)) *RepositoryList_GetAllLists_Call {
+func (_c *RepositoryList_DeleteLabel_Call) Run(run func(ctx context.Context, labelId uuid.UUID, listId uuid.UUID)) *RepositoryList_DeleteLabel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *RepositoryList_GetAllLists_Call) Return(_a0 []*structures.ListModel) *RepositoryList_GetAllLists_Call {
-	_c.Call.Return(_a0)
+func (_c *RepositoryList_DeleteLabel_Call) Return(_a0 *structures.LabelModel, _a1 error) *RepositoryList_DeleteLabel_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RepositoryList_GetAllLists_Call) RunAndReturn(run func(context.Context) []*structures.ListModel) *RepositoryList_GetAllLists_Call {
+func (_c *RepositoryList_DeleteLabel_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*structures.LabelModel, error)) *RepositoryList_DeleteLabel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListById provides a mock function with given fields: ctx, listId
-func (_m *RepositoryList) GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
+// DeleteList provides a mock function with given fields: ctx, listId
+func (_m *RepositoryList) DeleteList(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
 	ret := _m.Called(ctx, listId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListById")
+		panic("no return value specified for DeleteList")
 	}
 
 	var r0 *structures.ListModel
@@ -352,58 +415,58 @@ func (_m *RepositoryList) GetListById(ctx context.Context, listId uuid.UUID) (*s
 	return r0, r1
 }
 
-// RepositoryList_GetListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListById'
-type RepositoryList_GetListById_Call struct {
+// RepositoryList_DeleteList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteList'
+type RepositoryList_DeleteList_Call struct {
 	*mock.Call
 }
 
-// GetListById is a helper method to define mock.On call
+// DeleteList is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-func (_e *RepositoryList_Expecter) GetListById(ctx interface{}, listId interface{}) *RepositoryList_GetListById_Call {
-	return &RepositoryList_GetListById_Call{Call: _e.mock.On("GetListById", ctx, listId)}
+func (_e *RepositoryList_Expecter) DeleteList(ctx interface{}, listId interface{}) *RepositoryList_DeleteList_Call {
+	return &RepositoryList_DeleteList_Call{Call: _e.mock.On("DeleteList", ctx, listId)}
 }
 
-func (_c *RepositoryList_GetListById_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryList_GetListById_Call {
+func (_c *RepositoryList_DeleteList_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryList_DeleteList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *RepositoryList_GetListById_Call) Return(_a0 *structures.ListModel, _a1 error) *RepositoryList_GetListById_Call {
+func (_c *RepositoryList_DeleteList_Call) Return(_a0 *structures.ListModel, _a1 error) *RepositoryList_DeleteList_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RepositoryList_GetListById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListModel, error)) *RepositoryList_GetListById_Call {
+func (_c *RepositoryList_DeleteList_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListModel, error)) *RepositoryList_DeleteList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListOwner provides a mock function with given fields: ctx, listId
-func (_m *RepositoryList) GetListOwner(ctx context.Context, listId uuid.UUID) (*structures.UserModel, error) {
-	ret := _m.Called(ctx, listId)
+// DeleteTemplate provides a mock function with given fields: ctx, templateId
+func (_m *RepositoryList) DeleteTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateModel, error) {
+	ret := _m.Called(ctx, templateId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListOwner")
+		panic("no return value specified for DeleteTemplate")
 	}
 
-	var r0 *structures.UserModel
+	var r0 *structures.TemplateModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.UserModel, error)); ok {
-		return rf(ctx, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.TemplateModel, error)); ok {
+		return rf(ctx, templateId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.UserModel); ok {
-		r0 = rf(ctx, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.TemplateModel); ok {
+		r0 = rf(ctx, templateId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*structures.UserModel)
+			r0 = ret.Get(0).(*structures.TemplateModel)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, listId)
+		r1 = rf(ctx, templateId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -411,58 +474,108 @@ func (_m *RepositoryList) GetListOwner(ctx context.Context, listId uuid.UUID) (*
 	return r0, r1
 }
 
-// RepositoryList_GetListOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListOwner'
-type RepositoryList_GetListOwner_Call struct {
+// RepositoryList_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type RepositoryList_DeleteTemplate_Call struct {
 	*mock.Call
 }
 
-// GetListOwner is a helper method to define mock.On call
+// DeleteTemplate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - listId uuid.UUID
-func (_e *RepositoryList_Expecter) GetListOwner(ctx interface{}, listId interface{}) *RepositoryList_GetListOwner_Call {
-	return &RepositoryList_GetListOwner_Call{Call: _e.mock.On("GetListOwner", ctx, listId)}
+//   - templateId uuid.UUID
+func (_e *RepositoryList_Expecter) DeleteTemplate(ctx interface{}, templateId interface{}) *RepositoryList_DeleteTemplate_Call {
+	return &RepositoryList_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, templateId)}
 }
 
-func (_c *RepositoryList_GetListOwner_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryList_GetListOwner_Call {
+func (_c *RepositoryList_DeleteTemplate_Call) Run(run func(ctx context.Context, templateId uuid.UUID)) *RepositoryList_DeleteTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *RepositoryList_GetListOwner_Call) Return(_a0 *structures.UserModel, _a1 error) *RepositoryList_GetListOwner_Call {
+func (_c *RepositoryList_DeleteTemplate_Call) Return(_a0 *structures.TemplateModel, _a1 error) *RepositoryList_DeleteTemplate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RepositoryList_GetListOwner_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.UserModel, error)) *RepositoryList_GetListOwner_Call {
+func (_c *RepositoryList_DeleteTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.TemplateModel, error)) *RepositoryList_DeleteTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserFromListById provides a mock function with given fields: ctx, listId, username
-func (_m *RepositoryList) GetUserFromListById(ctx context.Context, listId uuid.UUID, username string) (*structures.UserModel, error) {
-	ret := _m.Called(ctx, listId, username)
+// DuplicateList provides a mock function with given fields: ctx, listId, entityList, entityUser, includeMembers
+func (_m *RepositoryList) DuplicateList(ctx context.Context, listId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, includeMembers bool) error {
+	ret := _m.Called(ctx, listId, entityList, entityUser, includeMembers)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserFromListById")
+		panic("no return value specified for DuplicateList")
 	}
 
-	var r0 *structures.UserModel
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.ListEntity, structures.ListUserEntity, bool) error); ok {
+		r0 = rf(ctx, listId, entityList, entityUser, includeMembers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryList_DuplicateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateList'
+type RepositoryList_DuplicateList_Call struct {
+	*mock.Call
+}
+
+// DuplicateList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - entityList structures.ListEntity
+//   - entityUser structures.ListUserEntity
+//   - includeMembers bool
+func (_e *RepositoryList_Expecter) DuplicateList(ctx interface{}, listId interface{}, entityList interface{}, entityUser interface{}, includeMembers interface{}) *RepositoryList_DuplicateList_Call {
+	return &RepositoryList_DuplicateList_Call{Call: _e.mock.On("DuplicateList", ctx, listId, entityList, entityUser, includeMembers)}
+}
+
+func (_c *RepositoryList_DuplicateList_Call) Run(run func(ctx context.Context, listId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, includeMembers bool)) *RepositoryList_DuplicateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.ListEntity), args[3].(structures.ListUserEntity), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_DuplicateList_Call) Return(_a0 error) *RepositoryList_DuplicateList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryList_DuplicateList_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.ListEntity, structures.ListUserEntity, bool) error) *RepositoryList_DuplicateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllLists provides a mock function with given fields: ctx, page
+func (_m *RepositoryList) GetAllLists(ctx context.Context, page structures.PageQuery) (*structures.ListPageModel, error) {
+	ret := _m.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllLists")
+	}
+
+	var r0 *structures.ListPageModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.UserModel, error)); ok {
-		return rf(ctx, listId, username)
+	if rf, ok := ret.Get(0).(func(context.Context, structures.PageQuery) (*structures.ListPageModel, error)); ok {
+		return rf(ctx, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.UserModel); ok {
-		r0 = rf(ctx, listId, username)
+	if rf, ok := ret.Get(0).(func(context.Context, structures.PageQuery) *structures.ListPageModel); ok {
+		r0 = rf(ctx, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*structures.UserModel)
+			r0 = ret.Get(0).(*structures.ListPageModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, listId, username)
+	if rf, ok := ret.Get(1).(func(context.Context, structures.PageQuery) error); ok {
+		r1 = rf(ctx, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -470,59 +583,58 @@ func (_m *RepositoryList) GetUserFromListById(ctx context.Context, listId uuid.U
 	return r0, r1
 }
 
-// RepositoryList_GetUserFromListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserFromListById'
-type RepositoryList_GetUserFromListById_Call struct {
+// RepositoryList_GetAllLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllLists'
+type RepositoryList_GetAllLists_Call struct {
 	*mock.Call
 }
 
-// GetUserFromListById is a helper method to define mock.On call
+// GetAllLists is a helper method to define mock.On call
 //   - ctx context.Context
-//   - listId uuid.UUID
-//   - username string
-func (_e *RepositoryList_Expecter) GetUserFromListById(ctx interface{}, listId interface{}, username interface{}) *RepositoryList_GetUserFromListById_Call {
-	return &RepositoryList_GetUserFromListById_Call{Call: _e.mock.On("GetUserFromListById", ctx, listId, username)}
+//   - page structures.PageQuery
+func (_e *RepositoryList_Expecter) GetAllLists(ctx interface{}, page interface{}) *RepositoryList_GetAllLists_Call {
+	return &RepositoryList_GetAllLists_Call{Call: _e.mock.On("GetAllLists", ctx, page)}
 }
 
-func (_c *RepositoryList_GetUserFromListById_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *RepositoryList_GetUserFromListById_Call {
+func (_c *RepositoryList_GetAllLists_Call) Run(run func(ctx context.Context, page structures.PageQuery)) *RepositoryList_GetAllLists_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(structures.PageQuery))
 	})
 	return _c
 }
 
-func (_c *RepositoryList_GetUserFromListById_Call) Return(_a0 *structures.UserModel, _a1 error) *RepositoryList_GetUserFromListById_Call {
+func (_c *RepositoryList_GetAllLists_Call) Return(_a0 *structures.ListPageModel, _a1 error) *RepositoryList_GetAllLists_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RepositoryList_GetUserFromListById_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.UserModel, error)) *RepositoryList_GetUserFromListById_Call {
+func (_c *RepositoryList_GetAllLists_Call) RunAndReturn(run func(context.Context, structures.PageQuery) (*structures.ListPageModel, error)) *RepositoryList_GetAllLists_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveUserUserFromList provides a mock function with given fields: ctx, entityUser
-func (_m *RepositoryList) RemoveUserUserFromList(ctx context.Context, entityUser structures.ListUserEntity) (*structures.UserModel, error) {
-	ret := _m.Called(ctx, entityUser)
+// GetLabels provides a mock function with given fields: ctx, listId
+func (_m *RepositoryList) GetLabels(ctx context.Context, listId uuid.UUID) ([]structures.LabelModel, error) {
+	ret := _m.Called(ctx, listId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveUserUserFromList")
+		panic("no return value specified for GetLabels")
 	}
 
-	var r0 *structures.UserModel
+	var r0 []structures.LabelModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, structures.ListUserEntity) (*structures.UserModel, error)); ok {
-		return rf(ctx, entityUser)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]structures.LabelModel, error)); ok {
+		return rf(ctx, listId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, structures.ListUserEntity) *structures.UserModel); ok {
-		r0 = rf(ctx, entityUser)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []structures.LabelModel); ok {
+		r0 = rf(ctx, listId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*structures.UserModel)
+			r0 = ret.Get(0).([]structures.LabelModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, structures.ListUserEntity) error); ok {
-		r1 = rf(ctx, entityUser)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, listId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -530,58 +642,58 @@ func (_m *RepositoryList) RemoveUserUserFromList(ctx context.Context, entityUser
 	return r0, r1
 }
 
-// RepositoryList_RemoveUserUserFromList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserUserFromList'
-type RepositoryList_RemoveUserUserFromList_Call struct {
+// RepositoryList_GetLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLabels'
+type RepositoryList_GetLabels_Call struct {
 	*mock.Call
 }
 
-// RemoveUserUserFromList is a helper method to define mock.On call
+// GetLabels is a helper method to define mock.On call
 //   - ctx context.Context
-//   - entityUser structures.ListUserEntity
-func (_e *RepositoryList_Expecter) RemoveUserUserFromList(ctx interface{}, entityUser interface{}) *RepositoryList_RemoveUserUserFromList_Call {
-	return &RepositoryList_RemoveUserUserFromList_Call{Call: _e.mock.On("RemoveUserUserFromList", ctx, entityUser)}
+//   - listId uuid.UUID
+func (_e *RepositoryList_Expecter) GetLabels(ctx interface{}, listId interface{}) *RepositoryList_GetLabels_Call {
+	return &RepositoryList_GetLabels_Call{Call: _e.mock.On("GetLabels", ctx, listId)}
 }
 
-func (_c *RepositoryList_RemoveUserUserFromList_Call) Run(run func(ctx context.Context, entityUser structures.ListUserEntity)) *RepositoryList_RemoveUserUserFromList_Call {
+func (_c *RepositoryList_GetLabels_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryList_GetLabels_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(structures.ListUserEntity))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *RepositoryList_RemoveUserUserFromList_Call) Return(_a0 *structures.UserModel, _a1 error) *RepositoryList_RemoveUserUserFromList_Call {
+func (_c *RepositoryList_GetLabels_Call) Return(_a0 []structures.LabelModel, _a1 error) *RepositoryList_GetLabels_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RepositoryList_RemoveUserUserFromList_Call) RunAndReturn(run func(context.Context, structures.ListUserEntity) (*structures.UserModel, error)) *RepositoryList_RemoveUserUserFromList_Call {
+func (_c *RepositoryList_GetLabels_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]structures.LabelModel, error)) *RepositoryList_GetLabels_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateList provides a mock function with given fields: ctx, listId, newListName
-func (_m *RepositoryList) UpdateList(ctx context.Context, listId uuid.UUID, newListName string) (*structures.ListModel, error) {
-	ret := _m.Called(ctx, listId, newListName)
+// GetListById provides a mock function with given fields: ctx, listId
+func (_m *RepositoryList) GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
+	ret := _m.Called(ctx, listId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateList")
+		panic("no return value specified for GetListById")
 	}
 
 	var r0 *structures.ListModel
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.ListModel, error)); ok {
-		return rf(ctx, listId, newListName)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.ListModel, error)); ok {
+		return rf(ctx, listId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.ListModel); ok {
-		r0 = rf(ctx, listId, newListName)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.ListModel); ok {
+		r0 = rf(ctx, listId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*structures.ListModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, listId, newListName)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, listId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -589,32 +701,711 @@ func (_m *RepositoryList) UpdateList(ctx context.Context, listId uuid.UUID, newL
 	return r0, r1
 }
 
-// RepositoryList_UpdateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateList'
-type RepositoryList_UpdateList_Call struct {
+// RepositoryList_GetListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListById'
+type RepositoryList_GetListById_Call struct {
 	*mock.Call
 }
 
-// UpdateList is a helper method to define mock.On call
+// GetListById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-//   - newListName string
-func (_e *RepositoryList_Expecter) UpdateList(ctx interface{}, listId interface{}, newListName interface{}) *RepositoryList_UpdateList_Call {
-	return &RepositoryList_UpdateList_Call{Call: _e.mock.On("UpdateList", ctx, listId, newListName)}
+func (_e *RepositoryList_Expecter) GetListById(ctx interface{}, listId interface{}) *RepositoryList_GetListById_Call {
+	return &RepositoryList_GetListById_Call{Call: _e.mock.On("GetListById", ctx, listId)}
 }
 
-func (_c *RepositoryList_UpdateList_Call) Run(run func(ctx context.Context, listId uuid.UUID, newListName string)) *RepositoryList_UpdateList_Call {
+func (_c *RepositoryList_GetListById_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryList_GetListById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *RepositoryList_UpdateList_Call) Return(_a0 *structures.ListModel, _a1 error) *RepositoryList_UpdateList_Call {
+func (_c *RepositoryList_GetListById_Call) Return(_a0 *structures.ListModel, _a1 error) *RepositoryList_GetListById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RepositoryList_UpdateList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.ListModel, error)) *RepositoryList_UpdateList_Call {
+func (_c *RepositoryList_GetListById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListModel, error)) *RepositoryList_GetListById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListOwner provides a mock function with given fields: ctx, listId
+func (_m *RepositoryList) GetListOwner(ctx context.Context, listId uuid.UUID) (*structures.UserModel, error) {
+	ret := _m.Called(ctx, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListOwner")
+	}
+
+	var r0 *structures.UserModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.UserModel, error)); ok {
+		return rf(ctx, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.UserModel); ok {
+		r0 = rf(ctx, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_GetListOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListOwner'
+type RepositoryList_GetListOwner_Call struct {
+	*mock.Call
+}
+
+// GetListOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+func (_e *RepositoryList_Expecter) GetListOwner(ctx interface{}, listId interface{}) *RepositoryList_GetListOwner_Call {
+	return &RepositoryList_GetListOwner_Call{Call: _e.mock.On("GetListOwner", ctx, listId)}
+}
+
+func (_c *RepositoryList_GetListOwner_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryList_GetListOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_GetListOwner_Call) Return(_a0 *structures.UserModel, _a1 error) *RepositoryList_GetListOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_GetListOwner_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.UserModel, error)) *RepositoryList_GetListOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListsOfUser provides a mock function with given fields: ctx, username
+func (_m *RepositoryList) GetListsOfUser(ctx context.Context, username string) []*structures.UserModel {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListsOfUser")
+	}
+
+	var r0 []*structures.UserModel
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*structures.UserModel); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*structures.UserModel)
+		}
+	}
+
+	return r0
+}
+
+// RepositoryList_GetListsOfUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListsOfUser'
+type RepositoryList_GetListsOfUser_Call struct {
+	*mock.Call
+}
+
+// GetListsOfUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *RepositoryList_Expecter) GetListsOfUser(ctx interface{}, username interface{}) *RepositoryList_GetListsOfUser_Call {
+	return &RepositoryList_GetListsOfUser_Call{Call: _e.mock.On("GetListsOfUser", ctx, username)}
+}
+
+func (_c *RepositoryList_GetListsOfUser_Call) Run(run func(ctx context.Context, username string)) *RepositoryList_GetListsOfUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_GetListsOfUser_Call) Return(_a0 []*structures.UserModel) *RepositoryList_GetListsOfUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryList_GetListsOfUser_Call) RunAndReturn(run func(context.Context, string) []*structures.UserModel) *RepositoryList_GetListsOfUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplate provides a mock function with given fields: ctx, templateId
+func (_m *RepositoryList) GetTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateModel, error) {
+	ret := _m.Called(ctx, templateId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplate")
+	}
+
+	var r0 *structures.TemplateModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.TemplateModel, error)); ok {
+		return rf(ctx, templateId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.TemplateModel); ok {
+		r0 = rf(ctx, templateId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.TemplateModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, templateId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_GetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplate'
+type RepositoryList_GetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateId uuid.UUID
+func (_e *RepositoryList_Expecter) GetTemplate(ctx interface{}, templateId interface{}) *RepositoryList_GetTemplate_Call {
+	return &RepositoryList_GetTemplate_Call{Call: _e.mock.On("GetTemplate", ctx, templateId)}
+}
+
+func (_c *RepositoryList_GetTemplate_Call) Run(run func(ctx context.Context, templateId uuid.UUID)) *RepositoryList_GetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_GetTemplate_Call) Return(_a0 *structures.TemplateModel, _a1 error) *RepositoryList_GetTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_GetTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.TemplateModel, error)) *RepositoryList_GetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplates provides a mock function with given fields: ctx, owner
+func (_m *RepositoryList) GetTemplates(ctx context.Context, owner string) ([]structures.TemplateModel, error) {
+	ret := _m.Called(ctx, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplates")
+	}
+
+	var r0 []structures.TemplateModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]structures.TemplateModel, error)); ok {
+		return rf(ctx, owner)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []structures.TemplateModel); ok {
+		r0 = rf(ctx, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.TemplateModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_GetTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplates'
+type RepositoryList_GetTemplates_Call struct {
+	*mock.Call
+}
+
+// GetTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+func (_e *RepositoryList_Expecter) GetTemplates(ctx interface{}, owner interface{}) *RepositoryList_GetTemplates_Call {
+	return &RepositoryList_GetTemplates_Call{Call: _e.mock.On("GetTemplates", ctx, owner)}
+}
+
+func (_c *RepositoryList_GetTemplates_Call) Run(run func(ctx context.Context, owner string)) *RepositoryList_GetTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_GetTemplates_Call) Return(_a0 []structures.TemplateModel, _a1 error) *RepositoryList_GetTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_GetTemplates_Call) RunAndReturn(run func(context.Context, string) ([]structures.TemplateModel, error)) *RepositoryList_GetTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserFromListById provides a mock function with given fields: ctx, listId, username
+func (_m *RepositoryList) GetUserFromListById(ctx context.Context, listId uuid.UUID, username string) (*structures.UserModel, error) {
+	ret := _m.Called(ctx, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserFromListById")
+	}
+
+	var r0 *structures.UserModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.UserModel, error)); ok {
+		return rf(ctx, listId, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.UserModel); ok {
+		r0 = rf(ctx, listId, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, listId, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_GetUserFromListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserFromListById'
+type RepositoryList_GetUserFromListById_Call struct {
+	*mock.Call
+}
+
+// GetUserFromListById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - username string
+func (_e *RepositoryList_Expecter) GetUserFromListById(ctx interface{}, listId interface{}, username interface{}) *RepositoryList_GetUserFromListById_Call {
+	return &RepositoryList_GetUserFromListById_Call{Call: _e.mock.On("GetUserFromListById", ctx, listId, username)}
+}
+
+func (_c *RepositoryList_GetUserFromListById_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *RepositoryList_GetUserFromListById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_GetUserFromListById_Call) Return(_a0 *structures.UserModel, _a1 error) *RepositoryList_GetUserFromListById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_GetUserFromListById_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.UserModel, error)) *RepositoryList_GetUserFromListById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserListRole provides a mock function with given fields: ctx, listId, username
+func (_m *RepositoryList) GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string {
+	ret := _m.Called(ctx, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserListRole")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
+		r0 = rf(ctx, listId, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// RepositoryList_GetUserListRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserListRole'
+type RepositoryList_GetUserListRole_Call struct {
+	*mock.Call
+}
+
+// GetUserListRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - username string
+func (_e *RepositoryList_Expecter) GetUserListRole(ctx interface{}, listId interface{}, username interface{}) *RepositoryList_GetUserListRole_Call {
+	return &RepositoryList_GetUserListRole_Call{Call: _e.mock.On("GetUserListRole", ctx, listId, username)}
+}
+
+func (_c *RepositoryList_GetUserListRole_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *RepositoryList_GetUserListRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_GetUserListRole_Call) Return(_a0 string) *RepositoryList_GetUserListRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryList_GetUserListRole_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) string) *RepositoryList_GetUserListRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkflow provides a mock function with given fields: ctx, listId
+func (_m *RepositoryList) GetWorkflow(ctx context.Context, listId uuid.UUID) (*structures.WorkflowModel, error) {
+	ret := _m.Called(ctx, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflow")
+	}
+
+	var r0 *structures.WorkflowModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.WorkflowModel, error)); ok {
+		return rf(ctx, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.WorkflowModel); ok {
+		r0 = rf(ctx, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.WorkflowModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_GetWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflow'
+type RepositoryList_GetWorkflow_Call struct {
+	*mock.Call
+}
+
+// GetWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+func (_e *RepositoryList_Expecter) GetWorkflow(ctx interface{}, listId interface{}) *RepositoryList_GetWorkflow_Call {
+	return &RepositoryList_GetWorkflow_Call{Call: _e.mock.On("GetWorkflow", ctx, listId)}
+}
+
+func (_c *RepositoryList_GetWorkflow_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryList_GetWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_GetWorkflow_Call) Return(_a0 *structures.WorkflowModel, _a1 error) *RepositoryList_GetWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_GetWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.WorkflowModel, error)) *RepositoryList_GetWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstantiateTemplate provides a mock function with given fields: ctx, templateId, entityList, entityUser, startDate
+func (_m *RepositoryList) InstantiateTemplate(ctx context.Context, templateId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, startDate time.Time) error {
+	ret := _m.Called(ctx, templateId, entityList, entityUser, startDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstantiateTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.ListEntity, structures.ListUserEntity, time.Time) error); ok {
+		r0 = rf(ctx, templateId, entityList, entityUser, startDate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryList_InstantiateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstantiateTemplate'
+type RepositoryList_InstantiateTemplate_Call struct {
+	*mock.Call
+}
+
+// InstantiateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateId uuid.UUID
+//   - entityList structures.ListEntity
+//   - entityUser structures.ListUserEntity
+//   - startDate time.Time
+func (_e *RepositoryList_Expecter) InstantiateTemplate(ctx interface{}, templateId interface{}, entityList interface{}, entityUser interface{}, startDate interface{}) *RepositoryList_InstantiateTemplate_Call {
+	return &RepositoryList_InstantiateTemplate_Call{Call: _e.mock.On("InstantiateTemplate", ctx, templateId, entityList, entityUser, startDate)}
+}
+
+func (_c *RepositoryList_InstantiateTemplate_Call) Run(run func(ctx context.Context, templateId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, startDate time.Time)) *RepositoryList_InstantiateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.ListEntity), args[3].(structures.ListUserEntity), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_InstantiateTemplate_Call) Return(_a0 error) *RepositoryList_InstantiateTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryList_InstantiateTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.ListEntity, structures.ListUserEntity, time.Time) error) *RepositoryList_InstantiateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserUserFromList provides a mock function with given fields: ctx, entityUser
+func (_m *RepositoryList) RemoveUserUserFromList(ctx context.Context, entityUser structures.ListUserEntity) (*structures.UserModel, error) {
+	ret := _m.Called(ctx, entityUser)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveUserUserFromList")
+	}
+
+	var r0 *structures.UserModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.ListUserEntity) (*structures.UserModel, error)); ok {
+		return rf(ctx, entityUser)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.ListUserEntity) *structures.UserModel); ok {
+		r0 = rf(ctx, entityUser)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.ListUserEntity) error); ok {
+		r1 = rf(ctx, entityUser)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_RemoveUserUserFromList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserUserFromList'
+type RepositoryList_RemoveUserUserFromList_Call struct {
+	*mock.Call
+}
+
+// RemoveUserUserFromList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityUser structures.ListUserEntity
+func (_e *RepositoryList_Expecter) RemoveUserUserFromList(ctx interface{}, entityUser interface{}) *RepositoryList_RemoveUserUserFromList_Call {
+	return &RepositoryList_RemoveUserUserFromList_Call{Call: _e.mock.On("RemoveUserUserFromList", ctx, entityUser)}
+}
+
+func (_c *RepositoryList_RemoveUserUserFromList_Call) Run(run func(ctx context.Context, entityUser structures.ListUserEntity)) *RepositoryList_RemoveUserUserFromList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.ListUserEntity))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_RemoveUserUserFromList_Call) Return(_a0 *structures.UserModel, _a1 error) *RepositoryList_RemoveUserUserFromList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_RemoveUserUserFromList_Call) RunAndReturn(run func(context.Context, structures.ListUserEntity) (*structures.UserModel, error)) *RepositoryList_RemoveUserUserFromList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLabel provides a mock function with given fields: ctx, entityLabel
+func (_m *RepositoryList) UpdateLabel(ctx context.Context, entityLabel structures.LabelEntity) (*structures.LabelModel, error) {
+	ret := _m.Called(ctx, entityLabel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLabel")
+	}
+
+	var r0 *structures.LabelModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.LabelEntity) (*structures.LabelModel, error)); ok {
+		return rf(ctx, entityLabel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.LabelEntity) *structures.LabelModel); ok {
+		r0 = rf(ctx, entityLabel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.LabelModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.LabelEntity) error); ok {
+		r1 = rf(ctx, entityLabel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_UpdateLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLabel'
+type RepositoryList_UpdateLabel_Call struct {
+	*mock.Call
+}
+
+// UpdateLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityLabel structures.LabelEntity
+func (_e *RepositoryList_Expecter) UpdateLabel(ctx interface{}, entityLabel interface{}) *RepositoryList_UpdateLabel_Call {
+	return &RepositoryList_UpdateLabel_Call{Call: _e.mock.On("UpdateLabel", ctx, entityLabel)}
+}
+
+func (_c *RepositoryList_UpdateLabel_Call) Run(run func(ctx context.Context, entityLabel structures.LabelEntity)) *RepositoryList_UpdateLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.LabelEntity))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_UpdateLabel_Call) Return(_a0 *structures.LabelModel, _a1 error) *RepositoryList_UpdateLabel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_UpdateLabel_Call) RunAndReturn(run func(context.Context, structures.LabelEntity) (*structures.LabelModel, error)) *RepositoryList_UpdateLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateList provides a mock function with given fields: ctx, listId, newListName
+func (_m *RepositoryList) UpdateList(ctx context.Context, listId uuid.UUID, newListName string) (*structures.ListModel, error) {
+	ret := _m.Called(ctx, listId, newListName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateList")
+	}
+
+	var r0 *structures.ListModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.ListModel, error)); ok {
+		return rf(ctx, listId, newListName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.ListModel); ok {
+		r0 = rf(ctx, listId, newListName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.ListModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, listId, newListName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_UpdateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateList'
+type RepositoryList_UpdateList_Call struct {
+	*mock.Call
+}
+
+// UpdateList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - newListName string
+func (_e *RepositoryList_Expecter) UpdateList(ctx interface{}, listId interface{}, newListName interface{}) *RepositoryList_UpdateList_Call {
+	return &RepositoryList_UpdateList_Call{Call: _e.mock.On("UpdateList", ctx, listId, newListName)}
+}
+
+func (_c *RepositoryList_UpdateList_Call) Run(run func(ctx context.Context, listId uuid.UUID, newListName string)) *RepositoryList_UpdateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_UpdateList_Call) Return(_a0 *structures.ListModel, _a1 error) *RepositoryList_UpdateList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_UpdateList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.ListModel, error)) *RepositoryList_UpdateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWorkflow provides a mock function with given fields: ctx, workflowEntity
+func (_m *RepositoryList) UpdateWorkflow(ctx context.Context, workflowEntity structures.WorkflowEntity) (*structures.WorkflowModel, error) {
+	ret := _m.Called(ctx, workflowEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorkflow")
+	}
+
+	var r0 *structures.WorkflowModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.WorkflowEntity) (*structures.WorkflowModel, error)); ok {
+		return rf(ctx, workflowEntity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.WorkflowEntity) *structures.WorkflowModel); ok {
+		r0 = rf(ctx, workflowEntity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.WorkflowModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.WorkflowEntity) error); ok {
+		r1 = rf(ctx, workflowEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryList_UpdateWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkflow'
+type RepositoryList_UpdateWorkflow_Call struct {
+	*mock.Call
+}
+
+// UpdateWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowEntity structures.WorkflowEntity
+func (_e *RepositoryList_Expecter) UpdateWorkflow(ctx interface{}, workflowEntity interface{}) *RepositoryList_UpdateWorkflow_Call {
+	return &RepositoryList_UpdateWorkflow_Call{Call: _e.mock.On("UpdateWorkflow", ctx, workflowEntity)}
+}
+
+func (_c *RepositoryList_UpdateWorkflow_Call) Run(run func(ctx context.Context, workflowEntity structures.WorkflowEntity)) *RepositoryList_UpdateWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.WorkflowEntity))
+	})
+	return _c
+}
+
+func (_c *RepositoryList_UpdateWorkflow_Call) Return(_a0 *structures.WorkflowModel, _a1 error) *RepositoryList_UpdateWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryList_UpdateWorkflow_Call) RunAndReturn(run func(context.Context, structures.WorkflowEntity) (*structures.WorkflowModel, error)) *RepositoryList_UpdateWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.51.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -25,17 +25,17 @@ func (_m *ServiceList) EXPECT() *ServiceList_Expecter {
 	return &ServiceList_Expecter{mock: &_m.Mock}
 }
 
-// AddUserToList provides a mock function with given fields: ctx, listId, username
-func (_m *ServiceList) AddUserToList(ctx context.Context, listId uuid.UUID, username string) error {
-	ret := _m.Called(ctx, listId, username)
+// AddUserToList provides a mock function with given fields: ctx, listId, username, role
+func (_m *ServiceList) AddUserToList(ctx context.Context, listId uuid.UUID, username string, role string) error {
+	ret := _m.Called(ctx, listId, username, role)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddUserToList")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
-		r0 = rf(ctx, listId, username)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = rf(ctx, listId, username, role)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -52,13 +52,14 @@ type ServiceList_AddUserToList_Call struct {
 //   - ctx context.Context
 //   - listId uuid.UUID
 //   - username string
-func (_e *ServiceList_Expecter) AddUserToList(ctx interface{}, listId interface{}, username interface{}) *ServiceList_AddUserToList_Call {
-	return &ServiceList_AddUserToList_Call{Call: _e.mock.On("AddUserToList", ctx, listId, username)}
+//   - role string
+func (_e *ServiceList_Expecter) AddUserToList(ctx interface{}, listId interface{}, username interface{}, role interface{}) *ServiceList_AddUserToList_Call {
+	return &ServiceList_AddUserToList_Call{Call: _e.mock.On("AddUserToList", ctx, listId, username, role)}
 }
 
-func (_c *ServiceList_AddUserToList_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ServiceList_AddUserToList_Call {
+func (_c *ServiceList_AddUserToList_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string, role string)) *ServiceList_AddUserToList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
 	})
 	return _c
 }
@@ -68,7 +69,7 @@ func (_c *ServiceList_AddUserToList_Call) Return(_a0 error) *ServiceList_AddUser
 	return _c
 }
 
-func (_c *ServiceList_AddUserToList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *ServiceList_AddUserToList_Call {
+func (_c *ServiceList_AddUserToList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) error) *ServiceList_AddUserToList_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -168,6 +169,66 @@ func (_c *ServiceList_ContainUserInList_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// CreateLabel provides a mock function with given fields: ctx, listId, input
+func (_m *ServiceList) CreateLabel(ctx context.Context, listId uuid.UUID, input structures.LabelInput) (*structures.LabelOutput, error) {
+	ret := _m.Called(ctx, listId, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLabel")
+	}
+
+	var r0 *structures.LabelOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.LabelInput) (*structures.LabelOutput, error)); ok {
+		return rf(ctx, listId, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.LabelInput) *structures.LabelOutput); ok {
+		r0 = rf(ctx, listId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.LabelOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.LabelInput) error); ok {
+		r1 = rf(ctx, listId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_CreateLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLabel'
+type ServiceList_CreateLabel_Call struct {
+	*mock.Call
+}
+
+// CreateLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - input structures.LabelInput
+func (_e *ServiceList_Expecter) CreateLabel(ctx interface{}, listId interface{}, input interface{}) *ServiceList_CreateLabel_Call {
+	return &ServiceList_CreateLabel_Call{Call: _e.mock.On("CreateLabel", ctx, listId, input)}
+}
+
+func (_c *ServiceList_CreateLabel_Call) Run(run func(ctx context.Context, listId uuid.UUID, input structures.LabelInput)) *ServiceList_CreateLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.LabelInput))
+	})
+	return _c
+}
+
+func (_c *ServiceList_CreateLabel_Call) Return(_a0 *structures.LabelOutput, _a1 error) *ServiceList_CreateLabel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_CreateLabel_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.LabelInput) (*structures.LabelOutput, error)) *ServiceList_CreateLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateList provides a mock function with given fields: ctx, listName, username
 func (_m *ServiceList) CreateList(ctx context.Context, listName string, username string) (*structures.ListOutput, error) {
 	ret := _m.Called(ctx, listName, username)
@@ -228,29 +289,29 @@ func (_c *ServiceList_CreateList_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
-// DeleteList provides a mock function with given fields: ctx, listId
-func (_m *ServiceList) DeleteList(ctx context.Context, listId uuid.UUID) (*structures.ListOutput, error) {
-	ret := _m.Called(ctx, listId)
+// CreateTemplate provides a mock function with given fields: ctx, listId, input, username
+func (_m *ServiceList) CreateTemplate(ctx context.Context, listId uuid.UUID, input structures.TemplateInput, username string) (*structures.TemplateOutput, error) {
+	ret := _m.Called(ctx, listId, input, username)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteList")
+		panic("no return value specified for CreateTemplate")
 	}
 
-	var r0 *structures.ListOutput
+	var r0 *structures.TemplateOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.ListOutput, error)); ok {
-		return rf(ctx, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TemplateInput, string) (*structures.TemplateOutput, error)); ok {
+		return rf(ctx, listId, input, username)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.ListOutput); ok {
-		r0 = rf(ctx, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TemplateInput, string) *structures.TemplateOutput); ok {
+		r0 = rf(ctx, listId, input, username)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*structures.ListOutput)
+			r0 = ret.Get(0).(*structures.TemplateOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, listId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.TemplateInput, string) error); ok {
+		r1 = rf(ctx, listId, input, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -258,89 +319,103 @@ func (_m *ServiceList) DeleteList(ctx context.Context, listId uuid.UUID) (*struc
 	return r0, r1
 }
 
-// ServiceList_DeleteList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteList'
-type ServiceList_DeleteList_Call struct {
+// ServiceList_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type ServiceList_CreateTemplate_Call struct {
 	*mock.Call
 }
 
-// DeleteList is a helper method to define mock.On call
+// CreateTemplate is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-func (_e *ServiceList_Expecter) DeleteList(ctx interface{}, listId interface{}) *ServiceList_DeleteList_Call {
-	return &ServiceList_DeleteList_Call{Call: _e.mock.On("DeleteList", ctx, listId)}
+//   - input structures.TemplateInput
+//   - username string
+func (_e *ServiceList_Expecter) CreateTemplate(ctx interface{}, listId interface{}, input interface{}, username interface{}) *ServiceList_CreateTemplate_Call {
+	return &ServiceList_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", ctx, listId, input, username)}
 }
 
-func (_c *ServiceList_DeleteList_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceList_DeleteList_Call {
+func (_c *ServiceList_CreateTemplate_Call) Run(run func(ctx context.Context, listId uuid.UUID, input structures.TemplateInput, username string)) *ServiceList_CreateTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.TemplateInput), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *ServiceList_DeleteList_Call) Return(_a0 *structures.ListOutput, _a1 error) *ServiceList_DeleteList_Call {
+func (_c *ServiceList_CreateTemplate_Call) Return(_a0 *structures.TemplateOutput, _a1 error) *ServiceList_CreateTemplate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ServiceList_DeleteList_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListOutput, error)) *ServiceList_DeleteList_Call {
+func (_c *ServiceList_CreateTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.TemplateInput, string) (*structures.TemplateOutput, error)) *ServiceList_CreateTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllLists provides a mock function with given fields: ctx
-func (_m *ServiceList) GetAllLists(ctx context.Context) []*structures.ListOutput {
-	ret := _m.Called(ctx)
+// DeleteLabel provides a mock function with given fields: ctx, labelId, listId
+func (_m *ServiceList) DeleteLabel(ctx context.Context, labelId uuid.UUID, listId uuid.UUID) (*structures.LabelOutput, error) {
+	ret := _m.Called(ctx, labelId, listId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllLists")
+		panic("no return value specified for DeleteLabel")
 	}
 
-	var r0 []*structures.ListOutput
-	if rf, ok := ret.Get(0).(func(context.Context) []*structures.ListOutput); ok {
-		r0 = rf(ctx)
+	var r0 *structures.LabelOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*structures.LabelOutput, error)); ok {
+		return rf(ctx, labelId, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *structures.LabelOutput); ok {
+		r0 = rf(ctx, labelId, listId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*structures.ListOutput)
+			r0 = ret.Get(0).(*structures.LabelOutput)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, labelId, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ServiceList_GetAllLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllLists'
-type ServiceList_GetAllLists_Call struct {
+// ServiceList_DeleteLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLabel'
+type ServiceList_DeleteLabel_Call struct {
 	*mock.Call
 }
 
-// GetAllLists is a helper method to define mock.On call
+// DeleteLabel is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *ServiceList_Expecter) GetAllLists(ctx interface{}) *ServiceList_GetAllLists_Call {
-	return &ServiceList_GetAllLists_Call{Call: _e.mock.On("GetAllLists", ctx)}
+//   - labelId uuid.UUID
+//   - listId uuid.UUID
+func (_e *ServiceList_Expecter) DeleteLabel(ctx interface{}, labelId interface{}, listId interface{}) *ServiceList_DeleteLabel_Call {
+	return &ServiceList_DeleteLabel_Call{Call: _e.mock.On("DeleteLabel", ctx, labelId, listId)}
 }
 
-func (_c *ServiceList_GetAllLists_Call) Run(run func(ctx context.Context)) *ServiceList_GetAllLists_Call {
+func (_c *ServiceList_DeleteLabel_Call) Run(run func(ctx context.Context, labelId uuid.UUID, listId uuid.UUID)) *ServiceList_DeleteLabel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *ServiceList_GetAllLists_Call) Return(_a0 []*structures.ListOutput) *ServiceList_GetAllLists_Call {
-	_c.Call.Return(_a0)
+func (_c *ServiceList_DeleteLabel_Call) Return(_a0 *structures.LabelOutput, _a1 error) *ServiceList_DeleteLabel_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ServiceList_GetAllLists_Call) RunAndReturn(run func(context.Context) []*structures.ListOutput) *ServiceList_GetAllLists_Call {
+func (_c *ServiceList_DeleteLabel_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*structures.LabelOutput, error)) *ServiceList_DeleteLabel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListById provides a mock function with given fields: ctx, listId
-func (_m *ServiceList) GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error) {
+// DeleteList provides a mock function with given fields: ctx, listId
+func (_m *ServiceList) DeleteList(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error) {
 	ret := _m.Called(ctx, listId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListById")
+		panic("no return value specified for DeleteList")
 	}
 
 	var r0 *structures.ListUserOutput
@@ -365,58 +440,58 @@ func (_m *ServiceList) GetListById(ctx context.Context, listId uuid.UUID) (*stru
 	return r0, r1
 }
 
-// ServiceList_GetListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListById'
-type ServiceList_GetListById_Call struct {
+// ServiceList_DeleteList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteList'
+type ServiceList_DeleteList_Call struct {
 	*mock.Call
 }
 
-// GetListById is a helper method to define mock.On call
+// DeleteList is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-func (_e *ServiceList_Expecter) GetListById(ctx interface{}, listId interface{}) *ServiceList_GetListById_Call {
-	return &ServiceList_GetListById_Call{Call: _e.mock.On("GetListById", ctx, listId)}
+func (_e *ServiceList_Expecter) DeleteList(ctx interface{}, listId interface{}) *ServiceList_DeleteList_Call {
+	return &ServiceList_DeleteList_Call{Call: _e.mock.On("DeleteList", ctx, listId)}
 }
 
-func (_c *ServiceList_GetListById_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceList_GetListById_Call {
+func (_c *ServiceList_DeleteList_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceList_DeleteList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *ServiceList_GetListById_Call) Return(_a0 *structures.ListUserOutput, _a1 error) *ServiceList_GetListById_Call {
+func (_c *ServiceList_DeleteList_Call) Return(_a0 *structures.ListUserOutput, _a1 error) *ServiceList_DeleteList_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ServiceList_GetListById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListUserOutput, error)) *ServiceList_GetListById_Call {
+func (_c *ServiceList_DeleteList_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListUserOutput, error)) *ServiceList_DeleteList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserFromListById provides a mock function with given fields: ctx, listId, username
-func (_m *ServiceList) GetUserFromListById(ctx context.Context, listId uuid.UUID, username string) (*structures.UserOutput, error) {
-	ret := _m.Called(ctx, listId, username)
+// DeleteTemplate provides a mock function with given fields: ctx, templateId, username, isAdmin
+func (_m *ServiceList) DeleteTemplate(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool) (*structures.TemplateOutput, error) {
+	ret := _m.Called(ctx, templateId, username, isAdmin)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserFromListById")
+		panic("no return value specified for DeleteTemplate")
 	}
 
-	var r0 *structures.UserOutput
+	var r0 *structures.TemplateOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.UserOutput, error)); ok {
-		return rf(ctx, listId, username)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, bool) (*structures.TemplateOutput, error)); ok {
+		return rf(ctx, templateId, username, isAdmin)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.UserOutput); ok {
-		r0 = rf(ctx, listId, username)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, bool) *structures.TemplateOutput); ok {
+		r0 = rf(ctx, templateId, username, isAdmin)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*structures.UserOutput)
+			r0 = ret.Get(0).(*structures.TemplateOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, listId, username)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, bool) error); ok {
+		r1 = rf(ctx, templateId, username, isAdmin)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -424,59 +499,60 @@ func (_m *ServiceList) GetUserFromListById(ctx context.Context, listId uuid.UUID
 	return r0, r1
 }
 
-// ServiceList_GetUserFromListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserFromListById'
-type ServiceList_GetUserFromListById_Call struct {
+// ServiceList_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type ServiceList_DeleteTemplate_Call struct {
 	*mock.Call
 }
 
-// GetUserFromListById is a helper method to define mock.On call
+// DeleteTemplate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - listId uuid.UUID
+//   - templateId uuid.UUID
 //   - username string
-func (_e *ServiceList_Expecter) GetUserFromListById(ctx interface{}, listId interface{}, username interface{}) *ServiceList_GetUserFromListById_Call {
-	return &ServiceList_GetUserFromListById_Call{Call: _e.mock.On("GetUserFromListById", ctx, listId, username)}
+//   - isAdmin bool
+func (_e *ServiceList_Expecter) DeleteTemplate(ctx interface{}, templateId interface{}, username interface{}, isAdmin interface{}) *ServiceList_DeleteTemplate_Call {
+	return &ServiceList_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, templateId, username, isAdmin)}
 }
 
-func (_c *ServiceList_GetUserFromListById_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ServiceList_GetUserFromListById_Call {
+func (_c *ServiceList_DeleteTemplate_Call) Run(run func(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool)) *ServiceList_DeleteTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(bool))
 	})
 	return _c
 }
 
-func (_c *ServiceList_GetUserFromListById_Call) Return(_a0 *structures.UserOutput, _a1 error) *ServiceList_GetUserFromListById_Call {
+func (_c *ServiceList_DeleteTemplate_Call) Return(_a0 *structures.TemplateOutput, _a1 error) *ServiceList_DeleteTemplate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ServiceList_GetUserFromListById_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.UserOutput, error)) *ServiceList_GetUserFromListById_Call {
+func (_c *ServiceList_DeleteTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, bool) (*structures.TemplateOutput, error)) *ServiceList_DeleteTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUsersFromListById provides a mock function with given fields: ctx, listId
-func (_m *ServiceList) GetUsersFromListById(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error) {
-	ret := _m.Called(ctx, listId)
+// DuplicateList provides a mock function with given fields: ctx, listId, input, username
+func (_m *ServiceList) DuplicateList(ctx context.Context, listId uuid.UUID, input structures.ListDuplicateInput, username string) (*structures.ListUserOutput, error) {
+	ret := _m.Called(ctx, listId, input, username)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUsersFromListById")
+		panic("no return value specified for DuplicateList")
 	}
 
 	var r0 *structures.ListUserOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.ListUserOutput, error)); ok {
-		return rf(ctx, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.ListDuplicateInput, string) (*structures.ListUserOutput, error)); ok {
+		return rf(ctx, listId, input, username)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.ListUserOutput); ok {
-		r0 = rf(ctx, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.ListDuplicateInput, string) *structures.ListUserOutput); ok {
+		r0 = rf(ctx, listId, input, username)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*structures.ListUserOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, listId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.ListDuplicateInput, string) error); ok {
+		r1 = rf(ctx, listId, input, username)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -484,58 +560,60 @@ func (_m *ServiceList) GetUsersFromListById(ctx context.Context, listId uuid.UUI
 	return r0, r1
 }
 
-// ServiceList_GetUsersFromListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersFromListById'
-type ServiceList_GetUsersFromListById_Call struct {
+// ServiceList_DuplicateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateList'
+type ServiceList_DuplicateList_Call struct {
 	*mock.Call
 }
 
-// GetUsersFromListById is a helper method to define mock.On call
+// DuplicateList is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-func (_e *ServiceList_Expecter) GetUsersFromListById(ctx interface{}, listId interface{}) *ServiceList_GetUsersFromListById_Call {
-	return &ServiceList_GetUsersFromListById_Call{Call: _e.mock.On("GetUsersFromListById", ctx, listId)}
+//   - input structures.ListDuplicateInput
+//   - username string
+func (_e *ServiceList_Expecter) DuplicateList(ctx interface{}, listId interface{}, input interface{}, username interface{}) *ServiceList_DuplicateList_Call {
+	return &ServiceList_DuplicateList_Call{Call: _e.mock.On("DuplicateList", ctx, listId, input, username)}
 }
 
-func (_c *ServiceList_GetUsersFromListById_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceList_GetUsersFromListById_Call {
+func (_c *ServiceList_DuplicateList_Call) Run(run func(ctx context.Context, listId uuid.UUID, input structures.ListDuplicateInput, username string)) *ServiceList_DuplicateList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.ListDuplicateInput), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *ServiceList_GetUsersFromListById_Call) Return(_a0 *structures.ListUserOutput, _a1 error) *ServiceList_GetUsersFromListById_Call {
+func (_c *ServiceList_DuplicateList_Call) Return(_a0 *structures.ListUserOutput, _a1 error) *ServiceList_DuplicateList_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ServiceList_GetUsersFromListById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListUserOutput, error)) *ServiceList_GetUsersFromListById_Call {
+func (_c *ServiceList_DuplicateList_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.ListDuplicateInput, string) (*structures.ListUserOutput, error)) *ServiceList_DuplicateList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveUserFromList provides a mock function with given fields: ctx, listId, username
-func (_m *ServiceList) RemoveUserFromList(ctx context.Context, listId uuid.UUID, username string) (*structures.UserOutput, error) {
-	ret := _m.Called(ctx, listId, username)
+// GetAllLists provides a mock function with given fields: ctx, page
+func (_m *ServiceList) GetAllLists(ctx context.Context, page structures.PageQuery) (*structures.ListPageOutput, error) {
+	ret := _m.Called(ctx, page)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveUserFromList")
+		panic("no return value specified for GetAllLists")
 	}
 
-	var r0 *structures.UserOutput
+	var r0 *structures.ListPageOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.UserOutput, error)); ok {
-		return rf(ctx, listId, username)
+	if rf, ok := ret.Get(0).(func(context.Context, structures.PageQuery) (*structures.ListPageOutput, error)); ok {
+		return rf(ctx, page)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.UserOutput); ok {
-		r0 = rf(ctx, listId, username)
+	if rf, ok := ret.Get(0).(func(context.Context, structures.PageQuery) *structures.ListPageOutput); ok {
+		r0 = rf(ctx, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*structures.UserOutput)
+			r0 = ret.Get(0).(*structures.ListPageOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, listId, username)
+	if rf, ok := ret.Get(1).(func(context.Context, structures.PageQuery) error); ok {
+		r1 = rf(ctx, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -543,59 +621,117 @@ func (_m *ServiceList) RemoveUserFromList(ctx context.Context, listId uuid.UUID,
 	return r0, r1
 }
 
-// ServiceList_RemoveUserFromList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromList'
-type ServiceList_RemoveUserFromList_Call struct {
+// ServiceList_GetAllLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllLists'
+type ServiceList_GetAllLists_Call struct {
 	*mock.Call
 }
 
-// RemoveUserFromList is a helper method to define mock.On call
+// GetAllLists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page structures.PageQuery
+func (_e *ServiceList_Expecter) GetAllLists(ctx interface{}, page interface{}) *ServiceList_GetAllLists_Call {
+	return &ServiceList_GetAllLists_Call{Call: _e.mock.On("GetAllLists", ctx, page)}
+}
+
+func (_c *ServiceList_GetAllLists_Call) Run(run func(ctx context.Context, page structures.PageQuery)) *ServiceList_GetAllLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.PageQuery))
+	})
+	return _c
+}
+
+func (_c *ServiceList_GetAllLists_Call) Return(_a0 *structures.ListPageOutput, _a1 error) *ServiceList_GetAllLists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_GetAllLists_Call) RunAndReturn(run func(context.Context, structures.PageQuery) (*structures.ListPageOutput, error)) *ServiceList_GetAllLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLabels provides a mock function with given fields: ctx, listId
+func (_m *ServiceList) GetLabels(ctx context.Context, listId uuid.UUID) ([]structures.LabelOutput, error) {
+	ret := _m.Called(ctx, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLabels")
+	}
+
+	var r0 []structures.LabelOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]structures.LabelOutput, error)); ok {
+		return rf(ctx, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []structures.LabelOutput); ok {
+		r0 = rf(ctx, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.LabelOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_GetLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLabels'
+type ServiceList_GetLabels_Call struct {
+	*mock.Call
+}
+
+// GetLabels is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-//   - username string
-func (_e *ServiceList_Expecter) RemoveUserFromList(ctx interface{}, listId interface{}, username interface{}) *ServiceList_RemoveUserFromList_Call {
-	return &ServiceList_RemoveUserFromList_Call{Call: _e.mock.On("RemoveUserFromList", ctx, listId, username)}
+func (_e *ServiceList_Expecter) GetLabels(ctx interface{}, listId interface{}) *ServiceList_GetLabels_Call {
+	return &ServiceList_GetLabels_Call{Call: _e.mock.On("GetLabels", ctx, listId)}
 }
 
-func (_c *ServiceList_RemoveUserFromList_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ServiceList_RemoveUserFromList_Call {
+func (_c *ServiceList_GetLabels_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceList_GetLabels_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *ServiceList_RemoveUserFromList_Call) Return(_a0 *structures.UserOutput, _a1 error) *ServiceList_RemoveUserFromList_Call {
+func (_c *ServiceList_GetLabels_Call) Return(_a0 []structures.LabelOutput, _a1 error) *ServiceList_GetLabels_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ServiceList_RemoveUserFromList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.UserOutput, error)) *ServiceList_RemoveUserFromList_Call {
+func (_c *ServiceList_GetLabels_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]structures.LabelOutput, error)) *ServiceList_GetLabels_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateList provides a mock function with given fields: ctx, listId, newListName
-func (_m *ServiceList) UpdateList(ctx context.Context, listId uuid.UUID, newListName string) (*structures.ListOutput, error) {
-	ret := _m.Called(ctx, listId, newListName)
+// GetListById provides a mock function with given fields: ctx, listId
+func (_m *ServiceList) GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error) {
+	ret := _m.Called(ctx, listId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateList")
+		panic("no return value specified for GetListById")
 	}
 
-	var r0 *structures.ListOutput
+	var r0 *structures.ListUserOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.ListOutput, error)); ok {
-		return rf(ctx, listId, newListName)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.ListUserOutput, error)); ok {
+		return rf(ctx, listId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.ListOutput); ok {
-		r0 = rf(ctx, listId, newListName)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.ListUserOutput); ok {
+		r0 = rf(ctx, listId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*structures.ListOutput)
+			r0 = ret.Get(0).(*structures.ListUserOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, listId, newListName)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, listId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -603,32 +739,730 @@ func (_m *ServiceList) UpdateList(ctx context.Context, listId uuid.UUID, newList
 	return r0, r1
 }
 
-// ServiceList_UpdateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateList'
-type ServiceList_UpdateList_Call struct {
+// ServiceList_GetListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListById'
+type ServiceList_GetListById_Call struct {
 	*mock.Call
 }
 
-// UpdateList is a helper method to define mock.On call
+// GetListById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-//   - newListName string
-func (_e *ServiceList_Expecter) UpdateList(ctx interface{}, listId interface{}, newListName interface{}) *ServiceList_UpdateList_Call {
-	return &ServiceList_UpdateList_Call{Call: _e.mock.On("UpdateList", ctx, listId, newListName)}
+func (_e *ServiceList_Expecter) GetListById(ctx interface{}, listId interface{}) *ServiceList_GetListById_Call {
+	return &ServiceList_GetListById_Call{Call: _e.mock.On("GetListById", ctx, listId)}
 }
 
-func (_c *ServiceList_UpdateList_Call) Run(run func(ctx context.Context, listId uuid.UUID, newListName string)) *ServiceList_UpdateList_Call {
+func (_c *ServiceList_GetListById_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceList_GetListById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *ServiceList_UpdateList_Call) Return(_a0 *structures.ListOutput, _a1 error) *ServiceList_UpdateList_Call {
+func (_c *ServiceList_GetListById_Call) Return(_a0 *structures.ListUserOutput, _a1 error) *ServiceList_GetListById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ServiceList_UpdateList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.ListOutput, error)) *ServiceList_UpdateList_Call {
+func (_c *ServiceList_GetListById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListUserOutput, error)) *ServiceList_GetListById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListsOfUser provides a mock function with given fields: ctx, username
+func (_m *ServiceList) GetListsOfUser(ctx context.Context, username string) []*structures.UserOutput {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListsOfUser")
+	}
+
+	var r0 []*structures.UserOutput
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*structures.UserOutput); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*structures.UserOutput)
+		}
+	}
+
+	return r0
+}
+
+// ServiceList_GetListsOfUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListsOfUser'
+type ServiceList_GetListsOfUser_Call struct {
+	*mock.Call
+}
+
+// GetListsOfUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *ServiceList_Expecter) GetListsOfUser(ctx interface{}, username interface{}) *ServiceList_GetListsOfUser_Call {
+	return &ServiceList_GetListsOfUser_Call{Call: _e.mock.On("GetListsOfUser", ctx, username)}
+}
+
+func (_c *ServiceList_GetListsOfUser_Call) Run(run func(ctx context.Context, username string)) *ServiceList_GetListsOfUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceList_GetListsOfUser_Call) Return(_a0 []*structures.UserOutput) *ServiceList_GetListsOfUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceList_GetListsOfUser_Call) RunAndReturn(run func(context.Context, string) []*structures.UserOutput) *ServiceList_GetListsOfUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplate provides a mock function with given fields: ctx, templateId, username, isAdmin
+func (_m *ServiceList) GetTemplate(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool) (*structures.TemplateOutput, error) {
+	ret := _m.Called(ctx, templateId, username, isAdmin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplate")
+	}
+
+	var r0 *structures.TemplateOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, bool) (*structures.TemplateOutput, error)); ok {
+		return rf(ctx, templateId, username, isAdmin)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, bool) *structures.TemplateOutput); ok {
+		r0 = rf(ctx, templateId, username, isAdmin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.TemplateOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, bool) error); ok {
+		r1 = rf(ctx, templateId, username, isAdmin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_GetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplate'
+type ServiceList_GetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateId uuid.UUID
+//   - username string
+//   - isAdmin bool
+func (_e *ServiceList_Expecter) GetTemplate(ctx interface{}, templateId interface{}, username interface{}, isAdmin interface{}) *ServiceList_GetTemplate_Call {
+	return &ServiceList_GetTemplate_Call{Call: _e.mock.On("GetTemplate", ctx, templateId, username, isAdmin)}
+}
+
+func (_c *ServiceList_GetTemplate_Call) Run(run func(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool)) *ServiceList_GetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ServiceList_GetTemplate_Call) Return(_a0 *structures.TemplateOutput, _a1 error) *ServiceList_GetTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_GetTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, bool) (*structures.TemplateOutput, error)) *ServiceList_GetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplates provides a mock function with given fields: ctx, username, isAdmin
+func (_m *ServiceList) GetTemplates(ctx context.Context, username string, isAdmin bool) ([]structures.TemplateOutput, error) {
+	ret := _m.Called(ctx, username, isAdmin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplates")
+	}
+
+	var r0 []structures.TemplateOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]structures.TemplateOutput, error)); ok {
+		return rf(ctx, username, isAdmin)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []structures.TemplateOutput); ok {
+		r0 = rf(ctx, username, isAdmin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.TemplateOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, username, isAdmin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_GetTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplates'
+type ServiceList_GetTemplates_Call struct {
+	*mock.Call
+}
+
+// GetTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - isAdmin bool
+func (_e *ServiceList_Expecter) GetTemplates(ctx interface{}, username interface{}, isAdmin interface{}) *ServiceList_GetTemplates_Call {
+	return &ServiceList_GetTemplates_Call{Call: _e.mock.On("GetTemplates", ctx, username, isAdmin)}
+}
+
+func (_c *ServiceList_GetTemplates_Call) Run(run func(ctx context.Context, username string, isAdmin bool)) *ServiceList_GetTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ServiceList_GetTemplates_Call) Return(_a0 []structures.TemplateOutput, _a1 error) *ServiceList_GetTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_GetTemplates_Call) RunAndReturn(run func(context.Context, string, bool) ([]structures.TemplateOutput, error)) *ServiceList_GetTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserFromListById provides a mock function with given fields: ctx, listId, username
+func (_m *ServiceList) GetUserFromListById(ctx context.Context, listId uuid.UUID, username string) (*structures.UserOutput, error) {
+	ret := _m.Called(ctx, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserFromListById")
+	}
+
+	var r0 *structures.UserOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.UserOutput, error)); ok {
+		return rf(ctx, listId, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.UserOutput); ok {
+		r0 = rf(ctx, listId, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, listId, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_GetUserFromListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserFromListById'
+type ServiceList_GetUserFromListById_Call struct {
+	*mock.Call
+}
+
+// GetUserFromListById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - username string
+func (_e *ServiceList_Expecter) GetUserFromListById(ctx interface{}, listId interface{}, username interface{}) *ServiceList_GetUserFromListById_Call {
+	return &ServiceList_GetUserFromListById_Call{Call: _e.mock.On("GetUserFromListById", ctx, listId, username)}
+}
+
+func (_c *ServiceList_GetUserFromListById_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ServiceList_GetUserFromListById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceList_GetUserFromListById_Call) Return(_a0 *structures.UserOutput, _a1 error) *ServiceList_GetUserFromListById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_GetUserFromListById_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.UserOutput, error)) *ServiceList_GetUserFromListById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserListRole provides a mock function with given fields: ctx, listId, username
+func (_m *ServiceList) GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string {
+	ret := _m.Called(ctx, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserListRole")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
+		r0 = rf(ctx, listId, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ServiceList_GetUserListRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserListRole'
+type ServiceList_GetUserListRole_Call struct {
+	*mock.Call
+}
+
+// GetUserListRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - username string
+func (_e *ServiceList_Expecter) GetUserListRole(ctx interface{}, listId interface{}, username interface{}) *ServiceList_GetUserListRole_Call {
+	return &ServiceList_GetUserListRole_Call{Call: _e.mock.On("GetUserListRole", ctx, listId, username)}
+}
+
+func (_c *ServiceList_GetUserListRole_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ServiceList_GetUserListRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceList_GetUserListRole_Call) Return(_a0 string) *ServiceList_GetUserListRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceList_GetUserListRole_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) string) *ServiceList_GetUserListRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersFromListById provides a mock function with given fields: ctx, listId
+func (_m *ServiceList) GetUsersFromListById(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error) {
+	ret := _m.Called(ctx, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersFromListById")
+	}
+
+	var r0 *structures.ListUserOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.ListUserOutput, error)); ok {
+		return rf(ctx, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.ListUserOutput); ok {
+		r0 = rf(ctx, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.ListUserOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_GetUsersFromListById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersFromListById'
+type ServiceList_GetUsersFromListById_Call struct {
+	*mock.Call
+}
+
+// GetUsersFromListById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+func (_e *ServiceList_Expecter) GetUsersFromListById(ctx interface{}, listId interface{}) *ServiceList_GetUsersFromListById_Call {
+	return &ServiceList_GetUsersFromListById_Call{Call: _e.mock.On("GetUsersFromListById", ctx, listId)}
+}
+
+func (_c *ServiceList_GetUsersFromListById_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceList_GetUsersFromListById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceList_GetUsersFromListById_Call) Return(_a0 *structures.ListUserOutput, _a1 error) *ServiceList_GetUsersFromListById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_GetUsersFromListById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.ListUserOutput, error)) *ServiceList_GetUsersFromListById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkflow provides a mock function with given fields: ctx, listId
+func (_m *ServiceList) GetWorkflow(ctx context.Context, listId uuid.UUID) (*structures.WorkflowOutput, error) {
+	ret := _m.Called(ctx, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflow")
+	}
+
+	var r0 *structures.WorkflowOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*structures.WorkflowOutput, error)); ok {
+		return rf(ctx, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *structures.WorkflowOutput); ok {
+		r0 = rf(ctx, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.WorkflowOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_GetWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflow'
+type ServiceList_GetWorkflow_Call struct {
+	*mock.Call
+}
+
+// GetWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+func (_e *ServiceList_Expecter) GetWorkflow(ctx interface{}, listId interface{}) *ServiceList_GetWorkflow_Call {
+	return &ServiceList_GetWorkflow_Call{Call: _e.mock.On("GetWorkflow", ctx, listId)}
+}
+
+func (_c *ServiceList_GetWorkflow_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceList_GetWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceList_GetWorkflow_Call) Return(_a0 *structures.WorkflowOutput, _a1 error) *ServiceList_GetWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_GetWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*structures.WorkflowOutput, error)) *ServiceList_GetWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstantiateTemplate provides a mock function with given fields: ctx, templateId, input, username, isAdmin
+func (_m *ServiceList) InstantiateTemplate(ctx context.Context, templateId uuid.UUID, input structures.TemplateInstanceInput, username string, isAdmin bool) (*structures.ListOutput, error) {
+	ret := _m.Called(ctx, templateId, input, username, isAdmin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstantiateTemplate")
+	}
+
+	var r0 *structures.ListOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TemplateInstanceInput, string, bool) (*structures.ListOutput, error)); ok {
+		return rf(ctx, templateId, input, username, isAdmin)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TemplateInstanceInput, string, bool) *structures.ListOutput); ok {
+		r0 = rf(ctx, templateId, input, username, isAdmin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.ListOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.TemplateInstanceInput, string, bool) error); ok {
+		r1 = rf(ctx, templateId, input, username, isAdmin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_InstantiateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstantiateTemplate'
+type ServiceList_InstantiateTemplate_Call struct {
+	*mock.Call
+}
+
+// InstantiateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateId uuid.UUID
+//   - input structures.TemplateInstanceInput
+//   - username string
+//   - isAdmin bool
+func (_e *ServiceList_Expecter) InstantiateTemplate(ctx interface{}, templateId interface{}, input interface{}, username interface{}, isAdmin interface{}) *ServiceList_InstantiateTemplate_Call {
+	return &ServiceList_InstantiateTemplate_Call{Call: _e.mock.On("InstantiateTemplate", ctx, templateId, input, username, isAdmin)}
+}
+
+func (_c *ServiceList_InstantiateTemplate_Call) Run(run func(ctx context.Context, templateId uuid.UUID, input structures.TemplateInstanceInput, username string, isAdmin bool)) *ServiceList_InstantiateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.TemplateInstanceInput), args[3].(string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *ServiceList_InstantiateTemplate_Call) Return(_a0 *structures.ListOutput, _a1 error) *ServiceList_InstantiateTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_InstantiateTemplate_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.TemplateInstanceInput, string, bool) (*structures.ListOutput, error)) *ServiceList_InstantiateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserFromList provides a mock function with given fields: ctx, listId, username
+func (_m *ServiceList) RemoveUserFromList(ctx context.Context, listId uuid.UUID, username string) (*structures.UserOutput, error) {
+	ret := _m.Called(ctx, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveUserFromList")
+	}
+
+	var r0 *structures.UserOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.UserOutput, error)); ok {
+		return rf(ctx, listId, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.UserOutput); ok {
+		r0 = rf(ctx, listId, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, listId, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_RemoveUserFromList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromList'
+type ServiceList_RemoveUserFromList_Call struct {
+	*mock.Call
+}
+
+// RemoveUserFromList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - username string
+func (_e *ServiceList_Expecter) RemoveUserFromList(ctx interface{}, listId interface{}, username interface{}) *ServiceList_RemoveUserFromList_Call {
+	return &ServiceList_RemoveUserFromList_Call{Call: _e.mock.On("RemoveUserFromList", ctx, listId, username)}
+}
+
+func (_c *ServiceList_RemoveUserFromList_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ServiceList_RemoveUserFromList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceList_RemoveUserFromList_Call) Return(_a0 *structures.UserOutput, _a1 error) *ServiceList_RemoveUserFromList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_RemoveUserFromList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.UserOutput, error)) *ServiceList_RemoveUserFromList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLabel provides a mock function with given fields: ctx, labelId, listId, input
+func (_m *ServiceList) UpdateLabel(ctx context.Context, labelId uuid.UUID, listId uuid.UUID, input structures.LabelInput) (*structures.LabelOutput, error) {
+	ret := _m.Called(ctx, labelId, listId, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLabel")
+	}
+
+	var r0 *structures.LabelOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, structures.LabelInput) (*structures.LabelOutput, error)); ok {
+		return rf(ctx, labelId, listId, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, structures.LabelInput) *structures.LabelOutput); ok {
+		r0 = rf(ctx, labelId, listId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.LabelOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, structures.LabelInput) error); ok {
+		r1 = rf(ctx, labelId, listId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_UpdateLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLabel'
+type ServiceList_UpdateLabel_Call struct {
+	*mock.Call
+}
+
+// UpdateLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - labelId uuid.UUID
+//   - listId uuid.UUID
+//   - input structures.LabelInput
+func (_e *ServiceList_Expecter) UpdateLabel(ctx interface{}, labelId interface{}, listId interface{}, input interface{}) *ServiceList_UpdateLabel_Call {
+	return &ServiceList_UpdateLabel_Call{Call: _e.mock.On("UpdateLabel", ctx, labelId, listId, input)}
+}
+
+func (_c *ServiceList_UpdateLabel_Call) Run(run func(ctx context.Context, labelId uuid.UUID, listId uuid.UUID, input structures.LabelInput)) *ServiceList_UpdateLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(structures.LabelInput))
+	})
+	return _c
+}
+
+func (_c *ServiceList_UpdateLabel_Call) Return(_a0 *structures.LabelOutput, _a1 error) *ServiceList_UpdateLabel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_UpdateLabel_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, structures.LabelInput) (*structures.LabelOutput, error)) *ServiceList_UpdateLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateList provides a mock function with given fields: ctx, listId, newListName
+func (_m *ServiceList) UpdateList(ctx context.Context, listId uuid.UUID, newListName string) (*structures.ListOutput, error) {
+	ret := _m.Called(ctx, listId, newListName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateList")
+	}
+
+	var r0 *structures.ListOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*structures.ListOutput, error)); ok {
+		return rf(ctx, listId, newListName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *structures.ListOutput); ok {
+		r0 = rf(ctx, listId, newListName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.ListOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, listId, newListName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_UpdateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateList'
+type ServiceList_UpdateList_Call struct {
+	*mock.Call
+}
+
+// UpdateList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - newListName string
+func (_e *ServiceList_Expecter) UpdateList(ctx interface{}, listId interface{}, newListName interface{}) *ServiceList_UpdateList_Call {
+	return &ServiceList_UpdateList_Call{Call: _e.mock.On("UpdateList", ctx, listId, newListName)}
+}
+
+func (_c *ServiceList_UpdateList_Call) Run(run func(ctx context.Context, listId uuid.UUID, newListName string)) *ServiceList_UpdateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceList_UpdateList_Call) Return(_a0 *structures.ListOutput, _a1 error) *ServiceList_UpdateList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_UpdateList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*structures.ListOutput, error)) *ServiceList_UpdateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWorkflow provides a mock function with given fields: ctx, listId, input
+func (_m *ServiceList) UpdateWorkflow(ctx context.Context, listId uuid.UUID, input structures.WorkflowInput) (*structures.WorkflowOutput, error) {
+	ret := _m.Called(ctx, listId, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWorkflow")
+	}
+
+	var r0 *structures.WorkflowOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.WorkflowInput) (*structures.WorkflowOutput, error)); ok {
+		return rf(ctx, listId, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.WorkflowInput) *structures.WorkflowOutput); ok {
+		r0 = rf(ctx, listId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.WorkflowOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.WorkflowInput) error); ok {
+		r1 = rf(ctx, listId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceList_UpdateWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkflow'
+type ServiceList_UpdateWorkflow_Call struct {
+	*mock.Call
+}
+
+// UpdateWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - input structures.WorkflowInput
+func (_e *ServiceList_Expecter) UpdateWorkflow(ctx interface{}, listId interface{}, input interface{}) *ServiceList_UpdateWorkflow_Call {
+	return &ServiceList_UpdateWorkflow_Call{Call: _e.mock.On("UpdateWorkflow", ctx, listId, input)}
+}
+
+func (_c *ServiceList_UpdateWorkflow_Call) Run(run func(ctx context.Context, listId uuid.UUID, input structures.WorkflowInput)) *ServiceList_UpdateWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.WorkflowInput))
+	})
+	return _c
+}
+
+func (_c *ServiceList_UpdateWorkflow_Call) Return(_a0 *structures.WorkflowOutput, _a1 error) *ServiceList_UpdateWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceList_UpdateWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.WorkflowInput) (*structures.WorkflowOutput, error)) *ServiceList_UpdateWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,7 +1,10 @@
 package list
 
 import (
+	"github.com/google/uuid"
 	"project/structures"
+	"project/utils"
+	"slices"
 )
 
 type ServiceConvertorList struct{}
@@ -36,7 +39,7 @@ func (s *ServiceConvertorList) ConvertListModelToEntities(listModel *structures.
 	listUserEntity := &structures.ListUserEntity{
 		ListId:   listModel.Id,
 		Username: listModel.Owner,
-		IsOwner:  true,
+		Role:     utils.Owner,
 	}
 
 	return listEntity, listUserEntity
@@ -47,10 +50,20 @@ func (s *ServiceConvertorList) ConvertUserModelToUserOutput(userModel *structure
 		ListId:   userModel.ListId,
 		ListName: userModel.ListName,
 		Username: userModel.Username,
+		Role:     userModel.Role,
 		IsOwner:  userModel.IsOwner,
 	}
 }
 
+func (s *ServiceConvertorList) ConvertUserModelsToUserOutputs(userModels []*structures.UserModel) []*structures.UserOutput {
+	outputs := make([]*structures.UserOutput, len(userModels))
+	for i, userModel := range userModels {
+		outputs[i] = s.ConvertUserModelToUserOutput(userModel)
+	}
+
+	return outputs
+}
+
 func (s *ServiceConvertorList) ConvertListModelToUserOutputs(listModel *structures.ListModel) []*structures.UserOutput {
 	outputs := make([]*structures.UserOutput, len(listModel.Users))
 	for i, user := range listModel.Users {
@@ -82,6 +95,7 @@ func (s *ServiceConvertorList) ConvertUserModelToOutput(userModel *structures.Us
 		ListId:   userModel.ListId,
 		ListName: userModel.ListName,
 		Username: userModel.Username,
+		Role:     userModel.Role,
 		IsOwner:  userModel.IsOwner,
 	}
 }
@@ -99,6 +113,117 @@ func (s *ServiceConvertorList) ConvertListModelsToOutputs(listModels []*structur
 	return outputs
 }
 
+func (s *ServiceConvertorList) ConvertLabelModelToOutput(labelModel *structures.LabelModel) *structures.LabelOutput {
+	return &structures.LabelOutput{
+		Id:     labelModel.Id,
+		ListId: labelModel.ListId,
+		Name:   labelModel.Name,
+		Color:  labelModel.Color,
+	}
+}
+
+func (s *ServiceConvertorList) ConvertLabelModelToEntity(labelModel *structures.LabelModel) *structures.LabelEntity {
+	return &structures.LabelEntity{
+		Id:     labelModel.Id,
+		ListId: labelModel.ListId,
+		Name:   labelModel.Name,
+		Color:  labelModel.Color,
+	}
+}
+
+func (s *ServiceConvertorList) ConvertWorkflowInputToModel(listId uuid.UUID, input structures.WorkflowInput) *structures.WorkflowModel {
+	workflowModel := structures.WorkflowModel{
+		ListId:      listId,
+		States:      make([]structures.WorkflowStateModel, len(input.States)),
+		Transitions: make([]structures.WorkflowTransitionModel, len(input.Transitions)),
+	}
+	for i, state := range input.States {
+		workflowModel.States[i] = structures.WorkflowStateModel{Name: state.Name, Done: state.Done}
+	}
+	for i, transition := range input.Transitions {
+		workflowModel.Transitions[i] = structures.WorkflowTransitionModel{From: transition.From, To: transition.To}
+	}
+
+	return &workflowModel
+}
+
+func (s *ServiceConvertorList) ConvertWorkflowModelToOutput(workflowModel *structures.WorkflowModel) *structures.WorkflowOutput {
+	workflowOutput := structures.WorkflowOutput{
+		ListId:      workflowModel.ListId,
+		States:      make([]structures.WorkflowStateOutput, len(workflowModel.States)),
+		Transitions: make([]structures.WorkflowTransitionOutput, len(workflowModel.Transitions)),
+	}
+	for i, state := range workflowModel.States {
+		workflowOutput.States[i] = structures.WorkflowStateOutput{Name: state.Name, Done: state.Done}
+	}
+	for i, transition := range workflowModel.Transitions {
+		workflowOutput.Transitions[i] = structures.WorkflowTransitionOutput{From: transition.From, To: transition.To}
+	}
+
+	return &workflowOutput
+}
+
+// ConvertWorkflowModelToEntity keeps the states in the order of the model, which becomes their position.
+func (s *ServiceConvertorList) ConvertWorkflowModelToEntity(workflowModel *structures.WorkflowModel) *structures.WorkflowEntity {
+	workflowEntity := structures.WorkflowEntity{
+		ListId:      workflowModel.ListId,
+		States:      make([]structures.WorkflowStateEntity, len(workflowModel.States)),
+		Transitions: make([]structures.WorkflowTransitionEntity, len(workflowModel.Transitions)),
+	}
+	for i, state := range workflowModel.States {
+		workflowEntity.States[i] = structures.WorkflowStateEntity{
+			ListId:   workflowModel.ListId,
+			Name:     state.Name,
+			Position: i,
+			Done:     state.Done,
+		}
+	}
+	for i, transition := range workflowModel.Transitions {
+		workflowEntity.Transitions[i] = structures.WorkflowTransitionEntity{
+			ListId: workflowModel.ListId,
+			From:   transition.From,
+			To:     transition.To,
+		}
+	}
+
+	return &workflowEntity
+}
+
+func (s *ServiceConvertorList) ConvertTemplateModelToOutput(templateModel *structures.TemplateModel) *structures.TemplateOutput {
+	templateOutput := structures.TemplateOutput{
+		Id:          templateModel.Id,
+		Name:        templateModel.Name,
+		Owner:       templateModel.Owner,
+		CreatedAt:   templateModel.CreatedAt,
+		States:      make([]structures.WorkflowStateOutput, len(templateModel.States)),
+		Transitions: make([]structures.WorkflowTransitionOutput, len(templateModel.Transitions)),
+		Labels:      make([]structures.TemplateLabelOutput, len(templateModel.Labels)),
+		Todos:       make([]structures.TemplateTodoOutput, len(templateModel.Todos)),
+	}
+	for i, state := range templateModel.States {
+		templateOutput.States[i] = structures.WorkflowStateOutput{Name: state.Name, Done: state.Done}
+	}
+	for i, transition := range templateModel.Transitions {
+		templateOutput.Transitions[i] = structures.WorkflowTransitionOutput{From: transition.From, To: transition.To}
+	}
+	for i, label := range templateModel.Labels {
+		templateOutput.Labels[i] = structures.TemplateLabelOutput{Name: label.Name, Color: label.Color}
+	}
+	for i, todo := range templateModel.Todos {
+		templateOutput.Todos[i] = structures.TemplateTodoOutput{
+			Name:           todo.Name,
+			Description:    todo.Description,
+			Priority:       todo.Priority,
+			Recurrence:     todo.Recurrence,
+			DeadlineOffset: todo.DeadlineOffset,
+			Labels:         append([]string{}, todo.Labels...),
+			Subtasks:       append([]string{}, todo.Subtasks...),
+		}
+	}
+
+	return &templateOutput
+}
+
 type RepositoryConvertorList struct{}
 
 func NewRepositoryListConvertor() *RepositoryConvertorList {
@@ -120,6 +245,151 @@ func (r *RepositoryConvertorList) ConvertUserEntityToModel(userEntity structures
 		ListId:   userEntity.ListId,
 		ListName: listName,
 		Username: userEntity.Username,
-		IsOwner:  userEntity.IsOwner,
+		Role:     userEntity.Role,
+		IsOwner:  userEntity.Role == utils.Owner,
+	}
+}
+
+func (r *RepositoryConvertorList) ConvertUserListEntitiesToModels(entities []structures.UserListEntity) []*structures.UserModel {
+	models := make([]*structures.UserModel, len(entities))
+	for i, e := range entities {
+		models[i] = &structures.UserModel{
+			ListId:   e.ListId,
+			ListName: e.ListName,
+			Username: e.Username,
+			Role:     e.Role,
+			IsOwner:  e.Role == utils.Owner,
+		}
 	}
+
+	return models
+}
+
+func (r *RepositoryConvertorList) ConvertLabelEntityToModel(entity structures.LabelEntity) structures.LabelModel {
+	return structures.LabelModel{
+		Id:     entity.Id,
+		ListId: entity.ListId,
+		Name:   entity.Name,
+		Color:  entity.Color,
+	}
+}
+
+func (r *RepositoryConvertorList) ConvertLabelEntitiesToModels(entities []structures.LabelEntity) []structures.LabelModel {
+	models := make([]structures.LabelModel, len(entities))
+	for i, e := range entities {
+		models[i] = r.ConvertLabelEntityToModel(e)
+	}
+
+	return models
+}
+
+// ConvertWorkflowEntityToModel orders the states by position and the transitions by the positions
+// of the states they connect, whatever order the rows were read in.
+func (r *RepositoryConvertorList) ConvertWorkflowEntityToModel(entity structures.WorkflowEntity) *structures.WorkflowModel {
+	states := slices.Clone(entity.States)
+	slices.SortFunc(states, func(a, b structures.WorkflowStateEntity) int {
+		return a.Position - b.Position
+	})
+	positions := make(map[string]int, len(states))
+	for _, state := range states {
+		positions[state.Name] = state.Position
+	}
+	transitions := slices.Clone(entity.Transitions)
+	slices.SortFunc(transitions, func(a, b structures.WorkflowTransitionEntity) int {
+		if positions[a.From] != positions[b.From] {
+			return positions[a.From] - positions[b.From]
+		}
+
+		return positions[a.To] - positions[b.To]
+	})
+
+	workflowModel := structures.WorkflowModel{
+		ListId:      entity.ListId,
+		States:      make([]structures.WorkflowStateModel, len(states)),
+		Transitions: make([]structures.WorkflowTransitionModel, len(transitions)),
+	}
+	for i, state := range states {
+		workflowModel.States[i] = structures.WorkflowStateModel{Name: state.Name, Done: state.Done}
+	}
+	for i, transition := range transitions {
+		workflowModel.Transitions[i] = structures.WorkflowTransitionModel{From: transition.From, To: transition.To}
+	}
+
+	return &workflowModel
+}
+
+func (r *RepositoryConvertorList) ConvertTemplateEntityToModel(entity structures.TemplateEntity) *structures.TemplateModel {
+	templateModel := structures.TemplateModel{
+		Id:          entity.Id,
+		Name:        entity.Name,
+		Owner:       entity.Owner,
+		CreatedAt:   entity.CreatedAt,
+		States:      make([]structures.WorkflowStateModel, len(entity.Workflow.States)),
+		Transitions: make([]structures.WorkflowTransitionModel, len(entity.Workflow.Transitions)),
+		Labels:      make([]structures.TemplateLabelModel, len(entity.Labels)),
+		Todos:       make([]structures.TemplateTodoModel, len(entity.Todos)),
+	}
+	for i, state := range entity.Workflow.States {
+		templateModel.States[i] = structures.WorkflowStateModel{Name: state.Name, Done: state.Done}
+	}
+	for i, transition := range entity.Workflow.Transitions {
+		templateModel.Transitions[i] = structures.WorkflowTransitionModel{From: transition.From, To: transition.To}
+	}
+	for i, label := range entity.Labels {
+		templateModel.Labels[i] = structures.TemplateLabelModel{Name: label.Name, Color: label.Color}
+	}
+	for i, todo := range entity.Todos {
+		templateModel.Todos[i] = structures.TemplateTodoModel{
+			Name:           todo.Name,
+			Description:    todo.Description,
+			Priority:       todo.Priority,
+			Recurrence:     todo.Recurrence,
+			DeadlineOffset: todo.DeadlineOffset,
+			Labels:         todo.Labels,
+			Subtasks:       todo.Subtasks,
+		}
+	}
+
+	return &templateModel
+}
+
+func (r *RepositoryConvertorList) ConvertWorkflowModelToTemplate(workflowModel *structures.WorkflowModel) structures.TemplateWorkflow {
+	workflow := structures.TemplateWorkflow{
+		States:      make([]structures.TemplateStateEntity, len(workflowModel.States)),
+		Transitions: make([]structures.TemplateTransitionEntity, len(workflowModel.Transitions)),
+	}
+	for i, state := range workflowModel.States {
+		workflow.States[i] = structures.TemplateStateEntity{Name: state.Name, Done: state.Done}
+	}
+	for i, transition := range workflowModel.Transitions {
+		workflow.Transitions[i] = structures.TemplateTransitionEntity{From: transition.From, To: transition.To}
+	}
+
+	return workflow
+}
+
+// ConvertTemplateToWorkflowEntity gives the list the workflow of a template, with the states at the positions of their order.
+func (r *RepositoryConvertorList) ConvertTemplateToWorkflowEntity(listId uuid.UUID, workflow structures.TemplateWorkflow) structures.WorkflowEntity {
+	workflowEntity := structures.WorkflowEntity{
+		ListId:      listId,
+		States:      make([]structures.WorkflowStateEntity, len(workflow.States)),
+		Transitions: make([]structures.WorkflowTransitionEntity, len(workflow.Transitions)),
+	}
+	for i, state := range workflow.States {
+		workflowEntity.States[i] = structures.WorkflowStateEntity{ListId: listId, Name: state.Name, Position: i, Done: state.Done}
+	}
+	for i, transition := range workflow.Transitions {
+		workflowEntity.Transitions[i] = structures.WorkflowTransitionEntity{ListId: listId, From: transition.From, To: transition.To}
+	}
+
+	return workflowEntity
+}
+
+func (r *RepositoryConvertorList) ConvertLabelModelsToTemplate(labelModels []structures.LabelModel) structures.TemplateLabels {
+	labels := make(structures.TemplateLabels, len(labelModels))
+	for i, labelModel := range labelModels {
+		labels[i] = structures.TemplateLabelEntity{Name: labelModel.Name, Color: labelModel.Color}
+	}
+
+	return labels
 }
@@ -0,0 +1,984 @@
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"project/memory"
+	"project/structures"
+	"project/utils"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+type MemoryRepositoryList struct {
+	store     *memory.Store
+	convertor RepositoryConvertorList
+}
+
+func NewMemoryRepositoryList(store *memory.Store, convertor RepositoryConvertorList) *MemoryRepositoryList {
+	return &MemoryRepositoryList{store: store, convertor: convertor}
+}
+
+func (r *MemoryRepositoryList) GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
+	var listModel *structures.ListModel
+	var err error
+	r.store.Read(ctx, func() {
+		listModel, err = r.getListById(ctx, listId)
+	})
+
+	return listModel, err
+}
+
+func (r *MemoryRepositoryList) GetAllLists(ctx context.Context, page structures.PageQuery) (*structures.ListPageModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := utils.ValidatePageQuery(page, utils.SortByName, false)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	var listPage structures.ListPageModel
+	r.store.Read(ctx, func() {
+		lists := r.sortedLists()
+
+		var entities []structures.ListEntity
+		for _, listEntity := range lists {
+			if page.After != nil && compareListToCursor(listEntity, *page.After) <= 0 {
+				continue
+			}
+			if page.Before != nil && compareListToCursor(listEntity, *page.Before) >= 0 {
+				continue
+			}
+			entities = append(entities, listEntity)
+		}
+
+		entities, listPage.PageInfo = utils.PageItems(page, entities, listCursor)
+		listPage.PageInfo.TotalCount = len(lists)
+		listPage.Lists = make([]*structures.ListModel, len(entities))
+		for i, listEntity := range entities {
+			listPage.Lists[i], err = r.getListById(ctx, listEntity.Id)
+			if err != nil {
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &listPage, nil
+}
+
+func (r *MemoryRepositoryList) GetListOwner(ctx context.Context, listId uuid.UUID) (*structures.UserModel, error) {
+	var owner *structures.UserModel
+	var err error
+	r.store.Read(ctx, func() {
+		owner, err = r.getListOwner(ctx, listId)
+	})
+
+	return owner, err
+}
+
+func (r *MemoryRepositoryList) GetUserFromListById(ctx context.Context, listId uuid.UUID, username string) (*structures.UserModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var userModel *structures.UserModel
+	var err error
+	r.store.Read(ctx, func() {
+		index := r.findMember(listId, username)
+		if index == -1 {
+			err = errors.New(fmt.Sprintf("error getting user of list with id: %s", listId))
+			log.Error(err)
+			return
+		}
+
+		userModel = r.convertor.ConvertUserEntityToModel(r.store.UsersLists[index], r.store.Lists[listId].Name)
+	})
+
+	return userModel, err
+}
+
+func (r *MemoryRepositoryList) CreateList(ctx context.Context, entityList structures.ListEntity, entityUser structures.ListUserEntity) error {
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		err := r.insertList(ctx, entityList, entityUser)
+		if err != nil {
+			return err
+		}
+
+		r.addWorkflow(utils.DefaultWorkflow(entityList.Id))
+		return nil
+	})
+}
+
+// insertList adds the list with its owner, without a workflow.
+func (r *MemoryRepositoryList) insertList(ctx context.Context, entityList structures.ListEntity, entityUser structures.ListUserEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if r.findListByName(entityList.Name) != nil {
+		err := errors.New(fmt.Sprintf("error already exists list with this name %s", entityList.Name))
+		log.Error(err)
+		return err
+	}
+
+	entityList.CreatedAt = time.Now()
+	memory.Put(r.store, r.store.Lists, entityList.Id, entityList)
+
+	return r.addMember(ctx, entityUser)
+}
+
+func (r *MemoryRepositoryList) AddUserToList(ctx context.Context, entityUser structures.ListUserEntity) error {
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		return r.addMember(ctx, entityUser)
+	})
+}
+
+func (r *MemoryRepositoryList) DeleteList(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var toBeDeleted *structures.ListModel
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		var err error
+		toBeDeleted, err = r.getListById(ctx, listId)
+		if err != nil {
+			err = errors.New(fmt.Sprintf("error not found list with id: %s", listId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Delete(r.store, r.store.Lists, listId)
+		memory.Change(r.store, &r.store.UsersLists)
+		members := r.store.UsersLists[:0]
+		for _, member := range r.store.UsersLists {
+			if member.ListId != listId {
+				members = append(members, member)
+			}
+		}
+		r.store.UsersLists = members
+		for todoId, todoEntity := range r.store.Todos {
+			if todoEntity.ListId == listId {
+				r.store.DeleteTodo(todoId)
+			}
+		}
+		for labelId, labelEntity := range r.store.Labels {
+			if labelEntity.ListId == listId {
+				r.store.DeleteLabel(labelId)
+			}
+		}
+		r.store.DeleteWorkflow(listId)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toBeDeleted, nil
+}
+
+func (r *MemoryRepositoryList) RemoveUserUserFromList(ctx context.Context, entityUser structures.ListUserEntity) (*structures.UserModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if entityUser.Role == utils.Owner {
+		deletedList, err := r.DeleteList(ctx, entityUser.ListId)
+		if err != nil {
+			return nil, err
+		}
+
+		deletedOwner := structures.UserModel{
+			ListId:   deletedList.Id,
+			ListName: deletedList.Name,
+			Username: entityUser.Username,
+			Role:     entityUser.Role,
+			IsOwner:  true,
+		}
+		return &deletedOwner, nil
+	}
+
+	var removedUser *structures.UserModel
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		listEntity, ok := r.store.Lists[entityUser.ListId]
+		if !ok {
+			err := errors.New(fmt.Sprintf("error not found list with id: %s", entityUser.ListId))
+			log.Error(err)
+			return err
+		}
+
+		index := r.findMember(entityUser.ListId, entityUser.Username)
+		if index == -1 {
+			err := errors.New(fmt.Sprintf("error removing user with this name %s from list with id: %s",
+				entityUser.Username, entityUser.ListId))
+			log.Error(err)
+			return err
+		}
+		memory.Change(r.store, &r.store.UsersLists)
+		r.store.UsersLists = append(r.store.UsersLists[:index], r.store.UsersLists[index+1:]...)
+
+		removedUser = &structures.UserModel{
+			ListId:   listEntity.Id,
+			ListName: listEntity.Name,
+			Username: entityUser.Username,
+			Role:     entityUser.Role,
+			IsOwner:  false,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return removedUser, nil
+}
+
+func (r *MemoryRepositoryList) UpdateList(ctx context.Context, listId uuid.UUID, newListName string) (*structures.ListModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if newListName == "" {
+		err := errors.New("list name is required")
+		log.Error(err)
+		return nil, err
+	}
+
+	var updatedList *structures.ListModel
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		listEntity, ok := r.store.Lists[listId]
+		if !ok {
+			err := errors.New(fmt.Sprintf("error not found list with id: %s", listId))
+			log.Error(err)
+			return err
+		}
+
+		sameName := r.findListByName(newListName)
+		if sameName != nil && sameName.Id != listId {
+			err := errors.New(fmt.Sprintf("error already exists list with this name %s", newListName))
+			log.Error(err)
+			return err
+		}
+
+		listEntity.Name = newListName
+		memory.Put(r.store, r.store.Lists, listId, listEntity)
+
+		var err error
+		updatedList, err = r.getListById(ctx, listId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedList, nil
+}
+
+func (r *MemoryRepositoryList) CheckIfListExists(ctx context.Context, listId uuid.UUID) bool {
+	var exists bool
+	r.store.Read(ctx, func() {
+		_, exists = r.store.Lists[listId]
+	})
+
+	return exists
+}
+
+func (r *MemoryRepositoryList) ContainsUserInList(ctx context.Context, listId uuid.UUID, username string) bool {
+	var contains bool
+	r.store.Read(ctx, func() {
+		contains = r.findMember(listId, username) != -1
+	})
+
+	return contains
+}
+
+func (r *MemoryRepositoryList) GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string {
+	role := utils.Unknown
+	r.store.Read(ctx, func() {
+		index := r.findMember(listId, username)
+		if index != -1 {
+			role = r.store.UsersLists[index].Role
+		}
+	})
+
+	return role
+}
+
+func (r *MemoryRepositoryList) GetListsOfUser(ctx context.Context, username string) []*structures.UserModel {
+	var entities []structures.UserListEntity
+	r.store.Read(ctx, func() {
+		for _, listEntity := range r.sortedLists() {
+			index := r.findMember(listEntity.Id, username)
+			if index == -1 {
+				continue
+			}
+
+			entities = append(entities, structures.UserListEntity{
+				ListId:   listEntity.Id,
+				ListName: listEntity.Name,
+				Username: username,
+				Role:     r.store.UsersLists[index].Role,
+			})
+		}
+	})
+
+	return r.convertor.ConvertUserListEntitiesToModels(entities)
+}
+
+func (r *MemoryRepositoryList) getListById(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	listEntity, ok := r.store.Lists[listId]
+	if !ok {
+		err := errors.New(fmt.Sprintf("error getting list by id: %s", listId))
+		log.Error(err)
+		return nil, err
+	}
+
+	owner, err := r.getListOwner(ctx, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	var usernames []string
+	for _, member := range r.store.UsersLists {
+		if member.ListId == listId {
+			usernames = append(usernames, member.Username)
+		}
+	}
+
+	return r.convertor.ConvertEntitiesToModel(&listEntity, usernames, owner.Username), nil
+}
+
+func (r *MemoryRepositoryList) getListOwner(ctx context.Context, listId uuid.UUID) (*structures.UserModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	for _, member := range r.store.UsersLists {
+		if member.ListId == listId && member.Role == utils.Owner {
+			return r.convertor.ConvertUserEntityToModel(member, r.store.Lists[listId].Name), nil
+		}
+	}
+
+	err := errors.New(fmt.Sprintf("error getting owner of list with id: %s", listId))
+	log.Error(err)
+	return nil, err
+}
+
+func (r *MemoryRepositoryList) addMember(ctx context.Context, entityUser structures.ListUserEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if _, ok := r.store.Lists[entityUser.ListId]; !ok {
+		err := errors.New(fmt.Sprintf("error not found list with id: %s", entityUser.ListId))
+		log.Error(err)
+		return err
+	}
+	if r.findMember(entityUser.ListId, entityUser.Username) != -1 {
+		err := errors.New(fmt.Sprintf("error already exists user with this name %s in list with id: %s", entityUser.Username, entityUser.ListId))
+		log.Error(err)
+		return err
+	}
+
+	memory.Append(r.store, &r.store.UsersLists, entityUser)
+	return nil
+}
+
+func (r *MemoryRepositoryList) findMember(listId uuid.UUID, username string) int {
+	for i, member := range r.store.UsersLists {
+		if member.ListId == listId && member.Username == username {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (r *MemoryRepositoryList) findListByName(name string) *structures.ListEntity {
+	for _, listEntity := range r.store.Lists {
+		if listEntity.Name == name {
+			return &listEntity
+		}
+	}
+
+	return nil
+}
+
+func (r *MemoryRepositoryList) sortedLists() []structures.ListEntity {
+	lists := make([]structures.ListEntity, 0, len(r.store.Lists))
+	for _, listEntity := range r.store.Lists {
+		lists = append(lists, listEntity)
+	}
+	sort.Slice(lists, func(i, j int) bool {
+		return lists[i].Name < lists[j].Name
+	})
+
+	return lists
+}
+
+func (r *MemoryRepositoryList) GetLabels(ctx context.Context, listId uuid.UUID) ([]structures.LabelModel, error) {
+	var entities []structures.LabelEntity
+	r.store.Read(ctx, func() {
+		for _, labelEntity := range r.store.Labels {
+			if labelEntity.ListId == listId {
+				entities = append(entities, labelEntity)
+			}
+		}
+	})
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Name < entities[j].Name
+	})
+
+	return r.convertor.ConvertLabelEntitiesToModels(entities), nil
+}
+
+func (r *MemoryRepositoryList) CreateLabel(ctx context.Context, entityLabel structures.LabelEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		if _, ok := r.store.Lists[entityLabel.ListId]; !ok {
+			err := errors.New(fmt.Sprintf("error not found list with id: %s", entityLabel.ListId))
+			log.Error(err)
+			return err
+		}
+		if r.findLabelByName(entityLabel.ListId, entityLabel.Name) != nil {
+			err := errors.New(fmt.Sprintf("error already exists label with name %s in list with id: %s", entityLabel.Name, entityLabel.ListId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Put(r.store, r.store.Labels, entityLabel.Id, entityLabel)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryList) UpdateLabel(ctx context.Context, entityLabel structures.LabelEntity) (*structures.LabelModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		_, err := r.findLabel(ctx, entityLabel.Id, entityLabel.ListId)
+		if err != nil {
+			return err
+		}
+
+		sameName := r.findLabelByName(entityLabel.ListId, entityLabel.Name)
+		if sameName != nil && sameName.Id != entityLabel.Id {
+			err = errors.New(fmt.Sprintf("error already exists label with name %s in list with id: %s", entityLabel.Name, entityLabel.ListId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Put(r.store, r.store.Labels, entityLabel.Id, entityLabel)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	labelModel := r.convertor.ConvertLabelEntityToModel(entityLabel)
+	return &labelModel, nil
+}
+
+func (r *MemoryRepositoryList) DeleteLabel(ctx context.Context, labelId, listId uuid.UUID) (*structures.LabelModel, error) {
+	var deletedLabel *structures.LabelEntity
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deletedLabel, err = r.findLabel(ctx, labelId, listId)
+		if err != nil {
+			return err
+		}
+
+		r.store.DeleteLabel(labelId)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	labelModel := r.convertor.ConvertLabelEntityToModel(*deletedLabel)
+	return &labelModel, nil
+}
+
+func (r *MemoryRepositoryList) findLabel(ctx context.Context, labelId, listId uuid.UUID) (*structures.LabelEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	labelEntity, ok := r.store.Labels[labelId]
+	if !ok || labelEntity.ListId != listId {
+		err := errors.New(fmt.Sprintf("error not found label with id %s in list with id: %s", labelId, listId))
+		log.Error(err)
+		return nil, err
+	}
+
+	return &labelEntity, nil
+}
+
+func (r *MemoryRepositoryList) findLabelByName(listId uuid.UUID, name string) *structures.LabelEntity {
+	for _, labelEntity := range r.store.Labels {
+		if labelEntity.ListId == listId && labelEntity.Name == name {
+			return &labelEntity
+		}
+	}
+
+	return nil
+}
+
+func (r *MemoryRepositoryList) GetWorkflow(ctx context.Context, listId uuid.UUID) (*structures.WorkflowModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	workflowEntity := structures.WorkflowEntity{ListId: listId}
+	r.store.Read(ctx, func() {
+		for _, state := range r.store.WorkflowStates {
+			if state.ListId == listId {
+				workflowEntity.States = append(workflowEntity.States, state)
+			}
+		}
+		for _, transition := range r.store.WorkflowTransitions {
+			if transition.ListId == listId {
+				workflowEntity.Transitions = append(workflowEntity.Transitions, transition)
+			}
+		}
+	})
+	if len(workflowEntity.States) == 0 {
+		err := errors.New(fmt.Sprintf("error not found workflow of list with id: %s", listId))
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.convertor.ConvertWorkflowEntityToModel(workflowEntity), nil
+}
+
+func (r *MemoryRepositoryList) UpdateWorkflow(ctx context.Context, workflowEntity structures.WorkflowEntity) (*structures.WorkflowModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		if _, ok := r.store.Lists[workflowEntity.ListId]; !ok {
+			err := errors.New(fmt.Sprintf("error not found list with id: %s", workflowEntity.ListId))
+			log.Error(err)
+			return err
+		}
+
+		r.store.DeleteWorkflow(workflowEntity.ListId)
+		r.addWorkflow(workflowEntity)
+
+		var droppedStatuses []string
+		for _, todoEntity := range r.store.Todos {
+			_, ok := r.store.WorkflowState(todoEntity.ListId, todoEntity.Status)
+			if todoEntity.ListId == workflowEntity.ListId && !ok && !slices.Contains(droppedStatuses, todoEntity.Status) {
+				droppedStatuses = append(droppedStatuses, todoEntity.Status)
+			}
+		}
+		if len(droppedStatuses) > 0 {
+			slices.Sort(droppedStatuses)
+			err := errors.New(fmt.Sprintf("error updating workflow of list with id: %s because todos are still in status: %s",
+				workflowEntity.ListId, strings.Join(droppedStatuses, ", ")))
+			log.Error(err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertor.ConvertWorkflowEntityToModel(workflowEntity), nil
+}
+
+func (r *MemoryRepositoryList) addWorkflow(workflowEntity structures.WorkflowEntity) {
+	for _, state := range workflowEntity.States {
+		state.ListId = workflowEntity.ListId
+		memory.Append(r.store, &r.store.WorkflowStates, state)
+	}
+	for _, transition := range workflowEntity.Transitions {
+		transition.ListId = workflowEntity.ListId
+		memory.Append(r.store, &r.store.WorkflowTransitions, transition)
+	}
+}
+
+func (r *MemoryRepositoryList) DuplicateList(ctx context.Context, listId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, includeMembers bool) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		if _, ok := r.store.Lists[listId]; !ok {
+			err := errors.New(fmt.Sprintf("error not found list with id: %s", listId))
+			log.Error(err)
+			return err
+		}
+		err := r.insertList(ctx, entityList, entityUser)
+		if err != nil {
+			return err
+		}
+
+		if includeMembers {
+			for _, member := range slices.Clone(r.store.UsersLists) {
+				if member.ListId != listId || member.Username == entityUser.Username {
+					continue
+				}
+				if member.Role == utils.Owner {
+					member.Role = utils.Manager
+				}
+
+				member.ListId = entityList.Id
+				memory.Append(r.store, &r.store.UsersLists, member)
+			}
+		}
+
+		workflowEntity, err := r.workflowEntity(ctx, listId)
+		if err != nil {
+			return err
+		}
+		workflowEntity.ListId = entityList.Id
+		r.addWorkflow(*workflowEntity)
+
+		labelIds := make(map[uuid.UUID]uuid.UUID)
+		for _, labelEntity := range r.listLabels(listId) {
+			labelIds[labelEntity.Id] = uuid.New()
+			labelEntity.Id, labelEntity.ListId = labelIds[labelEntity.Id], entityList.Id
+			memory.Put(r.store, r.store.Labels, labelEntity.Id, labelEntity)
+		}
+
+		var todoEntities []structures.TodoEntity
+		for _, todoEntity := range r.store.Todos {
+			if todoEntity.ListId == listId {
+				todoEntities = append(todoEntities, todoEntity)
+			}
+		}
+		sort.Slice(todoEntities, func(i, j int) bool {
+			if !todoEntities[i].CreationDate.Equal(todoEntities[j].CreationDate) {
+				return todoEntities[i].CreationDate.Before(todoEntities[j].CreationDate)
+			}
+			return todoEntities[i].Id.String() < todoEntities[j].Id.String()
+		})
+
+		copies := make(map[uuid.UUID]uuid.UUID, len(todoEntities))
+		series := make(map[uuid.UUID]uuid.UUID)
+		for _, todoEntity := range todoEntities {
+			copies[todoEntity.Id] = uuid.New()
+			if todoEntity.SeriesId.Valid {
+				if _, ok := series[todoEntity.SeriesId.UUID]; !ok {
+					series[todoEntity.SeriesId.UUID] = uuid.New()
+				}
+				todoEntity.SeriesId.UUID = series[todoEntity.SeriesId.UUID]
+			}
+
+			r.copyTodo(todoEntity, copies[todoEntity.Id], entityList.Id, labelIds)
+		}
+
+		for _, dependency := range slices.Clone(r.store.TodoDependencies) {
+			todoId, copied := copies[dependency.TodoId]
+			blockerId, blockerCopied := copies[dependency.BlockedById]
+			if copied && blockerCopied {
+				memory.Append(r.store, &r.store.TodoDependencies, structures.TodoDependencyEntity{TodoId: todoId, BlockedById: blockerId})
+			}
+		}
+
+		initial, _ := r.store.InitialState(entityList.Id)
+		for _, copyId := range copies {
+			copyEntity := r.store.Todos[copyId]
+			if copyEntity.Status == utils.Assigned && len(memory.TodoUsers(r.store.TodoAssignees, copyId)) == 0 {
+				copyEntity.Status = initial.Name
+				memory.Put(r.store, r.store.Todos, copyId, copyEntity)
+			}
+		}
+
+		return nil
+	})
+}
+
+// copyTodo copies the todo into the list, with the labels it maps to the ones of the list, its subtasks and
+// the assignees and watchers who are members of the list.
+func (r *MemoryRepositoryList) copyTodo(todoEntity structures.TodoEntity, copyId, listId uuid.UUID, labelIds map[uuid.UUID]uuid.UUID) {
+	todoId := todoEntity.Id
+	todoEntity.Id, todoEntity.ListId, todoEntity.CreationDate = copyId, listId, time.Now()
+	memory.Put(r.store, r.store.Todos, copyId, todoEntity)
+
+	for _, todoLabel := range slices.Clone(r.store.TodoLabels) {
+		if todoLabel.TodoId == todoId {
+			memory.Append(r.store, &r.store.TodoLabels, structures.TodoLabelEntity{TodoId: copyId, LabelId: labelIds[todoLabel.LabelId]})
+		}
+	}
+	for _, todoUsers := range []*[]structures.TodoUserEntity{&r.store.TodoAssignees, &r.store.TodoWatchers} {
+		for _, username := range memory.TodoUsers(*todoUsers, todoId) {
+			if r.store.IsListMember(listId, username) {
+				memory.Append(r.store, todoUsers, structures.TodoUserEntity{TodoId: copyId, Username: username})
+			}
+		}
+	}
+
+	var subtasks []structures.SubtaskEntity
+	for _, subtaskEntity := range r.store.Subtasks {
+		if subtaskEntity.TodoId == todoId {
+			subtasks = append(subtasks, subtaskEntity)
+		}
+	}
+	for _, subtaskEntity := range subtasks {
+		subtaskEntity.Id, subtaskEntity.TodoId = uuid.New(), copyId
+		if !r.store.IsListMember(listId, subtaskEntity.Assignee) {
+			subtaskEntity.Assignee = ""
+		}
+		memory.Put(r.store, r.store.Subtasks, subtaskEntity.Id, subtaskEntity)
+	}
+}
+
+// workflowEntity reads the states and the transitions of the workflow of the list.
+func (r *MemoryRepositoryList) workflowEntity(ctx context.Context, listId uuid.UUID) (*structures.WorkflowEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	workflowEntity := structures.WorkflowEntity{ListId: listId}
+	for _, state := range r.store.WorkflowStates {
+		if state.ListId == listId {
+			workflowEntity.States = append(workflowEntity.States, state)
+		}
+	}
+	for _, transition := range r.store.WorkflowTransitions {
+		if transition.ListId == listId {
+			workflowEntity.Transitions = append(workflowEntity.Transitions, transition)
+		}
+	}
+	if len(workflowEntity.States) == 0 {
+		err := errors.New(fmt.Sprintf("error not found workflow of list with id: %s", listId))
+		log.Error(err)
+		return nil, err
+	}
+
+	return &workflowEntity, nil
+}
+
+// listLabels is the label catalog of the list sorted by name.
+func (r *MemoryRepositoryList) listLabels(listId uuid.UUID) []structures.LabelEntity {
+	var entities []structures.LabelEntity
+	for _, labelEntity := range r.store.Labels {
+		if labelEntity.ListId == listId {
+			entities = append(entities, labelEntity)
+		}
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Name < entities[j].Name
+	})
+
+	return entities
+}
+
+func (r *MemoryRepositoryList) GetTemplates(ctx context.Context, owner string) ([]structures.TemplateModel, error) {
+	var templateModels []structures.TemplateModel
+	r.store.Read(ctx, func() {
+		for _, templateEntity := range r.store.Templates {
+			if owner != "" && templateEntity.Owner != owner {
+				continue
+			}
+			templateModels = append(templateModels, *r.convertor.ConvertTemplateEntityToModel(templateEntity))
+		}
+	})
+	sort.Slice(templateModels, func(i, j int) bool {
+		return templateModels[i].Name < templateModels[j].Name
+	})
+
+	return templateModels, nil
+}
+
+func (r *MemoryRepositoryList) GetTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateModel, error) {
+	var templateModel *structures.TemplateModel
+	var err error
+	r.store.Read(ctx, func() {
+		var templateEntity *structures.TemplateEntity
+		templateEntity, err = r.findTemplate(ctx, templateId)
+		if err == nil {
+			templateModel = r.convertor.ConvertTemplateEntityToModel(*templateEntity)
+		}
+	})
+
+	return templateModel, err
+}
+
+func (r *MemoryRepositoryList) findTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	templateEntity, ok := r.store.Templates[templateId]
+	if !ok {
+		err := errors.New(fmt.Sprintf("error not found template with id: %s", templateId))
+		log.Error(err)
+		return nil, err
+	}
+
+	return &templateEntity, nil
+}
+
+func (r *MemoryRepositoryList) CreateTemplate(ctx context.Context, listId uuid.UUID, entityTemplate structures.TemplateEntity, startDate *time.Time) (*structures.TemplateModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		if _, ok := r.store.Lists[listId]; !ok {
+			err := errors.New(fmt.Sprintf("error not found list with id: %s", listId))
+			log.Error(err)
+			return err
+		}
+		for _, templateEntity := range r.store.Templates {
+			if templateEntity.Owner == entityTemplate.Owner && templateEntity.Name == entityTemplate.Name {
+				err := errors.New(fmt.Sprintf("error already exists template with this name %s", entityTemplate.Name))
+				log.Error(err)
+				return err
+			}
+		}
+
+		workflowEntity, err := r.workflowEntity(ctx, listId)
+		if err != nil {
+			return err
+		}
+		entityTemplate.Workflow = r.convertor.ConvertWorkflowModelToTemplate(r.convertor.ConvertWorkflowEntityToModel(*workflowEntity))
+		labelModels := r.convertor.ConvertLabelEntitiesToModels(r.listLabels(listId))
+		entityTemplate.Labels = r.convertor.ConvertLabelModelsToTemplate(labelModels)
+		entityTemplate.Todos = r.templateTodos(listId, startDate)
+		for i := range entityTemplate.Todos {
+			entityTemplate.Todos[i].TemplateId, entityTemplate.Todos[i].Position = entityTemplate.Id, i
+		}
+
+		entityTemplate.CreatedAt = time.Now()
+		memory.Put(r.store, r.store.Templates, entityTemplate.Id, entityTemplate)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertor.ConvertTemplateEntityToModel(entityTemplate), nil
+}
+
+// templateTodos reads the todos the list leaves to a template the way the database does, all of them outside of
+// a series and the latest occurrence of each series, ordered by deadline and name.
+func (r *MemoryRepositoryList) templateTodos(listId uuid.UUID, startDate *time.Time) []structures.TemplateTodoEntity {
+	latest := make(map[uuid.UUID]structures.TodoEntity)
+	var start time.Time
+	for _, todoEntity := range r.store.Todos {
+		if todoEntity.ListId != listId {
+			continue
+		}
+		if start.IsZero() || todoEntity.Deadline.Before(start) {
+			start = todoEntity.Deadline
+		}
+
+		key := todoEntity.Id
+		if todoEntity.SeriesId.Valid {
+			key = todoEntity.SeriesId.UUID
+		}
+		if current, ok := latest[key]; !ok || todoEntity.Deadline.After(current.Deadline) {
+			latest[key] = todoEntity
+		}
+	}
+	if startDate != nil {
+		start = *startDate
+	}
+
+	todos := make([]structures.TemplateTodoEntity, 0, len(latest))
+	for _, todoEntity := range latest {
+		todoEntity = r.store.WithComputedColumns(todoEntity)
+		todo := structures.TemplateTodoEntity{
+			Name:           todoEntity.Name,
+			Description:    todoEntity.Description,
+			Priority:       todoEntity.Priority,
+			Recurrence:     todoEntity.Recurrence,
+			DeadlineOffset: daysBetween(start, todoEntity.Deadline),
+			Labels:         structures.TemplateNames{},
+			Subtasks:       structures.TemplateNames{},
+		}
+		for _, labelEntity := range todoEntity.Labels {
+			todo.Labels = append(todo.Labels, labelEntity.Name)
+		}
+
+		var subtasks []structures.SubtaskEntity
+		for _, subtaskEntity := range r.store.Subtasks {
+			if subtaskEntity.TodoId == todoEntity.Id {
+				subtasks = append(subtasks, subtaskEntity)
+			}
+		}
+		sort.Slice(subtasks, func(i, j int) bool {
+			return subtasks[i].Position < subtasks[j].Position
+		})
+		for _, subtaskEntity := range subtasks {
+			todo.Subtasks = append(todo.Subtasks, subtaskEntity.Title)
+		}
+
+		todos = append(todos, todo)
+	}
+	sort.Slice(todos, func(i, j int) bool {
+		if todos[i].DeadlineOffset != todos[j].DeadlineOffset {
+			return todos[i].DeadlineOffset < todos[j].DeadlineOffset
+		}
+		return todos[i].Name < todos[j].Name
+	})
+
+	return todos
+}
+
+// daysBetween counts the days between the dates of the two times, the way the database subtracts dates.
+func daysBetween(from, to time.Time) int {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func (r *MemoryRepositoryList) DeleteTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateModel, error) {
+	var deletedTemplate *structures.TemplateEntity
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deletedTemplate, err = r.findTemplate(ctx, templateId)
+		if err != nil {
+			return err
+		}
+
+		memory.Delete(r.store, r.store.Templates, templateId)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertor.ConvertTemplateEntityToModel(*deletedTemplate), nil
+}
+
+func (r *MemoryRepositoryList) InstantiateTemplate(ctx context.Context, templateId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, startDate time.Time) error {
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		templateEntity, err := r.findTemplate(ctx, templateId)
+		if err != nil {
+			return err
+		}
+		err = r.insertList(ctx, entityList, entityUser)
+		if err != nil {
+			return err
+		}
+		workflowEntity := r.convertor.ConvertTemplateToWorkflowEntity(entityList.Id, templateEntity.Workflow)
+		r.addWorkflow(workflowEntity)
+
+		labelIds := make(map[string]uuid.UUID, len(templateEntity.Labels))
+		for _, label := range templateEntity.Labels {
+			labelIds[label.Name] = uuid.New()
+			r.store.Labels[labelIds[label.Name]] = structures.LabelEntity{Id: labelIds[label.Name], ListId: entityList.Id, Name: label.Name, Color: label.Color}
+		}
+
+		for _, todo := range templateEntity.Todos {
+			todoEntity := structures.TodoEntity{
+				Id:           uuid.New(),
+				ListId:       entityList.Id,
+				Name:         todo.Name,
+				Description:  todo.Description,
+				Deadline:     startDate.AddDate(0, 0, todo.DeadlineOffset),
+				CreationDate: time.Now(),
+				Status:       workflowEntity.States[0].Name,
+				Priority:     todo.Priority,
+				Recurrence:   todo.Recurrence,
+			}
+			if todo.Recurrence != "" {
+				todoEntity.SeriesId = uuid.NullUUID{UUID: todoEntity.Id, Valid: true}
+			}
+			memory.Put(r.store, r.store.Todos, todoEntity.Id, todoEntity)
+
+			for _, label := range todo.Labels {
+				memory.Append(r.store, &r.store.TodoLabels, structures.TodoLabelEntity{TodoId: todoEntity.Id, LabelId: labelIds[label]})
+			}
+			for position, title := range todo.Subtasks {
+				subtaskEntity := structures.SubtaskEntity{Id: uuid.New(), TodoId: todoEntity.Id, Title: title, Position: position}
+				memory.Put(r.store, r.store.Subtasks, subtaskEntity.Id, subtaskEntity)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,26 @@
+package list
+
+import (
+	"bytes"
+	"project/structures"
+	"project/utils"
+	"strings"
+)
+
+// Lists are always paged by name; the id only breaks ties in cursors.
+func listCursor(entity structures.ListEntity) structures.Cursor {
+	return structures.Cursor{
+		SortBy: utils.SortByName,
+		Value:  entity.Name,
+		Id:     entity.Id,
+	}
+}
+
+func compareListToCursor(entity structures.ListEntity, cursor structures.Cursor) int {
+	result := strings.Compare(entity.Name, cursor.Value)
+	if result == 0 {
+		result = bytes.Compare(entity.Id[:], cursor.Id[:])
+	}
+
+	return result
+}
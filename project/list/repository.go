@@ -9,8 +9,11 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
 	"project/structures"
+	"project/uow"
 	"project/utils"
+	"slices"
 	"strings"
+	"time"
 )
 
 var (
@@ -20,12 +23,80 @@ var (
 	usersListsTableUsername = "username"
 	usersListsTable         = "users_lists"
 	listTableName           = "name"
-	usersListsTableIsOwner  = "is_owner"
+	usersListsTableRole     = "role"
 	usersListTableUsername  = "username"
 	listColumns             = []string{"id", "name", "created_at"}
-	usersListsColumns       = []string{"list_id", "username", "is_owner"}
+	usersListsColumns       = []string{"list_id", "username", "role"}
+	userListsColumns        = []string{"users_lists.list_id", "list.name", "users_lists.username", "users_lists.role"}
 	insertListColumn        = []string{"id", "name"}
-	insertUsersListsColumn  = []string{"list_id", "username", "is_owner"}
+	insertUsersListsColumn  = []string{"list_id", "username", "role"}
+	labelTable              = "label"
+	labelTableId            = "id"
+	labelTableListId        = "list_id"
+	labelTableName          = "name"
+	labelColumns            = []string{"id", "list_id", "name", "color"}
+	updateSetLabelColumns   = []string{"name = ?", "color = ?"}
+)
+
+var (
+	workflowStateTable        = "workflow_state"
+	workflowStateListId       = "list_id"
+	workflowStatePosition     = "position"
+	workflowStateColumns      = []string{"list_id", "name", "position", "done"}
+	workflowTransitionTable   = "workflow_transition"
+	workflowTransitionListId  = "list_id"
+	workflowTransitionColumns = []string{"list_id", "from_state", "to_state"}
+)
+
+var (
+	todoTable             = "todo"
+	todoTableId           = "id"
+	todoTableListId       = "list_id"
+	todoTableStatus       = "status"
+	todoTableSeriesId     = "series_id"
+	todoTableCreatedAt    = "created_at"
+	insertTodoColumns     = []string{"id", "list_id", "name", "description", "deadline", "priority", "status", "recurrence", "series_id"}
+	copyTodoColumns       = []string{"name", "description", "deadline", "priority", "status", "recurrence", "superseded"}
+	todoLabelTable        = "todo_label"
+	todoLabelTableTodoId  = "todo_id"
+	todoLabelTableLabelId = "label_id"
+	todoLabelColumns      = []string{"todo_id", "label_id"}
+	todoAssigneeTable     = "todo_assignee"
+	todoWatcherTable      = "todo_watcher"
+	todoUserTableTodoId   = "todo_id"
+	todoUserTableUsername = "username"
+	todoUserColumns       = []string{"todo_id", "username"}
+	todoDependencyTable   = "todo_dependency"
+	todoDependencyTodoId  = "todo_id"
+	todoDependencyColumns = []string{"todo_id", "blocked_by_id"}
+	subtaskTable          = "subtask"
+	subtaskTableTodoId    = "todo_id"
+	subtaskTablePosition  = "position"
+	subtaskColumns        = []string{"id", "todo_id", "title", "done", "assignee", "position"}
+)
+
+var (
+	templateTable          = "list_template"
+	templateTableId        = "id"
+	templateTableName      = "name"
+	templateTableOwner     = "owner"
+	templateColumns        = []string{"id", "name", "owner", "created_at", "workflow", "labels"}
+	insertTemplateColumns  = []string{"id", "name", "owner", "workflow", "labels"}
+	templateTodoTable      = "list_template_todo"
+	templateTodoTemplateId = "template_id"
+	templateTodoPosition   = "position"
+	templateTodoColumns    = []string{"template_id", "position", "name", "description", "priority", "recurrence", "deadline_offset", "labels", "subtasks"}
+	// templateTodosQuery reads the todos a list leaves to a template, all of them outside of a series and the latest
+	// occurrence of each series, with their deadlines as days after the start date or the earliest deadline when it is null.
+	templateTodosQuery = `SELECT name, description, priority, recurrence, deadline_offset, labels, subtasks FROM (` +
+		`SELECT DISTINCT ON (COALESCE(series_id, id)) name, COALESCE(description, '') AS description, priority, recurrence, ` +
+		`deadline - COALESCE(?::date, (SELECT MIN(deadline) FROM todo WHERE list_id = ?)) AS deadline_offset, ` +
+		`(SELECT COALESCE(json_agg(label.name ORDER BY label.name), '[]') FROM todo_label JOIN label ON label.id = todo_label.label_id ` +
+		`WHERE todo_label.todo_id = todo.id) AS labels, ` +
+		`(SELECT COALESCE(json_agg(subtask.title ORDER BY subtask.position), '[]') FROM subtask WHERE subtask.todo_id = todo.id) AS subtasks ` +
+		`FROM todo WHERE list_id = ? ORDER BY COALESCE(series_id, id), deadline DESC) AS latest ORDER BY deadline_offset, name`
+	// listMembersQuery reads the usernames of the members of a list, only they may stay on the todos of its copies.
+	listMembersQuery = "SELECT username FROM users_lists WHERE list_id = ?"
 )
 
 type DBRepositoryList struct {
@@ -37,6 +108,31 @@ func NewDBRepositoryList(db *sqlx.DB, convertor RepositoryConvertorList) *DBRepo
 	return &DBRepositoryList{db: db, convertor: convertor}
 }
 
+func (r *DBRepositoryList) executor(ctx context.Context) uow.Executor {
+	return uow.GetExecutor(ctx, r.db)
+}
+
+// lockList locks the row of the list until the end of the running unit of work.
+func (r *DBRepositoryList) lockList(ctx context.Context, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ?`, listTableId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s FOR UPDATE`, listTableId, listTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var id uuid.UUID
+	err := r.executor(ctx).Get(&id, query, listId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found list with id: %s", listId))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
 func (r *DBRepositoryList) GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
@@ -44,7 +140,7 @@ func (r *DBRepositoryList) GetListById(ctx context.Context, listId uuid.UUID) (*
 	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(listColumns, ", "), listTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var listEntity structures.ListEntity
-	err := r.db.Get(&listEntity, query, listId)
+	err := r.executor(ctx).Get(&listEntity, query, listId)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = errors.New(fmt.Sprintf("error getting list by id: %s", listId))
 		log.Error(err)
@@ -60,7 +156,7 @@ func (r *DBRepositoryList) GetListById(ctx context.Context, listId uuid.UUID) (*
 	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, usersListsTableUsername, usersListsTable, cond)
 	query = sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var usernames []string
-	err = r.db.Select(&usernames, query, listId)
+	err = r.executor(ctx).Select(&usernames, query, listId)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = errors.New(fmt.Sprintf("error getting owner of list with id: %s", listId))
 		log.Error(err)
@@ -70,34 +166,62 @@ func (r *DBRepositoryList) GetListById(ctx context.Context, listId uuid.UUID) (*
 	return r.convertor.ConvertEntitiesToModel(&listEntity, usernames, owner.Username), nil
 }
 
-func (r *DBRepositoryList) GetAllLists(ctx context.Context) []*structures.ListModel {
-	var listIds []uuid.UUID
-	sortBy := fmt.Sprintf(`ORDER BY %s`, listTableName)
-	stmt := fmt.Sprintf(`SELECT %s FROM %s %s`, listTableId, listTable, sortBy)
-	err := r.db.Select(&listIds, stmt)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil
+func (r *DBRepositoryList) GetAllLists(ctx context.Context, page structures.PageQuery) (*structures.ListPageModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := utils.ValidatePageQuery(page, utils.SortByName, false)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s`, listTableId, listTable)
+	var totalCount int
+	err = r.executor(ctx).Get(&totalCount, stmt)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	conds, args, sortBy := utils.KeysetConditions(page, listTableName, listTableId, false)
+	stmt = fmt.Sprintf(`SELECT %s FROM %s`, strings.Join(listColumns, ", "), listTable)
+	if len(conds) > 0 {
+		stmt += fmt.Sprintf(` WHERE %s`, strings.Join(conds, " AND "))
+	}
+	stmt += " " + sortBy
+	if rowLimit := utils.RowLimit(page); rowLimit > 0 {
+		stmt += ` LIMIT ?`
+		args = append(args, rowLimit)
+	}
+	var entities []structures.ListEntity
+	err = r.executor(ctx).Select(&entities, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
 	}
+	utils.ReverseLastPage(page, entities)
 
-	listModels := make([]*structures.ListModel, len(listIds))
-	for i, id := range listIds {
-		listModels[i], err = r.GetListById(ctx, id)
+	entities, pageInfo := utils.PageItems(page, entities, listCursor)
+	pageInfo.TotalCount = totalCount
+	listModels := make([]*structures.ListModel, len(entities))
+	for i, entity := range entities {
+		listModels[i], err = r.GetListById(ctx, entity.Id)
 		if err != nil {
-			return nil
+			return nil, err
 		}
 	}
 
-	return listModels
+	return &structures.ListPageModel{Lists: listModels, PageInfo: pageInfo}, nil
 }
 
 func (r *DBRepositoryList) GetListOwner(ctx context.Context, listId uuid.UUID) (*structures.UserModel, error) {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	cond := fmt.Sprintf(`%s = TRUE AND %s = ?`, usersListsTableIsOwner, usersListsTableListId)
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, usersListsTableRole, usersListsTableListId)
 	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(usersListsColumns, ", "), usersListsTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var userEntity structures.ListUserEntity
-	err := r.db.Get(&userEntity, query, listId)
+	err := r.executor(ctx).Get(&userEntity, query, utils.Owner, listId)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = errors.New(fmt.Sprintf("error getting owner of list with id: %s", listId))
 		log.Error(err)
@@ -108,7 +232,7 @@ func (r *DBRepositoryList) GetListOwner(ctx context.Context, listId uuid.UUID) (
 	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, listTableName, listTable, cond)
 	query = sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var listName string
-	err = r.db.Get(&listName, query, listId)
+	err = r.executor(ctx).Get(&listName, query, listId)
 	if errors.Is(err, sql.ErrNoRows) {
 		err := errors.New(fmt.Sprintf("error getting name of list with id: %s", listId))
 		log.Error(err)
@@ -126,7 +250,7 @@ func (r *DBRepositoryList) GetUserFromListById(ctx context.Context, listId uuid.
 	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(usersListsColumns, ", "), usersListsTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var userEntity structures.ListUserEntity
-	err := r.db.Get(&userEntity, query, listId, username)
+	err := r.executor(ctx).Get(&userEntity, query, listId, username)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = errors.New(fmt.Sprintf("error getting user of list with id: %s", listId))
 		log.Error(err)
@@ -137,7 +261,7 @@ func (r *DBRepositoryList) GetUserFromListById(ctx context.Context, listId uuid.
 	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, listTableName, listTable, cond)
 	query = sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var listName string
-	err = r.db.Get(&listName, query, listId)
+	err = r.executor(ctx).Get(&listName, query, listId)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = errors.New(fmt.Sprintf("error getting name of list with id: %s", listId))
 		return nil, err
@@ -148,18 +272,21 @@ func (r *DBRepositoryList) GetUserFromListById(ctx context.Context, listId uuid.
 }
 
 func (r *DBRepositoryList) CreateList(ctx context.Context, entityList structures.ListEntity, entityUser structures.ListUserEntity) error {
-	log := ctx.Value(utils.Logger).(*logrus.Entry)
-
-	tx, err := r.db.Beginx()
+	err := r.insertList(ctx, entityList, entityUser)
 	if err != nil {
-		log.Error(err)
 		return err
 	}
-	defer tx.Rollback()
+
+	return r.insertWorkflow(ctx, utils.DefaultWorkflow(entityList.Id))
+}
+
+// insertList adds the list with its owner, without a workflow.
+func (r *DBRepositoryList) insertList(ctx context.Context, entityList structures.ListEntity, entityUser structures.ListUserEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
 	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?)`, listTable, strings.Join(insertListColumn, ", "))
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := tx.Exec(query, entityList.Id, entityList.Name)
+	result, err := r.executor(ctx).Exec(query, entityList.Id, entityList.Name)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error already exists list with this name %s", entityList.Name))
@@ -182,7 +309,7 @@ func (r *DBRepositoryList) CreateList(ctx context.Context, entityList structures
 
 	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?)`, usersListsTable, strings.Join(insertUsersListsColumn, ", "))
 	query = sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err = tx.Exec(query, entityUser.ListId, entityUser.Username, entityUser.IsOwner)
+	result, err = r.executor(ctx).Exec(query, entityUser.ListId, entityUser.Username, entityUser.Role)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error already exists user with this name %s in list with id: %s", entityUser.Username, entityUser.ListId))
@@ -205,27 +332,15 @@ func (r *DBRepositoryList) CreateList(ctx context.Context, entityList structures
 		return err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Error(err)
-	}
-
-	return err
+	return nil
 }
 
 func (r *DBRepositoryList) AddUserToList(ctx context.Context, entityUser structures.ListUserEntity) error {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	tx, err := r.db.Beginx()
-	if err != nil {
-		log.Error(err)
-		return err
-	}
-	defer tx.Rollback()
-
 	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?)`, usersListsTable, strings.Join(insertUsersListsColumn, ", "))
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := r.db.Exec(query, entityUser.ListId, entityUser.Username, entityUser.IsOwner)
+	result, err := r.executor(ctx).Exec(query, entityUser.ListId, entityUser.Username, entityUser.Role)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error already exists user with this name %s in list with id: %s", entityUser.Username, entityUser.ListId))
@@ -248,23 +363,16 @@ func (r *DBRepositoryList) AddUserToList(ctx context.Context, entityUser structu
 		return err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Error(err)
-	}
-
-	return err
+	return nil
 }
 
 func (r *DBRepositoryList) DeleteList(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error) {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	tx, err := r.db.Beginx()
+	err := r.lockList(ctx, listId)
 	if err != nil {
-		log.Error(err)
 		return nil, err
 	}
-	defer tx.Rollback()
 
 	toBeDeleted, err := r.GetListById(ctx, listId)
 	if err != nil {
@@ -276,7 +384,7 @@ func (r *DBRepositoryList) DeleteList(ctx context.Context, listId uuid.UUID) (*s
 	cond := fmt.Sprintf(`%s = ?`, listTableId)
 	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, listTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := r.db.Exec(query, listId)
+	result, err := r.executor(ctx).Exec(query, listId)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error not found list with id: %s", listId))
@@ -297,18 +405,13 @@ func (r *DBRepositoryList) DeleteList(ctx context.Context, listId uuid.UUID) (*s
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Error(err)
-	}
-
-	return toBeDeleted, err
+	return toBeDeleted, nil
 }
 
 func (r *DBRepositoryList) RemoveUserUserFromList(ctx context.Context, entityUser structures.ListUserEntity) (*structures.UserModel, error) {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	if entityUser.IsOwner {
+	if entityUser.Role == utils.Owner {
 		deletedList, err := r.DeleteList(ctx, entityUser.ListId)
 		if err != nil {
 			log.Error(err)
@@ -319,21 +422,20 @@ func (r *DBRepositoryList) RemoveUserUserFromList(ctx context.Context, entityUse
 			ListId:   deletedList.Id,
 			ListName: deletedList.Name,
 			Username: entityUser.Username,
-			IsOwner:  entityUser.IsOwner,
+			Role:     entityUser.Role,
+			IsOwner:  true,
 		}
 		return &deletedOwner, nil
 	}
 
-	tx, err := r.db.Beginx()
+	err := r.lockList(ctx, entityUser.ListId)
 	if err != nil {
-		log.Error(err)
 		return nil, err
 	}
-	defer tx.Rollback()
 
 	removingFromList, err := r.GetListById(ctx, entityUser.ListId)
 	if err != nil {
-		err = errors.New(fmt.Sprintf("error not found list with id: %s", listId))
+		err = errors.New(fmt.Sprintf("error not found list with id: %s", entityUser.ListId))
 		log.Error(err)
 		return nil, err
 	}
@@ -341,7 +443,7 @@ func (r *DBRepositoryList) RemoveUserUserFromList(ctx context.Context, entityUse
 	cond := fmt.Sprintf(`%s = ? AND %s = ?`, usersListTableUsername, usersListsTableListId)
 	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, usersListsTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := r.db.Exec(query, entityUser.Username, entityUser.ListId)
+	result, err := r.executor(ctx).Exec(query, entityUser.Username, entityUser.ListId)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error not found list with id: %s", entityUser.ListId))
@@ -363,16 +465,12 @@ func (r *DBRepositoryList) RemoveUserUserFromList(ctx context.Context, entityUse
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Error(err)
-	}
-
 	removedUser := structures.UserModel{
 		ListId:   removingFromList.Id,
 		ListName: removingFromList.Name,
 		Username: entityUser.Username,
-		IsOwner:  entityUser.IsOwner,
+		Role:     entityUser.Role,
+		IsOwner:  false,
 	}
 	return &removedUser, nil
 }
@@ -386,17 +484,15 @@ func (r *DBRepositoryList) UpdateList(ctx context.Context, listId uuid.UUID, new
 		return nil, err
 	}
 
-	tx, err := r.db.Beginx()
+	err := r.lockList(ctx, listId)
 	if err != nil {
-		log.Error(err)
 		return nil, err
 	}
-	defer tx.Rollback()
 
 	cond := fmt.Sprintf(`%s = ?`, listTableId)
 	stmt := fmt.Sprintf(`UPDATE %s SET %s = ? WHERE %s`, listTable, listTableName, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := r.db.Exec(query, newListName, listId)
+	result, err := r.executor(ctx).Exec(query, newListName, listId)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error not found list with id: %s", listId))
@@ -419,13 +515,7 @@ func (r *DBRepositoryList) UpdateList(ctx context.Context, listId uuid.UUID, new
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Error(err)
-	}
-
-	updatedList, err := r.GetListById(ctx, listId)
-	return updatedList, err
+	return r.GetListById(ctx, listId)
 }
 
 func (r *DBRepositoryList) CheckIfListExists(ctx context.Context, listId uuid.UUID) bool {
@@ -433,7 +523,7 @@ func (r *DBRepositoryList) CheckIfListExists(ctx context.Context, listId uuid.UU
 	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s WHERE %s`, listTableId, listTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var count int
-	err := r.db.Get(&count, query, listId)
+	err := r.executor(ctx).Get(&count, query, listId)
 	if errors.Is(err, sql.ErrNoRows) {
 		return false
 	}
@@ -446,10 +536,665 @@ func (r *DBRepositoryList) ContainsUserInList(ctx context.Context, listId uuid.U
 	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s WHERE %s`, usersListTableUsername, usersListsTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var count int
-	err := r.db.Get(&count, query, username, listId)
+	err := r.executor(ctx).Get(&count, query, username, listId)
 	if errors.Is(err, sql.ErrNoRows) {
 		return false
 	}
 
 	return count == 1
 }
+
+func (r *DBRepositoryList) GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string {
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, usersListTableUsername, usersListsTableListId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, usersListsTableRole, usersListsTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var role string
+	err := r.executor(ctx).Get(&role, query, username, listId)
+	if err != nil {
+		return utils.Unknown
+	}
+
+	return role
+}
+
+func (r *DBRepositoryList) GetListsOfUser(ctx context.Context, username string) []*structures.UserModel {
+	join := fmt.Sprintf(`JOIN %s ON %s.%s = %s.%s`, listTable, listTable, listTableId, usersListsTable, usersListsTableListId)
+	cond := fmt.Sprintf(`%s.%s = ?`, usersListsTable, usersListTableUsername)
+	sortBy := fmt.Sprintf(`ORDER BY %s.%s`, listTable, listTableName)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s %s WHERE %s %s`,
+		strings.Join(userListsColumns, ", "), usersListsTable, join, cond, sortBy)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var entities []structures.UserListEntity
+	err := r.executor(ctx).Select(&entities, query, username)
+	if err != nil {
+		return nil
+	}
+
+	return r.convertor.ConvertUserListEntitiesToModels(entities)
+}
+
+func (r *DBRepositoryList) GetLabels(ctx context.Context, listId uuid.UUID) ([]structures.LabelModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ?`, labelTableListId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY %s`, strings.Join(labelColumns, ", "), labelTable, cond, labelTableName)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var entities []structures.LabelEntity
+	err := r.executor(ctx).Select(&entities, query, listId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.convertor.ConvertLabelEntitiesToModels(entities), nil
+}
+
+func (r *DBRepositoryList) getLabel(ctx context.Context, labelId, listId uuid.UUID) (*structures.LabelEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, labelTableId, labelTableListId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(labelColumns, ", "), labelTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var labelEntity structures.LabelEntity
+	err := r.executor(ctx).Get(&labelEntity, query, labelId, listId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found label with id %s in list with id: %s", labelId, listId))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	return &labelEntity, nil
+}
+
+func (r *DBRepositoryList) CreateLabel(ctx context.Context, entityLabel structures.LabelEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?, ?)`, labelTable, strings.Join(labelColumns, ", "))
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, entityLabel.Id, entityLabel.ListId, entityLabel.Name, entityLabel.Color)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists label with name %s in list with id: %s", entityLabel.Name, entityLabel.ListId))
+		} else if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error not found list with id: %s", entityLabel.ListId))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error creating label with name %s", entityLabel.Name))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositoryList) UpdateLabel(ctx context.Context, entityLabel structures.LabelEntity) (*structures.LabelModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, labelTableId, labelTableListId)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s WHERE %s`, labelTable, strings.Join(updateSetLabelColumns, ", "), cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, entityLabel.Name, entityLabel.Color, entityLabel.Id, entityLabel.ListId)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists label with name %s in list with id: %s", entityLabel.Name, entityLabel.ListId))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found label with id %s in list with id: %s", entityLabel.Id, entityLabel.ListId))
+		log.Error(err)
+		return nil, err
+	}
+
+	labelModel := r.convertor.ConvertLabelEntityToModel(entityLabel)
+	return &labelModel, nil
+}
+
+func (r *DBRepositoryList) DeleteLabel(ctx context.Context, labelId, listId uuid.UUID) (*structures.LabelModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	labelEntity, err := r.getLabel(ctx, labelId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, labelTableId, labelTableListId)
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, labelTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, labelId, listId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error deleting label with id: %s", labelId))
+		log.Error(err)
+		return nil, err
+	}
+
+	labelModel := r.convertor.ConvertLabelEntityToModel(*labelEntity)
+	return &labelModel, nil
+}
+
+func (r *DBRepositoryList) GetWorkflow(ctx context.Context, listId uuid.UUID) (*structures.WorkflowModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	workflowEntity := structures.WorkflowEntity{ListId: listId}
+	cond := fmt.Sprintf(`%s = ?`, workflowStateListId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY %s`, strings.Join(workflowStateColumns, ", "), workflowStateTable, cond, workflowStatePosition)
+	err := r.executor(ctx).Select(&workflowEntity.States, sqlx.Rebind(sqlx.DOLLAR, stmt), listId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if len(workflowEntity.States) == 0 {
+		err = errors.New(fmt.Sprintf("error not found workflow of list with id: %s", listId))
+		log.Error(err)
+		return nil, err
+	}
+
+	cond = fmt.Sprintf(`%s = ?`, workflowTransitionListId)
+	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(workflowTransitionColumns, ", "), workflowTransitionTable, cond)
+	err = r.executor(ctx).Select(&workflowEntity.Transitions, sqlx.Rebind(sqlx.DOLLAR, stmt), listId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.convertor.ConvertWorkflowEntityToModel(workflowEntity), nil
+}
+
+// UpdateWorkflow replaces the workflow of the list. It fails while todos of the list are in a status the new workflow drops.
+func (r *DBRepositoryList) UpdateWorkflow(ctx context.Context, workflowEntity structures.WorkflowEntity) (*structures.WorkflowModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.lockList(ctx, workflowEntity.ListId)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := fmt.Sprintf(`%s = ?`, workflowStateListId)
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, workflowStateTable, cond)
+	_, err = r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), workflowEntity.ListId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	err = r.insertWorkflow(ctx, workflowEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt = `SELECT DISTINCT status FROM todo WHERE list_id = ? AND status NOT IN (SELECT name FROM workflow_state WHERE list_id = ?) ORDER BY status`
+	var droppedStatuses []string
+	err = r.executor(ctx).Select(&droppedStatuses, sqlx.Rebind(sqlx.DOLLAR, stmt), workflowEntity.ListId, workflowEntity.ListId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if len(droppedStatuses) > 0 {
+		err = errors.New(fmt.Sprintf("error updating workflow of list with id: %s because todos are still in status: %s",
+			workflowEntity.ListId, strings.Join(droppedStatuses, ", ")))
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.convertor.ConvertWorkflowEntityToModel(workflowEntity), nil
+}
+
+func (r *DBRepositoryList) insertWorkflow(ctx context.Context, workflowEntity structures.WorkflowEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	values := make([]string, len(workflowEntity.States))
+	var args []any
+	for i, state := range workflowEntity.States {
+		values[i] = `(?, ?, ?, ?)`
+		args = append(args, workflowEntity.ListId, state.Name, state.Position, state.Done)
+	}
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES %s`, workflowStateTable, strings.Join(workflowStateColumns, ", "), strings.Join(values, ", "))
+	_, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error not found list with id: %s", workflowEntity.ListId))
+		}
+
+		log.Error(err)
+		return err
+	}
+	if len(workflowEntity.Transitions) == 0 {
+		return nil
+	}
+
+	values = make([]string, len(workflowEntity.Transitions))
+	args = nil
+	for i, transition := range workflowEntity.Transitions {
+		values[i] = `(?, ?, ?)`
+		args = append(args, workflowEntity.ListId, transition.From, transition.To)
+	}
+	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES %s`, workflowTransitionTable, strings.Join(workflowTransitionColumns, ", "), strings.Join(values, ", "))
+	_, err = r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// DuplicateList copies the list under the name and the owner of the new one, with its workflow, labels and todos.
+// The members come along only when asked, the owner of the list then becoming a manager, and the copied todos keep the
+// assignees and watchers who are members of the copy. Comments and the audit log stay with the list.
+func (r *DBRepositoryList) DuplicateList(ctx context.Context, listId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, includeMembers bool) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.lockList(ctx, listId)
+	if err != nil {
+		return err
+	}
+	err = r.insertList(ctx, entityList, entityUser)
+	if err != nil {
+		return err
+	}
+
+	if includeMembers {
+		stmt := fmt.Sprintf(`INSERT INTO %[1]s(%[2]s) SELECT ?, %[3]s, CASE WHEN %[4]s = ? THEN ? ELSE %[4]s END FROM %[1]s WHERE %[5]s = ? AND %[3]s <> ?`,
+			usersListsTable, strings.Join(insertUsersListsColumn, ", "), usersListsTableUsername, usersListsTableRole, usersListsTableListId)
+		err = r.execute(ctx, stmt, entityList.Id, utils.Owner, utils.Manager, listId, entityUser.Username)
+		if err != nil {
+			return err
+		}
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO %[1]s(%[2]s) SELECT ?, name, position, done FROM %[1]s WHERE %[3]s = ?`,
+		workflowStateTable, strings.Join(workflowStateColumns, ", "), workflowStateListId)
+	err = r.execute(ctx, stmt, entityList.Id, listId)
+	if err != nil {
+		return err
+	}
+	stmt = fmt.Sprintf(`INSERT INTO %[1]s(%[2]s) SELECT ?, from_state, to_state FROM %[1]s WHERE %[3]s = ?`,
+		workflowTransitionTable, strings.Join(workflowTransitionColumns, ", "), workflowTransitionListId)
+	err = r.execute(ctx, stmt, entityList.Id, listId)
+	if err != nil {
+		return err
+	}
+	labelModels, err := r.GetLabels(ctx, listId)
+	if err != nil {
+		return err
+	}
+	labelIds := make(map[uuid.UUID]uuid.UUID, len(labelModels))
+	for _, labelModel := range labelModels {
+		labelIds[labelModel.Id] = uuid.New()
+		err = r.CreateLabel(ctx, structures.LabelEntity{Id: labelIds[labelModel.Id], ListId: entityList.Id, Name: labelModel.Name, Color: labelModel.Color})
+		if err != nil {
+			return err
+		}
+	}
+
+	var members []string
+	err = r.executor(ctx).Select(&members, sqlx.Rebind(sqlx.DOLLAR, listMembersQuery), entityList.Id)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	stmt = fmt.Sprintf(`SELECT %s, %s FROM %s WHERE %s = ? ORDER BY %s, %s`,
+		todoTableId, todoTableSeriesId, todoTable, todoTableListId, todoTableCreatedAt, todoTableId)
+	var todoEntities []structures.TodoEntity
+	err = r.executor(ctx).Select(&todoEntities, sqlx.Rebind(sqlx.DOLLAR, stmt), listId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	copies := make(map[uuid.UUID]uuid.UUID, len(todoEntities))
+	series := make(map[uuid.UUID]uuid.UUID)
+	for _, todoEntity := range todoEntities {
+		copyId := uuid.New()
+		copies[todoEntity.Id] = copyId
+		seriesId := uuid.NullUUID{}
+		if todoEntity.SeriesId.Valid {
+			if _, ok := series[todoEntity.SeriesId.UUID]; !ok {
+				series[todoEntity.SeriesId.UUID] = uuid.New()
+			}
+			seriesId = uuid.NullUUID{UUID: series[todoEntity.SeriesId.UUID], Valid: true}
+		}
+
+		err = r.copyTodo(ctx, todoEntity.Id, copyId, entityList.Id, seriesId, labelIds, members)
+		if err != nil {
+			return err
+		}
+	}
+
+	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s IN (SELECT %s FROM %s WHERE %s = ?)`,
+		strings.Join(todoDependencyColumns, ", "), todoDependencyTable, todoDependencyTodoId, todoTableId, todoTable, todoTableListId)
+	var dependencies []structures.TodoDependencyEntity
+	err = r.executor(ctx).Select(&dependencies, sqlx.Rebind(sqlx.DOLLAR, stmt), listId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?)`, todoDependencyTable, strings.Join(todoDependencyColumns, ", "))
+	for _, dependency := range dependencies {
+		// The blockers in other lists stay with the original todos.
+		blockerId, blockerCopied := copies[dependency.BlockedById]
+		if !blockerCopied {
+			continue
+		}
+		err = r.execute(ctx, stmt, copies[dependency.TodoId], blockerId)
+		if err != nil {
+			return err
+		}
+	}
+
+	initialState := fmt.Sprintf(`SELECT name FROM %s WHERE %s = ? ORDER BY %s LIMIT 1`, workflowStateTable, workflowStateListId, workflowStatePosition)
+	assignedTodos := fmt.Sprintf(`SELECT %s FROM %s`, todoUserTableTodoId, todoAssigneeTable)
+	stmt = fmt.Sprintf(`UPDATE %[1]s SET %[2]s = (%[3]s) WHERE %[4]s = ? AND %[2]s = ? AND %[5]s NOT IN (%[6]s)`,
+		todoTable, todoTableStatus, initialState, todoTableListId, todoTableId, assignedTodos)
+	return r.execute(ctx, stmt, entityList.Id, entityList.Id, utils.Assigned)
+}
+
+// copyTodo copies the todo into the list, with the copies labelIds maps its labels to, its subtasks and the assignees
+// and watchers among the members of the list.
+func (r *DBRepositoryList) copyTodo(ctx context.Context, todoId, copyId, listId uuid.UUID, seriesId uuid.NullUUID, labelIds map[uuid.UUID]uuid.UUID, members []string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := fmt.Sprintf(`INSERT INTO %[1]s(%[2]s, %[3]s, %[4]s, %[5]s) SELECT ?, ?, ?, %[5]s FROM %[1]s WHERE %[2]s = ?`,
+		todoTable, todoTableId, todoTableListId, todoTableSeriesId, strings.Join(copyTodoColumns, ", "))
+	err := r.execute(ctx, stmt, copyId, listId, seriesId, todoId)
+	if err != nil {
+		return err
+	}
+
+	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s = ?`, todoLabelTableLabelId, todoLabelTable, todoLabelTableTodoId)
+	var todoLabelIds []uuid.UUID
+	err = r.executor(ctx).Select(&todoLabelIds, sqlx.Rebind(sqlx.DOLLAR, stmt), todoId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?)`, todoLabelTable, strings.Join(todoLabelColumns, ", "))
+	for _, labelId := range todoLabelIds {
+		err = r.execute(ctx, stmt, copyId, labelIds[labelId])
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, table := range []string{todoAssigneeTable, todoWatcherTable} {
+		stmt = fmt.Sprintf(`INSERT INTO %[1]s(%[2]s) SELECT ?, %[3]s FROM %[1]s WHERE %[4]s = ? AND %[3]s IN (%[5]s)`,
+			table, strings.Join(todoUserColumns, ", "), todoUserTableUsername, todoUserTableTodoId, listMembersQuery)
+		err = r.execute(ctx, stmt, copyId, todoId, listId)
+		if err != nil {
+			return err
+		}
+	}
+
+	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s = ? ORDER BY %s`,
+		strings.Join(subtaskColumns, ", "), subtaskTable, subtaskTableTodoId, subtaskTablePosition)
+	var subtasks []structures.SubtaskEntity
+	err = r.executor(ctx).Select(&subtasks, sqlx.Rebind(sqlx.DOLLAR, stmt), todoId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?, ?, ?, ?)`, subtaskTable, strings.Join(subtaskColumns, ", "))
+	for _, subtask := range subtasks {
+		if !slices.Contains(members, subtask.Assignee) {
+			subtask.Assignee = ""
+		}
+		err = r.execute(ctx, stmt, uuid.New(), copyId, subtask.Title, subtask.Done, subtask.Assignee, subtask.Position)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTemplates returns the templates saved by the owner, or every template when the owner is empty.
+func (r *DBRepositoryList) GetTemplates(ctx context.Context, owner string) ([]structures.TemplateModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := "TRUE"
+	var args []any
+	if owner != "" {
+		cond = fmt.Sprintf(`%s = ?`, templateTableOwner)
+		args = append(args, owner)
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY %s`, templateTableId, templateTable, cond, templateTableName)
+	var templateIds []uuid.UUID
+	err := r.executor(ctx).Select(&templateIds, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	templateModels := make([]structures.TemplateModel, len(templateIds))
+	for i, templateId := range templateIds {
+		templateEntity, err := r.getTemplate(ctx, templateId)
+		if err != nil {
+			return nil, err
+		}
+		templateModels[i] = *r.convertor.ConvertTemplateEntityToModel(*templateEntity)
+	}
+
+	return templateModels, nil
+}
+
+func (r *DBRepositoryList) GetTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateModel, error) {
+	templateEntity, err := r.getTemplate(ctx, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertor.ConvertTemplateEntityToModel(*templateEntity), nil
+}
+
+func (r *DBRepositoryList) getTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ?`, templateTableId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(templateColumns, ", "), templateTable, cond)
+	var templateEntity structures.TemplateEntity
+	err := r.executor(ctx).Get(&templateEntity, sqlx.Rebind(sqlx.DOLLAR, stmt), templateId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found template with id: %s", templateId))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	cond = fmt.Sprintf(`%s = ?`, templateTodoTemplateId)
+	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY %s`, strings.Join(templateTodoColumns, ", "), templateTodoTable, cond, templateTodoPosition)
+	err = r.executor(ctx).Select(&templateEntity.Todos, sqlx.Rebind(sqlx.DOLLAR, stmt), templateId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return &templateEntity, nil
+}
+
+// CreateTemplate saves the workflow, the labels and the todos of the list as a template. The deadlines of the todos
+// become days after the start date, the earliest of them when it is nil.
+func (r *DBRepositoryList) CreateTemplate(ctx context.Context, listId uuid.UUID, entityTemplate structures.TemplateEntity, startDate *time.Time) (*structures.TemplateModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.lockList(ctx, listId)
+	if err != nil {
+		return nil, err
+	}
+	workflowModel, err := r.GetWorkflow(ctx, listId)
+	if err != nil {
+		return nil, err
+	}
+	labelModels, err := r.GetLabels(ctx, listId)
+	if err != nil {
+		return nil, err
+	}
+	entityTemplate.Workflow = r.convertor.ConvertWorkflowModelToTemplate(workflowModel)
+	entityTemplate.Labels = r.convertor.ConvertLabelModelsToTemplate(labelModels)
+
+	err = r.executor(ctx).Select(&entityTemplate.Todos, sqlx.Rebind(sqlx.DOLLAR, templateTodosQuery), startDate, listId, listId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?, ?, ?)`, templateTable, strings.Join(insertTemplateColumns, ", "))
+	_, err = r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), entityTemplate.Id, entityTemplate.Name, entityTemplate.Owner,
+		entityTemplate.Workflow, entityTemplate.Labels)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists template with this name %s", entityTemplate.Name))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	if len(entityTemplate.Todos) > 0 {
+		values := make([]string, len(entityTemplate.Todos))
+		var args []any
+		for i, todo := range entityTemplate.Todos {
+			values[i] = `(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+			args = append(args, entityTemplate.Id, i, todo.Name, todo.Description, todo.Priority, todo.Recurrence, todo.DeadlineOffset,
+				todo.Labels, todo.Subtasks)
+		}
+		stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES %s`, templateTodoTable, strings.Join(templateTodoColumns, ", "), strings.Join(values, ", "))
+		err = r.execute(ctx, stmt, args...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r.GetTemplate(ctx, entityTemplate.Id)
+}
+
+func (r *DBRepositoryList) DeleteTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	toBeDeleted, err := r.GetTemplate(ctx, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := fmt.Sprintf(`%s = ?`, templateTableId)
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, templateTable, cond)
+	result, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), templateId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error deleting template with id: %s", templateId))
+		log.Error(err)
+		return nil, err
+	}
+
+	return toBeDeleted, nil
+}
+
+// InstantiateTemplate creates the list from the template. Its todos start in the first status of the workflow, with
+// their deadlines the given number of days after the start date.
+func (r *DBRepositoryList) InstantiateTemplate(ctx context.Context, templateId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, startDate time.Time) error {
+	templateEntity, err := r.getTemplate(ctx, templateId)
+	if err != nil {
+		return err
+	}
+	err = r.insertList(ctx, entityList, entityUser)
+	if err != nil {
+		return err
+	}
+	workflowEntity := r.convertor.ConvertTemplateToWorkflowEntity(entityList.Id, templateEntity.Workflow)
+	err = r.insertWorkflow(ctx, workflowEntity)
+	if err != nil {
+		return err
+	}
+
+	labelIds := make(map[string]uuid.UUID, len(templateEntity.Labels))
+	for _, label := range templateEntity.Labels {
+		labelIds[label.Name] = uuid.New()
+		err = r.CreateLabel(ctx, structures.LabelEntity{Id: labelIds[label.Name], ListId: entityList.Id, Name: label.Name, Color: label.Color})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, todo := range templateEntity.Todos {
+		todoId := uuid.New()
+		seriesId := uuid.NullUUID{}
+		if todo.Recurrence != "" {
+			seriesId = uuid.NullUUID{UUID: todoId, Valid: true}
+		}
+
+		stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, todoTable, strings.Join(insertTodoColumns, ", "))
+		err = r.execute(ctx, stmt, todoId, entityList.Id, todo.Name, todo.Description, startDate.AddDate(0, 0, todo.DeadlineOffset),
+			todo.Priority, workflowEntity.States[0].Name, todo.Recurrence, seriesId)
+		if err != nil {
+			return err
+		}
+		stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?)`, todoLabelTable, strings.Join(todoLabelColumns, ", "))
+		for _, label := range todo.Labels {
+			err = r.execute(ctx, stmt, todoId, labelIds[label])
+			if err != nil {
+				return err
+			}
+		}
+		stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?, ?, ?, ?)`, subtaskTable, strings.Join(subtaskColumns, ", "))
+		for position, title := range todo.Subtasks {
+			err = r.execute(ctx, stmt, uuid.New(), todoId, title, false, "", position)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *DBRepositoryList) execute(ctx context.Context, stmt string, args ...any) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	_, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return err
+}
@@ -1,13 +1,15 @@
 package list_test
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"github.com/google/uuid"
-	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	sqlxmock "github.com/zhashkevych/go-sqlxmock"
 	"project/list"
 	"project/structures"
+	"project/uow"
 	"project/utils"
 	"regexp"
 	"testing"
@@ -87,41 +89,113 @@ func TestRepositoryGetAll(t *testing.T) {
 	convertor := list.NewRepositoryListConvertor()
 	repo := list.NewDBRepositoryList(db, *convertor)
 	ctx := utils.HelperGetContext()
+	listColumns := []string{"id", "name", "created_at"}
+	expectGetListById := func(listId uuid.UUID, listName string) {
+		mock.ExpectQuery(`SELECT id, name, created_at FROM list WHERE id = \$1`).
+			WithArgs(listId).
+			WillReturnRows(sqlxmock.NewRows(listColumns).AddRow(listId, listName, time.Time{}))
+		mock.ExpectQuery(`SELECT list_id, username, role FROM users_lists WHERE role = \$1 AND list_id = \$2`).
+			WithArgs(utils.Owner, listId).
+			WillReturnRows(sqlxmock.NewRows([]string{"list_id", "username", "role"}).AddRow(listId, utils.TestUsername, utils.Owner))
+		mock.ExpectQuery(`SELECT name FROM list WHERE id = \$1`).
+			WithArgs(listId).
+			WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(listName))
+		mock.ExpectQuery(`SELECT username FROM users_lists WHERE list_id = \$1`).
+			WithArgs(listId).
+			WillReturnRows(sqlxmock.NewRows([]string{"username"}).AddRow(utils.TestUsername))
+	}
+	cursor := &structures.Cursor{SortBy: utils.SortByName, Value: utils.TestListName + "2", Id: uuid.UUID{2}}
 
 	testCases := []struct {
-		name     string
-		mock     func()
-		expected []string
+		name             string
+		inputPage        structures.PageQuery
+		mock             func()
+		expected         []string
+		expectedPageInfo structures.PageInfo
+		expectedErr      string
 	}{
 		{
 			name: "get all lists",
 			mock: func() {
-				rows := sqlxmock.NewRows([]string{"id"}).
-					AddRow(uuid.UUID{1}).
-					AddRow(uuid.UUID{2})
-				mock.ExpectQuery(`SELECT id FROM list`).
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM list$`).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(2))
+				rows := sqlxmock.NewRows(listColumns).
+					AddRow(uuid.UUID{1}, utils.TestListName+"1", time.Time{}).
+					AddRow(uuid.UUID{2}, utils.TestListName+"2", time.Time{})
+				mock.ExpectQuery(`SELECT id, name, created_at FROM list ORDER BY name ASC, id ASC$`).
 					WillReturnRows(rows)
-
-				rowsList := sqlxmock.NewRows([]string{"id", "name", "created_at"}).
-					AddRow(uuid.UUID{1}, utils.TestListName+"1", time.Now())
-				mock.ExpectQuery(`SELECT id, name, created_at FROM list WHERE id = \$1`).
-					WithArgs(uuid.UUID{1}).
-					WillReturnRows(rowsList)
-
-				rowsList = sqlxmock.NewRows([]string{"id", "name", "created_at"}).
-					AddRow(uuid.UUID{2}, utils.TestListName+"2", time.Now())
-				mock.ExpectQuery(`SELECT id, name, created_at FROM list WHERE id = \$1`).
-					WithArgs(uuid.UUID{2}).
-					WillReturnRows(rowsList)
+				expectGetListById(uuid.UUID{1}, utils.TestListName+"1")
+				expectGetListById(uuid.UUID{2}, utils.TestListName+"2")
+			},
+			expected: []string{utils.TestListName + "1", utils.TestListName + "2"},
+			expectedPageInfo: structures.PageInfo{
+				TotalCount:  2,
+				StartCursor: &structures.Cursor{SortBy: utils.SortByName, Value: utils.TestListName + "1", Id: uuid.UUID{1}},
+				EndCursor:   &structures.Cursor{SortBy: utils.SortByName, Value: utils.TestListName + "2", Id: uuid.UUID{2}},
 			},
-			expected: []string{"TestList1", "TestList2"},
 		}, {
 			name: "empty lists",
 			mock: func() {
-				mock.ExpectQuery(`SELECT id FROM list`).
-					WillReturnRows(sqlxmock.NewRows([]string{"id"}))
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM list$`).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(`SELECT id, name, created_at FROM list ORDER BY name ASC, id ASC$`).
+					WillReturnRows(sqlxmock.NewRows(listColumns))
+			},
+			expected: make([]string, 0),
+		}, {
+			name:      "first lists after a cursor",
+			inputPage: structures.PageQuery{Limit: 1, After: cursor},
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM list$`).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(4))
+				rows := sqlxmock.NewRows(listColumns).
+					AddRow(uuid.UUID{3}, utils.TestListName+"3", time.Time{}).
+					AddRow(uuid.UUID{4}, utils.TestListName+"4", time.Time{})
+				mock.ExpectQuery(`SELECT id, name, created_at FROM list WHERE \(name, id\) > \(\$1, \$2\) ORDER BY name ASC, id ASC LIMIT \$3$`).
+					WithArgs(cursor.Value, cursor.Id, 2).
+					WillReturnRows(rows)
+				expectGetListById(uuid.UUID{3}, utils.TestListName+"3")
+			},
+			expected: []string{utils.TestListName + "3"},
+			expectedPageInfo: structures.PageInfo{
+				TotalCount:      4,
+				StartCursor:     &structures.Cursor{SortBy: utils.SortByName, Value: utils.TestListName + "3", Id: uuid.UUID{3}},
+				EndCursor:       &structures.Cursor{SortBy: utils.SortByName, Value: utils.TestListName + "3", Id: uuid.UUID{3}},
+				HasNextPage:     true,
+				HasPreviousPage: true,
+			},
+		}, {
+			name:      "last lists before a cursor",
+			inputPage: structures.PageQuery{Last: 5, Before: cursor},
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM list$`).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(4))
+				rows := sqlxmock.NewRows(listColumns).
+					AddRow(uuid.UUID{1}, utils.TestListName+"1", time.Time{})
+				mock.ExpectQuery(`SELECT id, name, created_at FROM list WHERE \(name, id\) < \(\$1, \$2\) ORDER BY name DESC, id DESC LIMIT \$3$`).
+					WithArgs(cursor.Value, cursor.Id, 6).
+					WillReturnRows(rows)
+				expectGetListById(uuid.UUID{1}, utils.TestListName+"1")
+			},
+			expected: []string{utils.TestListName + "1"},
+			expectedPageInfo: structures.PageInfo{
+				TotalCount:  4,
+				StartCursor: &structures.Cursor{SortBy: utils.SortByName, Value: utils.TestListName + "1", Id: uuid.UUID{1}},
+				EndCursor:   &structures.Cursor{SortBy: utils.SortByName, Value: utils.TestListName + "1", Id: uuid.UUID{1}},
+				HasNextPage: true,
+			},
+		}, {
+			name:        "cursor of another order",
+			inputPage:   structures.PageQuery{After: &structures.Cursor{SortBy: utils.SortByDeadline}},
+			mock:        func() {},
+			expectedErr: utils.InvalidCursorErrorMsg,
+		}, {
+			name: "counting lists failed",
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM list$`).
+					WillReturnError(errors.New("connection refused"))
 			},
-			expected: []string(nil),
+			expectedErr: "connection refused",
 		},
 	}
 
@@ -129,10 +203,20 @@ func TestRepositoryGetAll(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			actual := repo.GetAllLists(ctx)
-			for i, expected := range testCase.expected {
-				assert.Contains(t, actual[i].Name, expected)
+			actualPage, err := repo.GetAllLists(ctx, testCase.inputPage)
+			require.NoError(t, mock.ExpectationsWereMet())
+			if testCase.expectedErr != "" {
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			actual := make([]string, len(actualPage.Lists))
+			for i, listModel := range actualPage.Lists {
+				actual[i] = listModel.Name
 			}
+			require.Equal(t, testCase.expected, actual)
+			require.Equal(t, testCase.expectedPageInfo, actualPage.PageInfo)
 		})
 	}
 }
@@ -161,9 +245,9 @@ func TestRepositoryGetUser(t *testing.T) {
 			inputListId: utils.TestListId,
 			inputUserId: utils.TestUsername,
 			mock: func() {
-				rows := sqlxmock.NewRows([]string{"list_id", "username", "is_owner"}).
-					AddRow(utils.TestListId, utils.TestUsername, true)
-				mock.ExpectQuery(`SELECT list_id, username, is_owner FROM users_lists WHERE list_id = \$1 AND username = \$2`).
+				rows := sqlxmock.NewRows([]string{"list_id", "username", "role"}).
+					AddRow(utils.TestListId, utils.TestUsername, utils.Owner)
+				mock.ExpectQuery(`SELECT list_id, username, role FROM users_lists WHERE list_id = \$1 AND username = \$2`).
 					WithArgs(utils.TestListId, utils.TestUsername).
 					WillReturnRows(rows)
 
@@ -179,7 +263,7 @@ func TestRepositoryGetUser(t *testing.T) {
 			inputListId: utils.TestListId,
 			inputUserId: utils.TestUsername,
 			mock: func() {
-				mock.ExpectQuery(`SELECT list_id, username, is_owner FROM users_lists WHERE list_id = \$1 AND username = \$2`).
+				mock.ExpectQuery(`SELECT list_id, username, role FROM users_lists WHERE list_id = \$1 AND username = \$2`).
 					WithArgs(utils.TestListId, utils.TestUsername).
 					WillReturnError(errors.New("user TestUser does not exist"))
 			},
@@ -232,10 +316,10 @@ func TestRepositoryGetListOwner(t *testing.T) {
 			name:        "getting existing owner",
 			inputListId: utils.TestListId,
 			mock: func() {
-				rows := sqlxmock.NewRows([]string{"list_id", "username", "is_owner"}).
-					AddRow(utils.TestListId, utils.TestUsername, true)
-				mock.ExpectQuery(`SELECT list_id, username, is_owner FROM users_lists WHERE is_owner = TRUE AND list_id = \$1`).
-					WithArgs(utils.TestListId).
+				rows := sqlxmock.NewRows([]string{"list_id", "username", "role"}).
+					AddRow(utils.TestListId, utils.TestUsername, utils.Owner)
+				mock.ExpectQuery(`SELECT list_id, username, role FROM users_lists WHERE role = \$1 AND list_id = \$2`).
+					WithArgs(utils.Owner, utils.TestListId).
 					WillReturnRows(rows)
 				rows = sqlxmock.NewRows([]string{"name"}).
 					AddRow(utils.TestListName)
@@ -248,9 +332,9 @@ func TestRepositoryGetListOwner(t *testing.T) {
 			name:        "non existing list",
 			inputListId: utils.TestListId,
 			mock: func() {
-				mock.ExpectQuery(`SELECT list_id, username, is_owner FROM users_lists WHERE is_owner = TRUE AND list_id = \$1`).
-					WithArgs(utils.TestListId).
-					WillReturnRows(sqlxmock.NewRows([]string{"list_id", "username", "is_owner"}))
+				mock.ExpectQuery(`SELECT list_id, username, role FROM users_lists WHERE role = \$1 AND list_id = \$2`).
+					WithArgs(utils.Owner, utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"list_id", "username", "role"}))
 			},
 			expectedErr: errors.New("error getting owner of list with id: +."),
 		},
@@ -273,6 +357,29 @@ func TestRepositoryGetListOwner(t *testing.T) {
 	}
 }
 
+func helperExpectLockList(mock sqlxmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT id FROM list WHERE id = \$1 FOR UPDATE`).
+		WithArgs(utils.TestListId).
+		WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow(utils.TestListId))
+}
+
+func helperExpectGetList(mock sqlxmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT id, name, created_at FROM list WHERE id = \$1`).
+		WithArgs(utils.TestListId).
+		WillReturnRows(sqlxmock.NewRows([]string{"id", "name", "created_at"}).
+			AddRow(utils.TestListId, utils.TestListName, time.Now()))
+	mock.ExpectQuery(`SELECT list_id, username, role FROM users_lists WHERE role = \$1 AND list_id = \$2`).
+		WithArgs(utils.Owner, utils.TestListId).
+		WillReturnRows(sqlxmock.NewRows([]string{"list_id", "username", "role"}).
+			AddRow(utils.TestListId, utils.TestUsername, utils.Owner))
+	mock.ExpectQuery(`SELECT name FROM list WHERE id = \$1`).
+		WithArgs(utils.TestListId).
+		WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.TestListName))
+	mock.ExpectQuery(`SELECT username FROM users_lists WHERE list_id = \$1`).
+		WithArgs(utils.TestListId).
+		WillReturnRows(sqlxmock.NewRows([]string{"username"}).AddRow(utils.TestUsername))
+}
+
 func TestRepositoryCreate(t *testing.T) {
 	db, mock, err := sqlxmock.Newx()
 	if err != nil {
@@ -282,6 +389,7 @@ func TestRepositoryCreate(t *testing.T) {
 
 	convertor := list.NewRepositoryListConvertor()
 	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
 	testCases := []struct {
@@ -300,7 +408,7 @@ func TestRepositoryCreate(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  true,
+				Role:     utils.Owner,
 			},
 			mock: func() {
 				mock.ExpectBegin()
@@ -308,11 +416,14 @@ func TestRepositoryCreate(t *testing.T) {
 					WithArgs(utils.TestListId, utils.TestListName).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 
-				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, is_owner\) VALUES \(\$1, \$2, \$3\)`).
-					WithArgs(utils.TestListId, utils.TestUsername, true).
+				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, role\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestListId, utils.TestUsername, utils.Owner).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
-				mock.ExpectCommit()
+
+				mock.ExpectExec(`INSERT INTO workflow_state\(list_id, name, position, done\) VALUES \(\$1, \$2, \$3, \$4\), .+`).
+					WillReturnResult(sqlxmock.NewResult(5, 5))
+				mock.ExpectExec(`INSERT INTO workflow_transition\(list_id, from_state, to_state\) VALUES \(\$1, \$2, \$3\), .+`).
+					WillReturnResult(sqlxmock.NewResult(4, 4))
 				mock.ExpectCommit()
 			},
 			expected: nil,
@@ -325,13 +436,14 @@ func TestRepositoryCreate(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  true,
+				Role:     utils.Owner,
 			},
 			mock: func() {
 				mock.ExpectBegin()
 				mock.ExpectExec(`INSERT INTO list\(id, name\) VALUES \(\$1, \$2\)`).
 					WithArgs(utils.TestListId, utils.TestListName).
 					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
 			},
 			expected: errors.New("error already exists list with this name .+"),
 		}, {
@@ -343,15 +455,39 @@ func TestRepositoryCreate(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  true,
+				Role:     utils.Owner,
 			},
 			mock: func() {
 				mock.ExpectBegin()
 				mock.ExpectExec(`INSERT INTO list\(id, name\) VALUES \(\$1, \$2\)`).
 					WithArgs(utils.TestListId, utils.TestListName).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
 			},
 			expected: errors.New("error creating list with this name .+"),
+		}, {
+			name: "owner connection fails rolls back the created list",
+			inputListEntity: structures.ListEntity{
+				Id:   utils.TestListId,
+				Name: utils.TestListName,
+			},
+			inputUserEntity: structures.ListUserEntity{
+				ListId:   utils.TestListId,
+				Username: utils.TestUsername,
+				Role:     utils.Owner,
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO list\(id, name\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestListId, utils.TestListName).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+
+				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, role\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestListId, utils.TestUsername, utils.Owner).
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expected: errors.New("error already exists user with this name .+ in list with id: .+"),
 		},
 	}
 
@@ -359,15 +495,18 @@ func TestRepositoryCreate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			actual := repo.CreateList(ctx, testCase.inputListEntity, testCase.inputUserEntity)
+			actual := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.CreateList(ctx, testCase.inputListEntity, testCase.inputUserEntity)
+			})
 			if actual != nil {
 				ok, err := regexp.MatchString(testCase.expected.Error(), actual.Error())
 				require.NoError(t, err)
 				require.True(t, ok)
-				return
+			} else {
+				require.Equal(t, testCase.expected, actual)
 			}
 
-			require.Equal(t, testCase.expected, actual)
+			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
@@ -381,6 +520,7 @@ func TestRepositoryAddUser(t *testing.T) {
 
 	convertor := list.NewRepositoryListConvertor()
 	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
 	testCases := []struct {
@@ -394,12 +534,12 @@ func TestRepositoryAddUser(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  false,
+				Role:     utils.Viewer,
 			},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, is_owner\) VALUES \(\$1, \$2, \$3\)`).
-					WithArgs(utils.TestListId, utils.TestUsername, false).
+				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, role\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestListId, utils.TestUsername, utils.Viewer).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
@@ -408,13 +548,14 @@ func TestRepositoryAddUser(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  false,
+				Role:     utils.Viewer,
 			},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, is_owner\) VALUES \(\$1, \$2, \$3\)`).
-					WithArgs(utils.TestListId, utils.TestUsername, false).
+				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, role\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestListId, utils.TestUsername, utils.Viewer).
 					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error already exists user with this name .+ in list with id: .+"),
 		}, {
@@ -422,13 +563,14 @@ func TestRepositoryAddUser(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  false,
+				Role:     utils.Viewer,
 			},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, is_owner\) VALUES \(\$1, \$2, \$3\)`).
-					WithArgs(utils.TestListId, utils.TestUsername, false).
+				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, role\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestListId, utils.TestUsername, utils.Viewer).
 					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error not found list with id: .+"),
 		}, {
@@ -436,13 +578,14 @@ func TestRepositoryAddUser(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  false,
+				Role:     utils.Viewer,
 			},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, is_owner\) VALUES \(\$1, \$2, \$3\)`).
-					WithArgs(utils.TestListId, utils.TestUsername, false).
+				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, role\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestListId, utils.TestUsername, utils.Viewer).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error creating user connection for .+ with list with id: .+"),
 		},
@@ -452,12 +595,13 @@ func TestRepositoryAddUser(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			err := repo.AddUserToList(ctx, testCase.inputUserEntity)
-			if testCase.expectedErr != nil {
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.AddUserToList(ctx, testCase.inputUserEntity)
+			})
+			if err != nil {
 				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, ok)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -476,6 +620,7 @@ func TestRepositoryDelete(t *testing.T) {
 
 	convertor := list.NewRepositoryListConvertor()
 	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
 	testCases := []struct {
@@ -489,6 +634,8 @@ func TestRepositoryDelete(t *testing.T) {
 			inputListId: utils.TestListId,
 			mock: func() {
 				mock.ExpectBegin()
+				helperExpectLockList(mock)
+				helperExpectGetList(mock)
 				mock.ExpectExec(`DELETE FROM list WHERE id = \$1`).
 					WithArgs(utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
@@ -499,9 +646,10 @@ func TestRepositoryDelete(t *testing.T) {
 			inputListId: utils.TestListId,
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`DELETE FROM list WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id FROM list WHERE id = \$1 FOR UPDATE`).
 					WithArgs(utils.TestListId).
-					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error not found list with id: .+"),
 		}, {
@@ -509,10 +657,12 @@ func TestRepositoryDelete(t *testing.T) {
 			inputListId: utils.TestListId,
 			mock: func() {
 				mock.ExpectBegin()
+				helperExpectLockList(mock)
+				helperExpectGetList(mock)
 				mock.ExpectExec(`DELETE FROM list WHERE id = \$1`).
 					WithArgs(utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
-				mock.ExpectCommit()
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error deleting list with id: .+"),
 		},
@@ -522,12 +672,14 @@ func TestRepositoryDelete(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			_, err := repo.DeleteList(ctx, testCase.inputListId)
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				_, err := repo.DeleteList(ctx, testCase.inputListId)
+				return err
+			})
 			if err != nil {
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -546,6 +698,7 @@ func TestRepositoryRemoveUser(t *testing.T) {
 
 	convertor := list.NewRepositoryListConvertor()
 	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
 	testCases := []struct {
@@ -559,27 +712,30 @@ func TestRepositoryRemoveUser(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  false,
+				Role:     utils.Viewer,
 			},
 			mock: func() {
 				mock.ExpectBegin()
+				helperExpectLockList(mock)
+				helperExpectGetList(mock)
 				mock.ExpectExec(`DELETE FROM users_lists WHERE username = \$1 AND list_id = \$2`).
 					WithArgs(utils.TestUsername, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
 		}, {
-			name: "remove not existing user",
+			name: "remove user from not existing list",
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  false,
+				Role:     utils.Viewer,
 			},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`DELETE FROM users_lists WHERE username = \$1 AND list_id = \$2`).
-					WithArgs(utils.TestUsername, utils.TestListId).
-					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+				mock.ExpectQuery(`SELECT id FROM list WHERE id = \$1 FOR UPDATE`).
+					WithArgs(utils.TestListId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error not found list with id: .+"),
 		}, {
@@ -587,10 +743,12 @@ func TestRepositoryRemoveUser(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  true,
+				Role:     utils.Owner,
 			},
 			mock: func() {
 				mock.ExpectBegin()
+				helperExpectLockList(mock)
+				helperExpectGetList(mock)
 				mock.ExpectExec(`DELETE FROM list WHERE id = \$1`).
 					WithArgs(utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
@@ -601,14 +759,16 @@ func TestRepositoryRemoveUser(t *testing.T) {
 			inputUserEntity: structures.ListUserEntity{
 				ListId:   utils.TestListId,
 				Username: utils.TestUsername,
-				IsOwner:  false,
+				Role:     utils.Viewer,
 			},
 			mock: func() {
 				mock.ExpectBegin()
+				helperExpectLockList(mock)
+				helperExpectGetList(mock)
 				mock.ExpectExec(`DELETE FROM users_lists WHERE username = \$1 AND list_id = \$2`).
 					WithArgs(utils.TestUsername, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
-				mock.ExpectCommit()
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error removing user with this name .+ from list with id: .+"),
 		},
@@ -618,12 +778,14 @@ func TestRepositoryRemoveUser(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			_, err := repo.RemoveUserUserFromList(ctx, testCase.inputUserEntity)
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				_, err := repo.RemoveUserUserFromList(ctx, testCase.inputUserEntity)
+				return err
+			})
 			if err != nil {
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -642,6 +804,7 @@ func TestRepositoryUpdate(t *testing.T) {
 
 	convertor := list.NewRepositoryListConvertor()
 	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
 	testCases := []struct {
@@ -657,9 +820,11 @@ func TestRepositoryUpdate(t *testing.T) {
 			inputNewName: utils.TestListName,
 			mock: func() {
 				mock.ExpectBegin()
+				helperExpectLockList(mock)
 				mock.ExpectExec(`UPDATE list SET name = \$1 WHERE id = \$2`).
 					WithArgs(utils.TestListName, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
+				helperExpectGetList(mock)
 				mock.ExpectCommit()
 			},
 		}, {
@@ -668,27 +833,45 @@ func TestRepositoryUpdate(t *testing.T) {
 			inputNewName: utils.TestListName,
 			mock: func() {
 				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id FROM list WHERE id = \$1 FOR UPDATE`).
+					WithArgs(utils.TestListId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found list with id: .+"),
+		}, {
+			name:         "update to already existing name",
+			inputListId:  utils.TestListId,
+			inputNewName: utils.TestListName,
+			mock: func() {
+				mock.ExpectBegin()
+				helperExpectLockList(mock)
 				mock.ExpectExec(`UPDATE list SET name = \$1 WHERE id = \$2`).
 					WithArgs(utils.TestListName, utils.TestListId).
-					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
 			},
-			expectedErr: errors.New("error not found list with id: .+"),
+			expectedErr: errors.New("error already exists list with this name .+"),
 		}, {
 			name:         "update with empty name",
 			inputListId:  utils.TestListId,
 			inputNewName: "",
-			mock:         func() {},
-			expectedErr:  errors.New("list name is required"),
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("list name is required"),
 		}, {
 			name:         "update existing list but not changed in table",
 			inputListId:  utils.TestListId,
 			inputNewName: utils.TestListName,
 			mock: func() {
 				mock.ExpectBegin()
+				helperExpectLockList(mock)
 				mock.ExpectExec(`UPDATE list SET name = \$1 WHERE id = \$2`).
 					WithArgs(utils.TestListName, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
-				mock.ExpectCommit()
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error updating list with id: .+"),
 		},
@@ -698,12 +881,14 @@ func TestRepositoryUpdate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			_, err := repo.UpdateList(ctx, testCase.inputListId, testCase.inputNewName)
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				_, err := repo.UpdateList(ctx, testCase.inputListId, testCase.inputNewName)
+				return err
+			})
 			if err != nil {
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -815,3 +1000,361 @@ func TestRepositoryContainsUser(t *testing.T) {
 		})
 	}
 }
+
+func TestRepositoryCreateLabel(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := list.NewRepositoryListConvertor()
+	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+	labelEntity := structures.LabelEntity{
+		Id:     utils.TestLabelId,
+		ListId: utils.TestListId,
+		Name:   utils.TestLabelName,
+		Color:  utils.TestLabelColor,
+	}
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "create new label",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO label\(id, list_id, name, color\) VALUES \(\$1, \$2, \$3, \$4\)`).
+					WithArgs(utils.TestLabelId, utils.TestListId, utils.TestLabelName, utils.TestLabelColor).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "create label with taken name",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO label\(id, list_id, name, color\) VALUES \(\$1, \$2, \$3, \$4\)`).
+					WithArgs(utils.TestLabelId, utils.TestListId, utils.TestLabelName, utils.TestLabelColor).
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error already exists label with name .+ in list with id: .+"),
+		}, {
+			name: "create label in not existing list",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO label\(id, list_id, name, color\) VALUES \(\$1, \$2, \$3, \$4\)`).
+					WithArgs(utils.TestLabelId, utils.TestListId, utils.TestLabelName, utils.TestLabelColor).
+					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found list with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.CreateLabel(ctx, labelEntity)
+			})
+			if err != nil {
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryDeleteLabel(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := list.NewRepositoryListConvertor()
+	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "delete existing label",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, list_id, name, color FROM label WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestLabelId, utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "color"}).
+						AddRow(utils.TestLabelId, utils.TestListId, utils.TestLabelName, utils.TestLabelColor))
+				mock.ExpectExec(`DELETE FROM label WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestLabelId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "delete label of another list",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, list_id, name, color FROM label WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestLabelId, utils.TestListId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found label with id .+ in list with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				_, err := repo.DeleteLabel(ctx, utils.TestLabelId, utils.TestListId)
+				return err
+			})
+			if err != nil {
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryDuplicateList(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := list.NewRepositoryListConvertor()
+	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+
+	copyListId, labelId, otherTodoId := uuid.UUID{8}, uuid.UUID{9}, uuid.UUID{10}
+	copyEntity := structures.ListEntity{Id: copyListId, Name: "Copy"}
+	ownerEntity := structures.ListUserEntity{ListId: copyListId, Username: utils.TestUsername, Role: utils.Owner}
+	helperLockList := func() {
+		mock.ExpectQuery(`SELECT id FROM list WHERE id = \$1 FOR UPDATE`).
+			WithArgs(utils.TestListId).
+			WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow(utils.TestListId))
+	}
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "duplicate list with labels, todos and subtasks",
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockList()
+				mock.ExpectExec(`INSERT INTO list\(id, name\) VALUES \(\$1, \$2\)`).
+					WithArgs(copyListId, "Copy").
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO users_lists\(list_id, username, role\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(copyListId, utils.TestUsername, utils.Owner).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO workflow_state\(list_id, name, position, done\) SELECT \$1, name, position, done FROM workflow_state WHERE list_id = \$2`).
+					WithArgs(copyListId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(5, 5))
+				mock.ExpectExec(`INSERT INTO workflow_transition\(list_id, from_state, to_state\) SELECT \$1, from_state, to_state FROM workflow_transition WHERE list_id = \$2`).
+					WithArgs(copyListId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(4, 4))
+				mock.ExpectQuery(`SELECT id, list_id, name, color FROM label WHERE list_id = \$1 ORDER BY name`).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "color"}).
+						AddRow(labelId, utils.TestListId, utils.TestLabelName, utils.TestLabelColor))
+				mock.ExpectExec(`INSERT INTO label\(id, list_id, name, color\) VALUES \(\$1, \$2, \$3, \$4\)`).
+					WithArgs(sqlxmock.AnyArg(), copyListId, utils.TestLabelName, utils.TestLabelColor).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(`SELECT username FROM users_lists WHERE list_id = \$1`).
+					WithArgs(copyListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"username"}).AddRow(utils.TestUsername))
+				mock.ExpectQuery(`SELECT id, series_id FROM todo WHERE list_id = \$1 ORDER BY created_at, id`).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "series_id"}).AddRow(utils.TestTodoId, nil))
+				mock.ExpectExec(`INSERT INTO todo\(id, list_id, series_id, name, description, deadline, priority, status, recurrence, superseded\) `+
+					`SELECT \$1, \$2, \$3, name, description, deadline, priority, status, recurrence, superseded FROM todo WHERE id = \$4`).
+					WithArgs(sqlxmock.AnyArg(), copyListId, uuid.NullUUID{}, utils.TestTodoId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(`SELECT label_id FROM todo_label WHERE todo_id = \$1`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"label_id"}).AddRow(labelId))
+				mock.ExpectExec(`INSERT INTO todo_label\(todo_id, label_id\) VALUES \(\$1, \$2\)`).
+					WithArgs(sqlxmock.AnyArg(), sqlxmock.AnyArg()).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				for _, table := range []string{"todo_assignee", "todo_watcher"} {
+					mock.ExpectExec(`INSERT INTO `+table+`\(todo_id, username\) SELECT \$1, username FROM `+table+
+						` WHERE todo_id = \$2 AND username IN \(SELECT username FROM users_lists WHERE list_id = \$3\)`).
+						WithArgs(sqlxmock.AnyArg(), utils.TestTodoId, copyListId).
+						WillReturnResult(sqlxmock.NewResult(0, 1))
+				}
+				mock.ExpectQuery(`SELECT id, todo_id, title, done, assignee, position FROM subtask WHERE todo_id = \$1 ORDER BY position`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "todo_id", "title", "done", "assignee", "position"}).
+						AddRow(uuid.UUID{11}, utils.TestTodoId, utils.TestSubtaskTitle, true, "Former", 0))
+				mock.ExpectExec(`INSERT INTO subtask\(id, todo_id, title, done, assignee, position\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+					WithArgs(sqlxmock.AnyArg(), sqlxmock.AnyArg(), utils.TestSubtaskTitle, true, "", 0).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(`SELECT todo_id, blocked_by_id FROM todo_dependency WHERE todo_id IN \(SELECT id FROM todo WHERE list_id = \$1\)`).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"todo_id", "blocked_by_id"}).AddRow(utils.TestTodoId, otherTodoId))
+				mock.ExpectExec(`UPDATE todo SET status = \(SELECT name FROM workflow_state WHERE list_id = \$1 ORDER BY position LIMIT 1\) `+
+					`WHERE list_id = \$2 AND status = \$3 AND id NOT IN \(SELECT todo_id FROM todo_assignee\)`).
+					WithArgs(copyListId, copyListId, utils.Assigned).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "duplicate missing list",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id FROM list WHERE id = \$1 FOR UPDATE`).
+					WithArgs(utils.TestListId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found list with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.DuplicateList(ctx, utils.TestListId, copyEntity, ownerEntity, false)
+			})
+			if err != nil {
+				require.NotNil(t, testCase.expectedErr, err.Error())
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryDeleteTemplate(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := list.NewRepositoryListConvertor()
+	repo := list.NewDBRepositoryList(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+
+	templateColumns := []string{"id", "name", "owner", "created_at", "workflow", "labels"}
+	templateTodoColumns := []string{"template_id", "position", "name", "description", "priority", "recurrence", "deadline_offset", "labels", "subtasks"}
+	testCases := []struct {
+		name        string
+		mock        func()
+		expected    *structures.TemplateModel
+		expectedErr error
+	}{
+		{
+			name: "delete existing template",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, name, owner, created_at, workflow, labels FROM list_template WHERE id = \$1`).
+					WithArgs(utils.TestTemplateId).
+					WillReturnRows(sqlxmock.NewRows(templateColumns).
+						AddRow(utils.TestTemplateId, utils.TestTemplateName, utils.TestUsername, time.Time{},
+							`{"states": [{"name": "Open", "done": false}, {"name": "Done", "done": true}], "transitions": [{"from": "Open", "to": "Done"}]}`,
+							`[{"name": "TestLabel", "color": "#ff0000"}]`))
+				mock.ExpectQuery(`SELECT template_id, position, .+ FROM list_template_todo WHERE template_id = \$1 ORDER BY position`).
+					WithArgs(utils.TestTemplateId).
+					WillReturnRows(sqlxmock.NewRows(templateTodoColumns).
+						AddRow(utils.TestTemplateId, 0, utils.TestTodoName, utils.TestTodoDescription, utils.MediumPriority, "", 3,
+							`["TestLabel"]`, `["TestSubtask"]`))
+				mock.ExpectExec(`DELETE FROM list_template WHERE id = \$1`).
+					WithArgs(utils.TestTemplateId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expected: &structures.TemplateModel{
+				Id:    utils.TestTemplateId,
+				Name:  utils.TestTemplateName,
+				Owner: utils.TestUsername,
+				States: []structures.WorkflowStateModel{
+					{Name: "Open"},
+					{Name: "Done", Done: true},
+				},
+				Transitions: []structures.WorkflowTransitionModel{{From: "Open", To: "Done"}},
+				Labels:      []structures.TemplateLabelModel{{Name: utils.TestLabelName, Color: utils.TestLabelColor}},
+				Todos: []structures.TemplateTodoModel{{
+					Name:           utils.TestTodoName,
+					Description:    utils.TestTodoDescription,
+					Priority:       utils.MediumPriority,
+					DeadlineOffset: 3,
+					Labels:         []string{utils.TestLabelName},
+					Subtasks:       []string{utils.TestSubtaskTitle},
+				}},
+			},
+		}, {
+			name: "delete missing template",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, name, owner, created_at, workflow, labels FROM list_template WHERE id = \$1`).
+					WithArgs(utils.TestTemplateId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found template with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			var deleted *structures.TemplateModel
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				var err error
+				deleted, err = repo.DeleteTemplate(ctx, utils.TestTemplateId)
+				return err
+			})
+			if err != nil {
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			} else {
+				require.Equal(t, testCase.expected, deleted)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package list
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -19,21 +20,50 @@ const (
 	listId             = "listId"
 	removeUserErrorMsg = "error removing"
 	addUserErrorMsg    = "error adding"
+	invalidListRoleMsg = "role must be one of: viewer, editor, manager"
+	labelId            = "labelId"
+	maxLabelNameLength = 50
+	invalidLabelMsg    = "label name must be 1-50 characters and color a hex value like #1a2b3c"
+
+	updatingWorkflowErrorMsg = "error updating workflow"
+	maxWorkflowStates        = 20
+	maxStatusLength          = 50
+
+	templateId             = "templateId"
+	maxListNameLength      = 100
+	invalidListNameMsg     = "list name must be 1-100 characters"
+	invalidTemplateNameMsg = "template name must be 1-100 characters"
 )
 
+var labelColorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
 //go:generate mockery --name ServiceList --output=automock --with-expecter=true
 type ServiceList interface {
 	GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error)
-	GetAllLists(ctx context.Context) []*structures.ListOutput
+	GetAllLists(ctx context.Context, page structures.PageQuery) (*structures.ListPageOutput, error)
 	GetUserFromListById(ctx context.Context, listId uuid.UUID, username string) (*structures.UserOutput, error)
 	GetUsersFromListById(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error)
 	CreateList(ctx context.Context, listName, username string) (*structures.ListOutput, error)
-	AddUserToList(ctx context.Context, listId uuid.UUID, username string) error
+	AddUserToList(ctx context.Context, listId uuid.UUID, username, role string) error
 	DeleteList(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error)
 	RemoveUserFromList(ctx context.Context, listId uuid.UUID, username string) (*structures.UserOutput, error)
 	UpdateList(ctx context.Context, listId uuid.UUID, newListName string) (*structures.ListOutput, error)
 	CheckIfListExistsInList(ctx context.Context, listId uuid.UUID) bool
 	ContainUserInList(ctx context.Context, listId uuid.UUID, username string) bool
+	GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string
+	GetListsOfUser(ctx context.Context, username string) []*structures.UserOutput
+	GetLabels(ctx context.Context, listId uuid.UUID) ([]structures.LabelOutput, error)
+	CreateLabel(ctx context.Context, listId uuid.UUID, input structures.LabelInput) (*structures.LabelOutput, error)
+	UpdateLabel(ctx context.Context, labelId, listId uuid.UUID, input structures.LabelInput) (*structures.LabelOutput, error)
+	DeleteLabel(ctx context.Context, labelId, listId uuid.UUID) (*structures.LabelOutput, error)
+	GetWorkflow(ctx context.Context, listId uuid.UUID) (*structures.WorkflowOutput, error)
+	UpdateWorkflow(ctx context.Context, listId uuid.UUID, input structures.WorkflowInput) (*structures.WorkflowOutput, error)
+	DuplicateList(ctx context.Context, listId uuid.UUID, input structures.ListDuplicateInput, username string) (*structures.ListUserOutput, error)
+	GetTemplates(ctx context.Context, username string, isAdmin bool) ([]structures.TemplateOutput, error)
+	GetTemplate(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool) (*structures.TemplateOutput, error)
+	CreateTemplate(ctx context.Context, listId uuid.UUID, input structures.TemplateInput, username string) (*structures.TemplateOutput, error)
+	DeleteTemplate(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool) (*structures.TemplateOutput, error)
+	InstantiateTemplate(ctx context.Context, templateId uuid.UUID, input structures.TemplateInstanceInput, username string, isAdmin bool) (*structures.ListOutput, error)
 }
 
 type ResolverListImpl struct {
@@ -56,6 +86,81 @@ func (r *ResolverListImpl) getListIdInput(req *http.Request) (*uuid.UUID, error)
 	return listId, nil
 }
 
+func (r *ResolverListImpl) isAssignableRole(role string) bool {
+	return role == utils.Viewer || role == utils.Editor || role == utils.Manager
+}
+
+func (r *ResolverListImpl) isValidLabel(input structures.LabelInput) bool {
+	name := strings.TrimSpace(input.Name)
+	return name != "" && len(name) <= maxLabelNameLength && labelColorRegex.MatchString(input.Color)
+}
+
+// validateWorkflow trims the state names and checks that the workflow has at least one done state
+// and that its transitions connect distinct states of the workflow.
+func validateWorkflow(input *structures.WorkflowInput) error {
+	if len(input.States) == 0 || len(input.States) > maxWorkflowStates {
+		return errors.New(fmt.Sprintf("workflow must have 1-%d states", maxWorkflowStates))
+	}
+
+	states := make(map[string]bool, len(input.States))
+	hasDone := false
+	for i := range input.States {
+		name := strings.TrimSpace(input.States[i].Name)
+		if name == "" || len(name) > maxStatusLength {
+			return errors.New(fmt.Sprintf("workflow state name must be 1-%d characters", maxStatusLength))
+		}
+		if states[name] {
+			return errors.New(fmt.Sprintf("workflow state %s is defined more than once", name))
+		}
+
+		input.States[i].Name = name
+		states[name] = true
+		hasDone = hasDone || input.States[i].Done
+	}
+	if !hasDone {
+		return errors.New("workflow must have at least one done state")
+	}
+
+	transitions := make(map[structures.WorkflowTransitionInput]bool, len(input.Transitions))
+	for i := range input.Transitions {
+		transition := &input.Transitions[i]
+		transition.From, transition.To = strings.TrimSpace(transition.From), strings.TrimSpace(transition.To)
+		if !states[transition.From] || !states[transition.To] {
+			return errors.New(fmt.Sprintf("workflow transition from %s to %s uses an unknown state", transition.From, transition.To))
+		}
+		if transition.From == transition.To {
+			return errors.New(fmt.Sprintf("workflow state %s cannot transition to itself", transition.From))
+		}
+		if transitions[*transition] {
+			return errors.New(fmt.Sprintf("workflow transition from %s to %s is defined more than once", transition.From, transition.To))
+		}
+
+		transitions[*transition] = true
+	}
+
+	return nil
+}
+
+// labelErrorStatus maps the errors shared by the label catalog writes, list duplication and templates to a response status.
+func labelErrorStatus(err error) int {
+	if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+		return http.StatusNotFound
+	} else if strings.Contains(err.Error(), utils.AlreadyExistsErrorMsg) {
+		return http.StatusConflict
+	} else if strings.Contains(err.Error(), utils.CreateErrorMsg) ||
+		strings.Contains(err.Error(), utils.DeletingErrorMsg) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
+
+// isValidName trims the name of a list or a template and checks its length.
+func isValidName(name *string) bool {
+	*name = strings.TrimSpace(*name)
+	return *name != "" && len(*name) <= maxListNameLength
+}
+
 func (r *ResolverListImpl) GetListById(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
@@ -90,10 +195,30 @@ func (r *ResolverListImpl) GetListById(w http.ResponseWriter, req *http.Request)
 func (r *ResolverListImpl) GetAllLists(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
-	allLists := r.service.GetAllLists(ctx)
+	page, err := utils.ParsePageQuery(req.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	listPage, err := r.service.GetAllLists(ctx, *page)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.InvalidCursorErrorMsg) || strings.Contains(err.Error(), utils.InvalidPageErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = "failed to get lists"
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
 
+	utils.SetPageHeaders(w, listPage.PageInfoOutput)
 	w.WriteHeader(http.StatusOK)
-	utils.ResponseHandling(req, w, allLists)
+	utils.ResponseHandling(req, w, listPage.Lists)
 }
 
 func (r *ResolverListImpl) CreateList(w http.ResponseWriter, req *http.Request) {
@@ -233,8 +358,22 @@ func (r *ResolverListImpl) AddUserToList(w http.ResponseWriter, req *http.Reques
 		utils.ResponseHandling(req, w, msg)
 		return
 	}
+	if userInput.Role == "" {
+		userInput.Role = utils.Viewer
+	}
+	if !r.isAssignableRole(userInput.Role) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, invalidListRoleMsg)
+		return
+	}
+	if utils.ListRole[userInput.Role] > utils.GetListRoleFromContext(ctx) {
+		w.WriteHeader(http.StatusForbidden)
+		msg := fmt.Sprintf("cannot grant %s role in list with id: %s", userInput.Role, *listIdInput)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
 
-	err = r.service.AddUserToList(ctx, *listIdInput, userInput.Username)
+	err = r.service.AddUserToList(ctx, *listIdInput, userInput.Username, userInput.Role)
 	if err != nil {
 		msg := err.Error()
 		if strings.Contains(err.Error(), utils.AlreadyExistsErrorMsg) {
@@ -268,9 +407,34 @@ func (r *ResolverListImpl) RemoveUserFromList(w http.ResponseWriter, req *http.R
 		return
 	}
 
+	requester := req.Header.Get(username)
 	params := mux.Vars(req)
 	username := params[username]
 
+	member, err := r.service.GetUserFromListById(ctx, *listIdInput, username)
+	if err != nil {
+		isGetError, regErr := regexp.MatchString(utils.GetErrorMsg, err.Error())
+		if isGetError && regErr == nil {
+			w.WriteHeader(http.StatusNotFound)
+			utils.ResponseHandling(req, w, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := fmt.Sprintf("failed to remove user %s from list with id: %s", username, listIdInput)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	requesterRank := utils.GetListRoleFromContext(ctx)
+	if username != requester && requesterRank != utils.ListRole[utils.Owner] &&
+		utils.ListRole[member.Role] >= requesterRank {
+		w.WriteHeader(http.StatusForbidden)
+		msg := fmt.Sprintf("cannot remove %s %s from list with id: %s", member.Role, username, *listIdInput)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
 	removedUser, err := r.service.RemoveUserFromList(ctx, *listIdInput, username)
 	if err != nil {
 		msg := err.Error()
@@ -363,15 +527,455 @@ func (r *ResolverListImpl) GetUsersFromListById(w http.ResponseWriter, req *http
 	utils.ResponseHandling(req, w, userOutputs)
 }
 
-func (r *ResolverListImpl) IsOwnerUserOwnerToListById(ctx context.Context, listId uuid.UUID, username string) bool {
-	entity, err := r.service.GetUserFromListById(ctx, listId, username)
+func (r *ResolverListImpl) GetCurrentUserLists(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	username := req.Header.Get(username)
+	userLists := r.service.GetListsOfUser(ctx, username)
+
+	w.WriteHeader(http.StatusOK)
+	utils.ResponseHandling(req, w, userLists)
+}
+
+func (r *ResolverListImpl) GetUserListRights(ctx context.Context, listId uuid.UUID, username string) int {
+	rank, exists := utils.ListRole[r.service.GetUserListRole(ctx, listId, username)]
+	if !exists {
+		return utils.ListRole[utils.Unknown]
+	}
+
+	return rank
+}
+
+func (r *ResolverListImpl) GetLabels(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	listIdInput, err := r.getListIdInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	labels, err := r.service.GetLabels(ctx, *listIdInput)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := fmt.Sprintf("failed to get labels of list with id: %s", listIdInput)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	utils.ResponseHandling(req, w, labels)
+}
+
+func (r *ResolverListImpl) CreateLabel(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	listIdInput, err := r.getListIdInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	var input structures.LabelInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode label")
+		return
+	}
+	if !r.isValidLabel(input) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, invalidLabelMsg)
+		return
+	}
+	input.Name = strings.TrimSpace(input.Name)
+
+	newLabel, err := r.service.CreateLabel(ctx, *listIdInput, input)
 	if err != nil {
-		return false
+		msg := err.Error()
+		status := labelErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to create label in list with id: %s", listIdInput)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
 	}
 
-	return entity.IsOwner
+	w.WriteHeader(http.StatusCreated)
+	log.Info(fmt.Sprintf("success creating label with id: %s", newLabel.Id))
+	utils.ResponseHandling(req, w, newLabel)
 }
 
-func (r *ResolverListImpl) IsUserPartOfList(ctx context.Context, listId uuid.UUID, username string) bool {
-	return r.service.ContainUserInList(ctx, listId, username)
+func (r *ResolverListImpl) UpdateLabel(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	listIdInput, err := r.getListIdInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+	labelIdInput, err := utils.GetID(mux.Vars(req), labelId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	var input structures.LabelInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode label")
+		return
+	}
+	if !r.isValidLabel(input) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, invalidLabelMsg)
+		return
+	}
+	input.Name = strings.TrimSpace(input.Name)
+
+	updatedLabel, err := r.service.UpdateLabel(ctx, *labelIdInput, *listIdInput, input)
+	if err != nil {
+		msg := err.Error()
+		status := labelErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to update label with id: %s", labelIdInput)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success updating label with id: %s", updatedLabel.Id))
+	utils.ResponseHandling(req, w, updatedLabel)
+}
+
+func (r *ResolverListImpl) DeleteLabel(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	listIdInput, err := r.getListIdInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+	labelIdInput, err := utils.GetID(mux.Vars(req), labelId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	deletedLabel, err := r.service.DeleteLabel(ctx, *labelIdInput, *listIdInput)
+	if err != nil {
+		msg := err.Error()
+		status := labelErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to delete label with id: %s", labelIdInput)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success deleting label with id: %s", deletedLabel.Id))
+	utils.ResponseHandling(req, w, deletedLabel)
+}
+
+func (r *ResolverListImpl) GetWorkflow(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	listIdInput, err := r.getListIdInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	workflow, err := r.service.GetWorkflow(ctx, *listIdInput)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to get workflow of list with id: %s", listIdInput)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	utils.ResponseHandling(req, w, workflow)
+}
+
+func (r *ResolverListImpl) UpdateWorkflow(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	listIdInput, err := r.getListIdInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	var input structures.WorkflowInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode workflow")
+		return
+	}
+	err = validateWorkflow(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	workflow, err := r.service.UpdateWorkflow(ctx, *listIdInput, input)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), updatingWorkflowErrorMsg) {
+			w.WriteHeader(http.StatusConflict)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to update workflow of list with id: %s", listIdInput)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success updating workflow of list with id: %s", listIdInput))
+	utils.ResponseHandling(req, w, workflow)
+}
+
+func (r *ResolverListImpl) DuplicateList(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	listIdInput, err := r.getListIdInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	var input structures.ListDuplicateInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode list duplicate")
+		return
+	}
+	if !isValidName(&input.Name) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, invalidListNameMsg)
+		return
+	}
+	// Copying the members hands them a new list, which only the managers of the list may do.
+	if input.IncludeMembers && utils.GetListRoleFromContext(ctx) < utils.ListRole[utils.Manager] {
+		w.WriteHeader(http.StatusForbidden)
+		utils.ResponseHandling(req, w, "only managers can duplicate a list with its members")
+		return
+	}
+
+	newList, err := r.service.DuplicateList(ctx, *listIdInput, input, req.Header.Get(username))
+	if err != nil {
+		msg := err.Error()
+		status := labelErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to duplicate list with id: %s", listIdInput)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	log.WithField(utils.Status, http.StatusCreated).Info(fmt.Sprintf("success duplicating list with id: %s", listIdInput))
+	w.WriteHeader(http.StatusCreated)
+	utils.ResponseHandling(req, w, newList)
+}
+
+func (r *ResolverListImpl) GetTemplates(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	isAdmin := utils.GetRoleFromContext(ctx) == utils.Role[utils.Admin]
+	templates, err := r.service.GetTemplates(ctx, req.Header.Get(username), isAdmin)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		utils.ResponseHandling(req, w, "failed to get templates")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	utils.ResponseHandling(req, w, templates)
+}
+
+func (r *ResolverListImpl) GetTemplate(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	templateIdInput, err := utils.GetID(mux.Vars(req), templateId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	isAdmin := utils.GetRoleFromContext(ctx) == utils.Role[utils.Admin]
+	template, err := r.service.GetTemplate(ctx, *templateIdInput, req.Header.Get(username), isAdmin)
+	if err != nil {
+		msg := err.Error()
+		status := labelErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to get template with id: %s", templateIdInput)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	utils.ResponseHandling(req, w, template)
+}
+
+func (r *ResolverListImpl) CreateTemplate(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	listIdInput, err := r.getListIdInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	var input structures.TemplateInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode template")
+		return
+	}
+	if !isValidName(&input.Name) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, invalidTemplateNameMsg)
+		return
+	}
+
+	template, err := r.service.CreateTemplate(ctx, *listIdInput, input, req.Header.Get(username))
+	if err != nil {
+		msg := err.Error()
+		status := labelErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to save list with id: %s as template", listIdInput)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	log.WithField(utils.Status, http.StatusCreated).Info(fmt.Sprintf("success creating template with id: %s", template.Id))
+	w.WriteHeader(http.StatusCreated)
+	utils.ResponseHandling(req, w, template)
+}
+
+func (r *ResolverListImpl) DeleteTemplate(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	templateIdInput, err := utils.GetID(mux.Vars(req), templateId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	isAdmin := utils.GetRoleFromContext(ctx) == utils.Role[utils.Admin]
+	deletedTemplate, err := r.service.DeleteTemplate(ctx, *templateIdInput, req.Header.Get(username), isAdmin)
+	if err != nil {
+		msg := err.Error()
+		status := labelErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to delete template with id: %s", templateIdInput)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success deleting template with id: %s", deletedTemplate.Id))
+	utils.ResponseHandling(req, w, deletedTemplate)
+}
+
+func (r *ResolverListImpl) InstantiateTemplate(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	templateIdInput, err := utils.GetID(mux.Vars(req), templateId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	var input structures.TemplateInstanceInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode template instance")
+		return
+	}
+	if !isValidName(&input.Name) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, invalidListNameMsg)
+		return
+	}
+	if input.StartDate.IsZero() {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "start_date is required")
+		return
+	}
+
+	isAdmin := utils.GetRoleFromContext(ctx) == utils.Role[utils.Admin]
+	newList, err := r.service.InstantiateTemplate(ctx, *templateIdInput, input, req.Header.Get(username), isAdmin)
+	if err != nil {
+		msg := err.Error()
+		status := labelErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to create list from template with id: %s", templateIdInput)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	log.WithField(utils.Status, http.StatusCreated).Info(fmt.Sprintf("success creating list with id: %s from template", newList.Id))
+	w.WriteHeader(http.StatusCreated)
+	utils.ResponseHandling(req, w, newList)
 }
@@ -2,6 +2,7 @@ package list_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
@@ -16,6 +17,7 @@ import (
 	"project/utils"
 	"regexp"
 	"testing"
+	"time"
 )
 
 func TestResolverGetListById(t *testing.T) {
@@ -77,43 +79,104 @@ func TestResolverGetListById(t *testing.T) {
 }
 
 func TestResolverGetAllListNames(t *testing.T) {
+	cursor := structures.Cursor{SortBy: utils.SortByName, Value: utils.TestListName, Id: utils.TestListId}
+
 	testCases := []struct {
-		name           string
-		service        func() *mocks.ServiceList
-		expectedStatus int
+		name            string
+		service         func() *mocks.ServiceList
+		inputQuery      string
+		expected        []string
+		expectedStatus  int
+		expectedHeaders map[string]string
 	}{
 		{
 			name: "get all lists",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
-				srvMock.EXPECT().GetAllLists(mock.Anything).Return([]*structures.ListOutput{
-					&structures.ListOutput{
-						Name: utils.TestListName + "0",
-					}, &structures.ListOutput{
-						Name: utils.TestListName + "1",
-					}, &structures.ListOutput{
-						Name: utils.TestListName + "2",
+				srvMock.EXPECT().GetAllLists(mock.Anything, structures.PageQuery{}).Return(&structures.ListPageOutput{
+					Lists: []*structures.ListOutput{
+						&structures.ListOutput{
+							Name: utils.TestListName + "0",
+						}, &structures.ListOutput{
+							Name: utils.TestListName + "1",
+						}, &structures.ListOutput{
+							Name: utils.TestListName + "2",
+						},
 					},
-				})
+					PageInfoOutput: structures.PageInfoOutput{TotalCount: 3, StartCursor: "start-cursor", EndCursor: "end-cursor"},
+				}, nil)
 				return srvMock
 			},
+			expected:       []string{utils.TestListName + "0", utils.TestListName + "2"},
 			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				utils.TotalCountHeader:      "3",
+				utils.StartCursorHeader:     "start-cursor",
+				utils.EndCursorHeader:       "end-cursor",
+				utils.HasNextPageHeader:     "false",
+				utils.HasPreviousPageHeader: "false",
+			},
 		}, {
 			name: "get no lists",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
-				srvMock.EXPECT().GetAllLists(mock.Anything).Return(nil)
+				srvMock.EXPECT().GetAllLists(mock.Anything, structures.PageQuery{}).Return(&structures.ListPageOutput{}, nil)
 				return srvMock
 			},
+			expectedStatus:  http.StatusOK,
+			expectedHeaders: map[string]string{utils.TotalCountHeader: "0"},
+		}, {
+			name: "last lists before a cursor",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetAllLists(mock.Anything, structures.PageQuery{Last: 2, Before: &cursor}).
+					Return(&structures.ListPageOutput{
+						PageInfoOutput: structures.PageInfoOutput{TotalCount: 3, HasNextPage: true, HasPreviousPage: true},
+					}, nil)
+				return srvMock
+			},
+			inputQuery:     "last=2&before=" + utils.EncodeCursor(cursor),
 			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				utils.HasNextPageHeader:     "true",
+				utils.HasPreviousPageHeader: "true",
+			},
+		}, {
+			name:           "invalid page",
+			service:        func() *mocks.ServiceList { return &mocks.ServiceList{} },
+			inputQuery:     "limit=0",
+			expected:       []string{"error invalid limit 0"},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "cursor of another order",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetAllLists(mock.Anything, structures.PageQuery{After: &cursor}).
+					Return(nil, errors.New(utils.InvalidCursorErrorMsg))
+				return srvMock
+			},
+			inputQuery:     "cursor=" + utils.EncodeCursor(cursor),
+			expected:       []string{utils.InvalidCursorErrorMsg},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "getting lists failed",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetAllLists(mock.Anything, structures.PageQuery{}).
+					Return(nil, errors.New("connection refused"))
+				return srvMock
+			},
+			expected:       []string{"failed to get lists"},
+			expectedStatus: http.StatusInternalServerError,
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			resolver := list.NewResolverList(testCase.service())
+			service := testCase.service()
+			resolver := list.NewResolverList(service)
 
-			req, err := http.NewRequest(http.MethodGet, "/todo/api/", nil)
+			req, err := http.NewRequest(http.MethodGet, "/todo/api/list?"+testCase.inputQuery, nil)
 			req = req.WithContext(utils.HelperGetContext())
 			require.NoError(t, err)
 
@@ -122,6 +185,13 @@ func TestResolverGetAllListNames(t *testing.T) {
 			resolver.GetAllLists(rr, req)
 
 			require.Equal(t, testCase.expectedStatus, rr.Code)
+			for header, expected := range testCase.expectedHeaders {
+				require.Equal(t, expected, rr.Header().Get(header), header)
+			}
+			for _, expected := range testCase.expected {
+				require.Contains(t, rr.Body.String(), expected)
+			}
+			service.AssertExpectations(t)
 		})
 	}
 }
@@ -191,7 +261,7 @@ func TestResolverDeleteList(t *testing.T) {
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
 				srvMock.EXPECT().DeleteList(mock.Anything, utils.TestListId).
-					Return(&structures.ListOutput{
+					Return(&structures.ListUserOutput{
 						Id:    utils.TestListId,
 						Name:  utils.TestListName,
 						Owner: utils.TestUsername,
@@ -332,30 +402,63 @@ func TestResolverAddUserToList(t *testing.T) {
 		service        func() *mocks.ServiceList
 		inputListId    uuid.UUID
 		inputUsername  []byte
+		requesterRole  string
 		expectedStatus int
 	}{
 		{
 			name: "add user to existing list",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
-				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername).
+				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername, utils.Viewer).
 					Return(nil).Once()
 				return srvMock
 			},
 			inputListId:    utils.TestListId,
 			inputUsername:  []byte(fmt.Sprintf(`{"username": "%s"}`, utils.TestUsername)),
+			requesterRole:  utils.Manager,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "add user with editor role",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername, utils.Editor).
+					Return(nil).Once()
+				return srvMock
+			},
+			inputListId:    utils.TestListId,
+			inputUsername:  []byte(fmt.Sprintf(`{"username": "%s", "role": "%s"}`, utils.TestUsername, utils.Editor)),
+			requesterRole:  utils.Owner,
 			expectedStatus: http.StatusOK,
+		}, {
+			name: "add user with owner role",
+			service: func() *mocks.ServiceList {
+				return nil
+			},
+			inputListId:    utils.TestListId,
+			inputUsername:  []byte(fmt.Sprintf(`{"username": "%s", "role": "%s"}`, utils.TestUsername, utils.Owner)),
+			requesterRole:  utils.Owner,
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "grant role higher than requester's",
+			service: func() *mocks.ServiceList {
+				return nil
+			},
+			inputListId:    utils.TestListId,
+			inputUsername:  []byte(fmt.Sprintf(`{"username": "%s", "role": "%s"}`, utils.TestUsername, utils.Manager)),
+			requesterRole:  utils.Editor,
+			expectedStatus: http.StatusForbidden,
 		}, {
 			name: "add user to non-existing list",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
-				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername).
+				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername, utils.Viewer).
 					Return(errors.New(fmt.Sprintf("error not found list with id: %s", utils.TestListId))).
 					Once()
 				return srvMock
 			},
 			inputUsername:  []byte(fmt.Sprintf(`{"username": "%s"}`, utils.TestUsername)),
 			inputListId:    utils.TestListId,
+			requesterRole:  utils.Manager,
 			expectedStatus: http.StatusNotFound,
 		}, {
 			name: "empty username",
@@ -363,30 +466,33 @@ func TestResolverAddUserToList(t *testing.T) {
 				return nil
 			},
 			inputUsername:  []byte(`{"username": ""}`),
+			requesterRole:  utils.Manager,
 			expectedStatus: http.StatusBadRequest,
 		}, {
 			name: "already added username",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
-				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername).
+				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername, utils.Viewer).
 					Return(errors.New(fmt.Sprintf("error already exists user %s in list with id: %s", utils.TestUsername, utils.TestListId))).
 					Once()
 				return srvMock
 			},
 			inputListId:    utils.TestListId,
 			inputUsername:  []byte(fmt.Sprintf(`{"username": "%s"}`, utils.TestUsername)),
+			requesterRole:  utils.Manager,
 			expectedStatus: http.StatusConflict,
 		}, {
 			name: "already added username",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
-				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername).
+				srvMock.EXPECT().AddUserToList(mock.Anything, utils.TestListId, utils.TestUsername, utils.Viewer).
 					Return(errors.New(fmt.Sprintf("error adding user %s in list with id: %s", utils.TestUsername, utils.TestListId))).
 					Once()
 				return srvMock
 			},
 			inputListId:    utils.TestListId,
 			inputUsername:  []byte(fmt.Sprintf(`{"username": "%s"}`, utils.TestUsername)),
+			requesterRole:  utils.Manager,
 			expectedStatus: http.StatusBadRequest,
 		},
 	}
@@ -398,7 +504,8 @@ func TestResolverAddUserToList(t *testing.T) {
 			req, err := http.NewRequest(http.MethodPost,
 				fmt.Sprintf("/todo/api/%s", testCase.inputListId.String()),
 				bytes.NewBuffer(testCase.inputUsername))
-			req = req.WithContext(utils.HelperGetContext())
+			ctx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[testCase.requesterRole])
+			req = req.WithContext(ctx)
 			req = mux.SetURLVars(req, map[string]string{"listId": testCase.inputListId.String()})
 			require.NoError(t, err)
 
@@ -417,17 +524,22 @@ func TestResolverRemoveUser(t *testing.T) {
 		service        func() *mocks.ServiceList
 		inputListId    uuid.UUID
 		inputUsername  string
+		requesterRole  string
 		expectedStatus int
 	}{
 		{
 			name: "remove user from existing list",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetUserFromListById(mock.Anything, utils.TestListId, utils.TestUsername).
+					Return(&structures.UserOutput{Username: utils.TestUsername, Role: utils.Owner, IsOwner: true}, nil).
+					Once()
 				srvMock.EXPECT().RemoveUserFromList(mock.Anything, utils.TestListId, utils.TestUsername).
 					Return(&structures.UserOutput{
 						ListId:   utils.TestListId,
 						ListName: utils.TestListName,
 						Username: utils.TestUsername,
+						Role:     utils.Owner,
 						IsOwner:  true,
 					}, nil).
 					Once()
@@ -435,11 +547,44 @@ func TestResolverRemoveUser(t *testing.T) {
 			},
 			inputListId:    utils.TestListId,
 			inputUsername:  utils.TestUsername,
+			requesterRole:  utils.Owner,
 			expectedStatus: http.StatusOK,
+		}, {
+			name: "manager removes editor",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetUserFromListById(mock.Anything, utils.TestListId, "Ivan").
+					Return(&structures.UserOutput{Username: "Ivan", Role: utils.Editor}, nil).
+					Once()
+				srvMock.EXPECT().RemoveUserFromList(mock.Anything, utils.TestListId, "Ivan").
+					Return(&structures.UserOutput{Username: "Ivan", Role: utils.Editor}, nil).
+					Once()
+				return srvMock
+			},
+			inputListId:    utils.TestListId,
+			inputUsername:  "Ivan",
+			requesterRole:  utils.Manager,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "manager removes another manager",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetUserFromListById(mock.Anything, utils.TestListId, "Ivan").
+					Return(&structures.UserOutput{Username: "Ivan", Role: utils.Manager}, nil).
+					Once()
+				return srvMock
+			},
+			inputListId:    utils.TestListId,
+			inputUsername:  "Ivan",
+			requesterRole:  utils.Manager,
+			expectedStatus: http.StatusForbidden,
 		}, {
 			name: "remove user from non-existing list",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetUserFromListById(mock.Anything, utils.TestListId, utils.TestUsername).
+					Return(&structures.UserOutput{Username: utils.TestUsername, Role: utils.Viewer}, nil).
+					Once()
 				srvMock.EXPECT().RemoveUserFromList(mock.Anything, utils.TestListId, utils.TestUsername).
 					Return(nil,
 						errors.New(fmt.Sprintf("error not found list with id: %s", utils.TestListId))).
@@ -448,11 +593,29 @@ func TestResolverRemoveUser(t *testing.T) {
 			},
 			inputListId:    utils.TestListId,
 			inputUsername:  utils.TestUsername,
+			requesterRole:  utils.Owner,
 			expectedStatus: http.StatusNotFound,
 		}, {
 			name: "remove non-existing username",
 			service: func() *mocks.ServiceList {
 				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetUserFromListById(mock.Anything, utils.TestListId, utils.TestUsername).
+					Return(nil,
+						errors.New(fmt.Sprintf("error getting user of list with id: %s", utils.TestListId))).
+					Once()
+				return srvMock
+			},
+			inputListId:    utils.TestListId,
+			inputUsername:  utils.TestUsername,
+			requesterRole:  utils.Owner,
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "remove user but not removed from the table",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetUserFromListById(mock.Anything, utils.TestListId, utils.TestUsername).
+					Return(&structures.UserOutput{Username: utils.TestUsername, Role: utils.Viewer}, nil).
+					Once()
 				srvMock.EXPECT().RemoveUserFromList(mock.Anything, utils.TestListId, utils.TestUsername).
 					Return(nil,
 						errors.New(fmt.Sprintf("error removing user %s from list with id: %s", utils.TestUsername, utils.TestListId))).
@@ -461,6 +624,7 @@ func TestResolverRemoveUser(t *testing.T) {
 			},
 			inputListId:    utils.TestListId,
 			inputUsername:  utils.TestUsername,
+			requesterRole:  utils.Owner,
 			expectedStatus: http.StatusBadRequest,
 		},
 	}
@@ -470,7 +634,8 @@ func TestResolverRemoveUser(t *testing.T) {
 			resolver := list.NewResolverList(testCase.service())
 
 			req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/todo/api/%s/%s", testCase.inputListId, testCase.inputUsername), nil)
-			req = req.WithContext(utils.HelperGetContext())
+			ctx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[testCase.requesterRole])
+			req = req.WithContext(ctx)
 			req = mux.SetURLVars(req, map[string]string{"listId": testCase.inputListId.String(), "userId": testCase.inputUsername})
 			require.NoError(t, err)
 
@@ -621,3 +786,413 @@ func TestResolverGetAllUsersFromListById(t *testing.T) {
 		})
 	}
 }
+
+func TestResolverCreateLabel(t *testing.T) {
+	labelInput := structures.LabelInput{Name: utils.TestLabelName, Color: utils.TestLabelColor}
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceList
+		inputLabel     []byte
+		expectedStatus int
+	}{
+		{
+			name: "create new label",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().CreateLabel(mock.Anything, utils.TestListId, labelInput).
+					Return(&structures.LabelOutput{Id: utils.TestLabelId, ListId: utils.TestListId, Name: utils.TestLabelName, Color: utils.TestLabelColor}, nil).
+					Once()
+				return srvMock
+			},
+			inputLabel:     []byte(fmt.Sprintf(`{"name": "%s", "color": "%s"}`, utils.TestLabelName, utils.TestLabelColor)),
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "create label with taken name",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().CreateLabel(mock.Anything, utils.TestListId, labelInput).
+					Return(nil, errors.New(fmt.Sprintf("error already exists label with name %s in list with id: %s", utils.TestLabelName, utils.TestListId))).
+					Once()
+				return srvMock
+			},
+			inputLabel:     []byte(fmt.Sprintf(`{"name": "%s", "color": "%s"}`, utils.TestLabelName, utils.TestLabelColor)),
+			expectedStatus: http.StatusConflict,
+		}, {
+			name: "create label with invalid color",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			inputLabel:     []byte(fmt.Sprintf(`{"name": "%s", "color": "red"}`, utils.TestLabelName)),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "create label without name",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			inputLabel:     []byte(fmt.Sprintf(`{"name": " ", "color": "%s"}`, utils.TestLabelColor)),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := list.NewResolverList(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/todo/api/list/%s/labels", utils.TestListId), bytes.NewReader(testCase.inputLabel))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String()})
+
+			rr := httptest.NewRecorder()
+
+			resolver.CreateLabel(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverGetWorkflow(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceList
+		expectedStatus int
+	}{
+		{
+			name: "get workflow",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetWorkflow(mock.Anything, utils.TestListId).
+					Return(&structures.WorkflowOutput{ListId: utils.TestListId}, nil).
+					Once()
+				return srvMock
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "get workflow of missing list",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().GetWorkflow(mock.Anything, utils.TestListId).
+					Return(nil, errors.New(fmt.Sprintf("error not found workflow of list with id: %s", utils.TestListId))).
+					Once()
+				return srvMock
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := list.NewResolverList(testCase.service())
+
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/todo/api/list/%s/workflow", utils.TestListId), nil)
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String()})
+
+			rr := httptest.NewRecorder()
+
+			resolver.GetWorkflow(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverUpdateWorkflow(t *testing.T) {
+	workflowInput := structures.WorkflowInput{
+		States:      []structures.WorkflowStateInput{{Name: utils.NotAssigned}, {Name: "Done", Done: true}},
+		Transitions: []structures.WorkflowTransitionInput{{From: utils.NotAssigned, To: "Done"}},
+	}
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceList
+		inputWorkflow  []byte
+		expectedStatus int
+	}{
+		{
+			name: "update workflow",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().UpdateWorkflow(mock.Anything, utils.TestListId, workflowInput).
+					Return(&structures.WorkflowOutput{ListId: utils.TestListId}, nil).
+					Once()
+				return srvMock
+			},
+			inputWorkflow: []byte(`{"states": [{"name": " Not Assigned "}, {"name": "Done", "done": true}],
+"transitions": [{"from": "Not Assigned", "to": "Done "}]}`),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "update workflow dropping a status in use",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().UpdateWorkflow(mock.Anything, utils.TestListId, workflowInput).
+					Return(nil, errors.New(fmt.Sprintf("error updating workflow of list with id: %s because todos are still in status: %s",
+						utils.TestListId, utils.Assigned))).
+					Once()
+				return srvMock
+			},
+			inputWorkflow: []byte(`{"states": [{"name": "Not Assigned"}, {"name": "Done", "done": true}],
+"transitions": [{"from": "Not Assigned", "to": "Done"}]}`),
+			expectedStatus: http.StatusConflict,
+		}, {
+			name: "update workflow without done state",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			inputWorkflow:  []byte(`{"states": [{"name": "Not Assigned"}, {"name": "Done"}]}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "update workflow with duplicated state",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			inputWorkflow:  []byte(`{"states": [{"name": "Done", "done": true}, {"name": " Done"}]}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "update workflow with transition to unknown state",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			inputWorkflow:  []byte(`{"states": [{"name": "Done", "done": true}], "transitions": [{"from": "Done", "to": "Archived"}]}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "update workflow with transition to the same state",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			inputWorkflow:  []byte(`{"states": [{"name": "Done", "done": true}], "transitions": [{"from": "Done", "to": "Done"}]}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "update workflow with invalid body",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			inputWorkflow:  []byte(`{"states": `),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := list.NewResolverList(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/todo/api/list/%s/workflow", utils.TestListId), bytes.NewReader(testCase.inputWorkflow))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String()})
+
+			rr := httptest.NewRecorder()
+
+			resolver.UpdateWorkflow(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverDuplicateList(t *testing.T) {
+	duplicateInput := structures.ListDuplicateInput{Name: utils.TestListName, IncludeMembers: true}
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceList
+		listRole       string
+		inputList      []byte
+		expectedStatus int
+	}{
+		{
+			name: "duplicate list with members as manager",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().DuplicateList(mock.Anything, utils.TestListId, duplicateInput, utils.TestUsername).
+					Return(&structures.ListUserOutput{Name: utils.TestListName, Owner: utils.TestUsername}, nil).
+					Once()
+				return srvMock
+			},
+			listRole:       utils.Manager,
+			inputList:      []byte(fmt.Sprintf(`{"name": " %s ", "include_members": true}`, utils.TestListName)),
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "duplicate list with members as viewer",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			listRole:       utils.Viewer,
+			inputList:      []byte(fmt.Sprintf(`{"name": "%s", "include_members": true}`, utils.TestListName)),
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name: "duplicate list with members as editor",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			listRole:       utils.Editor,
+			inputList:      []byte(fmt.Sprintf(`{"name": "%s", "include_members": true}`, utils.TestListName)),
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name: "duplicate list without members as viewer",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().DuplicateList(mock.Anything, utils.TestListId, structures.ListDuplicateInput{Name: utils.TestListName}, utils.TestUsername).
+					Return(&structures.ListUserOutput{Name: utils.TestListName, Owner: utils.TestUsername}, nil).
+					Once()
+				return srvMock
+			},
+			listRole:       utils.Viewer,
+			inputList:      []byte(fmt.Sprintf(`{"name": "%s"}`, utils.TestListName)),
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "duplicate list with taken name",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().DuplicateList(mock.Anything, utils.TestListId, structures.ListDuplicateInput{Name: utils.TestListName}, utils.TestUsername).
+					Return(nil, errors.New(fmt.Sprintf("error already exists list with this name %s", utils.TestListName))).
+					Once()
+				return srvMock
+			},
+			listRole:       utils.Viewer,
+			inputList:      []byte(fmt.Sprintf(`{"name": "%s"}`, utils.TestListName)),
+			expectedStatus: http.StatusConflict,
+		}, {
+			name: "duplicate list without name",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			listRole:       utils.Manager,
+			inputList:      []byte(`{"name": ""}`),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := testCase.service()
+			resolver := list.NewResolverList(service)
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/todo/api/list/%s/duplicate", utils.TestListId), bytes.NewReader(testCase.inputList))
+			require.NoError(t, err)
+			req.Header.Set("userId", utils.TestUsername)
+			ctx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[testCase.listRole])
+			req = req.WithContext(ctx)
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String()})
+
+			rr := httptest.NewRecorder()
+
+			resolver.DuplicateList(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolverDeleteTemplate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceList
+		expectedStatus int
+	}{
+		{
+			name: "delete own template",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().DeleteTemplate(mock.Anything, utils.TestTemplateId, utils.TestUsername, false).
+					Return(&structures.TemplateOutput{Id: utils.TestTemplateId, Name: utils.TestTemplateName}, nil).
+					Once()
+				return srvMock
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "delete missing template",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().DeleteTemplate(mock.Anything, utils.TestTemplateId, utils.TestUsername, false).
+					Return(nil, errors.New(fmt.Sprintf("error not found template with id: %s", utils.TestTemplateId))).
+					Once()
+				return srvMock
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := list.NewResolverList(testCase.service())
+
+			req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/todo/api/templates/%s", utils.TestTemplateId), nil)
+			require.NoError(t, err)
+			req.Header.Set("userId", utils.TestUsername)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"templateId": utils.TestTemplateId.String()})
+
+			rr := httptest.NewRecorder()
+
+			resolver.DeleteTemplate(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverInstantiateTemplate(t *testing.T) {
+	startDate := time.Date(2030, time.January, 10, 0, 0, 0, 0, time.UTC)
+	instanceInput := structures.TemplateInstanceInput{Name: utils.TestListName, StartDate: startDate}
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceList
+		inputInstance  []byte
+		expectedStatus int
+	}{
+		{
+			name: "create list from template",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().InstantiateTemplate(mock.Anything, utils.TestTemplateId, instanceInput, utils.TestUsername, false).
+					Return(&structures.ListOutput{Id: utils.TestListId, Name: utils.TestListName}, nil).
+					Once()
+				return srvMock
+			},
+			inputInstance:  []byte(fmt.Sprintf(`{"name": "%s", "start_date": "2030-01-10T00:00:00Z"}`, utils.TestListName)),
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "create list from missing template",
+			service: func() *mocks.ServiceList {
+				srvMock := &mocks.ServiceList{}
+				srvMock.EXPECT().InstantiateTemplate(mock.Anything, utils.TestTemplateId, instanceInput, utils.TestUsername, false).
+					Return(nil, errors.New(fmt.Sprintf("error not found template with id: %s", utils.TestTemplateId))).
+					Once()
+				return srvMock
+			},
+			inputInstance:  []byte(fmt.Sprintf(`{"name": "%s", "start_date": "2030-01-10T00:00:00Z"}`, utils.TestListName)),
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "create list from template without start date",
+			service: func() *mocks.ServiceList {
+				return &mocks.ServiceList{}
+			},
+			inputInstance:  []byte(fmt.Sprintf(`{"name": "%s"}`, utils.TestListName)),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := list.NewResolverList(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/todo/api/templates/%s/instantiate", utils.TestTemplateId), bytes.NewReader(testCase.inputInstance))
+			require.NoError(t, err)
+			req.Header.Set("userId", utils.TestUsername)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"templateId": utils.TestTemplateId.String()})
+
+			rr := httptest.NewRecorder()
+
+			resolver.InstantiateTemplate(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -2,14 +2,19 @@ package list
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"project/structures"
+	"project/uow"
+	"project/utils"
+	"time"
 )
 
 //go:generate mockery --name RepositoryList --output=automock --with-expecter=true
 type RepositoryList interface {
 	GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListModel, error)
-	GetAllLists(ctx context.Context) []*structures.ListModel
+	GetAllLists(ctx context.Context, page structures.PageQuery) (*structures.ListPageModel, error)
 	GetListOwner(ctx context.Context, listId uuid.UUID) (*structures.UserModel, error)
 	GetUserFromListById(ctx context.Context, listId uuid.UUID, username string) (*structures.UserModel, error)
 	CreateList(ctx context.Context, entityList structures.ListEntity, entityUser structures.ListUserEntity) error
@@ -19,15 +24,46 @@ type RepositoryList interface {
 	UpdateList(ctx context.Context, listId uuid.UUID, newListName string) (*structures.ListModel, error)
 	CheckIfListExists(ctx context.Context, listId uuid.UUID) bool
 	ContainsUserInList(ctx context.Context, listId uuid.UUID, username string) bool
+	GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string
+	GetListsOfUser(ctx context.Context, username string) []*structures.UserModel
+	GetLabels(ctx context.Context, listId uuid.UUID) ([]structures.LabelModel, error)
+	CreateLabel(ctx context.Context, entityLabel structures.LabelEntity) error
+	UpdateLabel(ctx context.Context, entityLabel structures.LabelEntity) (*structures.LabelModel, error)
+	DeleteLabel(ctx context.Context, labelId, listId uuid.UUID) (*structures.LabelModel, error)
+	GetWorkflow(ctx context.Context, listId uuid.UUID) (*structures.WorkflowModel, error)
+	UpdateWorkflow(ctx context.Context, workflowEntity structures.WorkflowEntity) (*structures.WorkflowModel, error)
+	DuplicateList(ctx context.Context, listId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, includeMembers bool) error
+	GetTemplates(ctx context.Context, owner string) ([]structures.TemplateModel, error)
+	GetTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateModel, error)
+	CreateTemplate(ctx context.Context, listId uuid.UUID, entityTemplate structures.TemplateEntity, startDate *time.Time) (*structures.TemplateModel, error)
+	DeleteTemplate(ctx context.Context, templateId uuid.UUID) (*structures.TemplateModel, error)
+	InstantiateTemplate(ctx context.Context, templateId uuid.UUID, entityList structures.ListEntity, entityUser structures.ListUserEntity, startDate time.Time) error
+}
+
+//go:generate mockery --name AuditLog --output=automock --with-expecter=true
+type AuditLog interface {
+	AddEntry(ctx context.Context, entry structures.AuditEntity) error
 }
 
 type ServiceListImpl struct {
-	repo      RepositoryList
-	converter ServiceConvertorList
+	repo       RepositoryList
+	converter  ServiceConvertorList
+	unitOfWork uow.UnitOfWork
+	auditLog   AuditLog
 }
 
-func NewServiceList(repo RepositoryList, converter ServiceConvertorList) *ServiceListImpl {
-	return &ServiceListImpl{repo: repo, converter: converter}
+func NewServiceList(repo RepositoryList, converter ServiceConvertorList, unitOfWork uow.UnitOfWork, auditLog AuditLog) *ServiceListImpl {
+	return &ServiceListImpl{repo: repo, converter: converter, unitOfWork: unitOfWork, auditLog: auditLog}
+}
+
+// record adds the change to the audit log in the unit of work that made it.
+func (s *ServiceListImpl) record(ctx context.Context, action, entityType, entityId string, listId uuid.UUID, before, after any) error {
+	entry, err := utils.NewAuditEntry(ctx, action, entityType, entityId, listId, nil, before, after)
+	if err != nil {
+		return err
+	}
+
+	return s.auditLog.AddEntry(ctx, *entry)
 }
 
 func (s *ServiceListImpl) GetListById(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error) {
@@ -39,9 +75,16 @@ func (s *ServiceListImpl) GetListById(ctx context.Context, listId uuid.UUID) (*s
 	return s.converter.ConvertListModelToListUserOutput(listModel), nil
 }
 
-func (s *ServiceListImpl) GetAllLists(ctx context.Context) []*structures.ListOutput {
-	result := s.repo.GetAllLists(ctx)
-	return s.converter.ConvertListModelsToOutputs(result)
+func (s *ServiceListImpl) GetAllLists(ctx context.Context, page structures.PageQuery) (*structures.ListPageOutput, error) {
+	listPage, err := s.repo.GetAllLists(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &structures.ListPageOutput{
+		Lists:          s.converter.ConvertListModelsToOutputs(listPage.Lists),
+		PageInfoOutput: utils.ConvertPageInfoToOutput(listPage.PageInfo),
+	}, nil
 }
 
 func (s *ServiceListImpl) GetUserFromListById(ctx context.Context, listId uuid.UUID, username string) (*structures.UserOutput, error) {
@@ -70,26 +113,56 @@ func (s *ServiceListImpl) CreateList(ctx context.Context, listName, username str
 	}
 
 	listEntity, listUserEntity := s.converter.ConvertListModelToEntities(&listModel)
-	err := s.repo.CreateList(ctx, *listEntity, *listUserEntity)
+	listOutput := s.converter.ConvertListModelToOutput(&listModel)
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		err := s.repo.CreateList(ctx, *listEntity, *listUserEntity)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditCreateList, utils.AuditEntityList, listModel.Id.String(), listModel.Id, nil, listOutput)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.converter.ConvertListModelToOutput(&listModel), nil
+	return listOutput, nil
 }
 
-func (s *ServiceListImpl) AddUserToList(ctx context.Context, listId uuid.UUID, username string) error {
+func (s *ServiceListImpl) AddUserToList(ctx context.Context, listId uuid.UUID, username, role string) error {
 	entityUser := structures.ListUserEntity{
 		Username: username,
 		ListId:   listId,
-		IsOwner:  false,
+		Role:     role,
 	}
 
-	return s.repo.AddUserToList(ctx, entityUser)
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		err := s.repo.AddUserToList(ctx, entityUser)
+		if err != nil {
+			return err
+		}
+		member, err := s.repo.GetUserFromListById(ctx, listId, username)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditAddMember, utils.AuditEntityMember, username, listId, nil,
+			s.converter.ConvertUserModelToUserOutput(member))
+	})
 }
 
 func (s *ServiceListImpl) DeleteList(ctx context.Context, listId uuid.UUID) (*structures.ListUserOutput, error) {
-	deletedList, err := s.repo.DeleteList(ctx, listId)
+	var deletedList *structures.ListModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deletedList, err = s.repo.DeleteList(ctx, listId)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditDeleteList, utils.AuditEntityList, listId.String(), listId,
+			s.converter.ConvertListModelToUserOutput(deletedList), nil)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -98,19 +171,27 @@ func (s *ServiceListImpl) DeleteList(ctx context.Context, listId uuid.UUID) (*st
 }
 
 func (s *ServiceListImpl) RemoveUserFromList(ctx context.Context, listId uuid.UUID, username string) (*structures.UserOutput, error) {
-	owner, err := s.repo.GetListOwner(ctx, listId)
-	if err != nil {
-		return nil, err
-	}
+	var removedUser *structures.UserModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		member, err := s.repo.GetUserFromListById(ctx, listId, username)
+		if err != nil {
+			return err
+		}
 
-	isOwner := owner.Username == username
-	entityUser := structures.ListUserEntity{
-		Username: username,
-		ListId:   listId,
-		IsOwner:  isOwner,
-	}
+		entityUser := structures.ListUserEntity{
+			Username: username,
+			ListId:   listId,
+			Role:     member.Role,
+		}
+
+		removedUser, err = s.repo.RemoveUserUserFromList(ctx, entityUser)
+		if err != nil {
+			return err
+		}
 
-	removedUser, err := s.repo.RemoveUserUserFromList(ctx, entityUser)
+		return s.record(ctx, utils.AuditRemoveMember, utils.AuditEntityMember, username, listId,
+			s.converter.ConvertUserModelToUserOutput(member), nil)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +199,20 @@ func (s *ServiceListImpl) RemoveUserFromList(ctx context.Context, listId uuid.UU
 }
 
 func (s *ServiceListImpl) UpdateList(ctx context.Context, listId uuid.UUID, newListName string) (*structures.ListOutput, error) {
-	updatedList, err := s.repo.UpdateList(ctx, listId, newListName)
+	var updatedList *structures.ListModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		listModel, err := s.repo.GetListById(ctx, listId)
+		if err != nil {
+			return err
+		}
+		updatedList, err = s.repo.UpdateList(ctx, listId, newListName)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditUpdateList, utils.AuditEntityList, listId.String(), listId,
+			s.converter.ConvertListModelToOutput(listModel), s.converter.ConvertListModelToOutput(updatedList))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -133,3 +227,298 @@ func (s *ServiceListImpl) CheckIfListExistsInList(ctx context.Context, listId uu
 func (s *ServiceListImpl) ContainUserInList(ctx context.Context, listId uuid.UUID, username string) bool {
 	return s.repo.ContainsUserInList(ctx, listId, username)
 }
+
+func (s *ServiceListImpl) GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string {
+	return s.repo.GetUserListRole(ctx, listId, username)
+}
+
+func (s *ServiceListImpl) GetListsOfUser(ctx context.Context, username string) []*structures.UserOutput {
+	userModels := s.repo.GetListsOfUser(ctx, username)
+	return s.converter.ConvertUserModelsToUserOutputs(userModels)
+}
+
+func (s *ServiceListImpl) GetLabels(ctx context.Context, listId uuid.UUID) ([]structures.LabelOutput, error) {
+	labelModels, err := s.repo.GetLabels(ctx, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]structures.LabelOutput, len(labelModels))
+	for i, labelModel := range labelModels {
+		labels[i] = *s.converter.ConvertLabelModelToOutput(&labelModel)
+	}
+
+	return labels, nil
+}
+
+func (s *ServiceListImpl) CreateLabel(ctx context.Context, listId uuid.UUID, input structures.LabelInput) (*structures.LabelOutput, error) {
+	labelModel := structures.LabelModel{
+		Id:     uuid.New(),
+		ListId: listId,
+		Name:   input.Name,
+		Color:  input.Color,
+	}
+
+	labelOutput := s.converter.ConvertLabelModelToOutput(&labelModel)
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		err := s.repo.CreateLabel(ctx, *s.converter.ConvertLabelModelToEntity(&labelModel))
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditCreateLabel, utils.AuditEntityLabel, labelModel.Id.String(), listId, nil, labelOutput)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return labelOutput, nil
+}
+
+func (s *ServiceListImpl) UpdateLabel(ctx context.Context, labelId, listId uuid.UUID, input structures.LabelInput) (*structures.LabelOutput, error) {
+	labelModel := structures.LabelModel{
+		Id:     labelId,
+		ListId: listId,
+		Name:   input.Name,
+		Color:  input.Color,
+	}
+
+	var updatedLabel *structures.LabelModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		labels, err := s.repo.GetLabels(ctx, listId)
+		if err != nil {
+			return err
+		}
+		updatedLabel, err = s.repo.UpdateLabel(ctx, *s.converter.ConvertLabelModelToEntity(&labelModel))
+		if err != nil {
+			return err
+		}
+
+		var before *structures.LabelOutput
+		for _, label := range labels {
+			if label.Id == labelId {
+				before = s.converter.ConvertLabelModelToOutput(&label)
+			}
+		}
+		return s.record(ctx, utils.AuditUpdateLabel, utils.AuditEntityLabel, labelId.String(), listId,
+			before, s.converter.ConvertLabelModelToOutput(updatedLabel))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertLabelModelToOutput(updatedLabel), nil
+}
+
+func (s *ServiceListImpl) DeleteLabel(ctx context.Context, labelId, listId uuid.UUID) (*structures.LabelOutput, error) {
+	var deletedLabel *structures.LabelModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deletedLabel, err = s.repo.DeleteLabel(ctx, labelId, listId)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditDeleteLabel, utils.AuditEntityLabel, labelId.String(), listId,
+			s.converter.ConvertLabelModelToOutput(deletedLabel), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertLabelModelToOutput(deletedLabel), nil
+}
+
+func (s *ServiceListImpl) GetWorkflow(ctx context.Context, listId uuid.UUID) (*structures.WorkflowOutput, error) {
+	workflowModel, err := s.repo.GetWorkflow(ctx, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertWorkflowModelToOutput(workflowModel), nil
+}
+
+func (s *ServiceListImpl) UpdateWorkflow(ctx context.Context, listId uuid.UUID, input structures.WorkflowInput) (*structures.WorkflowOutput, error) {
+	workflowModel := s.converter.ConvertWorkflowInputToModel(listId, input)
+
+	var updatedWorkflow *structures.WorkflowModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		workflow, err := s.repo.GetWorkflow(ctx, listId)
+		if err != nil {
+			return err
+		}
+		updatedWorkflow, err = s.repo.UpdateWorkflow(ctx, *s.converter.ConvertWorkflowModelToEntity(workflowModel))
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditUpdateWorkflow, utils.AuditEntityWorkflow, listId.String(), listId,
+			s.converter.ConvertWorkflowModelToOutput(workflow), s.converter.ConvertWorkflowModelToOutput(updatedWorkflow))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertWorkflowModelToOutput(updatedWorkflow), nil
+}
+
+func (s *ServiceListImpl) DuplicateList(ctx context.Context, listId uuid.UUID, input structures.ListDuplicateInput, username string) (*structures.ListUserOutput, error) {
+	listModel := structures.ListModel{
+		Id:    uuid.New(),
+		Name:  input.Name,
+		Owner: username,
+		Users: []string{username},
+	}
+
+	listEntity, listUserEntity := s.converter.ConvertListModelToEntities(&listModel)
+	var duplicatedList *structures.ListModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		err := s.repo.DuplicateList(ctx, listId, *listEntity, *listUserEntity, input.IncludeMembers)
+		if err != nil {
+			return err
+		}
+		duplicatedList, err = s.repo.GetListById(ctx, listModel.Id)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditDuplicateList, utils.AuditEntityList, listModel.Id.String(), listModel.Id, nil,
+			s.converter.ConvertListModelToListUserOutput(duplicatedList))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertListModelToListUserOutput(duplicatedList), nil
+}
+
+// GetTemplates returns the templates the user saved, or every template for an admin.
+func (s *ServiceListImpl) GetTemplates(ctx context.Context, username string, isAdmin bool) ([]structures.TemplateOutput, error) {
+	owner := username
+	if isAdmin {
+		owner = ""
+	}
+
+	templateModels, err := s.repo.GetTemplates(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]structures.TemplateOutput, len(templateModels))
+	for i, templateModel := range templateModels {
+		templates[i] = *s.converter.ConvertTemplateModelToOutput(&templateModel)
+	}
+
+	return templates, nil
+}
+
+func (s *ServiceListImpl) GetTemplate(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool) (*structures.TemplateOutput, error) {
+	templateModel, err := s.ownTemplate(ctx, templateId, username, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertTemplateModelToOutput(templateModel), nil
+}
+
+// ownTemplate returns the template if the user saved it or is an admin. The templates of other users are
+// reported as not found, so their ids do not tell that they exist.
+func (s *ServiceListImpl) ownTemplate(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool) (*structures.TemplateModel, error) {
+	templateModel, err := s.repo.GetTemplate(ctx, templateId)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && templateModel.Owner != username {
+		return nil, errors.New(fmt.Sprintf("error %s template with id: %s", utils.NotFoundErrorMsg, templateId))
+	}
+
+	return templateModel, nil
+}
+
+func (s *ServiceListImpl) CreateTemplate(ctx context.Context, listId uuid.UUID, input structures.TemplateInput, username string) (*structures.TemplateOutput, error) {
+	templateEntity := structures.TemplateEntity{
+		Id:    uuid.New(),
+		Name:  input.Name,
+		Owner: username,
+	}
+
+	var startDate *time.Time
+	if input.StartDate != nil {
+		date := truncateToDate(*input.StartDate)
+		startDate = &date
+	}
+
+	var createdTemplate *structures.TemplateModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		createdTemplate, err = s.repo.CreateTemplate(ctx, listId, templateEntity, startDate)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditCreateTemplate, utils.AuditEntityTemplate, templateEntity.Id.String(), listId, nil,
+			s.converter.ConvertTemplateModelToOutput(createdTemplate))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertTemplateModelToOutput(createdTemplate), nil
+}
+
+// DeleteTemplate deletes the template if the user saved it or is an admin. Templates belong to no list,
+// so their deletion is not audited.
+func (s *ServiceListImpl) DeleteTemplate(ctx context.Context, templateId uuid.UUID, username string, isAdmin bool) (*structures.TemplateOutput, error) {
+	var deletedTemplate *structures.TemplateModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		_, err := s.ownTemplate(ctx, templateId, username, isAdmin)
+		if err != nil {
+			return err
+		}
+
+		deletedTemplate, err = s.repo.DeleteTemplate(ctx, templateId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertTemplateModelToOutput(deletedTemplate), nil
+}
+
+// InstantiateTemplate creates a list from the template if the user saved it or is an admin.
+func (s *ServiceListImpl) InstantiateTemplate(ctx context.Context, templateId uuid.UUID, input structures.TemplateInstanceInput, username string, isAdmin bool) (*structures.ListOutput, error) {
+	listModel := structures.ListModel{
+		Id:    uuid.New(),
+		Name:  input.Name,
+		Owner: username,
+		Users: []string{username},
+	}
+
+	listEntity, listUserEntity := s.converter.ConvertListModelToEntities(&listModel)
+	listOutput := s.converter.ConvertListModelToOutput(&listModel)
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		_, err := s.ownTemplate(ctx, templateId, username, isAdmin)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.InstantiateTemplate(ctx, templateId, *listEntity, *listUserEntity, truncateToDate(input.StartDate))
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditUseTemplate, utils.AuditEntityList, listModel.Id.String(), listModel.Id, nil, listOutput)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return listOutput, nil
+}
+
+// truncateToDate keeps the day of the time in UTC, the deadlines of todos are dates.
+func truncateToDate(date time.Time) time.Time {
+	date = date.UTC()
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"flag"
 	log "github.com/sirupsen/logrus"
 	"os"
 	"project/api"
+	"project/config"
 )
 
 func init() {
@@ -14,5 +16,13 @@ func init() {
 }
 
 func main() {
-	api.ServerHandler()
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	level, _ := log.ParseLevel(cfg.Log.Level)
+	log.SetLevel(level)
+
+	api.ServerHandler(cfg)
 }
@@ -0,0 +1,404 @@
+package memory
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project/structures"
+	"project/utils"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+const developmentPasswordHash = "$2a$10$FH4NNQY/Nmf.00eLfifmk.x0pyi4/Tt./2p3j.1nVxDxg5yj.TazW"
+
+// DevelopmentUsers are the accounts of the in-memory storage and of the seed-dev command of the migrate tool,
+// all with the password "example".
+var DevelopmentUsers = []structures.UserAccountEntity{
+	{Username: "Niki", Role: utils.Admin, PasswordHash: developmentPasswordHash, IsActive: true},
+	{Username: "Ivan", Role: utils.Writer, PasswordHash: developmentPasswordHash, IsActive: true},
+	{Username: "Miro", Role: utils.Reader, PasswordHash: developmentPasswordHash, IsActive: true},
+	{Username: "Yosif", Role: utils.Writer, PasswordHash: developmentPasswordHash, IsActive: true},
+}
+
+// Store keeps the tables of the in-memory repositories. Its tables are only
+// safe to use inside Read or Do, and a unit of work changes them through Put,
+// Delete, Append and Change so it can take its changes back.
+type Store struct {
+	mu sync.RWMutex
+	// undo holds the steps taking back the changes of the running unit of work,
+	// oldest first; changed holds the tables Change already saved in it.
+	undo       []func()
+	changed    map[any]bool
+	Users      map[string]structures.UserAccountEntity
+	Sessions   map[uuid.UUID]structures.SessionEntity
+	Lists      map[uuid.UUID]structures.ListEntity
+	UsersLists []structures.ListUserEntity
+	Todos      map[uuid.UUID]structures.TodoEntity
+	Subtasks   map[uuid.UUID]structures.SubtaskEntity
+	Labels     map[uuid.UUID]structures.LabelEntity
+	TodoLabels []structures.TodoLabelEntity
+	Comments   map[uuid.UUID]structures.CommentEntity
+	// TodoAssignees and TodoWatchers are the users working on and following the todos.
+	TodoAssignees []structures.TodoUserEntity
+	TodoWatchers  []structures.TodoUserEntity
+	// TodoDependencies tie the todos to the todos they wait for, across lists.
+	TodoDependencies []structures.TodoDependencyEntity
+	// WorkflowStates and WorkflowTransitions make up the workflows of the lists.
+	WorkflowStates      []structures.WorkflowStateEntity
+	WorkflowTransitions []structures.WorkflowTransitionEntity
+	// Templates are kept together with their todos and outlive the lists they were saved from.
+	Templates map[uuid.UUID]structures.TemplateEntity
+	// AuditLog is append-only and outlives the lists and todos it describes.
+	AuditLog []structures.AuditEntity
+}
+
+func NewStore() *Store {
+	return &Store{
+		Users:     make(map[string]structures.UserAccountEntity),
+		Sessions:  make(map[uuid.UUID]structures.SessionEntity),
+		Lists:     make(map[uuid.UUID]structures.ListEntity),
+		Todos:     make(map[uuid.UUID]structures.TodoEntity),
+		Subtasks:  make(map[uuid.UUID]structures.SubtaskEntity),
+		Labels:    make(map[uuid.UUID]structures.LabelEntity),
+		Comments:  make(map[uuid.UUID]structures.CommentEntity),
+		Templates: make(map[uuid.UUID]structures.TemplateEntity),
+	}
+}
+
+func (s *Store) SeedUsers(users []structures.UserAccountEntity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, user := range users {
+		user.CreatedAt = time.Now()
+		s.Users[user.Username] = user
+	}
+}
+
+// Do runs fn with exclusive access to the store and takes back the changes fn made
+// when it fails, so it serves as the unit of work of the in-memory repositories.
+// Calls nested in fn join the running unit of work.
+func (s *Store) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.inUnitOfWork(ctx) {
+		return fn(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.changed = make(map[any]bool)
+	defer func() {
+		s.undo, s.changed = nil, nil
+	}()
+	err := fn(context.WithValue(ctx, utils.Transaction, s))
+	if err != nil {
+		for i := len(s.undo) - 1; i >= 0; i-- {
+			s.undo[i]()
+		}
+	}
+
+	return err
+}
+
+// Read runs fn with shared access to the store unless a unit of work already owns it.
+func (s *Store) Read(ctx context.Context, fn func()) {
+	if !s.inUnitOfWork(ctx) {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+	}
+
+	fn()
+}
+
+func (s *Store) inUnitOfWork(ctx context.Context) bool {
+	store, ok := ctx.Value(utils.Transaction).(*Store)
+	return ok && store == s
+}
+
+// remember keeps undo for when the running unit of work fails, outside of one
+// there is nothing to take back.
+func (s *Store) remember(undo func()) {
+	if s.changed != nil {
+		s.undo = append(s.undo, undo)
+	}
+}
+
+// Put sets the row of the table under key.
+func Put[K comparable, V any](s *Store, table map[K]V, key K, value V) {
+	rememberRow(s, table, key)
+	table[key] = value
+}
+
+// Delete removes the row of the table under key.
+func Delete[K comparable, V any](s *Store, table map[K]V, key K) {
+	rememberRow(s, table, key)
+	delete(table, key)
+}
+
+func rememberRow[K comparable, V any](s *Store, table map[K]V, key K) {
+	row, existed := table[key]
+	s.remember(func() {
+		if existed {
+			table[key] = row
+		} else {
+			delete(table, key)
+		}
+	})
+}
+
+// Append adds the rows at the end of the table.
+func Append[T any](s *Store, table *[]T, rows ...T) {
+	length := len(*table)
+	*table = append(*table, rows...)
+	s.remember(func() {
+		*table = (*table)[:length]
+	})
+}
+
+// Change saves the rows of the table before the caller rewrites them in place,
+// once per unit of work.
+func Change[T any](s *Store, table *[]T) {
+	if s.changed == nil || s.changed[table] {
+		return
+	}
+
+	s.changed[table] = true
+	rows := slices.Clone(*table)
+	s.remember(func() {
+		*table = rows
+	})
+}
+
+// DeleteTodo removes the todo together with its subtasks, labels, comments, assignees, watchers and
+// dependencies, the way the database cascades the delete.
+func (s *Store) DeleteTodo(todoId uuid.UUID) {
+	Delete(s, s.Todos, todoId)
+	for subtaskId, subtaskEntity := range s.Subtasks {
+		if subtaskEntity.TodoId == todoId {
+			Delete(s, s.Subtasks, subtaskId)
+		}
+	}
+	s.removeTodoLabels(func(todoLabel structures.TodoLabelEntity) bool {
+		return todoLabel.TodoId == todoId
+	})
+	for commentId, commentEntity := range s.Comments {
+		if commentEntity.TodoId == todoId {
+			Delete(s, s.Comments, commentId)
+		}
+	}
+	s.RemoveTodoUsers(&s.TodoAssignees, todoId)
+	s.RemoveTodoUsers(&s.TodoWatchers, todoId)
+	Change(s, &s.TodoDependencies)
+	s.TodoDependencies = slices.DeleteFunc(s.TodoDependencies, func(dependency structures.TodoDependencyEntity) bool {
+		return dependency.TodoId == todoId || dependency.BlockedById == todoId
+	})
+}
+
+// DependsOn tells whether the todo waits for the other one, directly or through the todos it waits for.
+func (s *Store) DependsOn(todoId, otherId uuid.UUID) bool {
+	visited := map[uuid.UUID]bool{todoId: true}
+	pending := []uuid.UUID{todoId}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		for _, dependency := range s.TodoDependencies {
+			if dependency.TodoId != current || visited[dependency.BlockedById] {
+				continue
+			}
+			if dependency.BlockedById == otherId {
+				return true
+			}
+
+			visited[dependency.BlockedById] = true
+			pending = append(pending, dependency.BlockedById)
+		}
+	}
+
+	return false
+}
+
+// dependencyRefs reads the todos of the dependencies that todoId is the own side of, as the database does.
+func (s *Store) dependencyRefs(todoId uuid.UUID, own, other func(dependency structures.TodoDependencyEntity) uuid.UUID) structures.TodoDependencies {
+	var refs structures.TodoDependencies
+	for _, dependency := range s.TodoDependencies {
+		if own(dependency) != todoId {
+			continue
+		}
+
+		otherTodo := s.Todos[other(dependency)]
+		state, _ := s.WorkflowState(otherTodo.ListId, otherTodo.Status)
+		refs = append(refs, structures.TodoDependencyRefEntity{Id: otherTodo.Id, ListId: otherTodo.ListId, Name: otherTodo.Name, Done: state.Done})
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Name != refs[j].Name {
+			return refs[i].Name < refs[j].Name
+		}
+		return refs[i].Id.String() < refs[j].Id.String()
+	})
+
+	return refs
+}
+
+// IsListMember tells whether the user has any role in the list.
+func (s *Store) IsListMember(listId uuid.UUID, username string) bool {
+	return slices.ContainsFunc(s.UsersLists, func(member structures.ListUserEntity) bool {
+		return member.ListId == listId && member.Username == username
+	})
+}
+
+// TodoUsers are the usernames the todo has in todoUsers, sorted the way the database reads them.
+func TodoUsers(todoUsers []structures.TodoUserEntity, todoId uuid.UUID) []string {
+	var usernames []string
+	for _, todoUser := range todoUsers {
+		if todoUser.TodoId == todoId {
+			usernames = append(usernames, todoUser.Username)
+		}
+	}
+	sort.Strings(usernames)
+
+	return usernames
+}
+
+// RemoveTodoUsers drops the rows of the todo from todoUsers, the assignees or the watchers of the store, or only
+// those of the given usernames.
+func (s *Store) RemoveTodoUsers(todoUsers *[]structures.TodoUserEntity, todoId uuid.UUID, usernames ...string) {
+	Change(s, todoUsers)
+	kept := (*todoUsers)[:0]
+	for _, todoUser := range *todoUsers {
+		if todoUser.TodoId != todoId || (len(usernames) > 0 && !slices.Contains(usernames, todoUser.Username)) {
+			kept = append(kept, todoUser)
+		}
+	}
+	*todoUsers = kept
+}
+
+// DeleteLabel removes the label from the catalog of its list and from every todo it is attached to.
+func (s *Store) DeleteLabel(labelId uuid.UUID) {
+	Delete(s, s.Labels, labelId)
+	s.removeTodoLabels(func(todoLabel structures.TodoLabelEntity) bool {
+		return todoLabel.LabelId == labelId
+	})
+}
+
+// WorkflowState finds the state with the given name in the workflow of the list.
+func (s *Store) WorkflowState(listId uuid.UUID, name string) (structures.WorkflowStateEntity, bool) {
+	for _, state := range s.WorkflowStates {
+		if state.ListId == listId && state.Name == name {
+			return state, true
+		}
+	}
+
+	return structures.WorkflowStateEntity{}, false
+}
+
+// InitialState is the first state of the workflow of the list, the one new todos start in.
+func (s *Store) InitialState(listId uuid.UUID) (structures.WorkflowStateEntity, bool) {
+	var initial structures.WorkflowStateEntity
+	found := false
+	for _, state := range s.WorkflowStates {
+		if state.ListId == listId && (!found || state.Position < initial.Position) {
+			initial, found = state, true
+		}
+	}
+
+	return initial, found
+}
+
+// StateBefore is the closest state of the workflow of the list before position, skipping the done ones
+// when asked.
+func (s *Store) StateBefore(listId uuid.UUID, position int, skipDone bool) (structures.WorkflowStateEntity, bool) {
+	var before structures.WorkflowStateEntity
+	found := false
+	for _, state := range s.WorkflowStates {
+		if state.ListId != listId || state.Position >= position || (skipDone && state.Done) {
+			continue
+		}
+		if !found || state.Position > before.Position {
+			before, found = state, true
+		}
+	}
+
+	return before, found
+}
+
+func (s *Store) AllowsTransition(listId uuid.UUID, from, to string) bool {
+	for _, transition := range s.WorkflowTransitions {
+		if transition.ListId == listId && transition.From == from && transition.To == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DeleteWorkflow removes the states and transitions of the workflow of the list.
+func (s *Store) DeleteWorkflow(listId uuid.UUID) {
+	Change(s, &s.WorkflowStates)
+	states := s.WorkflowStates[:0]
+	for _, state := range s.WorkflowStates {
+		if state.ListId != listId {
+			states = append(states, state)
+		}
+	}
+	s.WorkflowStates = states
+
+	Change(s, &s.WorkflowTransitions)
+	transitions := s.WorkflowTransitions[:0]
+	for _, transition := range s.WorkflowTransitions {
+		if transition.ListId != listId {
+			transitions = append(transitions, transition)
+		}
+	}
+	s.WorkflowTransitions = transitions
+}
+
+func (s *Store) removeTodoLabels(matches func(todoLabel structures.TodoLabelEntity) bool) {
+	Change(s, &s.TodoLabels)
+	todoLabels := s.TodoLabels[:0]
+	for _, todoLabel := range s.TodoLabels {
+		if !matches(todoLabel) {
+			todoLabels = append(todoLabels, todoLabel)
+		}
+	}
+	s.TodoLabels = todoLabels
+}
+
+// WithComputedColumns fills the subtask counts, the labels, the assignees, the watchers, the dependencies and
+// the workflow state the database computes when a todo is read.
+func (s *Store) WithComputedColumns(todoEntity structures.TodoEntity) structures.TodoEntity {
+	todoEntity.SubtasksTotal, todoEntity.SubtasksDone = 0, 0
+	for _, subtaskEntity := range s.Subtasks {
+		if subtaskEntity.TodoId != todoEntity.Id {
+			continue
+		}
+
+		todoEntity.SubtasksTotal++
+		if subtaskEntity.Done {
+			todoEntity.SubtasksDone++
+		}
+	}
+
+	todoEntity.Labels = nil
+	for _, todoLabel := range s.TodoLabels {
+		if todoLabel.TodoId == todoEntity.Id {
+			todoEntity.Labels = append(todoEntity.Labels, s.Labels[todoLabel.LabelId])
+		}
+	}
+	sort.Slice(todoEntity.Labels, func(i, j int) bool {
+		return todoEntity.Labels[i].Name < todoEntity.Labels[j].Name
+	})
+
+	todoEntity.Assignees = TodoUsers(s.TodoAssignees, todoEntity.Id)
+	todoEntity.Watchers = TodoUsers(s.TodoWatchers, todoEntity.Id)
+	blockedById := func(dependency structures.TodoDependencyEntity) uuid.UUID { return dependency.BlockedById }
+	dependentId := func(dependency structures.TodoDependencyEntity) uuid.UUID { return dependency.TodoId }
+	todoEntity.BlockedBy = s.dependencyRefs(todoEntity.Id, dependentId, blockedById)
+	todoEntity.Blocks = s.dependencyRefs(todoEntity.Id, blockedById, dependentId)
+
+	state, _ := s.WorkflowState(todoEntity.ListId, todoEntity.Status)
+	todoEntity.StatusPosition, todoEntity.Done = state.Position, state.Done
+
+	return todoEntity
+}
@@ -0,0 +1,130 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"project/memory"
+	"project/structures"
+	"project/utils"
+	"testing"
+)
+
+func TestStoreDo(t *testing.T) {
+	listId := uuid.New()
+	testCases := []struct {
+		name          string
+		fn            func(store *memory.Store) func(ctx context.Context) error
+		expectedErr   string
+		expectedLists int
+	}{
+		{
+			name: "commits changes",
+			fn: func(store *memory.Store) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					memory.Put(store, store.Lists, listId, structures.ListEntity{Id: listId, Name: utils.TestListName})
+					return nil
+				}
+			},
+			expectedLists: 1,
+		}, {
+			name: "restores tables on error",
+			fn: func(store *memory.Store) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					memory.Put(store, store.Lists, listId, structures.ListEntity{Id: listId, Name: utils.TestListName})
+					memory.Append(store, &store.UsersLists, structures.ListUserEntity{ListId: listId, Username: utils.TestUsername})
+					return errors.New("error failing unit of work")
+				}
+			},
+			expectedErr: "error failing unit of work",
+		}, {
+			name: "takes back changes in the order they were made",
+			fn: func(store *memory.Store) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					memory.Put(store, store.Lists, listId, structures.ListEntity{Id: listId, Name: utils.TestListName})
+					memory.Put(store, store.Lists, listId, structures.ListEntity{Id: listId, Name: "Renamed"})
+					memory.Delete(store, store.Lists, listId)
+					memory.Append(store, &store.UsersLists, structures.ListUserEntity{ListId: listId, Username: utils.TestUsername})
+					memory.Change(store, &store.UsersLists)
+					store.UsersLists = store.UsersLists[:0]
+					memory.Append(store, &store.UsersLists, structures.ListUserEntity{ListId: listId, Username: "Other"})
+					return errors.New("error failing unit of work")
+				}
+			},
+			expectedErr: "error failing unit of work",
+		}, {
+			name: "nested call joins the running unit of work",
+			fn: func(store *memory.Store) func(ctx context.Context) error {
+				return func(ctx context.Context) error {
+					err := store.Do(ctx, func(ctx context.Context) error {
+						memory.Put(store, store.Lists, listId, structures.ListEntity{Id: listId, Name: utils.TestListName})
+						return nil
+					})
+					if err != nil {
+						return err
+					}
+
+					return errors.New("error failing outer unit of work")
+				}
+			},
+			expectedErr: "error failing outer unit of work",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := memory.NewStore()
+
+			err := store.Do(utils.HelperGetContext(), testCase.fn(store))
+			if testCase.expectedErr != "" {
+				require.EqualError(t, err, testCase.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			store.Read(utils.HelperGetContext(), func() {
+				require.Len(t, store.Lists, testCase.expectedLists)
+				require.Empty(t, store.UsersLists)
+			})
+		})
+	}
+}
+
+func TestStoreDoKeepsCommittedChanges(t *testing.T) {
+	store := memory.NewStore()
+	listId := uuid.New()
+	member := structures.ListUserEntity{ListId: listId, Username: utils.TestUsername}
+	err := store.Do(utils.HelperGetContext(), func(ctx context.Context) error {
+		memory.Put(store, store.Lists, listId, structures.ListEntity{Id: listId, Name: utils.TestListName})
+		memory.Append(store, &store.UsersLists, member)
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = store.Do(utils.HelperGetContext(), func(ctx context.Context) error {
+		memory.Delete(store, store.Lists, listId)
+		store.RemoveTodoUsers(&store.TodoAssignees, uuid.New())
+		memory.Change(store, &store.UsersLists)
+		store.UsersLists = store.UsersLists[:0]
+		return errors.New("error failing unit of work")
+	})
+	require.EqualError(t, err, "error failing unit of work")
+
+	store.Read(utils.HelperGetContext(), func() {
+		require.Equal(t, utils.TestListName, store.Lists[listId].Name)
+		require.Equal(t, []structures.ListUserEntity{member}, store.UsersLists)
+	})
+}
+
+func TestStoreSeedUsers(t *testing.T) {
+	store := memory.NewStore()
+	store.SeedUsers(memory.DevelopmentUsers)
+
+	store.Read(utils.HelperGetContext(), func() {
+		require.Len(t, store.Users, len(memory.DevelopmentUsers))
+		require.Equal(t, utils.Admin, store.Users["Niki"].Role)
+		require.True(t, store.Users["Niki"].IsActive)
+		require.False(t, store.Users["Niki"].CreatedAt.IsZero())
+	})
+}
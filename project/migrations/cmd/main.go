@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"os"
+	"project/config"
+	"project/memory"
+	"project/migrations"
+	"project/structures"
+	"project/user"
+	"project/utils"
+	"strconv"
+	"strings"
+)
+
+const adminPasswordEnv = "ADMIN_PASSWORD"
+
+const usage = `usage: migrate [flags] <command>
+
+commands:
+  up        apply all pending migrations
+  down      roll back the latest applied migration
+  status    list migrations and whether they are applied
+  to N      migrate up or down to version N (0 rolls back everything)
+  create-admin NAME
+            create the admin NAME with the password from the ADMIN_PASSWORD environment variable
+  seed-dev  create the development accounts, all with the password "example", never run it in production`
+
+func init() {
+	log.SetFormatter(&log.TextFormatter{
+		DisableColors: true,
+	})
+	log.SetOutput(os.Stdout)
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), usage+"\n\nflags:")
+		flag.PrintDefaults()
+	}
+
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	args := flag.CommandLine.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	availableMigrations, err := migrations.Embedded()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db, err := utils.ConnectToDB(cfg.DB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), utils.Logger, log.WithField("command", args[0]))
+	migrator := migrations.NewMigrator(db, availableMigrations)
+
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		err = migrator.Up(ctx)
+	case args[0] == "down" && len(args) == 1:
+		err = migrator.Down(ctx)
+	case args[0] == "status" && len(args) == 1:
+		err = printStatus(ctx, migrator)
+	case args[0] == "to" && len(args) == 2:
+		version, convErr := strconv.Atoi(args[1])
+		if convErr != nil {
+			log.Fatal(fmt.Sprintf("invalid migration version: %s", args[1]))
+		}
+		err = migrator.To(ctx, version)
+	case args[0] == "create-admin" && len(args) == 2:
+		err = createAdmin(ctx, user.NewDBRepositoryUser(db, *user.NewRepositoryUserConvertor()), args[1])
+	case args[0] == "seed-dev" && len(args) == 1:
+		err = seedDevelopmentUsers(ctx, user.NewDBRepositoryUser(db, *user.NewRepositoryUserConvertor()))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func printStatus(ctx context.Context, migrator *migrations.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.Applied {
+			appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+
+		fmt.Printf("%04d  %-40s %s\n", status.Version, status.Name, appliedAt)
+	}
+
+	return nil
+}
+
+func createAdmin(ctx context.Context, repo user.RepositoryUser, username string) error {
+	password := os.Getenv(adminPasswordEnv)
+	if password == "" {
+		return errors.New(fmt.Sprintf("%s must be set to create an admin", adminPasswordEnv))
+	}
+
+	service := user.NewServiceUser(repo, *user.NewServiceUserConvertor())
+	_, err := service.CreateUser(ctx, structures.UserAccountInput{Username: username, Password: password, Role: utils.Admin})
+	if err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("admin %s created", username))
+	return nil
+}
+
+func seedDevelopmentUsers(ctx context.Context, repo user.RepositoryUser) error {
+	for _, developmentUser := range memory.DevelopmentUsers {
+		err := repo.CreateUser(ctx, developmentUser)
+		if err != nil && !strings.Contains(err.Error(), "already exists") {
+			return err
+		}
+	}
+
+	log.Info(fmt.Sprintf("%d development accounts seeded", len(memory.DevelopmentUsers)))
+	return nil
+}
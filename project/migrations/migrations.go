@@ -0,0 +1,98 @@
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+const (
+	upDirection   = "up"
+	downDirection = "down"
+)
+
+//go:embed sql/*.sql
+var embeddedFiles embed.FS
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+func Embedded() ([]Migration, error) {
+	files, err := fs.Sub(embeddedFiles, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	return Load(files)
+}
+
+func Load(files fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		parts := migrationFileName.FindStringSubmatch(entry.Name())
+		if parts == nil {
+			return nil, errors.New(fmt.Sprintf("error invalid migration file name: %s", entry.Name()))
+		}
+
+		version, err := strconv.Atoi(parts[1])
+		if err != nil || version < 1 {
+			return nil, errors.New(fmt.Sprintf("error invalid migration version in file: %s", entry.Name()))
+		}
+
+		content, err := fs.ReadFile(files, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: parts[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != parts[2] {
+			return nil, errors.New(fmt.Sprintf("error migration %d has conflicting names: %s and %s",
+				version, migration.Name, parts[2]))
+		}
+
+		if parts[3] == upDirection {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, errors.New(fmt.Sprintf("error migration %04d_%s must have both up and down files",
+				migration.Version, migration.Name))
+		}
+
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
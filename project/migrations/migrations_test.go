@@ -0,0 +1,68 @@
+package migrations_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"project/migrations"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad(t *testing.T) {
+	testCases := []struct {
+		name        string
+		files       fstest.MapFS
+		expected    []migrations.Migration
+		expectedErr error
+	}{
+		{
+			name: "load migrations ordered by version",
+			files: fstest.MapFS{
+				"0002_add_labels.up.sql":       {Data: []byte("CREATE TABLE labels();")},
+				"0002_add_labels.down.sql":     {Data: []byte("DROP TABLE labels;")},
+				"0001_initial_schema.up.sql":   {Data: []byte("CREATE TABLE list();")},
+				"0001_initial_schema.down.sql": {Data: []byte("DROP TABLE list;")},
+			},
+			expected: []migrations.Migration{
+				{Version: 1, Name: "initial_schema", Up: "CREATE TABLE list();", Down: "DROP TABLE list;"},
+				{Version: 2, Name: "add_labels", Up: "CREATE TABLE labels();", Down: "DROP TABLE labels;"},
+			},
+		}, {
+			name: "migration without down file",
+			files: fstest.MapFS{
+				"0001_initial_schema.up.sql": {Data: []byte("CREATE TABLE list();")},
+			},
+			expectedErr: errors.New("error migration 0001_initial_schema must have both up and down files"),
+		}, {
+			name: "invalid file name",
+			files: fstest.MapFS{
+				"initial_schema.sql": {Data: []byte("CREATE TABLE list();")},
+			},
+			expectedErr: errors.New("error invalid migration file name: initial_schema.sql"),
+		}, {
+			name: "conflicting names for the same version",
+			files: fstest.MapFS{
+				"0001_initial_schema.up.sql": {Data: []byte("CREATE TABLE list();")},
+				"0001_other_schema.down.sql": {Data: []byte("DROP TABLE list;")},
+			},
+			expectedErr: errors.New("error migration 1 has conflicting names: initial_schema and other_schema"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual, err := migrations.Load(testCase.files)
+			require.Equal(t, testCase.expectedErr, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestEmbedded(t *testing.T) {
+	actual, err := migrations.Embedded()
+	require.NoError(t, err)
+	require.NotEmpty(t, actual)
+	require.Equal(t, 1, actual[0].Version)
+	require.Equal(t, "initial_schema", actual[0].Name)
+	require.NotContains(t, actual[0].Up, "INSERT INTO users")
+}
@@ -0,0 +1,256 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"project/utils"
+	"time"
+)
+
+const (
+	schemaMigrationsTable     = "schema_migrations"
+	schemaMigrationsVersion   = "version"
+	schemaMigrationsName      = "name"
+	schemaMigrationsAppliedAt = "applied_at"
+
+	// migrationsLockKey is the Postgres advisory lock held while migrating, so servers starting together
+	// apply every migration once. It spells "migr" in ASCII.
+	migrationsLockKey = 0x6d696772
+)
+
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+type appliedMigration struct {
+	Version   int       `db:"version"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+// executor is what the migrator runs its statements on, the database or the connection holding the lock.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+	BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error)
+}
+
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sqlx.DB, migrations []Migration) *Migrator {
+	return &Migrator{db: db, migrations: migrations}
+}
+
+func (m *Migrator) Up(ctx context.Context) error {
+	latest := 0
+	if len(m.migrations) > 0 {
+		latest = m.migrations[len(m.migrations)-1].Version
+	}
+
+	return m.To(ctx, latest)
+}
+
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.withLock(ctx, func(conn executor) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			if _, ok := applied[m.migrations[i].Version]; ok {
+				return m.run(ctx, conn, m.migrations[i], downDirection)
+			}
+		}
+
+		log := ctx.Value(utils.Logger).(*logrus.Entry)
+		log.Info("no applied migrations to roll back")
+		return nil
+	})
+}
+
+func (m *Migrator) To(ctx context.Context, version int) error {
+	if version != 0 && !m.isKnown(version) {
+		return errors.New(fmt.Sprintf("error not found migration with version: %d", version))
+	}
+
+	return m.withLock(ctx, func(conn executor) error {
+		// The applied migrations are read under the lock, another server may have just finished migrating.
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; ok && migration.Version > version {
+				err = m.run(ctx, conn, migration, downDirection)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; !ok && migration.Version <= version {
+				err = m.run(ctx, conn, migration, upDirection)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = &appliedAt
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) isKnown(version int) bool {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withLock runs fn holding the advisory lock of the migrations. Everything runs on the connection holding the
+// lock, so migrating needs no more than one connection.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn executor) error) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	conn, err := m.db.Connx(ctx)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationsLockKey)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("error locking %s: %s", schemaMigrationsTable, err))
+		log.Error(err)
+		return err
+	}
+	defer func() {
+		_, unlockErr := conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, migrationsLockKey)
+		if unlockErr != nil {
+			log.Error(unlockErr)
+		}
+	}()
+
+	return fn(conn)
+}
+
+func (m *Migrator) applied(ctx context.Context, db executor) (map[int]time.Time, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	%s INT PRIMARY KEY,
+	%s VARCHAR(255) NOT NULL,
+	%s TIMESTAMP NOT NULL DEFAULT NOW()
+)`, schemaMigrationsTable, schemaMigrationsVersion, schemaMigrationsName, schemaMigrationsAppliedAt)
+	_, err := db.ExecContext(ctx, stmt)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("error creating %s table: %s", schemaMigrationsTable, err))
+		log.Error(err)
+		return nil, err
+	}
+
+	var rows []appliedMigration
+	stmt = fmt.Sprintf(`SELECT %s, %s FROM %s`, schemaMigrationsVersion, schemaMigrationsAppliedAt, schemaMigrationsTable)
+	err = db.SelectContext(ctx, &rows, stmt)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("error getting applied migrations: %s", err))
+		log.Error(err)
+		return nil, err
+	}
+
+	applied := make(map[int]time.Time, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row.AppliedAt
+	}
+
+	return applied, nil
+}
+
+func (m *Migrator) run(ctx context.Context, db executor, migration Migration, direction string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry).
+		WithField(schemaMigrationsVersion, migration.Version).
+		WithField(schemaMigrationsName, migration.Name)
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	script := migration.Up
+	if direction == downDirection {
+		script = migration.Down
+	}
+
+	_, err = tx.ExecContext(ctx, script)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("error running migration %04d_%s %s: %s", migration.Version, migration.Name, direction, err))
+		log.Error(err)
+		return err
+	}
+
+	var stmt string
+	var args []any
+	if direction == upDirection {
+		stmt = fmt.Sprintf(`INSERT INTO %s (%s, %s) VALUES (?, ?)`, schemaMigrationsTable, schemaMigrationsVersion, schemaMigrationsName)
+		args = []any{migration.Version, migration.Name}
+	} else {
+		stmt = fmt.Sprintf(`DELETE FROM %s WHERE %s = ?`, schemaMigrationsTable, schemaMigrationsVersion)
+		args = []any{migration.Version}
+	}
+
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("error recording migration %04d_%s %s: %s", migration.Version, migration.Name, direction, err))
+		log.Error(err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	log.Info(fmt.Sprintf("migration %s applied", direction))
+	return nil
+}
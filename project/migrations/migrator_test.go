@@ -0,0 +1,277 @@
+package migrations_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"project/migrations"
+	"project/utils"
+	"regexp"
+	"testing"
+	"time"
+)
+
+const (
+	createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations`
+	selectSchemaMigrations = `SELECT version, applied_at FROM schema_migrations`
+	insertSchemaMigration  = `INSERT INTO schema_migrations \(version, name\) VALUES \(\$1, \$2\)`
+	deleteSchemaMigration  = `DELETE FROM schema_migrations WHERE version = \$1`
+	lockMigrations         = `SELECT pg_advisory_lock\(\$1\)`
+	unlockMigrations       = `SELECT pg_advisory_unlock\(\$1\)`
+	migrationsLockKey      = 0x6d696772
+)
+
+var testMigrations = []migrations.Migration{
+	{Version: 1, Name: "initial_schema", Up: "CREATE TABLE list();", Down: "DROP TABLE list;"},
+	{Version: 2, Name: "add_labels", Up: "CREATE TABLE labels();", Down: "DROP TABLE labels;"},
+}
+
+func TestMigratorUp(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	migrator := migrations.NewMigrator(db, testMigrations)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "apply all pending migrations",
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}))
+				for _, migration := range testMigrations {
+					mock.ExpectBegin()
+					mock.ExpectExec(regexp.QuoteMeta(migration.Up)).WillReturnResult(sqlxmock.NewResult(0, 0))
+					mock.ExpectExec(insertSchemaMigration).
+						WithArgs(migration.Version, migration.Name).
+						WillReturnResult(sqlxmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				}
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+		}, {
+			name: "apply only pending migrations",
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}).AddRow(1, time.Now()))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(testMigrations[1].Up)).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(insertSchemaMigration).
+					WithArgs(testMigrations[1].Version, testMigrations[1].Name).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+		}, {
+			name: "nothing to apply",
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}).
+						AddRow(1, time.Now()).
+						AddRow(2, time.Now()))
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+		}, {
+			name: "failing migration is rolled back",
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(testMigrations[0].Up)).WillReturnError(errors.New("syntax error"))
+				mock.ExpectRollback()
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+			expectedErr: errors.New("error running migration 0001_initial_schema up: syntax error"),
+		}, {
+			name: "lock cannot be taken",
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnError(errors.New("connection refused"))
+			},
+			expectedErr: errors.New("error locking schema_migrations: connection refused"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := migrator.Up(ctx)
+			require.Equal(t, testCase.expectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigratorDown(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	migrator := migrations.NewMigrator(db, testMigrations)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "roll back latest applied migration",
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}).
+						AddRow(1, time.Now()).
+						AddRow(2, time.Now()))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(testMigrations[1].Down)).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(deleteSchemaMigration).
+					WithArgs(testMigrations[1].Version).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+		}, {
+			name: "no applied migrations",
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}))
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+		}, {
+			name: "schema_migrations table cannot be read",
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).WillReturnError(errors.New("connection refused"))
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+			expectedErr: errors.New("error getting applied migrations: connection refused"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := migrator.Down(ctx)
+			require.Equal(t, testCase.expectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigratorTo(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	migrator := migrations.NewMigrator(db, testMigrations)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		version     int
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name:    "migrate up to version",
+			version: 1,
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(testMigrations[0].Up)).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(insertSchemaMigration).
+					WithArgs(testMigrations[0].Version, testMigrations[0].Name).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+		}, {
+			name:    "migrate down to zero",
+			version: 0,
+			mock: func() {
+				mock.ExpectExec(lockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectQuery(selectSchemaMigrations).
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}).
+						AddRow(1, time.Now()).
+						AddRow(2, time.Now()))
+				for i := len(testMigrations) - 1; i >= 0; i-- {
+					mock.ExpectBegin()
+					mock.ExpectExec(regexp.QuoteMeta(testMigrations[i].Down)).WillReturnResult(sqlxmock.NewResult(0, 0))
+					mock.ExpectExec(deleteSchemaMigration).
+						WithArgs(testMigrations[i].Version).
+						WillReturnResult(sqlxmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				}
+				mock.ExpectExec(unlockMigrations).WithArgs(migrationsLockKey).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+		}, {
+			name:        "migrate to unknown version",
+			version:     7,
+			mock:        func() {},
+			expectedErr: errors.New("error not found migration with version: 7"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := migrator.To(ctx, testCase.version)
+			require.Equal(t, testCase.expectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigratorStatus(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	migrator := migrations.NewMigrator(db, testMigrations)
+	ctx := utils.HelperGetContext()
+	appliedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(createSchemaMigrations).WillReturnResult(sqlxmock.NewResult(0, 0))
+	mock.ExpectQuery(selectSchemaMigrations).
+		WillReturnRows(sqlxmock.NewRows([]string{"version", "applied_at"}).AddRow(1, appliedAt))
+
+	actual, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []migrations.MigrationStatus{
+		{Version: 1, Name: "initial_schema", Applied: true, AppliedAt: &appliedAt},
+		{Version: 2, Name: "add_labels"},
+	}, actual)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
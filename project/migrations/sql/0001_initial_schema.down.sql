@@ -1,15 +1,19 @@
-BEGIN;
-
 DROP TABLE IF EXISTS list CASCADE;
 
 DROP TABLE IF EXISTS users_lists CASCADE;
 
 DROP TABLE IF EXISTS todo CASCADE;
 
+DROP TABLE IF EXISTS user_sessions CASCADE;
+
+DROP TABLE IF EXISTS users CASCADE;
+
 DROP TYPE IF EXISTS priority_type CASCADE;
 
 DROP TYPE IF EXISTS status_type CASCADE;
 
-DROP FUNCTION IF EXISTS modify_time_field();
+DROP TYPE IF EXISTS role_type CASCADE;
+
+DROP TYPE IF EXISTS list_role_type CASCADE;
 
-COMMIT;
\ No newline at end of file
+DROP FUNCTION IF EXISTS modify_time_field();
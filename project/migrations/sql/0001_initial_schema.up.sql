@@ -1,4 +1,20 @@
-BEGIN;
+CREATE TYPE role_type
+AS ENUM('reader', 'writer', 'admin');
+
+CREATE TABLE IF NOT EXISTS users (
+    username VARCHAR(100) NOT NULL PRIMARY KEY,
+    role role_type NOT NULL DEFAULT 'reader',
+    password_hash VARCHAR(255) NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at DATE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS user_sessions (
+    id UUID NOT NULL PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    username VARCHAR(100) NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    revoked BOOLEAN NOT NULL DEFAULT FALSE
+);
 
 CREATE TABLE IF NOT EXISTS list (
     id UUID NOT NULL PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
@@ -6,10 +22,13 @@ CREATE TABLE IF NOT EXISTS list (
     created_at DATE NOT NULL
 );
 
+CREATE TYPE list_role_type
+AS ENUM('viewer', 'editor', 'manager', 'owner');
+
 CREATE TABLE IF NOT EXISTS users_lists (
     username VARCHAR(100) NOT NULL,
     list_id UUID NOT NULL REFERENCES list(id) ON DELETE CASCADE,
-    is_owner BOOLEAN NOT NULL DEFAULT FALSE,
+    role list_role_type NOT NULL DEFAULT 'viewer',
     CONSTRAINT user_list_connection UNIQUE (list_id, username)
 );
 
@@ -52,6 +71,11 @@ CREATE TRIGGER modify_time_field_when_insert_todo_trigger
     FOR EACH ROW
 EXECUTE FUNCTION modify_time_field();
 
+CREATE TRIGGER modify_time_field_when_insert_users_trigger
+    BEFORE INSERT ON users
+    FOR EACH ROW
+EXECUTE FUNCTION modify_time_field();
+
 CREATE INDEX users_lists_username_index
 ON users_lists(username);
 
@@ -61,4 +85,5 @@ ON users_lists(list_id);
 CREATE INDEX todo_list_id_index
 ON todo(list_id);
 
-COMMIT;
\ No newline at end of file
+CREATE INDEX user_sessions_username_index
+ON user_sessions(username);
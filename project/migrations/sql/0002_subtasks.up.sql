@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS subtask (
+    id UUID NOT NULL PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    todo_id UUID NOT NULL REFERENCES todo(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    assignee VARCHAR(100) NOT NULL DEFAULT '',
+    position INT NOT NULL CHECK (position >= 0)
+);
+
+CREATE INDEX subtask_todo_id_index
+ON subtask(todo_id, position);
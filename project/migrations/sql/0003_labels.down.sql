@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS todo_label CASCADE;
+
+DROP TABLE IF EXISTS label CASCADE;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS label (
+    id UUID NOT NULL PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    list_id UUID NOT NULL REFERENCES list(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    color VARCHAR(7) NOT NULL,
+    CONSTRAINT label_list_constraint UNIQUE (name, list_id)
+);
+
+CREATE TABLE IF NOT EXISTS todo_label (
+    todo_id UUID NOT NULL REFERENCES todo(id) ON DELETE CASCADE,
+    label_id UUID NOT NULL REFERENCES label(id) ON DELETE CASCADE,
+    PRIMARY KEY (todo_id, label_id)
+);
+
+CREATE INDEX label_list_id_index
+ON label(list_id);
+
+CREATE INDEX todo_label_label_id_index
+ON todo_label(label_id);
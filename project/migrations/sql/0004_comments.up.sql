@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS comment (
+    id UUID NOT NULL PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    todo_id UUID NOT NULL REFERENCES todo(id) ON DELETE CASCADE,
+    author VARCHAR(100) NOT NULL,
+    body VARCHAR(2000) NOT NULL CHECK (body <> ''),
+    created_at TIMESTAMPTZ NOT NULL,
+    edited_at TIMESTAMPTZ
+);
+
+CREATE INDEX comment_todo_id_index
+ON comment(todo_id, created_at, id);
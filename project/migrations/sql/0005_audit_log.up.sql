@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID NOT NULL PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    actor VARCHAR(100) NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(100) NOT NULL,
+    list_id UUID NOT NULL,
+    todo_id UUID,
+    before JSONB,
+    after JSONB,
+    request_id VARCHAR(36) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX audit_log_created_at_index
+ON audit_log(created_at, id);
+
+CREATE INDEX audit_log_list_id_index
+ON audit_log(list_id, created_at, id);
+
+CREATE INDEX audit_log_todo_id_index
+ON audit_log(todo_id, created_at, id);
+
+CREATE INDEX audit_log_actor_index
+ON audit_log(actor, created_at, id);
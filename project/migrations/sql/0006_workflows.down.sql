@@ -0,0 +1,11 @@
+CREATE TYPE status_type
+AS ENUM('Undefined', 'Not Assigned', 'Assigned', 'In Progress', 'In Review', 'Completed');
+
+ALTER TABLE todo ALTER COLUMN status TYPE status_type
+USING (CASE WHEN status IN ('Not Assigned', 'Assigned', 'In Progress', 'In Review', 'Completed') THEN status ELSE 'Undefined' END)::status_type;
+
+ALTER TABLE todo ALTER COLUMN status SET DEFAULT 'Not Assigned';
+
+DROP TABLE IF EXISTS workflow_transition CASCADE;
+
+DROP TABLE IF EXISTS workflow_state CASCADE;
@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS workflow_state (
+    list_id UUID NOT NULL REFERENCES list(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL CHECK (name <> ''),
+    position INT NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (list_id, name),
+    CONSTRAINT workflow_state_position_constraint UNIQUE (list_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS workflow_transition (
+    list_id UUID NOT NULL,
+    from_state VARCHAR(50) NOT NULL,
+    to_state VARCHAR(50) NOT NULL,
+    PRIMARY KEY (list_id, from_state, to_state),
+    FOREIGN KEY (list_id, from_state) REFERENCES workflow_state(list_id, name) ON DELETE CASCADE,
+    FOREIGN KEY (list_id, to_state) REFERENCES workflow_state(list_id, name) ON DELETE CASCADE
+);
+
+INSERT INTO workflow_state(list_id, name, position, done)
+SELECT list.id, state.name, state.position, state.done
+FROM list CROSS JOIN (VALUES
+    ('Not Assigned', 0, FALSE),
+    ('Assigned', 1, FALSE),
+    ('In Progress', 2, FALSE),
+    ('In Review', 3, FALSE),
+    ('Completed', 4, TRUE)
+) AS state(name, position, done);
+
+INSERT INTO workflow_transition(list_id, from_state, to_state)
+SELECT list.id, transition.from_state, transition.to_state
+FROM list CROSS JOIN (VALUES
+    ('Not Assigned', 'Assigned'),
+    ('Assigned', 'In Progress'),
+    ('In Progress', 'In Review'),
+    ('In Review', 'Completed')
+) AS transition(from_state, to_state);
+
+ALTER TABLE todo ALTER COLUMN status DROP DEFAULT;
+
+ALTER TABLE todo ALTER COLUMN status TYPE VARCHAR(50)
+USING (CASE WHEN status = 'Undefined' THEN 'Not Assigned' ELSE status::TEXT END);
+
+DROP TYPE IF EXISTS status_type;
@@ -0,0 +1,8 @@
+ALTER TABLE todo ADD COLUMN IF NOT EXISTS assignee VARCHAR(100) DEFAULT '';
+
+UPDATE todo SET assignee = (SELECT MIN(username) FROM todo_assignee WHERE todo_assignee.todo_id = todo.id)
+WHERE EXISTS (SELECT 1 FROM todo_assignee WHERE todo_assignee.todo_id = todo.id);
+
+DROP TABLE IF EXISTS todo_watcher CASCADE;
+
+DROP TABLE IF EXISTS todo_assignee CASCADE;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS todo_assignee (
+    todo_id UUID NOT NULL REFERENCES todo(id) ON DELETE CASCADE,
+    username VARCHAR(100) NOT NULL CHECK (username <> ''),
+    PRIMARY KEY (todo_id, username)
+);
+
+CREATE TABLE IF NOT EXISTS todo_watcher (
+    todo_id UUID NOT NULL REFERENCES todo(id) ON DELETE CASCADE,
+    username VARCHAR(100) NOT NULL CHECK (username <> ''),
+    PRIMARY KEY (todo_id, username)
+);
+
+CREATE INDEX todo_assignee_username_index
+ON todo_assignee(username);
+
+INSERT INTO todo_assignee(todo_id, username)
+SELECT id, assignee FROM todo WHERE assignee <> '';
+
+ALTER TABLE todo DROP COLUMN IF EXISTS assignee;
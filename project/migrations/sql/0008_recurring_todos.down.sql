@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS todo_list_constraint;
+
+-- The superseded occurrences are kept under their name and the day they were due on, so the names are unique again.
+UPDATE todo SET name = LEFT(name, 87) || ' (' || TO_CHAR(deadline, 'YYYY-MM-DD') || ')' WHERE superseded;
+
+ALTER TABLE todo ADD CONSTRAINT todo_list_constraint UNIQUE (name, list_id);
+
+DROP INDEX IF EXISTS todo_series_index;
+
+ALTER TABLE todo DROP CONSTRAINT IF EXISTS todo_superseded_series_constraint;
+
+ALTER TABLE todo DROP COLUMN IF EXISTS superseded;
+
+ALTER TABLE todo DROP COLUMN IF EXISTS series_id;
+
+ALTER TABLE todo DROP COLUMN IF EXISTS recurrence;
@@ -0,0 +1,17 @@
+ALTER TABLE todo ADD COLUMN IF NOT EXISTS recurrence VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE todo ADD COLUMN IF NOT EXISTS series_id UUID;
+
+-- An occurrence is superseded once the next occurrence of its series is created and takes over its name.
+ALTER TABLE todo ADD COLUMN IF NOT EXISTS superseded BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE todo ADD CONSTRAINT todo_superseded_series_constraint CHECK (NOT superseded OR series_id IS NOT NULL);
+
+CREATE INDEX todo_series_index
+ON todo(series_id);
+
+-- Names stay unique among the todos of a list, only the superseded occurrences of a series share the name of the next one.
+ALTER TABLE todo DROP CONSTRAINT IF EXISTS todo_list_constraint;
+
+CREATE UNIQUE INDEX todo_list_constraint
+ON todo(name, list_id) WHERE NOT superseded;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS todo_dependency (
+    todo_id UUID NOT NULL REFERENCES todo(id) ON DELETE CASCADE,
+    blocked_by_id UUID NOT NULL REFERENCES todo(id) ON DELETE CASCADE,
+    PRIMARY KEY (todo_id, blocked_by_id),
+    CHECK (todo_id <> blocked_by_id)
+);
+
+CREATE INDEX todo_dependency_blocked_by_index
+ON todo_dependency(blocked_by_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS list_template_todo CASCADE;
+DROP TABLE IF EXISTS list_template CASCADE;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS list_template (
+    id UUID NOT NULL PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    name VARCHAR(100) NOT NULL,
+    owner VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- The workflow and the label catalog are copied whole into every list created from the template.
+    workflow JSONB NOT NULL,
+    labels JSONB NOT NULL DEFAULT '[]',
+    -- Templates are private to the user who saved them, so only the templates of one owner need distinct names.
+    CONSTRAINT list_template_owner_name_key UNIQUE (owner, name)
+);
+
+CREATE TABLE IF NOT EXISTS list_template_todo (
+    template_id UUID NOT NULL REFERENCES list_template(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position >= 0),
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(1024) NOT NULL DEFAULT '',
+    priority priority_type NOT NULL,
+    recurrence VARCHAR(255) NOT NULL DEFAULT '',
+    -- The deadline of the todo is this many days after the start date of the list created from the template.
+    deadline_offset INT NOT NULL,
+    labels JSONB NOT NULL DEFAULT '[]',
+    subtasks JSONB NOT NULL DEFAULT '[]',
+    PRIMARY KEY (template_id, position)
+);
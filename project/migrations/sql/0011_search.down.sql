@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS todo_search_index;
+DROP INDEX IF EXISTS list_search_index;
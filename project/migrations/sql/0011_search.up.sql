@@ -0,0 +1,6 @@
+-- The search matches the same expressions, so the planner can use these indexes for it.
+CREATE INDEX IF NOT EXISTS list_search_index
+ON list USING GIN ((setweight(to_tsvector('english', name), 'A')));
+
+CREATE INDEX IF NOT EXISTS todo_search_index
+ON todo USING GIN ((setweight(to_tsvector('english', name), 'A') || setweight(to_tsvector('english', COALESCE(description, '')), 'B')));
@@ -0,0 +1,117 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"project/structures"
+	"project/utils"
+	"testing"
+	"time"
+)
+
+// RunRepositoryAudit checks the behaviour every audit.RepositoryAudit implementation has to share.
+func RunRepositoryAudit(t *testing.T, newBackend NewBackend) {
+	t.Run("filter and page entries newest first", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		other := backend.createList(t, testOwner)
+		todoId := uuid.New()
+		// The actor is unique, so entries other tests leave in the database do not match it.
+		actor := backend.listName()
+		createdAt := time.Now().UTC().Truncate(time.Microsecond)
+
+		oldest := backend.addAuditEntry(t, testOwner, listEntity.Id, nil, createdAt)
+		middle := backend.addAuditEntry(t, actor, listEntity.Id, &todoId, createdAt.Add(time.Minute))
+		newest := backend.addAuditEntry(t, actor, other.Id, nil, createdAt.Add(2*time.Minute))
+
+		listPage, err := backend.Audit.GetEntries(ctx, structures.AuditQuery{ListId: &listEntity.Id})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{middle.Id, oldest.Id}, auditIds(listPage))
+		require.Equal(t, 2, listPage.TotalCount)
+		require.JSONEq(t, `{"name":"before"}`, string(listPage.Entries[1].Before))
+		require.Nil(t, listPage.Entries[1].TodoId)
+		require.True(t, listPage.Entries[1].CreatedAt.Equal(createdAt))
+
+		todoPage, err := backend.Audit.GetEntries(ctx, structures.AuditQuery{ListId: &listEntity.Id, TodoId: &todoId})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{middle.Id}, auditIds(todoPage))
+		require.Equal(t, todoId, *todoPage.Entries[0].TodoId)
+
+		firstPage, err := backend.Audit.GetEntries(ctx, structures.AuditQuery{
+			Actor:     actor,
+			PageQuery: structures.PageQuery{Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{newest.Id}, auditIds(firstPage))
+		require.True(t, firstPage.HasNextPage)
+
+		nextPage, err := backend.Audit.GetEntries(ctx, structures.AuditQuery{
+			Actor:     actor,
+			PageQuery: structures.PageQuery{Limit: 1, After: firstPage.EndCursor},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{middle.Id}, auditIds(nextPage))
+		require.False(t, nextPage.HasNextPage)
+		require.True(t, nextPage.HasPreviousPage)
+	})
+
+	t.Run("entries roll back with the unit of work", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		entry := newAuditEntry(testOwner, listEntity.Id, nil, time.Now().UTC())
+
+		failure := errors.New("change failed")
+		err := backend.do(func(ctx context.Context) error {
+			err := backend.Audit.AddEntry(ctx, entry)
+			if err != nil {
+				return err
+			}
+
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+
+		auditPage, err := backend.Audit.GetEntries(utils.HelperGetContext(), structures.AuditQuery{ListId: &listEntity.Id})
+		require.NoError(t, err)
+		require.Empty(t, auditPage.Entries)
+	})
+}
+
+func newAuditEntry(actor string, listId uuid.UUID, todoId *uuid.UUID, createdAt time.Time) structures.AuditEntity {
+	return structures.AuditEntity{
+		Id:         uuid.New(),
+		Actor:      actor,
+		Action:     utils.AuditUpdateList,
+		EntityType: utils.AuditEntityList,
+		EntityId:   listId.String(),
+		ListId:     listId,
+		TodoId:     todoId,
+		Before:     []byte(`{"name":"before"}`),
+		After:      []byte(`{"name":"after"}`),
+		RequestId:  uuid.NewString(),
+		CreatedAt:  createdAt,
+	}
+}
+
+func (b Backend) addAuditEntry(t *testing.T, actor string, listId uuid.UUID, todoId *uuid.UUID, createdAt time.Time) structures.AuditEntity {
+	entry := newAuditEntry(actor, listId, todoId, createdAt)
+
+	err := b.do(func(ctx context.Context) error {
+		return b.Audit.AddEntry(ctx, entry)
+	})
+	require.NoError(t, err)
+
+	return entry
+}
+
+func auditIds(auditPage *structures.AuditPageModel) []uuid.UUID {
+	ids := make([]uuid.UUID, len(auditPage.Entries))
+	for i, auditModel := range auditPage.Entries {
+		ids[i] = auditModel.Id
+	}
+
+	return ids
+}
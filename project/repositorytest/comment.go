@@ -0,0 +1,149 @@
+package repositorytest
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"project/structures"
+	"project/utils"
+	"testing"
+	"time"
+)
+
+// RunRepositoryComment checks the behaviour every comment.RepositoryComment implementation has to share.
+func RunRepositoryComment(t *testing.T, newBackend NewBackend) {
+	t.Run("page comments oldest first", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		createdAt := time.Now().UTC().Truncate(time.Microsecond)
+		first := backend.createComment(t, listEntity.Id, todoEntity.Id, testOwner, createdAt)
+		second := backend.createComment(t, listEntity.Id, todoEntity.Id, testMember, createdAt.Add(time.Minute))
+		third := backend.createComment(t, listEntity.Id, todoEntity.Id, testOwner, createdAt.Add(2*time.Minute))
+
+		firstPage, err := backend.Comments.GetComments(ctx, todoEntity.Id, listEntity.Id, structures.PageQuery{Limit: 2})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{first.Id, second.Id}, commentIds(firstPage))
+		require.Equal(t, 3, firstPage.TotalCount)
+		require.True(t, firstPage.HasNextPage)
+		require.Equal(t, testMember, firstPage.Comments[1].Author)
+		require.True(t, firstPage.Comments[0].CreatedAt.Equal(createdAt))
+
+		nextPage, err := backend.Comments.GetComments(ctx, todoEntity.Id, listEntity.Id,
+			structures.PageQuery{Limit: 2, After: firstPage.EndCursor})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{third.Id}, commentIds(nextPage))
+		require.False(t, nextPage.HasNextPage)
+		require.True(t, nextPage.HasPreviousPage)
+
+		lastPage, err := backend.Comments.GetComments(ctx, todoEntity.Id, listEntity.Id, structures.PageQuery{Last: 2})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{second.Id, third.Id}, commentIds(lastPage))
+		require.True(t, lastPage.HasPreviousPage)
+	})
+
+	t.Run("comments of missing todo", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		other := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		_, err := backend.Comments.GetComments(utils.HelperGetContext(), todoEntity.Id, other.Id, structures.PageQuery{})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Comments.CreateComment(ctx, structures.CommentEntity{
+				Id:        uuid.New(),
+				TodoId:    todoEntity.Id,
+				Author:    testOwner,
+				Body:      utils.TestCommentBody,
+				CreatedAt: time.Now().UTC(),
+			}, other.Id)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("edit and delete comment", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		created := backend.createComment(t, listEntity.Id, todoEntity.Id, testMember, time.Now().UTC().Truncate(time.Microsecond))
+
+		editedAt := created.CreatedAt.Add(time.Minute)
+		created.Body, created.EditedAt = "edited", &editedAt
+		err := backend.do(func(ctx context.Context) error {
+			_, err := backend.Comments.UpdateComment(ctx, created)
+			return err
+		})
+		require.NoError(t, err)
+
+		commentModel, err := backend.Comments.GetComment(ctx, created.Id, todoEntity.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, "edited", commentModel.Body)
+		require.Equal(t, testMember, commentModel.Author)
+		require.NotNil(t, commentModel.EditedAt)
+		require.True(t, commentModel.EditedAt.Equal(editedAt))
+
+		var deleted *structures.CommentModel
+		err = backend.do(func(ctx context.Context) error {
+			var err error
+			deleted, err = backend.Comments.DeleteComment(ctx, created.Id, todoEntity.Id)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, "edited", deleted.Body)
+
+		_, err = backend.Comments.GetComment(ctx, created.Id, todoEntity.Id, listEntity.Id)
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Comments.UpdateComment(ctx, created)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("delete todo cascades to comments", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		created := backend.createComment(t, listEntity.Id, todoEntity.Id, testOwner, time.Now().UTC())
+
+		err := backend.do(func(ctx context.Context) error {
+			_, err := backend.Todos.DeleteTodo(ctx, todoEntity.Id, listEntity.Id)
+			return err
+		})
+		require.NoError(t, err)
+
+		_, err = backend.Comments.GetComment(utils.HelperGetContext(), created.Id, todoEntity.Id, listEntity.Id)
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+}
+
+func (b Backend) createComment(t *testing.T, listId, todoId uuid.UUID, author string, createdAt time.Time) structures.CommentEntity {
+	commentEntity := structures.CommentEntity{
+		Id:        uuid.New(),
+		TodoId:    todoId,
+		Author:    author,
+		Body:      utils.TestCommentBody,
+		CreatedAt: createdAt,
+	}
+
+	err := b.do(func(ctx context.Context) error {
+		return b.Comments.CreateComment(ctx, commentEntity, listId)
+	})
+	require.NoError(t, err)
+
+	return commentEntity
+}
+
+func commentIds(commentPage *structures.CommentPageModel) []uuid.UUID {
+	ids := make([]uuid.UUID, len(commentPage.Comments))
+	for i, commentModel := range commentPage.Comments {
+		ids[i] = commentModel.Id
+	}
+
+	return ids
+}
@@ -0,0 +1,596 @@
+package repositorytest
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"project/structures"
+	"project/utils"
+	"testing"
+	"time"
+)
+
+const (
+	testOwner  = "Ivan"
+	testMember = "Miro"
+	testCopier = "Yosif"
+)
+
+// RunRepositoryList checks the behaviour every list.RepositoryList implementation has to share.
+func RunRepositoryList(t *testing.T, newBackend NewBackend) {
+	t.Run("create and get list", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		created := backend.createList(t, testOwner)
+
+		listModel, err := backend.Lists.GetListById(ctx, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, created.Id, listModel.Id)
+		require.Equal(t, created.Name, listModel.Name)
+		require.Equal(t, testOwner, listModel.Owner)
+		require.Equal(t, []string{testOwner}, listModel.Users)
+
+		owner, err := backend.Lists.GetListOwner(ctx, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, testOwner, owner.Username)
+		require.Equal(t, created.Name, owner.ListName)
+
+		require.True(t, backend.Lists.CheckIfListExists(ctx, created.Id))
+		require.True(t, backend.Lists.ContainsUserInList(ctx, created.Id, testOwner))
+		require.Equal(t, utils.Owner, backend.Lists.GetUserListRole(ctx, created.Id, testOwner))
+		require.Contains(t, listIds(backend.Lists.GetListsOfUser(ctx, testOwner)), created.Id)
+	})
+
+	t.Run("page lists by name", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		prefix := backend.listName()
+		first := backend.createNamedList(t, testOwner, prefix+"-a")
+		second := backend.createNamedList(t, testOwner, prefix+"-b")
+		third := backend.createNamedList(t, testOwner, prefix+"-c")
+
+		afterFirst, err := backend.Lists.GetAllLists(ctx, structures.PageQuery{Limit: 1, After: &structures.Cursor{
+			SortBy: utils.SortByName,
+			Value:  first.Name,
+			Id:     first.Id,
+		}})
+		require.NoError(t, err)
+		require.Len(t, afterFirst.Lists, 1)
+		require.Equal(t, second.Id, afterFirst.Lists[0].Id)
+		require.True(t, afterFirst.HasNextPage)
+		require.True(t, afterFirst.HasPreviousPage)
+		require.Equal(t, second.Id, afterFirst.EndCursor.Id)
+		require.GreaterOrEqual(t, afterFirst.TotalCount, 3)
+
+		beforeThird, err := backend.Lists.GetAllLists(ctx, structures.PageQuery{Last: 2, Before: &structures.Cursor{
+			SortBy: utils.SortByName,
+			Value:  third.Name,
+			Id:     third.Id,
+		}})
+		require.NoError(t, err)
+		require.Len(t, beforeThird.Lists, 2)
+		require.Equal(t, []uuid.UUID{first.Id, second.Id}, []uuid.UUID{beforeThird.Lists[0].Id, beforeThird.Lists[1].Id})
+		require.True(t, beforeThird.HasNextPage)
+		require.Equal(t, first.Id, beforeThird.StartCursor.Id)
+
+		_, err = backend.Lists.GetAllLists(ctx, structures.PageQuery{After: &structures.Cursor{SortBy: utils.SortByDeadline}})
+		require.ErrorContains(t, err, utils.InvalidCursorErrorMsg)
+	})
+
+	t.Run("get missing list", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		missingId := uuid.New()
+
+		_, err := backend.Lists.GetListById(ctx, missingId)
+		require.ErrorContains(t, err, utils.GetErrorMsg)
+		require.False(t, backend.Lists.CheckIfListExists(ctx, missingId))
+		require.Equal(t, utils.Unknown, backend.Lists.GetUserListRole(ctx, missingId, testOwner))
+	})
+
+	t.Run("duplicate list name", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		created := backend.createList(t, testOwner)
+
+		duplicateId := uuid.New()
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Lists.CreateList(ctx, structures.ListEntity{Id: duplicateId, Name: created.Name},
+				structures.ListUserEntity{ListId: duplicateId, Username: testMember, Role: utils.Owner})
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+		require.False(t, backend.Lists.CheckIfListExists(ctx, duplicateId))
+		require.False(t, backend.Lists.ContainsUserInList(ctx, duplicateId, testMember))
+	})
+
+	t.Run("add and remove member", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		created := backend.createList(t, testOwner)
+		member := structures.ListUserEntity{ListId: created.Id, Username: testMember, Role: utils.Viewer}
+
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Lists.AddUserToList(ctx, member)
+		})
+		require.NoError(t, err)
+		require.Equal(t, utils.Viewer, backend.Lists.GetUserListRole(ctx, created.Id, testMember))
+		userModel, err := backend.Lists.GetUserFromListById(ctx, created.Id, testMember)
+		require.NoError(t, err)
+		require.Equal(t, created.Name, userModel.ListName)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Lists.AddUserToList(ctx, member)
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+
+		var removed *structures.UserModel
+		err = backend.do(func(ctx context.Context) error {
+			var err error
+			removed, err = backend.Lists.RemoveUserUserFromList(ctx, member)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, testMember, removed.Username)
+		require.False(t, removed.IsOwner)
+		require.False(t, backend.Lists.ContainsUserInList(ctx, created.Id, testMember))
+		require.True(t, backend.Lists.CheckIfListExists(ctx, created.Id))
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.RemoveUserUserFromList(ctx, member)
+			return err
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("add member to missing list", func(t *testing.T) {
+		backend := newBackend(t)
+		missingId := uuid.New()
+
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Lists.AddUserToList(ctx, structures.ListUserEntity{ListId: missingId, Username: testMember, Role: utils.Viewer})
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("update list name", func(t *testing.T) {
+		backend := newBackend(t)
+		created := backend.createList(t, testOwner)
+		other := backend.createList(t, testOwner)
+		newName := backend.listName()
+
+		var updated *structures.ListModel
+		err := backend.do(func(ctx context.Context) error {
+			var err error
+			updated, err = backend.Lists.UpdateList(ctx, created.Id, newName)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, newName, updated.Name)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.UpdateList(ctx, created.Id, other.Name)
+			return err
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.UpdateList(ctx, uuid.New(), backend.listName())
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("delete list cascades to members and todos", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		created := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, created.Id, utils.TestTodoName)
+
+		var deleted *structures.ListModel
+		err := backend.do(func(ctx context.Context) error {
+			var err error
+			deleted, err = backend.Lists.DeleteList(ctx, created.Id)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, created.Name, deleted.Name)
+
+		require.False(t, backend.Lists.CheckIfListExists(ctx, created.Id))
+		require.False(t, backend.Lists.ContainsUserInList(ctx, created.Id, testOwner))
+		require.NotContains(t, listIds(backend.Lists.GetListsOfUser(ctx, testOwner)), created.Id)
+		require.False(t, backend.Todos.CheckIfListContainsTodo(ctx, todoEntity.Id, created.Id))
+		require.Empty(t, backend.allTodos(t, created.Id))
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.DeleteList(ctx, created.Id)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("label catalog", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		created := backend.createList(t, testOwner)
+		other := backend.createList(t, testOwner)
+		bug := backend.createLabel(t, created.Id, "bug")
+		docs := backend.createLabel(t, created.Id, "docs")
+		backend.createLabel(t, other.Id, "bug")
+
+		labels, err := backend.Lists.GetLabels(ctx, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{"bug", "docs"}, labelNames(labels))
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Lists.CreateLabel(ctx, structures.LabelEntity{Id: uuid.New(), ListId: created.Id, Name: "bug", Color: utils.TestLabelColor})
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Lists.CreateLabel(ctx, structures.LabelEntity{Id: uuid.New(), ListId: uuid.New(), Name: "bug", Color: utils.TestLabelColor})
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		var updated *structures.LabelModel
+		err = backend.do(func(ctx context.Context) error {
+			var err error
+			updated, err = backend.Lists.UpdateLabel(ctx, structures.LabelEntity{Id: docs.Id, ListId: created.Id, Name: "chore", Color: "#00ff00"})
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, "chore", updated.Name)
+		require.Equal(t, "#00ff00", updated.Color)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.UpdateLabel(ctx, structures.LabelEntity{Id: docs.Id, ListId: created.Id, Name: "bug", Color: utils.TestLabelColor})
+			return err
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.UpdateLabel(ctx, structures.LabelEntity{Id: bug.Id, ListId: other.Id, Name: "feature", Color: utils.TestLabelColor})
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		var deleted *structures.LabelModel
+		err = backend.do(func(ctx context.Context) error {
+			var err error
+			deleted, err = backend.Lists.DeleteLabel(ctx, bug.Id, created.Id)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, "bug", deleted.Name)
+
+		labels, err = backend.Lists.GetLabels(ctx, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{"chore"}, labelNames(labels))
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.DeleteLabel(ctx, bug.Id, created.Id)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("status workflow", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		created := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, created.Id, utils.TestTodoName)
+
+		workflowModel, err := backend.Lists.GetWorkflow(ctx, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{utils.NotAssigned, utils.Assigned, utils.InProgress, utils.InReview, utils.Completed}, stateNames(workflowModel.States))
+		require.True(t, workflowModel.States[4].Done)
+		require.Len(t, workflowModel.Transitions, 4)
+
+		custom := structures.WorkflowEntity{
+			ListId: created.Id,
+			States: []structures.WorkflowStateEntity{
+				{Name: "Done", Position: 1, Done: true},
+				{Name: utils.NotAssigned, Position: 0},
+			},
+			Transitions: []structures.WorkflowTransitionEntity{
+				{From: "Done", To: utils.NotAssigned},
+				{From: utils.NotAssigned, To: "Done"},
+			},
+		}
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.UpdateWorkflow(ctx, custom)
+			return err
+		})
+		require.NoError(t, err)
+
+		workflowModel, err = backend.Lists.GetWorkflow(ctx, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{utils.NotAssigned, "Done"}, stateNames(workflowModel.States))
+		require.Equal(t, []structures.WorkflowTransitionModel{
+			{From: utils.NotAssigned, To: "Done"},
+			{From: "Done", To: utils.NotAssigned},
+		}, workflowModel.Transitions)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.ChangeTodoStatus(ctx, todoEntity.Id, created.Id, "Done")
+		})
+		require.NoError(t, err)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.UpdateWorkflow(ctx, utils.DefaultWorkflow(created.Id))
+			return err
+		})
+		require.ErrorContains(t, err, "because todos are still in status: Done")
+
+		workflowModel, err = backend.Lists.GetWorkflow(ctx, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{utils.NotAssigned, "Done"}, stateNames(workflowModel.States))
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.UpdateWorkflow(ctx, utils.DefaultWorkflow(uuid.New()))
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		_, err = backend.Lists.GetWorkflow(ctx, uuid.New())
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("removing the owner deletes the list", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		created := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, created.Id, utils.TestTodoName)
+
+		var removed *structures.UserModel
+		err := backend.do(func(ctx context.Context) error {
+			var err error
+			removed, err = backend.Lists.RemoveUserUserFromList(ctx, structures.ListUserEntity{
+				ListId:   created.Id,
+				Username: testOwner,
+				Role:     utils.Owner,
+			})
+			return err
+		})
+		require.NoError(t, err)
+		require.True(t, removed.IsOwner)
+		require.Equal(t, created.Name, removed.ListName)
+
+		require.False(t, backend.Lists.CheckIfListExists(ctx, created.Id))
+		require.False(t, backend.Todos.CheckIfListContainsTodo(ctx, todoEntity.Id, created.Id))
+	})
+
+	t.Run("duplicate list", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		source := backend.createList(t, testOwner)
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Lists.AddUserToList(ctx, structures.ListUserEntity{ListId: source.Id, Username: testMember, Role: utils.Viewer})
+		})
+		require.NoError(t, err)
+		bug := backend.createLabel(t, source.Id, "bug")
+		blocker := backend.createTodo(t, source.Id, "blocker")
+		blocked := backend.createTodo(t, source.Id, "blocked")
+		backend.createSubtask(t, source.Id, blocker.Id, "step", nil)
+		err = backend.do(func(ctx context.Context) error {
+			err := backend.Todos.AttachLabel(ctx, blocker.Id, source.Id, bug.Id)
+			if err != nil {
+				return err
+			}
+			err = backend.Todos.AssignTodoToUser(ctx, blocker.Id, source.Id, testMember)
+			if err != nil {
+				return err
+			}
+
+			return backend.Todos.AddTodoDependency(ctx, blocked.Id, source.Id, blocker.Id, source.Id)
+		})
+		require.NoError(t, err)
+
+		duplicate := func(includeMembers bool) uuid.UUID {
+			listEntity := structures.ListEntity{Id: uuid.New(), Name: backend.listName()}
+			err := backend.do(func(ctx context.Context) error {
+				return backend.Lists.DuplicateList(ctx, source.Id, listEntity, structures.ListUserEntity{
+					ListId:   listEntity.Id,
+					Username: testCopier,
+					Role:     utils.Owner,
+				}, includeMembers)
+			})
+			require.NoError(t, err)
+
+			return listEntity.Id
+		}
+
+		withoutMembers := duplicate(false)
+		listModel, err := backend.Lists.GetListById(ctx, withoutMembers)
+		require.NoError(t, err)
+		require.Equal(t, testCopier, listModel.Owner)
+		require.Equal(t, []string{testCopier}, listModel.Users)
+		labels, err := backend.Lists.GetLabels(ctx, withoutMembers)
+		require.NoError(t, err)
+		require.Equal(t, []string{"bug"}, labelNames(labels))
+
+		todos := backend.allTodos(t, withoutMembers)
+		require.Len(t, todos, 2)
+		copies := make(map[string]structures.TodoModel, len(todos))
+		for _, todoModel := range todos {
+			copies[todoModel.Name] = todoModel
+		}
+		require.Empty(t, copies["blocker"].Assignees)
+		require.Equal(t, utils.NotAssigned, copies["blocker"].Status)
+		require.Equal(t, 1, copies["blocker"].SubtasksTotal)
+		require.Equal(t, []string{"bug"}, labelNames(copies["blocker"].Labels))
+		require.Equal(t, labels[0].Id, copies["blocker"].Labels[0].Id)
+		require.Len(t, copies["blocked"].BlockedBy, 1)
+		require.Equal(t, copies["blocker"].Id, copies["blocked"].BlockedBy[0].Id)
+
+		withMembers := duplicate(true)
+		require.Equal(t, utils.Manager, backend.Lists.GetUserListRole(ctx, withMembers, testOwner))
+		require.Equal(t, utils.Viewer, backend.Lists.GetUserListRole(ctx, withMembers, testMember))
+		require.Equal(t, utils.Owner, backend.Lists.GetUserListRole(ctx, withMembers, testCopier))
+		for _, todoModel := range backend.allTodos(t, withMembers) {
+			if todoModel.Name == "blocker" {
+				require.Equal(t, []string{testMember}, todoModel.Assignees)
+				require.Equal(t, utils.Assigned, todoModel.Status)
+			}
+		}
+
+		require.Len(t, backend.allTodos(t, source.Id), 2)
+
+		err = backend.do(func(ctx context.Context) error {
+			listEntity := structures.ListEntity{Id: uuid.New(), Name: source.Name}
+			return backend.Lists.DuplicateList(ctx, source.Id, listEntity,
+				structures.ListUserEntity{ListId: listEntity.Id, Username: testCopier, Role: utils.Owner}, false)
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+	})
+
+	t.Run("list templates", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		source := backend.createList(t, testOwner)
+		bug := backend.createLabel(t, source.Id, "bug")
+		start := time.Date(2030, time.January, 10, 0, 0, 0, 0, time.UTC)
+		later := backend.insertTodo(t, structures.TodoEntity{
+			ListId:      source.Id,
+			Name:        "later",
+			Description: utils.TestTodoDescription,
+			Deadline:    start.AddDate(0, 0, 2),
+			Priority:    utils.HighPriority,
+		})
+		backend.insertTodo(t, structures.TodoEntity{
+			ListId:      source.Id,
+			Name:        "first",
+			Description: utils.TestTodoDescription,
+			Deadline:    start,
+			Priority:    utils.MediumPriority,
+		})
+		backend.createSubtask(t, source.Id, later.Id, "step", nil)
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.AttachLabel(ctx, later.Id, source.Id, bug.Id)
+		})
+		require.NoError(t, err)
+
+		templateEntity := structures.TemplateEntity{Id: uuid.New(), Name: backend.listName(), Owner: testOwner}
+		var created *structures.TemplateModel
+		err = backend.do(func(ctx context.Context) error {
+			var err error
+			created, err = backend.Lists.CreateTemplate(ctx, source.Id, templateEntity, nil)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, templateEntity.Name, created.Name)
+		require.Equal(t, testOwner, created.Owner)
+		require.Equal(t, []string{utils.NotAssigned, utils.Assigned, utils.InProgress, utils.InReview, utils.Completed}, stateNames(created.States))
+		require.Equal(t, []structures.TemplateLabelModel{{Name: "bug", Color: utils.TestLabelColor}}, created.Labels)
+		require.Len(t, created.Todos, 2)
+		require.Equal(t, "first", created.Todos[0].Name)
+		require.Equal(t, 0, created.Todos[0].DeadlineOffset)
+		require.Equal(t, structures.TemplateTodoModel{
+			Name:           "later",
+			Description:    utils.TestTodoDescription,
+			Priority:       utils.HighPriority,
+			DeadlineOffset: 2,
+			Labels:         []string{"bug"},
+			Subtasks:       []string{"step"},
+		}, created.Todos[1])
+
+		templateModel, err := backend.Lists.GetTemplate(ctx, templateEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, created.Todos, templateModel.Todos)
+		templates, err := backend.Lists.GetTemplates(ctx, testOwner)
+		require.NoError(t, err)
+		require.Contains(t, templateIds(templates), templateEntity.Id)
+		templates, err = backend.Lists.GetTemplates(ctx, "")
+		require.NoError(t, err)
+		require.Contains(t, templateIds(templates), templateEntity.Id)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.CreateTemplate(ctx, source.Id, structures.TemplateEntity{Id: uuid.New(), Name: templateEntity.Name, Owner: testOwner}, &start)
+			return err
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+		memberTemplate := structures.TemplateEntity{Id: uuid.New(), Name: templateEntity.Name, Owner: testMember}
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.CreateTemplate(ctx, source.Id, memberTemplate, nil)
+			return err
+		})
+		require.NoError(t, err)
+		templates, err = backend.Lists.GetTemplates(ctx, testMember)
+		require.NoError(t, err)
+		require.Contains(t, templateIds(templates), memberTemplate.Id)
+		require.NotContains(t, templateIds(templates), templateEntity.Id)
+
+		instance := structures.ListEntity{Id: uuid.New(), Name: backend.listName()}
+		instanceStart := time.Date(2031, time.March, 1, 0, 0, 0, 0, time.UTC)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Lists.InstantiateTemplate(ctx, templateEntity.Id, instance,
+				structures.ListUserEntity{ListId: instance.Id, Username: testCopier, Role: utils.Owner}, instanceStart)
+		})
+		require.NoError(t, err)
+		require.Equal(t, utils.Owner, backend.Lists.GetUserListRole(ctx, instance.Id, testCopier))
+		labels, err := backend.Lists.GetLabels(ctx, instance.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{"bug"}, labelNames(labels))
+
+		deadlines := make(map[string]string)
+		for _, todoModel := range backend.allTodos(t, instance.Id) {
+			deadlines[todoModel.Name] = todoModel.Deadline.UTC().Format(time.DateOnly)
+			require.Equal(t, utils.NotAssigned, todoModel.Status)
+			if todoModel.Name == "later" {
+				require.Equal(t, []string{"bug"}, labelNames(todoModel.Labels))
+				require.Equal(t, 1, todoModel.SubtasksTotal)
+			}
+		}
+		require.Equal(t, map[string]string{"first": "2031-03-01", "later": "2031-03-03"}, deadlines)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.DeleteTemplate(ctx, templateEntity.Id)
+			return err
+		})
+		require.NoError(t, err)
+		_, err = backend.Lists.GetTemplate(ctx, templateEntity.Id)
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+		require.True(t, backend.Lists.CheckIfListExists(ctx, instance.Id))
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Lists.InstantiateTemplate(ctx, templateEntity.Id, structures.ListEntity{Id: uuid.New(), Name: backend.listName()},
+				structures.ListUserEntity{Username: testCopier, Role: utils.Owner}, instanceStart)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+}
+
+func templateIds(templateModels []structures.TemplateModel) []uuid.UUID {
+	ids := make([]uuid.UUID, len(templateModels))
+	for i, templateModel := range templateModels {
+		ids[i] = templateModel.Id
+	}
+
+	return ids
+}
+
+func listIds(userModels []*structures.UserModel) []uuid.UUID {
+	ids := make([]uuid.UUID, len(userModels))
+	for i, userModel := range userModels {
+		ids[i] = userModel.ListId
+	}
+
+	return ids
+}
+
+func labelNames(labelModels []structures.LabelModel) []string {
+	names := make([]string, len(labelModels))
+	for i, labelModel := range labelModels {
+		names[i] = labelModel.Name
+	}
+
+	return names
+}
+
+func stateNames(stateModels []structures.WorkflowStateModel) []string {
+	names := make([]string, len(stateModels))
+	for i, stateModel := range stateModels {
+		names[i] = stateModel.Name
+	}
+
+	return names
+}
@@ -0,0 +1,171 @@
+// Package repositorytest holds the contract every list, todo, subtask, comment, audit and search repository
+// implementation has to satisfy, so the in-memory and SQL backends stay interchangeable.
+package repositorytest
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"os"
+	"project/audit"
+	"project/comment"
+	"project/list"
+	"project/memory"
+	"project/migrations"
+	"project/search"
+	"project/structures"
+	"project/subtask"
+	"project/todo"
+	"project/uow"
+	"project/utils"
+	"testing"
+	"time"
+)
+
+const TestDatabaseEnv = "TEST_DATABASE_URL"
+
+type Backend struct {
+	UnitOfWork uow.UnitOfWork
+	Lists      list.RepositoryList
+	Todos      todo.RepositoryTodo
+	Subtasks   subtask.RepositorySubtask
+	Comments   comment.RepositoryComment
+	Audit      audit.RepositoryAudit
+	Search     search.RepositorySearch
+	// NamePrefix starts the name of every list the contract creates.
+	NamePrefix string
+}
+
+// NewBackend returns a backend whose data does not leak into other tests.
+type NewBackend func(t *testing.T) Backend
+
+func NewMemoryBackend(t *testing.T) Backend {
+	store := memory.NewStore()
+	store.SeedUsers(memory.DevelopmentUsers)
+
+	return Backend{
+		UnitOfWork: store,
+		Lists:      list.NewMemoryRepositoryList(store, *list.NewRepositoryListConvertor()),
+		Todos:      todo.NewMemoryRepositoryTodo(store, *todo.NewRepositoryTodoConvertor()),
+		Subtasks:   subtask.NewMemoryRepositorySubtask(store, *subtask.NewRepositorySubtaskConvertor()),
+		Comments:   comment.NewMemoryRepositoryComment(store, *comment.NewRepositoryCommentConvertor()),
+		Audit:      audit.NewMemoryRepositoryAudit(store, *audit.NewRepositoryAuditConvertor()),
+		Search:     search.NewMemoryRepositorySearch(store, *search.NewRepositorySearchConvertor()),
+		NamePrefix: utils.TestListName,
+	}
+}
+
+// NewPostgresBackend connects to the database from TestDatabaseEnv and skips the test when it is not set.
+// Every list and template created through the backend is deleted, the lists together with their audit log,
+// when the test ends.
+func NewPostgresBackend(t *testing.T) Backend {
+	db := ConnectTestDB(t)
+
+	namePrefix := "contract-" + uuid.NewString()
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM audit_log WHERE list_id IN (SELECT id FROM list WHERE name LIKE $1)`, namePrefix+"%")
+		db.Exec(`DELETE FROM list WHERE name LIKE $1`, namePrefix+"%")
+		db.Exec(`DELETE FROM list_template WHERE name LIKE $1`, namePrefix+"%")
+	})
+
+	return Backend{
+		UnitOfWork: uow.NewDBUnitOfWork(db),
+		Lists:      list.NewDBRepositoryList(db, *list.NewRepositoryListConvertor()),
+		Todos:      todo.NewDBRepositoryTodo(db, *todo.NewRepositoryTodoConvertor()),
+		Subtasks:   subtask.NewDBRepositorySubtask(db, *subtask.NewRepositorySubtaskConvertor()),
+		Comments:   comment.NewDBRepositoryComment(db, *comment.NewRepositoryCommentConvertor()),
+		Audit:      audit.NewDBRepositoryAudit(db, *audit.NewRepositoryAuditConvertor()),
+		Search:     search.NewDBRepositorySearch(db, *search.NewRepositorySearchConvertor()),
+		NamePrefix: namePrefix,
+	}
+}
+
+func ConnectTestDB(t *testing.T) *sqlx.DB {
+	dsn := os.Getenv(TestDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", TestDatabaseEnv)
+	}
+
+	db, err := sqlx.Connect("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	availableMigrations, err := migrations.Embedded()
+	require.NoError(t, err)
+	require.NoError(t, migrations.NewMigrator(db, availableMigrations).Up(utils.HelperGetContext()))
+
+	return db
+}
+
+func (b Backend) do(fn func(ctx context.Context) error) error {
+	return b.UnitOfWork.Do(utils.HelperGetContext(), fn)
+}
+
+func (b Backend) createList(t *testing.T, owner string) structures.ListEntity {
+	return b.createNamedList(t, owner, b.listName())
+}
+
+func (b Backend) createNamedList(t *testing.T, owner, name string) structures.ListEntity {
+	listEntity := structures.ListEntity{Id: uuid.New(), Name: name}
+
+	err := b.do(func(ctx context.Context) error {
+		return b.Lists.CreateList(ctx, listEntity, structures.ListUserEntity{
+			ListId:   listEntity.Id,
+			Username: owner,
+			Role:     utils.Owner,
+		})
+	})
+	require.NoError(t, err)
+
+	return listEntity
+}
+
+func (b Backend) createTodo(t *testing.T, listId uuid.UUID, name string) structures.TodoEntity {
+	return b.insertTodo(t, structures.TodoEntity{
+		ListId:      listId,
+		Name:        name,
+		Description: utils.TestTodoDescription,
+		Deadline:    time.Now().Add(24 * time.Hour),
+		Priority:    utils.MediumPriority,
+	})
+}
+
+func (b Backend) insertTodo(t *testing.T, todoEntity structures.TodoEntity) structures.TodoEntity {
+	todoEntity.Id = uuid.New()
+
+	err := b.do(func(ctx context.Context) error {
+		return b.Todos.CreateTodo(ctx, todoEntity)
+	})
+	require.NoError(t, err)
+
+	return todoEntity
+}
+
+func (b Backend) createLabel(t *testing.T, listId uuid.UUID, name string) structures.LabelEntity {
+	labelEntity := structures.LabelEntity{Id: uuid.New(), ListId: listId, Name: name, Color: utils.TestLabelColor}
+
+	err := b.do(func(ctx context.Context) error {
+		return b.Lists.CreateLabel(ctx, labelEntity)
+	})
+	require.NoError(t, err)
+
+	return labelEntity
+}
+
+func (b Backend) allTodos(t *testing.T, listId uuid.UUID) []structures.TodoModel {
+	todoPage, err := b.Todos.GetAllTasks(utils.HelperGetContext(), listId, structures.TodoQuery{})
+	require.NoError(t, err)
+	require.Len(t, todoPage.Todos, todoPage.TotalCount)
+	require.False(t, todoPage.HasNextPage)
+
+	return todoPage.Todos
+}
+
+// listName returns a list name that cannot clash with lists created outside the contract.
+func (b Backend) listName() string {
+	return b.NamePrefix + "-" + uuid.NewString()
+}
@@ -0,0 +1,59 @@
+package repositorytest_test
+
+import (
+	"project/repositorytest"
+	"testing"
+)
+
+var backends = map[string]repositorytest.NewBackend{
+	"memory":   repositorytest.NewMemoryBackend,
+	"postgres": repositorytest.NewPostgresBackend,
+}
+
+func TestRepositoryList(t *testing.T) {
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			repositorytest.RunRepositoryList(t, newBackend)
+		})
+	}
+}
+
+func TestRepositoryTodo(t *testing.T) {
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			repositorytest.RunRepositoryTodo(t, newBackend)
+		})
+	}
+}
+
+func TestRepositorySubtask(t *testing.T) {
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			repositorytest.RunRepositorySubtask(t, newBackend)
+		})
+	}
+}
+
+func TestRepositoryComment(t *testing.T) {
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			repositorytest.RunRepositoryComment(t, newBackend)
+		})
+	}
+}
+
+func TestRepositoryAudit(t *testing.T) {
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			repositorytest.RunRepositoryAudit(t, newBackend)
+		})
+	}
+}
+
+func TestRepositorySearch(t *testing.T) {
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			repositorytest.RunRepositorySearch(t, newBackend)
+		})
+	}
+}
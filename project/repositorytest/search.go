@@ -0,0 +1,126 @@
+package repositorytest
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"project/structures"
+	"project/utils"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RunRepositorySearch checks the behaviour every search.RepositorySearch implementation has to share.
+func RunRepositorySearch(t *testing.T, newBackend NewBackend) {
+	t.Run("search the lists of the user", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		// The word is unique, so lists and todos other tests leave in the database do not match it.
+		word := searchWord()
+
+		listEntity := backend.createNamedList(t, testOwner, backend.listName()+" "+word)
+		named := backend.createTodo(t, listEntity.Id, "Plan "+word)
+		described := backend.insertTodo(t, structures.TodoEntity{
+			ListId:      listEntity.Id,
+			Name:        "Release",
+			Description: "Announce the " + word + " release",
+			Deadline:    time.Now().Add(24 * time.Hour),
+			Priority:    utils.MediumPriority,
+		})
+		backend.createTodo(t, listEntity.Id, "Unrelated")
+		hidden := backend.createList(t, testMember)
+		hiddenTodo := backend.createTodo(t, hidden.Id, word)
+
+		results, err := backend.Search.Search(ctx, structures.SearchQuery{Text: strings.ToUpper(word), Username: testOwner, Limit: 10})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uuid.UUID{listEntity.Id, named.Id, described.Id}, searchIds(results))
+		require.Equal(t, described.Id, results[2].Id)
+		require.Greater(t, results[1].Rank, results[2].Rank)
+		for _, result := range results {
+			require.Equal(t, listEntity.Id, result.ListId)
+			require.Equal(t, listEntity.Name, result.ListName)
+			if result.Id == listEntity.Id {
+				require.Equal(t, "list", result.Type)
+			} else {
+				require.Equal(t, "todo", result.Type)
+			}
+		}
+		require.Contains(t, results[2].DescriptionHighlight, "<b>"+word+"</b>")
+		require.Equal(t, described.Description, results[2].Description)
+
+		results, err = backend.Search.Search(ctx, structures.SearchQuery{Text: word + " announce", Username: testOwner, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{described.Id}, searchIds(results))
+
+		results, err = backend.Search.Search(ctx, structures.SearchQuery{Text: word, All: true, Limit: 10})
+		require.NoError(t, err)
+		require.Contains(t, searchIds(results), hiddenTodo.Id)
+
+		results, err = backend.Search.Search(ctx, structures.SearchQuery{Text: word, Username: testOwner, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+	})
+
+	t.Run("members see the lists they were added to", func(t *testing.T) {
+		backend := newBackend(t)
+		word := searchWord()
+		listEntity := backend.createNamedList(t, testOwner, backend.listName()+" "+word)
+
+		results, err := backend.Search.Search(utils.HelperGetContext(), structures.SearchQuery{Text: word, Username: testMember, Limit: 10})
+		require.NoError(t, err)
+		require.Empty(t, results)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Lists.AddUserToList(ctx, structures.ListUserEntity{ListId: listEntity.Id, Username: testMember, Role: utils.Viewer})
+		})
+		require.NoError(t, err)
+
+		results, err = backend.Search.Search(utils.HelperGetContext(), structures.SearchQuery{Text: word, Username: testMember, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{listEntity.Id}, searchIds(results))
+		require.Contains(t, results[0].NameHighlight, "<b>"+word+"</b>")
+	})
+
+	t.Run("highlights escape the text", func(t *testing.T) {
+		backend := newBackend(t)
+		word := searchWord()
+		listEntity := backend.createList(t, testOwner)
+		backend.insertTodo(t, structures.TodoEntity{
+			ListId:      listEntity.Id,
+			Name:        `<img src=x onerror="alert(1)"> ` + word,
+			Description: "Tom & Jerry's " + word + " <script>",
+			Deadline:    time.Now().Add(24 * time.Hour),
+			Priority:    utils.MediumPriority,
+		})
+
+		results, err := backend.Search.Search(utils.HelperGetContext(), structures.SearchQuery{Text: word, Username: testOwner, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, `&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <b>`+word+`</b>`, results[0].NameHighlight)
+		require.Contains(t, results[0].DescriptionHighlight, `&amp; Jerry&#39;s <b>`+word+`</b> &lt;script&gt;`)
+		require.NotContains(t, results[0].DescriptionHighlight, "<script")
+	})
+}
+
+// searchWord returns a word made of letters only, so every backend reads it as a single word.
+func searchWord() string {
+	return "zq" + strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return 'a' + r - '0'
+		}
+		if r == '-' {
+			return -1
+		}
+		return r
+	}, uuid.NewString())[:12]
+}
+
+func searchIds(results []structures.SearchModel) []uuid.UUID {
+	ids := make([]uuid.UUID, len(results))
+	for i, result := range results {
+		ids[i] = result.Id
+	}
+
+	return ids
+}
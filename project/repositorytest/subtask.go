@@ -0,0 +1,199 @@
+package repositorytest
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"project/structures"
+	"project/utils"
+	"testing"
+)
+
+// RunRepositorySubtask checks the behaviour every subtask.RepositorySubtask implementation has to share.
+func RunRepositorySubtask(t *testing.T, newBackend NewBackend) {
+	t.Run("create subtasks in order", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		second := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "second", nil)
+		third := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "third", nil)
+		first := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "first", positionOf(0))
+		require.Equal(t, 0, first.Position)
+
+		require.Equal(t, []uuid.UUID{first.Id, second.Id, third.Id}, backend.subtaskIds(t, listEntity.Id, todoEntity.Id))
+
+		err := backend.do(func(ctx context.Context) error {
+			_, err := backend.Subtasks.CreateSubtask(ctx, structures.SubtaskEntity{Id: uuid.New(), TodoId: todoEntity.Id, Title: "late"},
+				listEntity.Id, positionOf(4))
+			return err
+		})
+		require.ErrorContains(t, err, utils.InvalidPositionErrorMsg)
+	})
+
+	t.Run("subtasks of missing todo", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		other := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		_, err := backend.Subtasks.GetSubtasks(ctx, todoEntity.Id, other.Id)
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Subtasks.CreateSubtask(ctx, structures.SubtaskEntity{Id: uuid.New(), TodoId: uuid.New(), Title: "missing"},
+				listEntity.Id, nil)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("assignee must be a list member", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		err := backend.do(func(ctx context.Context) error {
+			_, err := backend.Subtasks.CreateSubtask(ctx, structures.SubtaskEntity{
+				Id:       uuid.New(),
+				TodoId:   todoEntity.Id,
+				Title:    utils.TestSubtaskTitle,
+				Assignee: testMember,
+			}, listEntity.Id, nil)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotMemberErrorMsg)
+
+		created := backend.createSubtask(t, listEntity.Id, todoEntity.Id, utils.TestSubtaskTitle, nil)
+		created.Assignee = testOwner
+		var updated *structures.SubtaskModel
+		err = backend.do(func(ctx context.Context) error {
+			var err error
+			updated, err = backend.Subtasks.UpdateSubtask(ctx, created, listEntity.Id, nil)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, testOwner, updated.Assignee)
+	})
+
+	t.Run("update moves subtask and counts progress", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		first := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "first", nil)
+		second := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "second", nil)
+		third := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "third", nil)
+
+		first.Done = true
+		first.Title = "done first"
+		var updated *structures.SubtaskModel
+		err := backend.do(func(ctx context.Context) error {
+			var err error
+			updated, err = backend.Subtasks.UpdateSubtask(ctx, first, listEntity.Id, positionOf(2))
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, updated.Position)
+		require.Equal(t, "done first", updated.Title)
+		require.Equal(t, []uuid.UUID{second.Id, third.Id, first.Id}, backend.subtaskIds(t, listEntity.Id, todoEntity.Id))
+
+		todoModel, err := backend.Todos.GetTodo(ctx, todoEntity.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, 3, todoModel.SubtasksTotal)
+		require.Equal(t, 1, todoModel.SubtasksDone)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Subtasks.UpdateSubtask(ctx, second, listEntity.Id, positionOf(3))
+			return err
+		})
+		require.ErrorContains(t, err, utils.InvalidPositionErrorMsg)
+
+		second.Id = uuid.New()
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Subtasks.UpdateSubtask(ctx, second, listEntity.Id, nil)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("delete subtask closes the gap", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		first := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "first", nil)
+		second := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "second", nil)
+		third := backend.createSubtask(t, listEntity.Id, todoEntity.Id, "third", nil)
+
+		var deleted *structures.SubtaskModel
+		err := backend.do(func(ctx context.Context) error {
+			var err error
+			deleted, err = backend.Subtasks.DeleteSubtask(ctx, second.Id, todoEntity.Id, listEntity.Id)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, second.Title, deleted.Title)
+
+		subtasks, err := backend.Subtasks.GetSubtasks(utils.HelperGetContext(), todoEntity.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Len(t, subtasks, 2)
+		require.Equal(t, first.Id, subtasks[0].Id)
+		require.Equal(t, third.Id, subtasks[1].Id)
+		require.Equal(t, 1, subtasks[1].Position)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Subtasks.DeleteSubtask(ctx, second.Id, todoEntity.Id, listEntity.Id)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("delete todo cascades to subtasks", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		backend.createSubtask(t, listEntity.Id, todoEntity.Id, utils.TestSubtaskTitle, nil)
+
+		err := backend.do(func(ctx context.Context) error {
+			_, err := backend.Todos.DeleteTodo(ctx, todoEntity.Id, listEntity.Id)
+			return err
+		})
+		require.NoError(t, err)
+
+		_, err = backend.Subtasks.GetSubtasks(ctx, todoEntity.Id, listEntity.Id)
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+}
+
+func positionOf(position int) *int {
+	return &position
+}
+
+func (b Backend) createSubtask(t *testing.T, listId, todoId uuid.UUID, title string, position *int) structures.SubtaskEntity {
+	subtaskEntity := structures.SubtaskEntity{Id: uuid.New(), TodoId: todoId, Title: title}
+
+	var created *structures.SubtaskModel
+	err := b.do(func(ctx context.Context) error {
+		var err error
+		created, err = b.Subtasks.CreateSubtask(ctx, subtaskEntity, listId, position)
+		return err
+	})
+	require.NoError(t, err)
+	subtaskEntity.Position = created.Position
+
+	return subtaskEntity
+}
+
+func (b Backend) subtaskIds(t *testing.T, listId, todoId uuid.UUID) []uuid.UUID {
+	subtasks, err := b.Subtasks.GetSubtasks(utils.HelperGetContext(), todoId, listId)
+	require.NoError(t, err)
+
+	ids := make([]uuid.UUID, len(subtasks))
+	for i, subtaskModel := range subtasks {
+		ids[i] = subtaskModel.Id
+	}
+
+	return ids
+}
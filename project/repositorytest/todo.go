@@ -0,0 +1,842 @@
+package repositorytest
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"project/structures"
+	"project/utils"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// RunRepositoryTodo checks the behaviour every todo.RepositoryTodo implementation has to share.
+func RunRepositoryTodo(t *testing.T, newBackend NewBackend) {
+	t.Run("create and get todo", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		second := backend.createTodo(t, listEntity.Id, "b-"+utils.TestTodoName)
+		first := backend.createTodo(t, listEntity.Id, "a-"+utils.TestTodoName)
+
+		todoModel, err := backend.Todos.GetTodo(ctx, first.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, first.Name, todoModel.Name)
+		require.Equal(t, first.Description, todoModel.Description)
+		require.Equal(t, first.Priority, todoModel.Priority)
+		require.Equal(t, utils.NotAssigned, todoModel.Status)
+		require.Empty(t, todoModel.Assignees)
+		require.Empty(t, todoModel.Watchers)
+		require.True(t, backend.Todos.CheckIfListContainsTodo(ctx, first.Id, listEntity.Id))
+
+		todos := backend.allTodos(t, listEntity.Id)
+		require.Len(t, todos, 2)
+		require.Equal(t, first.Id, todos[0].Id)
+		require.Equal(t, second.Id, todos[1].Id)
+	})
+
+	t.Run("get todo from another list", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		other := backend.createList(t, testOwner)
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		_, err := backend.Todos.GetTodo(ctx, todoEntity.Id, other.Id)
+		require.ErrorContains(t, err, utils.GetErrorMsg)
+		require.False(t, backend.Todos.CheckIfListContainsTodo(ctx, todoEntity.Id, other.Id))
+	})
+
+	t.Run("duplicate todo name", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		other := backend.createList(t, testOwner)
+		created := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		duplicate := created
+		duplicate.Id = uuid.New()
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.CreateTodo(ctx, duplicate)
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+
+		backend.createTodo(t, other.Id, utils.TestTodoName)
+	})
+
+	t.Run("create todo in missing list", func(t *testing.T) {
+		backend := newBackend(t)
+		missingId := uuid.New()
+
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.CreateTodo(ctx, structures.TodoEntity{
+				Id:       uuid.New(),
+				ListId:   missingId,
+				Name:     utils.TestTodoName,
+				Priority: utils.MediumPriority,
+			})
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("update todo", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		created := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		other := backend.createTodo(t, listEntity.Id, "other-"+utils.TestTodoName)
+
+		var updated *structures.TodoModel
+		err := backend.do(func(ctx context.Context) error {
+			var err error
+			updated, err = backend.Todos.UpdateTodo(ctx, structures.TodoEntity{Id: created.Id, Description: "updated"}, listEntity.Id)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, created.Name, updated.Name)
+		require.Equal(t, "updated", updated.Description)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Todos.UpdateTodo(ctx, structures.TodoEntity{Id: created.Id, Name: other.Name}, listEntity.Id)
+			return err
+		})
+		require.ErrorContains(t, err, "already created")
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Todos.UpdateTodo(ctx, structures.TodoEntity{Id: uuid.New(), Name: "missing"}, listEntity.Id)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("assign todo", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		created := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, created.Id, listEntity.Id, "")
+		})
+		require.Error(t, err)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{testOwner}, backend.Todos.GetTodoAssignees(ctx, created.Id))
+		todoModel, err := backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, utils.Assigned, todoModel.Status)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, created.Id, listEntity.Id, testMember)
+		})
+		require.NoError(t, err)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.ErrorContains(t, err, "is already assigned")
+		require.Equal(t, []string{testOwner, testMember}, backend.Todos.GetTodoAssignees(ctx, created.Id))
+		todoModel, err = backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{testOwner, testMember}, todoModel.Assignees)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, uuid.New(), listEntity.Id, testOwner)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("status transitions", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		created := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.NoError(t, err)
+
+		for _, status := range []string{utils.InProgress, utils.InReview, utils.Completed} {
+			err = backend.do(func(ctx context.Context) error {
+				return backend.Todos.ChangeTodoStatus(ctx, created.Id, listEntity.Id, status)
+			})
+			require.NoError(t, err)
+
+			todoModel, err := backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+			require.NoError(t, err)
+			require.Equal(t, status, todoModel.Status)
+			require.Equal(t, status == utils.Completed, todoModel.Done)
+		}
+
+		testCases := []struct {
+			name     string
+			todoId   uuid.UUID
+			status   string
+			expected string
+		}{
+			{name: "already in status", todoId: created.Id, status: utils.Completed, expected: "is already " + utils.Completed},
+			{name: "not allowed", todoId: created.Id, status: utils.NotAssigned, expected: "is not allowed by the workflow"},
+			{name: "unknown status", todoId: created.Id, status: "Done", expected: "because Done is not a status in the workflow"},
+			{name: "missing todo", todoId: uuid.New(), status: utils.InProgress, expected: utils.NotFoundErrorMsg},
+		}
+		for _, testCase := range testCases {
+			err = backend.do(func(ctx context.Context) error {
+				return backend.Todos.ChangeTodoStatus(ctx, testCase.todoId, listEntity.Id, testCase.status)
+			})
+			require.ErrorContains(t, err, testCase.expected, testCase.name)
+		}
+	})
+
+	t.Run("reopen, step back, unassign and reassign", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		created := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		requireTodo := func(assignee, status string) {
+			todoModel, err := backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+			require.NoError(t, err)
+			if assignee == "" {
+				require.Empty(t, todoModel.Assignees)
+			} else {
+				require.Equal(t, []string{assignee}, todoModel.Assignees)
+			}
+			require.Equal(t, status, todoModel.Status)
+		}
+
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.UnassignTodo(ctx, created.Id, listEntity.Id)
+		})
+		require.ErrorContains(t, err, "because it is not assigned")
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.ReassignTodo(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.ErrorContains(t, err, "because it is not assigned")
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.NoError(t, err)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.UnassignTodo(ctx, created.Id, listEntity.Id)
+		})
+		require.NoError(t, err)
+		requireTodo("", utils.NotAssigned)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.NoError(t, err)
+		for _, status := range []string{utils.InProgress, utils.InReview, utils.Completed} {
+			err = backend.do(func(ctx context.Context) error {
+				return backend.Todos.ChangeTodoStatus(ctx, created.Id, listEntity.Id, status)
+			})
+			require.NoError(t, err)
+		}
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.StepBackTodo(ctx, created.Id, listEntity.Id)
+		})
+		require.ErrorContains(t, err, "because it is done, reopen it instead")
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.ReopenTodo(ctx, created.Id, listEntity.Id)
+		})
+		require.NoError(t, err)
+		requireTodo(testOwner, utils.InReview)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.ReopenTodo(ctx, created.Id, listEntity.Id)
+		})
+		require.ErrorContains(t, err, "is not a done status")
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.StepBackTodo(ctx, created.Id, listEntity.Id)
+		})
+		require.NoError(t, err)
+		requireTodo(testOwner, utils.InProgress)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.ReassignTodo(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.ErrorContains(t, err, "is already assigned to "+testOwner)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.ReassignTodo(ctx, created.Id, listEntity.Id, "someone")
+		})
+		require.NoError(t, err)
+		requireTodo("someone", utils.InProgress)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.UnassignTodo(ctx, created.Id, listEntity.Id)
+		})
+		require.NoError(t, err)
+		requireTodo("", utils.InProgress)
+
+		for _, status := range []string{utils.Assigned, utils.NotAssigned} {
+			err = backend.do(func(ctx context.Context) error {
+				return backend.Todos.StepBackTodo(ctx, created.Id, listEntity.Id)
+			})
+			require.NoError(t, err)
+			requireTodo("", status)
+		}
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.StepBackTodo(ctx, created.Id, listEntity.Id)
+		})
+		require.ErrorContains(t, err, "is the first status of the workflow")
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.ReopenTodo(ctx, uuid.New(), listEntity.Id)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("remove assignees and watch todos", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		created := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		for _, username := range []string{testOwner, testMember} {
+			err := backend.do(func(ctx context.Context) error {
+				return backend.Todos.AssignTodoToUser(ctx, created.Id, listEntity.Id, username)
+			})
+			require.NoError(t, err)
+		}
+
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.RemoveTodoAssignee(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.NoError(t, err)
+		todoModel, err := backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{testMember}, todoModel.Assignees)
+		require.Equal(t, utils.Assigned, todoModel.Status)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.RemoveTodoAssignee(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.RemoveTodoAssignee(ctx, created.Id, listEntity.Id, testMember)
+		})
+		require.NoError(t, err)
+		todoModel, err = backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Empty(t, todoModel.Assignees)
+		require.Equal(t, utils.NotAssigned, todoModel.Status)
+
+		for _, username := range []string{testMember, testOwner} {
+			err = backend.do(func(ctx context.Context) error {
+				return backend.Todos.AddTodoWatcher(ctx, created.Id, listEntity.Id, username)
+			})
+			require.NoError(t, err)
+		}
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AddTodoWatcher(ctx, created.Id, listEntity.Id, testOwner)
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+		todoModel, err = backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{testOwner, testMember}, todoModel.Watchers)
+		require.Empty(t, todoModel.Assignees)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.RemoveTodoWatcher(ctx, created.Id, listEntity.Id, testMember)
+		})
+		require.NoError(t, err)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.RemoveTodoWatcher(ctx, created.Id, listEntity.Id, testMember)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+		todoModel, err = backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{testOwner}, todoModel.Watchers)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AddTodoWatcher(ctx, uuid.New(), listEntity.Id, testOwner)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+
+	t.Run("recurring todos share their name with the occurrences they superseded", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		created := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.StopTodoRecurrence(ctx, created.Id, listEntity.Id)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.SetTodoRecurrence(ctx, created.Id, listEntity.Id, "FREQ=WEEKLY")
+		})
+		require.NoError(t, err)
+		todoModel, err := backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, "FREQ=WEEKLY", todoModel.Recurrence)
+		require.Equal(t, created.Id, *todoModel.SeriesId)
+
+		next := created
+		next.Id = uuid.New()
+		next.Deadline = created.Deadline.AddDate(0, 0, 7)
+		next.Recurrence = "FREQ=WEEKLY"
+		next.SeriesId = uuid.NullUUID{UUID: created.Id, Valid: true}
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.CreateTodo(ctx, next)
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			err := backend.Todos.SupersedeTodo(ctx, created.Id, listEntity.Id)
+			if err != nil {
+				return err
+			}
+
+			return backend.Todos.CreateTodo(ctx, next)
+		})
+		require.NoError(t, err)
+		todoModel, err = backend.Todos.GetTodo(ctx, created.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Empty(t, todoModel.Recurrence)
+		require.Equal(t, created.Id, *todoModel.SeriesId)
+		nextModel, err := backend.Todos.GetTodo(ctx, next.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, utils.TestTodoName, nextModel.Name)
+		require.Equal(t, created.Id, *nextModel.SeriesId)
+		taken, err := backend.Todos.ContainsTodoName(ctx, listEntity.Id, utils.TestTodoName)
+		require.NoError(t, err)
+		require.True(t, taken)
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.StopTodoRecurrence(ctx, next.Id, listEntity.Id)
+		})
+		require.NoError(t, err)
+
+		outside := created
+		outside.Id = uuid.New()
+		outside.Recurrence, outside.SeriesId = "", uuid.NullUUID{}
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.CreateTodo(ctx, outside)
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+	})
+
+	t.Run("dependencies across lists block todos", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		otherList := backend.createList(t, testOwner)
+		blocked := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		blocker := backend.createTodo(t, otherList.Id, "Blocker")
+		third := backend.createTodo(t, listEntity.Id, "Third")
+
+		addDependency := func(todo, blockedBy structures.TodoEntity) error {
+			return backend.do(func(ctx context.Context) error {
+				return backend.Todos.AddTodoDependency(ctx, todo.Id, todo.ListId, blockedBy.Id, blockedBy.ListId)
+			})
+		}
+		require.NoError(t, addDependency(blocked, blocker))
+		require.NoError(t, addDependency(blocker, third))
+		require.ErrorContains(t, addDependency(blocked, blocker), utils.AlreadyExistsErrorMsg)
+		require.ErrorContains(t, addDependency(third, blocked), "would create a cycle")
+		require.ErrorContains(t, addDependency(third, third), "on itself")
+		missing := blocker
+		missing.ListId = listEntity.Id
+		require.ErrorContains(t, addDependency(blocked, missing), utils.NotFoundErrorMsg)
+
+		todoModel, err := backend.Todos.GetTodo(ctx, blocked.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.True(t, todoModel.Blocked)
+		require.Equal(t, []structures.TodoDependencyModel{{Id: blocker.Id, ListId: otherList.Id, Name: "Blocker"}}, todoModel.BlockedBy)
+		blockerModel, err := backend.Todos.GetTodo(ctx, blocker.Id, otherList.Id)
+		require.NoError(t, err)
+		require.Equal(t, []structures.TodoDependencyModel{{Id: blocked.Id, ListId: listEntity.Id, Name: utils.TestTodoName}}, blockerModel.Blocks)
+		foreignList := backend.createList(t, testMember)
+		listIds, err := backend.Todos.GetUserListIds(ctx, testOwner)
+		require.NoError(t, err)
+		require.Subset(t, listIds, []uuid.UUID{listEntity.Id, otherList.Id})
+		require.NotContains(t, listIds, foreignList.Id)
+
+		changeStatus := func(todo structures.TodoEntity, status string) error {
+			return backend.do(func(ctx context.Context) error {
+				return backend.Todos.ChangeTodoStatus(ctx, todo.Id, todo.ListId, status)
+			})
+		}
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, blocked.Id, listEntity.Id, testOwner)
+		})
+		require.NoError(t, err)
+		require.NoError(t, changeStatus(blocked, utils.InProgress))
+		require.ErrorContains(t, changeStatus(blocked, utils.InReview), "because it is blocked")
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, blocker.Id, otherList.Id, testOwner)
+		})
+		require.NoError(t, err)
+		require.NoError(t, changeStatus(blocker, utils.InProgress))
+		require.ErrorContains(t, changeStatus(blocker, utils.InReview), "because it is blocked")
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.RemoveTodoDependency(ctx, blocker.Id, otherList.Id, third.Id)
+		})
+		require.NoError(t, err)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.RemoveTodoDependency(ctx, blocker.Id, otherList.Id, third.Id)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+		require.NoError(t, changeStatus(blocker, utils.InReview))
+		require.NoError(t, changeStatus(blocker, utils.Completed))
+
+		todoModel, err = backend.Todos.GetTodo(ctx, blocked.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.False(t, todoModel.Blocked)
+		require.True(t, todoModel.BlockedBy[0].Done)
+		require.NoError(t, changeStatus(blocked, utils.InReview))
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Todos.DeleteTodo(ctx, blocker.Id, otherList.Id)
+			return err
+		})
+		require.NoError(t, err)
+		todoModel, err = backend.Todos.GetTodo(ctx, blocked.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Empty(t, todoModel.BlockedBy)
+	})
+
+	t.Run("concurrent dependencies cannot close a cycle", func(t *testing.T) {
+		backend := newBackend(t)
+		listEntity := backend.createList(t, testOwner)
+		first := backend.createTodo(t, listEntity.Id, "First")
+		second := backend.createTodo(t, listEntity.Id, "Second")
+		third := backend.createTodo(t, listEntity.Id, "Third")
+		fourth := backend.createTodo(t, listEntity.Id, "Fourth")
+		addDependency := func(todo, blockedBy structures.TodoEntity) error {
+			return backend.do(func(ctx context.Context) error {
+				return backend.Todos.AddTodoDependency(ctx, todo.Id, todo.ListId, blockedBy.Id, blockedBy.ListId)
+			})
+		}
+		require.NoError(t, addDependency(second, third))
+		require.NoError(t, addDependency(fourth, first))
+
+		// Each unit of work waits for the other dependency to be added before committing, so without a lock both
+		// would pass the cycle check and close first -> second -> third -> fourth -> first.
+		var added sync.WaitGroup
+		added.Add(2)
+		bothAdded := make(chan struct{})
+		go func() {
+			added.Wait()
+			close(bothAdded)
+		}()
+		results := make([]error, 2)
+		var wg sync.WaitGroup
+		for i, dependency := range [][2]structures.TodoEntity{{first, second}, {third, fourth}} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = backend.do(func(ctx context.Context) error {
+					err := backend.Todos.AddTodoDependency(ctx, dependency[0].Id, dependency[0].ListId, dependency[1].Id, dependency[1].ListId)
+					added.Done()
+					select {
+					case <-bothAdded:
+					case <-time.After(500 * time.Millisecond):
+					}
+					return err
+				})
+			}()
+		}
+		wg.Wait()
+
+		failed := slices.DeleteFunc(results, func(err error) bool {
+			return err == nil
+		})
+		require.Len(t, failed, 1)
+		require.ErrorContains(t, failed[0], "would create a cycle")
+	})
+
+	t.Run("move and copy todos between lists", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		otherList := backend.createList(t, testOwner)
+		labelId := backend.createLabel(t, listEntity.Id, "urgent").Id
+		todoEntity := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		subtaskId := uuid.New()
+		err := backend.do(func(ctx context.Context) error {
+			err := backend.Lists.AddUserToList(ctx, structures.ListUserEntity{ListId: listEntity.Id, Username: testMember, Role: utils.Editor})
+			if err != nil {
+				return err
+			}
+			for _, username := range []string{testOwner, testMember} {
+				err = backend.Todos.AssignTodoToUser(ctx, todoEntity.Id, listEntity.Id, username)
+				if err != nil {
+					return err
+				}
+			}
+			err = backend.Todos.AddTodoWatcher(ctx, todoEntity.Id, listEntity.Id, testMember)
+			if err != nil {
+				return err
+			}
+			err = backend.Todos.AttachLabel(ctx, todoEntity.Id, listEntity.Id, labelId)
+			if err != nil {
+				return err
+			}
+			_, err = backend.Subtasks.CreateSubtask(ctx, structures.SubtaskEntity{Id: subtaskId, TodoId: todoEntity.Id, Title: "Step", Assignee: testMember},
+				listEntity.Id, nil)
+			return err
+		})
+		require.NoError(t, err)
+
+		copyId := uuid.New()
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.CopyTodo(ctx, todoEntity.Id, listEntity.Id, copyId, listEntity.Id, "Copy")
+		})
+		require.NoError(t, err)
+		copied, err := backend.Todos.GetTodo(ctx, copyId, listEntity.Id)
+		require.NoError(t, err)
+		require.Equal(t, utils.Assigned, copied.Status)
+		require.Equal(t, []string{testOwner, testMember}, copied.Assignees)
+		require.Equal(t, []string{testMember}, copied.Watchers)
+		require.Len(t, copied.Labels, 1)
+		require.Equal(t, 1, copied.SubtasksTotal)
+
+		taken, err := backend.Todos.ContainsTodoName(ctx, otherList.Id, utils.TestTodoName)
+		require.NoError(t, err)
+		require.False(t, taken)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.MoveTodo(ctx, todoEntity.Id, listEntity.Id, otherList.Id, utils.TestTodoName)
+		})
+		require.NoError(t, err)
+		_, err = backend.Todos.GetTodo(ctx, todoEntity.Id, listEntity.Id)
+		require.Error(t, err)
+		moved, err := backend.Todos.GetTodo(ctx, todoEntity.Id, otherList.Id)
+		require.NoError(t, err)
+		require.Equal(t, utils.Assigned, moved.Status)
+		require.Equal(t, []string{testOwner}, moved.Assignees)
+		require.Empty(t, moved.Watchers)
+		require.Empty(t, moved.Labels)
+		subtasks, err := backend.Subtasks.GetSubtasks(ctx, todoEntity.Id, otherList.Id)
+		require.NoError(t, err)
+		require.Len(t, subtasks, 1)
+		require.Empty(t, subtasks[0].Assignee)
+
+		taken, err = backend.Todos.ContainsTodoName(ctx, otherList.Id, utils.TestTodoName)
+		require.NoError(t, err)
+		require.True(t, taken)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.CopyTodo(ctx, copyId, listEntity.Id, uuid.New(), otherList.Id, utils.TestTodoName)
+		})
+		require.ErrorContains(t, err, utils.AlreadyExistsErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			err := backend.Todos.RemoveTodoAssignee(ctx, copyId, listEntity.Id, testOwner)
+			if err != nil {
+				return err
+			}
+			return backend.Todos.MoveTodo(ctx, copyId, listEntity.Id, otherList.Id, "Copy")
+		})
+		require.NoError(t, err)
+		moved, err = backend.Todos.GetTodo(ctx, copyId, otherList.Id)
+		require.NoError(t, err)
+		require.Equal(t, utils.NotAssigned, moved.Status)
+		require.Empty(t, moved.Assignees)
+	})
+
+	t.Run("filter, sort and page todos", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		day := time.Now().UTC().Truncate(24 * time.Hour).Add(36 * time.Hour)
+		alpha := backend.insertTodo(t, structures.TodoEntity{ListId: listEntity.Id, Name: "alpha", Description: "write docs",
+			Deadline: day, Priority: utils.HighPriority})
+		beta := backend.insertTodo(t, structures.TodoEntity{ListId: listEntity.Id, Name: "beta", Description: "review",
+			Deadline: day.Add(48 * time.Hour), Priority: utils.LowPriority})
+		gamma := backend.insertTodo(t, structures.TodoEntity{ListId: listEntity.Id, Name: "gamma", Description: "Write tests",
+			Deadline: day.Add(24 * time.Hour), Priority: utils.MediumPriority})
+		err := backend.do(func(ctx context.Context) error {
+			return backend.Todos.AssignTodoToUser(ctx, gamma.Id, listEntity.Id, testOwner)
+		})
+		require.NoError(t, err)
+		for _, status := range []string{utils.Assigned, utils.InProgress} {
+			err = backend.do(func(ctx context.Context) error {
+				return backend.Todos.ChangeTodoStatus(ctx, alpha.Id, listEntity.Id, status)
+			})
+			require.NoError(t, err)
+		}
+
+		testCases := []struct {
+			name     string
+			query    structures.TodoQuery
+			expected []structures.TodoEntity
+		}{
+			{name: "by status", query: structures.TodoQuery{Status: utils.Assigned}, expected: []structures.TodoEntity{gamma}},
+			{name: "by priority", query: structures.TodoQuery{Priority: utils.LowPriority}, expected: []structures.TodoEntity{beta}},
+			{name: "by assignee", query: structures.TodoQuery{Assignee: testOwner}, expected: []structures.TodoEntity{gamma}},
+			{name: "by text", query: structures.TodoQuery{Text: "WRITE"}, expected: []structures.TodoEntity{alpha, gamma}},
+			{name: "by text with wildcards", query: structures.TodoQuery{Text: "%"}},
+			{
+				name:     "by deadline range",
+				query:    structures.TodoQuery{DeadlineFrom: day.Add(24 * time.Hour), DeadlineTo: day.Add(48 * time.Hour)},
+				expected: []structures.TodoEntity{beta, gamma},
+			},
+			{
+				name:     "sorted by deadline descending",
+				query:    structures.TodoQuery{SortBy: utils.SortByDeadline, Descending: true},
+				expected: []structures.TodoEntity{beta, gamma, alpha},
+			},
+			{
+				name:     "sorted by priority",
+				query:    structures.TodoQuery{SortBy: utils.SortByPriority},
+				expected: []structures.TodoEntity{beta, gamma, alpha},
+			},
+			{
+				name:     "sorted by workflow status descending",
+				query:    structures.TodoQuery{SortBy: utils.SortByStatus, Descending: true},
+				expected: []structures.TodoEntity{alpha, gamma, beta},
+			},
+		}
+		for _, testCase := range testCases {
+			todoPage, err := backend.Todos.GetAllTasks(ctx, listEntity.Id, testCase.query)
+			require.NoError(t, err, testCase.name)
+			require.Equal(t, todoIds(testCase.expected), modelIds(todoPage.Todos), testCase.name)
+			require.Equal(t, len(testCase.expected), todoPage.TotalCount, testCase.name)
+		}
+
+		query := structures.TodoQuery{SortBy: utils.SortByDeadline, PageQuery: structures.PageQuery{Limit: 2}}
+		firstPage, err := backend.Todos.GetAllTasks(ctx, listEntity.Id, query)
+		require.NoError(t, err)
+		require.Equal(t, todoIds([]structures.TodoEntity{alpha, gamma}), modelIds(firstPage.Todos))
+		require.Equal(t, 3, firstPage.TotalCount)
+		require.True(t, firstPage.HasNextPage)
+		require.False(t, firstPage.HasPreviousPage)
+		require.Equal(t, alpha.Id, firstPage.StartCursor.Id)
+		require.Equal(t, gamma.Id, firstPage.EndCursor.Id)
+
+		query.After = firstPage.EndCursor
+		secondPage, err := backend.Todos.GetAllTasks(ctx, listEntity.Id, query)
+		require.NoError(t, err)
+		require.Equal(t, todoIds([]structures.TodoEntity{beta}), modelIds(secondPage.Todos))
+		require.Equal(t, 3, secondPage.TotalCount)
+		require.False(t, secondPage.HasNextPage)
+		require.True(t, secondPage.HasPreviousPage)
+
+		backwards := structures.TodoQuery{SortBy: utils.SortByDeadline, PageQuery: structures.PageQuery{Last: 2}}
+		lastPage, err := backend.Todos.GetAllTasks(ctx, listEntity.Id, backwards)
+		require.NoError(t, err)
+		require.Equal(t, todoIds([]structures.TodoEntity{gamma, beta}), modelIds(lastPage.Todos))
+		require.False(t, lastPage.HasNextPage)
+		require.True(t, lastPage.HasPreviousPage)
+
+		backwards.Before = lastPage.StartCursor
+		previousPage, err := backend.Todos.GetAllTasks(ctx, listEntity.Id, backwards)
+		require.NoError(t, err)
+		require.Equal(t, todoIds([]structures.TodoEntity{alpha}), modelIds(previousPage.Todos))
+		require.True(t, previousPage.HasNextPage)
+		require.False(t, previousPage.HasPreviousPage)
+
+		backwards.Limit = 1
+		_, err = backend.Todos.GetAllTasks(ctx, listEntity.Id, backwards)
+		require.ErrorContains(t, err, utils.InvalidPageErrorMsg)
+
+		query.SortBy = utils.SortByName
+		_, err = backend.Todos.GetAllTasks(ctx, listEntity.Id, query)
+		require.ErrorContains(t, err, utils.InvalidCursorErrorMsg)
+	})
+
+	t.Run("attach, filter and detach labels", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		otherList := backend.createList(t, testOwner)
+		labelled := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+		plain := backend.createTodo(t, listEntity.Id, utils.TestTodoName+"-plain")
+		bug := backend.createLabel(t, listEntity.Id, "bug")
+		docs := backend.createLabel(t, listEntity.Id, "docs")
+		foreign := backend.createLabel(t, otherList.Id, "bug")
+
+		attach := func(labelId uuid.UUID) error {
+			return backend.do(func(ctx context.Context) error {
+				return backend.Todos.AttachLabel(ctx, labelled.Id, listEntity.Id, labelId)
+			})
+		}
+		require.NoError(t, attach(docs.Id))
+		require.NoError(t, attach(bug.Id))
+		require.ErrorContains(t, attach(bug.Id), utils.AlreadyExistsErrorMsg)
+		require.ErrorContains(t, attach(foreign.Id), utils.NotFoundErrorMsg)
+
+		todoModel, err := backend.Todos.GetTodo(ctx, labelled.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Len(t, todoModel.Labels, 2)
+		require.Equal(t, []string{"bug", "docs"}, []string{todoModel.Labels[0].Name, todoModel.Labels[1].Name})
+		require.Equal(t, bug.Color, todoModel.Labels[0].Color)
+
+		todoPage, err := backend.Todos.GetAllTasks(ctx, listEntity.Id, structures.TodoQuery{Label: "bug"})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{labelled.Id}, modelIds(todoPage.Todos))
+		require.Equal(t, 1, todoPage.TotalCount)
+		require.ElementsMatch(t, []uuid.UUID{labelled.Id, plain.Id}, modelIds(backend.allTodos(t, listEntity.Id)))
+
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.DetachLabel(ctx, labelled.Id, listEntity.Id, bug.Id)
+		})
+		require.NoError(t, err)
+		err = backend.do(func(ctx context.Context) error {
+			return backend.Todos.DetachLabel(ctx, labelled.Id, listEntity.Id, bug.Id)
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Lists.DeleteLabel(ctx, docs.Id, listEntity.Id)
+			return err
+		})
+		require.NoError(t, err)
+
+		todoModel, err = backend.Todos.GetTodo(ctx, labelled.Id, listEntity.Id)
+		require.NoError(t, err)
+		require.Empty(t, todoModel.Labels)
+	})
+
+	t.Run("delete todo", func(t *testing.T) {
+		backend := newBackend(t)
+		ctx := utils.HelperGetContext()
+		listEntity := backend.createList(t, testOwner)
+		created := backend.createTodo(t, listEntity.Id, utils.TestTodoName)
+
+		var deleted *structures.TodoModel
+		err := backend.do(func(ctx context.Context) error {
+			var err error
+			deleted, err = backend.Todos.DeleteTodo(ctx, created.Id, listEntity.Id)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, created.Name, deleted.Name)
+		require.False(t, backend.Todos.CheckIfListContainsTodo(ctx, created.Id, listEntity.Id))
+		require.Empty(t, backend.allTodos(t, listEntity.Id))
+
+		err = backend.do(func(ctx context.Context) error {
+			_, err := backend.Todos.DeleteTodo(ctx, created.Id, listEntity.Id)
+			return err
+		})
+		require.ErrorContains(t, err, utils.NotFoundErrorMsg)
+	})
+}
+
+func todoIds(entities []structures.TodoEntity) []uuid.UUID {
+	ids := make([]uuid.UUID, len(entities))
+	for i, entity := range entities {
+		ids[i] = entity.Id
+	}
+
+	return ids
+}
+
+func modelIds(models []structures.TodoModel) []uuid.UUID {
+	ids := make([]uuid.UUID, len(models))
+	for i, model := range models {
+		ids[i] = model.Id
+	}
+
+	return ids
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	structures "project/structures"
+)
+
+// RepositorySearch is an autogenerated mock type for the RepositorySearch type
+type RepositorySearch struct {
+	mock.Mock
+}
+
+type RepositorySearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepositorySearch) EXPECT() *RepositorySearch_Expecter {
+	return &RepositorySearch_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function with given fields: ctx, query
+func (_m *RepositorySearch) Search(ctx context.Context, query structures.SearchQuery) ([]structures.SearchModel, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []structures.SearchModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SearchQuery) ([]structures.SearchModel, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SearchQuery) []structures.SearchModel); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.SearchModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.SearchQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositorySearch_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type RepositorySearch_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query structures.SearchQuery
+func (_e *RepositorySearch_Expecter) Search(ctx interface{}, query interface{}) *RepositorySearch_Search_Call {
+	return &RepositorySearch_Search_Call{Call: _e.mock.On("Search", ctx, query)}
+}
+
+func (_c *RepositorySearch_Search_Call) Run(run func(ctx context.Context, query structures.SearchQuery)) *RepositorySearch_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.SearchQuery))
+	})
+	return _c
+}
+
+func (_c *RepositorySearch_Search_Call) Return(_a0 []structures.SearchModel, _a1 error) *RepositorySearch_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositorySearch_Search_Call) RunAndReturn(run func(context.Context, structures.SearchQuery) ([]structures.SearchModel, error)) *RepositorySearch_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepositorySearch creates a new instance of RepositorySearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepositorySearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepositorySearch {
+	mock := &RepositorySearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
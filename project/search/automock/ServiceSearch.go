@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	structures "project/structures"
+)
+
+// ServiceSearch is an autogenerated mock type for the ServiceSearch type
+type ServiceSearch struct {
+	mock.Mock
+}
+
+type ServiceSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceSearch) EXPECT() *ServiceSearch_Expecter {
+	return &ServiceSearch_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function with given fields: ctx, query
+func (_m *ServiceSearch) Search(ctx context.Context, query structures.SearchQuery) ([]structures.SearchOutput, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []structures.SearchOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SearchQuery) ([]structures.SearchOutput, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SearchQuery) []structures.SearchOutput); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.SearchOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.SearchQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceSearch_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type ServiceSearch_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query structures.SearchQuery
+func (_e *ServiceSearch_Expecter) Search(ctx interface{}, query interface{}) *ServiceSearch_Search_Call {
+	return &ServiceSearch_Search_Call{Call: _e.mock.On("Search", ctx, query)}
+}
+
+func (_c *ServiceSearch_Search_Call) Run(run func(ctx context.Context, query structures.SearchQuery)) *ServiceSearch_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.SearchQuery))
+	})
+	return _c
+}
+
+func (_c *ServiceSearch_Search_Call) Return(_a0 []structures.SearchOutput, _a1 error) *ServiceSearch_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceSearch_Search_Call) RunAndReturn(run func(context.Context, structures.SearchQuery) ([]structures.SearchOutput, error)) *ServiceSearch_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceSearch creates a new instance of ServiceSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceSearch {
+	mock := &ServiceSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,54 @@
+package search
+
+import (
+	"project/structures"
+)
+
+type ServiceSearchConvertor struct{}
+
+func NewServiceSearchConvertor() *ServiceSearchConvertor {
+	return &ServiceSearchConvertor{}
+}
+
+func (s *ServiceSearchConvertor) ConvertSearchModelToOutput(searchModel *structures.SearchModel) *structures.SearchOutput {
+	return &structures.SearchOutput{
+		Type:                 searchModel.Type,
+		Id:                   searchModel.Id,
+		ListId:               searchModel.ListId,
+		ListName:             searchModel.ListName,
+		Name:                 searchModel.Name,
+		Description:          searchModel.Description,
+		Rank:                 searchModel.Rank,
+		NameHighlight:        searchModel.NameHighlight,
+		DescriptionHighlight: searchModel.DescriptionHighlight,
+	}
+}
+
+type RepositorySearchConvertor struct{}
+
+func NewRepositorySearchConvertor() *RepositorySearchConvertor {
+	return &RepositorySearchConvertor{}
+}
+
+func (r *RepositorySearchConvertor) ConvertEntityToModel(entity structures.SearchEntity) structures.SearchModel {
+	return structures.SearchModel{
+		Type:                 entity.Type,
+		Id:                   entity.Id,
+		ListId:               entity.ListId,
+		ListName:             entity.ListName,
+		Name:                 entity.Name,
+		Description:          entity.Description,
+		Rank:                 entity.Rank,
+		NameHighlight:        entity.NameHighlight,
+		DescriptionHighlight: entity.DescriptionHighlight,
+	}
+}
+
+func (r *RepositorySearchConvertor) ConvertEntitiesToModels(entities []structures.SearchEntity) []structures.SearchModel {
+	models := make([]structures.SearchModel, len(entities))
+	for i, e := range entities {
+		models[i] = r.ConvertEntityToModel(e)
+	}
+
+	return models
+}
@@ -0,0 +1,153 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"github.com/google/uuid"
+	"html"
+	"project/memory"
+	"project/structures"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// nameWeight and descriptionWeight follow the default weights Postgres gives to the A and B parts of a vector.
+	nameWeight        = 1.0
+	descriptionWeight = 0.4
+)
+
+type MemoryRepositorySearch struct {
+	store     *memory.Store
+	converter RepositorySearchConvertor
+}
+
+func NewMemoryRepositorySearch(store *memory.Store, convertor RepositorySearchConvertor) *MemoryRepositorySearch {
+	return &MemoryRepositorySearch{store: store, converter: convertor}
+}
+
+// Search is the substring fallback of the full text search. A list or todo matches when each word of the text is
+// part of its name or description, ignoring case, and ranks higher the more of the words are in its name.
+func (r *MemoryRepositorySearch) Search(ctx context.Context, query structures.SearchQuery) ([]structures.SearchModel, error) {
+	terms := searchTerms(query.Text)
+	if len(terms) == 0 {
+		return []structures.SearchModel{}, nil
+	}
+
+	var entities []structures.SearchEntity
+	r.store.Read(ctx, func() {
+		visible := func(listId uuid.UUID) bool {
+			return query.All || slices.ContainsFunc(r.store.UsersLists, func(member structures.ListUserEntity) bool {
+				return member.ListId == listId && member.Username == query.Username
+			})
+		}
+
+		for _, listEntity := range r.store.Lists {
+			rank := matchRank(terms, listEntity.Name, "")
+			if rank == 0 || !visible(listEntity.Id) {
+				continue
+			}
+			entities = append(entities, structures.SearchEntity{
+				Type:          listType,
+				Id:            listEntity.Id,
+				ListId:        listEntity.Id,
+				ListName:      listEntity.Name,
+				Name:          listEntity.Name,
+				Rank:          rank,
+				NameHighlight: highlight(listEntity.Name, terms),
+			})
+		}
+		for _, todoEntity := range r.store.Todos {
+			rank := matchRank(terms, todoEntity.Name, todoEntity.Description)
+			if rank == 0 || !visible(todoEntity.ListId) {
+				continue
+			}
+			entities = append(entities, structures.SearchEntity{
+				Type:                 todoType,
+				Id:                   todoEntity.Id,
+				ListId:               todoEntity.ListId,
+				ListName:             r.store.Lists[todoEntity.ListId].Name,
+				Name:                 todoEntity.Name,
+				Description:          todoEntity.Description,
+				Rank:                 rank,
+				NameHighlight:        highlight(todoEntity.Name, terms),
+				DescriptionHighlight: highlight(todoEntity.Description, terms),
+			})
+		}
+	})
+
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].Rank != entities[j].Rank {
+			return entities[i].Rank > entities[j].Rank
+		}
+		if entities[i].Name != entities[j].Name {
+			return entities[i].Name < entities[j].Name
+		}
+		return bytes.Compare(entities[i].Id[:], entities[j].Id[:]) < 0
+	})
+	if query.Limit > 0 && len(entities) > query.Limit {
+		entities = entities[:query.Limit]
+	}
+
+	return r.converter.ConvertEntitiesToModels(entities), nil
+}
+
+// searchTerms splits the text into its lower case words.
+func searchTerms(text string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	slices.Sort(terms)
+
+	return slices.Compact(terms)
+}
+
+// matchRank is 0 unless every term is in the name or the description, each term adding the weight of the
+// first one it is in.
+func matchRank(terms []string, name, description string) float64 {
+	name, description = strings.ToLower(name), strings.ToLower(description)
+
+	rank := 0.0
+	for _, term := range terms {
+		switch {
+		case strings.Contains(name, term):
+			rank += nameWeight
+		case strings.Contains(description, term):
+			rank += descriptionWeight
+		default:
+			return 0
+		}
+	}
+
+	return rank / float64(len(terms))
+}
+
+// highlight wraps the terms in the text with the tags the Postgres search uses, preferring the longer terms.
+// The text is escaped as HTML, so only the tags of the highlight are read as markup.
+func highlight(text string, terms []string) string {
+	if text == "" {
+		return ""
+	}
+
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = regexp.QuoteMeta(term)
+	}
+	sort.SliceStable(quoted, func(i, j int) bool {
+		return len(quoted[i]) > len(quoted[j])
+	})
+
+	var highlighted strings.Builder
+	last := 0
+	for _, match := range regexp.MustCompile(`(?i)(`+strings.Join(quoted, "|")+`)`).FindAllStringIndex(text, -1) {
+		highlighted.WriteString(html.EscapeString(text[last:match[0]]))
+		highlighted.WriteString("<b>" + html.EscapeString(text[match[0]:match[1]]) + "</b>")
+		last = match[1]
+	}
+	highlighted.WriteString(html.EscapeString(text[last:]))
+
+	return highlighted.String()
+}
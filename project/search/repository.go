@@ -0,0 +1,75 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"project/structures"
+	"project/uow"
+	"project/utils"
+)
+
+var (
+	// searchConfig is the text search configuration of the indexes created by the search migration, the
+	// vectors below have to stay the same as the indexed expressions for the indexes to be used.
+	searchConfig = "'english'"
+	listVector   = fmt.Sprintf(`setweight(to_tsvector(%s, list.name), 'A')`, searchConfig)
+	todoVector   = fmt.Sprintf(`(setweight(to_tsvector(%[1]s, todo.name), 'A') || setweight(to_tsvector(%[1]s, COALESCE(todo.description, '')), 'B'))`, searchConfig)
+	// escapeHTML escapes the text before ts_headline adds its tags, so only the tags of the highlight are read as markup.
+	escapeHTML       = `replace(replace(replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&#34;'), '''', '&#39;')`
+	nameHighlight    = `'StartSel=<b>, StopSel=</b>, HighlightAll=TRUE'`
+	textHighlight    = `'StartSel=<b>, StopSel=</b>, MaxFragments=2'`
+	memberCondition  = ` AND list.id IN (SELECT list_id FROM users_lists WHERE username = ?)`
+	searchColumns    = `type, id, list_id, list_name, name, description, rank, name_highlight, description_highlight`
+	searchListSelect = fmt.Sprintf(`SELECT '%[1]s' AS type, list.id, list.id AS list_id, list.name AS list_name, list.name, '' AS description, `+
+		`ts_rank(%[2]s, query) AS rank, ts_headline(%[3]s, %[5]s, query, %[4]s) AS name_highlight, '' AS description_highlight `+
+		`FROM list, websearch_to_tsquery(%[3]s, ?) query WHERE %[2]s @@ query`,
+		listType, listVector, searchConfig, nameHighlight, fmt.Sprintf(escapeHTML, "list.name"))
+	searchTodoSelect = fmt.Sprintf(`SELECT '%[1]s', todo.id, todo.list_id, list.name, todo.name, COALESCE(todo.description, ''), `+
+		`ts_rank(%[2]s, query), ts_headline(%[3]s, %[6]s, query, %[4]s), ts_headline(%[3]s, %[7]s, query, %[5]s) `+
+		`FROM todo JOIN list ON list.id = todo.list_id, websearch_to_tsquery(%[3]s, ?) query WHERE %[2]s @@ query`,
+		todoType, todoVector, searchConfig, nameHighlight, textHighlight,
+		fmt.Sprintf(escapeHTML, "todo.name"), fmt.Sprintf(escapeHTML, "COALESCE(todo.description, '')"))
+)
+
+type DBRepositorySearch struct {
+	db        *sqlx.DB
+	converter RepositorySearchConvertor
+}
+
+func NewDBRepositorySearch(db *sqlx.DB, convertor RepositorySearchConvertor) *DBRepositorySearch {
+	return &DBRepositorySearch{db: db, converter: convertor}
+}
+
+func (r *DBRepositorySearch) executor(ctx context.Context) uow.Executor {
+	return uow.GetExecutor(ctx, r.db)
+}
+
+// Search ranks the lists and todos matching the text with the full text search of Postgres. The text is read
+// like a web search, so quoted phrases, "or" and words excluded with a minus work as well.
+func (r *DBRepositorySearch) Search(ctx context.Context, query structures.SearchQuery) ([]structures.SearchModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	listSelect, todoSelect := searchListSelect, searchTodoSelect
+	listArgs, todoArgs := []any{query.Text}, []any{query.Text}
+	if !query.All {
+		listSelect += memberCondition
+		todoSelect += memberCondition
+		listArgs = append(listArgs, query.Username)
+		todoArgs = append(todoArgs, query.Username)
+	}
+
+	stmt := fmt.Sprintf(`SELECT %s FROM (%s UNION ALL %s) AS results ORDER BY rank DESC, name, id LIMIT ?`,
+		searchColumns, listSelect, todoSelect)
+	args := append(append(listArgs, todoArgs...), query.Limit)
+	var entities []structures.SearchEntity
+	err := r.executor(ctx).Select(&entities, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.converter.ConvertEntitiesToModels(entities), nil
+}
@@ -0,0 +1,99 @@
+package search_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"project/search"
+	"project/structures"
+	"project/utils"
+	"testing"
+)
+
+var searchColumns = []string{"type", "id", "list_id", "list_name", "name", "description", "rank", "name_highlight", "description_highlight"}
+
+func TestRepositorySearch(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := search.NewDBRepositorySearch(db, *search.NewRepositorySearchConvertor())
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		inputQuery  structures.SearchQuery
+		mock        func()
+		expected    []structures.SearchModel
+		expectedErr error
+	}{
+		{
+			name:       "search the lists of the user",
+			inputQuery: structures.SearchQuery{Text: "release", Username: utils.TestUsername, Limit: 20},
+			mock: func() {
+				rows := sqlxmock.NewRows(searchColumns).
+					AddRow("list", utils.TestListId, utils.TestListId, "Release", "Release", "", 0.6, "<b>Release</b>", "").
+					AddRow("todo", utils.TestTodoId, utils.TestListId, "Release", "Plan", "Plan the release", 0.2, "Plan", "Plan the <b>release</b>")
+				mock.ExpectQuery(`SELECT type, id, list_id, list_name, name, description, rank, name_highlight, description_highlight FROM \(`+
+					`SELECT 'list' AS type, .+ FROM list, websearch_to_tsquery\('english', \$1\) query WHERE .+ @@ query `+
+					`AND list.id IN \(SELECT list_id FROM users_lists WHERE username = \$2\) UNION ALL `+
+					`SELECT 'todo', .+ FROM todo JOIN list ON list.id = todo.list_id, websearch_to_tsquery\('english', \$3\) query WHERE .+ @@ query `+
+					`AND list.id IN \(SELECT list_id FROM users_lists WHERE username = \$4\)\) AS results ORDER BY rank DESC, name, id LIMIT \$5`).
+					WithArgs("release", utils.TestUsername, "release", utils.TestUsername, 20).
+					WillReturnRows(rows)
+			},
+			expected: []structures.SearchModel{
+				{
+					Type:          "list",
+					Id:            utils.TestListId,
+					ListId:        utils.TestListId,
+					ListName:      "Release",
+					Name:          "Release",
+					Rank:          0.6,
+					NameHighlight: "<b>Release</b>",
+				}, {
+					Type:                 "todo",
+					Id:                   utils.TestTodoId,
+					ListId:               utils.TestListId,
+					ListName:             "Release",
+					Name:                 "Plan",
+					Description:          "Plan the release",
+					Rank:                 0.2,
+					NameHighlight:        "Plan",
+					DescriptionHighlight: "Plan the <b>release</b>",
+				},
+			},
+		}, {
+			name:       "search all lists",
+			inputQuery: structures.SearchQuery{Text: "release", All: true, Limit: 5},
+			mock: func() {
+				mock.ExpectQuery(`FROM list, websearch_to_tsquery\('english', \$1\) query WHERE setweight\(to_tsvector\('english', list\.name\), 'A'\) @@ query UNION ALL `+
+					`.+ websearch_to_tsquery\('english', \$2\) query WHERE .+ @@ query\) AS results ORDER BY rank DESC, name, id LIMIT \$3`).
+					WithArgs("release", "release", 5).
+					WillReturnRows(sqlxmock.NewRows(searchColumns))
+			},
+			expected: []structures.SearchModel{},
+		}, {
+			name:       "search failed",
+			inputQuery: structures.SearchQuery{Text: "release", All: true, Limit: 5},
+			mock: func() {
+				mock.ExpectQuery(`SELECT .+ AS results`).
+					WillReturnError(errors.New("connection refused"))
+			},
+			expectedErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.Search(ctx, testCase.inputQuery)
+			require.Equal(t, testCase.expectedErr, err)
+			require.Equal(t, testCase.expected, actual)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
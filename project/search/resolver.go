@@ -0,0 +1,81 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/structures"
+	"project/utils"
+	"strconv"
+	"strings"
+)
+
+const (
+	username  = "userId"
+	textParam = "q"
+
+	listType = "list"
+	todoType = "todo"
+
+	defaultSearchLimit = 20
+)
+
+//go:generate mockery --name ServiceSearch --output=automock --with-expecter=true
+type ServiceSearch interface {
+	Search(ctx context.Context, query structures.SearchQuery) ([]structures.SearchOutput, error)
+}
+
+type ResolverSearch struct {
+	service ServiceSearch
+}
+
+func NewResolverSearch(service ServiceSearch) *ResolverSearch {
+	return &ResolverSearch{
+		service: service,
+	}
+}
+
+// Search looks for the q parameter in the lists the user belongs to and their todos, or in all of them for
+// admins, returning at most limit results with the best matches first.
+func (r *ResolverSearch) Search(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	values := req.URL.Query()
+	query := structures.SearchQuery{
+		Text:     strings.TrimSpace(values.Get(textParam)),
+		Username: req.Header.Get(username),
+		All:      utils.GetRoleFromContext(ctx) == utils.Role[utils.Admin],
+		Limit:    defaultSearchLimit,
+	}
+	if query.Text == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("%s is required", textParam)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	if values.Has(utils.LimitParam) {
+		var err error
+		query.Limit, err = strconv.Atoi(values.Get(utils.LimitParam))
+		if err != nil || query.Limit < 1 || query.Limit > utils.MaxPageSize {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := fmt.Sprintf("%s must be a number between 1 and %d", utils.LimitParam, utils.MaxPageSize)
+			utils.ResponseHandling(req, w, msg)
+			return
+		}
+	}
+
+	results, err := r.service.Search(ctx, query)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := fmt.Sprintf("failed to search for %s", query.Text)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success searching for %s with %d results", query.Text, len(results)))
+	utils.ResponseHandling(req, w, results)
+}
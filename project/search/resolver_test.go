@@ -0,0 +1,110 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"project/search"
+	mocks "project/search/automock"
+	"project/structures"
+	"project/utils"
+	"strings"
+	"testing"
+)
+
+func TestResolverSearch(t *testing.T) {
+	adminCtx := context.WithValue(utils.HelperGetContext(), utils.UserRole, utils.Role[utils.Admin])
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceSearch
+		ctx            context.Context
+		inputQuery     string
+		expected       string
+		expectedStatus int
+	}{
+		{
+			name: "search the lists of the user",
+			service: func() *mocks.ServiceSearch {
+				service := &mocks.ServiceSearch{}
+				service.EXPECT().Search(mock.Anything, structures.SearchQuery{Text: "release plan", Username: utils.TestUsername, Limit: 20}).
+					Return([]structures.SearchOutput{{
+						Type:          "todo",
+						Id:            utils.TestTodoId,
+						ListId:        utils.TestListId,
+						Name:          "Release plan",
+						NameHighlight: "<b>Release</b> <b>plan</b>",
+					}}, nil).
+					Once()
+				return service
+			},
+			inputQuery:     "?q=release+plan",
+			expected:       `"name":"Release plan"`,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "admin searches all lists",
+			service: func() *mocks.ServiceSearch {
+				service := &mocks.ServiceSearch{}
+				service.EXPECT().Search(mock.Anything, structures.SearchQuery{Text: "release", Username: utils.TestUsername, All: true, Limit: 5}).
+					Return([]structures.SearchOutput{}, nil).
+					Once()
+				return service
+			},
+			ctx:            adminCtx,
+			inputQuery:     "?q=release&limit=5",
+			expected:       "[]",
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "search without text",
+			service: func() *mocks.ServiceSearch {
+				return nil
+			},
+			inputQuery:     "?q=+",
+			expected:       "q is required",
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "search with invalid limit",
+			service: func() *mocks.ServiceSearch {
+				return nil
+			},
+			inputQuery:     "?q=release&limit=0",
+			expected:       "limit must be a number between 1 and 100",
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "search failed",
+			service: func() *mocks.ServiceSearch {
+				service := &mocks.ServiceSearch{}
+				service.EXPECT().Search(mock.Anything, structures.SearchQuery{Text: "release", Username: utils.TestUsername, Limit: 20}).
+					Return(nil, errors.New("connection refused")).
+					Once()
+				return service
+			},
+			inputQuery:     "?q=release",
+			expected:       "failed to search for release",
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := search.NewResolverSearch(testCase.service())
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, "/todo/api/search"+testCase.inputQuery, nil)
+			require.NoError(t, err)
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = utils.HelperGetContext()
+			}
+			req = req.WithContext(ctx)
+			req.Header.Set("userId", utils.TestUsername)
+
+			resolver.Search(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.True(t, strings.Contains(rr.Body.String(), testCase.expected), rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package search
+
+import (
+	"context"
+	"project/structures"
+)
+
+//go:generate mockery --name RepositorySearch --output=automock --with-expecter=true
+type RepositorySearch interface {
+	Search(ctx context.Context, query structures.SearchQuery) ([]structures.SearchModel, error)
+}
+
+type ServiceSearchImpl struct {
+	repo      RepositorySearch
+	convertor ServiceSearchConvertor
+}
+
+func NewServiceSearch(repo RepositorySearch, convertor ServiceSearchConvertor) *ServiceSearchImpl {
+	return &ServiceSearchImpl{repo: repo, convertor: convertor}
+}
+
+func (s *ServiceSearchImpl) Search(ctx context.Context, query structures.SearchQuery) ([]structures.SearchOutput, error) {
+	models, err := s.repo.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]structures.SearchOutput, len(models))
+	for i, model := range models {
+		result[i] = *s.convertor.ConvertSearchModelToOutput(&model)
+	}
+
+	return result, nil
+}
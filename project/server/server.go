@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net"
+	"net/http"
+	"project/config"
+	"sync"
+)
+
+type Hook func(ctx context.Context) error
+
+type Server struct {
+	name       string
+	cfg        config.ServerConfig
+	httpServer *http.Server
+	listener   net.Listener
+	onStart    []Hook
+	onStop     []Hook
+	serveErr   chan error
+	stopOnce   sync.Once
+	stopErr    error
+}
+
+func New(name string, cfg config.ServerConfig, handler http.Handler) *Server {
+	return &Server{
+		name: name,
+		cfg:  cfg,
+		httpServer: &http.Server{
+			Handler:      handler,
+			ReadTimeout:  cfg.ReadTimeout,
+			WriteTimeout: cfg.WriteTimeout,
+			IdleTimeout:  cfg.IdleTimeout,
+		},
+		serveErr: make(chan error, 1),
+	}
+}
+
+// OnStart registers a hook that runs before the server starts listening.
+func (s *Server) OnStart(hook Hook) {
+	s.onStart = append(s.onStart, hook)
+}
+
+// OnStop registers a hook that runs after in-flight requests are drained.
+// Stop hooks run in reverse registration order.
+func (s *Server) OnStop(hook Hook) {
+	s.onStop = append(s.onStop, hook)
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	if s.listener != nil {
+		return errors.New(fmt.Sprintf("%s server is already started", s.name))
+	}
+
+	for _, hook := range s.onStart {
+		err := hook(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	listener, err := net.Listen("tcp", s.cfg.Address())
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+
+	go func() {
+		err := s.httpServer.Serve(listener)
+		if !errors.Is(err, http.ErrServerClosed) {
+			s.serveErr <- err
+		}
+		close(s.serveErr)
+	}()
+
+	logrus.WithField("address", s.Addr()).Info(fmt.Sprintf("%s server started", s.name))
+	return nil
+}
+
+// Addr returns the address the server listens on, which includes the chosen
+// port when the server was configured with port 0.
+func (s *Server) Addr() string {
+	if s.listener == nil {
+		return ""
+	}
+
+	return s.listener.Addr().String()
+}
+
+func (s *Server) URL() string {
+	return "http://" + s.Addr()
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() {
+		var errs []error
+		if s.listener != nil {
+			logrus.Info(fmt.Sprintf("%s server is shutting down", s.name))
+			errs = append(errs, s.httpServer.Shutdown(ctx))
+		}
+
+		for i := len(s.onStop) - 1; i >= 0; i-- {
+			errs = append(errs, s.onStop[i](ctx))
+		}
+
+		s.stopErr = errors.Join(errs...)
+	})
+
+	return s.stopErr
+}
+
+// Run starts the server and blocks until ctx is cancelled or the server fails,
+// then drains in-flight requests within the configured shutdown timeout.
+func (s *Server) Run(ctx context.Context) error {
+	err := s.Start(ctx)
+	if err != nil {
+		return errors.Join(err, s.Stop(context.Background()))
+	}
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+	case serveErr = <-s.serveErr:
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	return errors.Join(serveErr, s.Stop(shutdownCtx))
+}
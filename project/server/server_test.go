@@ -0,0 +1,150 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"project/config"
+	"project/server"
+	"testing"
+	"time"
+)
+
+func helperServerConfig() config.ServerConfig {
+	return config.ServerConfig{
+		Port:            0,
+		ReadTimeout:     time.Second,
+		WriteTimeout:    time.Second,
+		IdleTimeout:     time.Second,
+		ShutdownTimeout: time.Second,
+	}
+}
+
+func TestServerStartAndStop(t *testing.T) {
+	var calls []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte("Success"))
+		require.NoError(t, err)
+	})
+
+	srv := server.New("test", helperServerConfig(), handler)
+	srv.OnStart(func(_ context.Context) error {
+		calls = append(calls, "start")
+		return nil
+	})
+	srv.OnStop(func(_ context.Context) error {
+		calls = append(calls, "first stop")
+		return nil
+	})
+	srv.OnStop(func(_ context.Context) error {
+		calls = append(calls, "second stop")
+		return nil
+	})
+
+	require.NoError(t, srv.Start(context.Background()))
+	require.NotEmpty(t, srv.Addr())
+
+	resp, err := http.Get(srv.URL())
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "Success", string(body))
+
+	require.NoError(t, srv.Stop(context.Background()))
+	require.NoError(t, srv.Stop(context.Background()))
+	require.Equal(t, []string{"start", "second stop", "first stop"}, calls)
+
+	_, err = http.Get(srv.URL())
+	require.Error(t, err)
+}
+
+func TestServerDrainsInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+
+	srv := server.New("test", helperServerConfig(), handler)
+	require.NoError(t, srv.Start(context.Background()))
+
+	responses := make(chan int, 1)
+	go func() {
+		resp, err := http.Get(srv.URL())
+		if err != nil {
+			responses <- 0
+			return
+		}
+		resp.Body.Close()
+		responses <- resp.StatusCode
+	}()
+
+	<-started
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- srv.Stop(context.Background())
+	}()
+
+	select {
+	case <-stopped:
+		t.Fatal("server stopped before in-flight request finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.Equal(t, http.StatusOK, <-responses)
+	require.NoError(t, <-stopped)
+}
+
+func TestServerRun(t *testing.T) {
+	testCases := []struct {
+		name        string
+		startErr    error
+		stopErr     error
+		expectedErr error
+	}{
+		{
+			name: "stops when context is cancelled",
+		}, {
+			name:        "start hook fails",
+			startErr:    errors.New("error running migrations"),
+			expectedErr: errors.New("error running migrations"),
+		}, {
+			name:        "stop hook fails",
+			stopErr:     errors.New("error closing database"),
+			expectedErr: errors.New("error closing database"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stopped := false
+			srv := server.New("test", helperServerConfig(), http.NotFoundHandler())
+			srv.OnStart(func(_ context.Context) error {
+				return testCase.startErr
+			})
+			srv.OnStop(func(_ context.Context) error {
+				stopped = true
+				return testCase.stopErr
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := srv.Run(ctx)
+			if testCase.expectedErr != nil {
+				require.ErrorContains(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.True(t, stopped)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package structures
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+// For Resolver
+type AuditOutput struct {
+	Id         uuid.UUID       `json:"id"`
+	Actor      string          `json:"actor"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityId   string          `json:"entity_id"`
+	ListId     uuid.UUID       `json:"list_id"`
+	TodoId     *uuid.UUID      `json:"todo_id"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	RequestId  string          `json:"request_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type AuditPageOutput struct {
+	Entries []AuditOutput
+	PageInfoOutput
+}
+
+// For Service
+// AuditQuery selects entries of the audit log, newest first. Empty filters match every entry.
+type AuditQuery struct {
+	PageQuery
+	ListId *uuid.UUID
+	TodoId *uuid.UUID
+	Actor  string
+}
+
+type AuditPageModel struct {
+	Entries []AuditModel
+	PageInfo
+}
+
+type AuditModel struct {
+	Id         uuid.UUID
+	Actor      string
+	Action     string
+	EntityType string
+	EntityId   string
+	ListId     uuid.UUID
+	TodoId     *uuid.UUID
+	// Before and After hold only the fields the action changed, as JSON objects.
+	// Before is empty for created entities and After for deleted ones.
+	Before    json.RawMessage
+	After     json.RawMessage
+	RequestId string
+	CreatedAt time.Time
+}
+
+// For Repository
+type AuditEntity struct {
+	Id         uuid.UUID  `db:"id"`
+	Actor      string     `db:"actor"`
+	Action     string     `db:"action"`
+	EntityType string     `db:"entity_type"`
+	EntityId   string     `db:"entity_id"`
+	ListId     uuid.UUID  `db:"list_id"`
+	TodoId     *uuid.UUID `db:"todo_id"`
+	Before     []byte     `db:"before"`
+	After      []byte     `db:"after"`
+	RequestId  string     `db:"request_id"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
@@ -0,0 +1,40 @@
+package structures
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// For Resolver
+type LoginInput struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type TokenInput struct {
+	Token string `json:"token"`
+}
+
+// For Service
+type TokenClaimsModel struct {
+	Username  string
+	SessionId uuid.UUID
+	TokenType string
+	ExpiresAt time.Time
+}
+
+// For Repository
+type SessionEntity struct {
+	Id        uuid.UUID `db:"id"`
+	Username  string    `db:"username"`
+	ExpiresAt time.Time `db:"expires_at"`
+	Revoked   bool      `db:"revoked"`
+}
+
+// For Resolver
+type TokenOutput struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
@@ -0,0 +1,51 @@
+package structures
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// For Resolver
+type CommentInput struct {
+	Body string `json:"body"`
+}
+
+type CommentOutput struct {
+	Id        uuid.UUID  `json:"id"`
+	TodoId    uuid.UUID  `json:"todo_id"`
+	Author    string     `json:"author"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	EditedAt  *time.Time `json:"edited_at"`
+}
+
+type CommentPageOutput struct {
+	Comments []CommentOutput
+	PageInfoOutput
+}
+
+// For Service
+type CommentPageModel struct {
+	Comments []CommentModel
+	PageInfo
+}
+
+type CommentModel struct {
+	Id        uuid.UUID
+	TodoId    uuid.UUID
+	Author    string
+	Body      string
+	CreatedAt time.Time
+	// EditedAt is nil until the body of the comment is changed.
+	EditedAt *time.Time
+}
+
+// For Repository
+type CommentEntity struct {
+	Id        uuid.UUID  `db:"id"`
+	TodoId    uuid.UUID  `db:"todo_id"`
+	Author    string     `db:"author"`
+	Body      string     `db:"body"`
+	CreatedAt time.Time  `db:"created_at"`
+	EditedAt  *time.Time `db:"edited_at"`
+}
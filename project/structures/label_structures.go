@@ -0,0 +1,59 @@
+package structures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// For Resolver
+type LabelInput struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type LabelOutput struct {
+	Id     uuid.UUID `json:"id"`
+	ListId uuid.UUID `json:"list_id"`
+	Name   string    `json:"name"`
+	Color  string    `json:"color"`
+}
+
+// For Service
+type LabelModel struct {
+	Id     uuid.UUID
+	ListId uuid.UUID
+	Name   string
+	Color  string
+}
+
+// For Repository
+type LabelEntity struct {
+	Id     uuid.UUID `db:"id" json:"id"`
+	ListId uuid.UUID `db:"list_id" json:"list_id"`
+	Name   string    `db:"name" json:"name"`
+	Color  string    `db:"color" json:"color"`
+}
+
+// LabelEntities holds the labels of a todo, which the database reads as one JSON array.
+type LabelEntities []LabelEntity
+
+func (l *LabelEntities) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, l)
+	case string:
+		return json.Unmarshal([]byte(value), l)
+	default:
+		return errors.New(fmt.Sprintf("error scanning labels from %T", src))
+	}
+}
+
+type TodoLabelEntity struct {
+	TodoId  uuid.UUID `db:"todo_id"`
+	LabelId uuid.UUID `db:"label_id"`
+}
@@ -10,11 +10,23 @@ type ListInput struct {
 	Name string `json:"name"`
 }
 
+// ListDuplicateInput names the copy of a list, which only takes over the members of the list when IncludeMembers is set.
+type ListDuplicateInput struct {
+	Name           string `json:"name"`
+	IncludeMembers bool   `json:"include_members"`
+}
+
 type ListUserInput struct {
 	Username string `json:"username"`
+	Role     string `json:"role"`
 }
 
 // For Service
+type ListPageModel struct {
+	Lists []*ListModel
+	PageInfo
+}
+
 type ListModel struct {
 	Id           uuid.UUID
 	Name         string
@@ -27,6 +39,7 @@ type UserModel struct {
 	ListId   uuid.UUID
 	ListName string
 	Username string
+	Role     string
 	IsOwner  bool
 }
 
@@ -40,10 +53,22 @@ type ListEntity struct {
 type ListUserEntity struct {
 	ListId   uuid.UUID `db:"list_id"`
 	Username string    `db:"username"`
-	IsOwner  bool      `db:"is_owner"`
+	Role     string    `db:"role"`
+}
+
+type UserListEntity struct {
+	ListId   uuid.UUID `db:"list_id"`
+	ListName string    `db:"name"`
+	Username string    `db:"username"`
+	Role     string    `db:"role"`
 }
 
 // For Resolver
+type ListPageOutput struct {
+	Lists []*ListOutput
+	PageInfoOutput
+}
+
 type ListOutput struct {
 	Id    uuid.UUID `json:"id"`
 	Name  string    `json:"name"`
@@ -61,5 +86,6 @@ type UserOutput struct {
 	ListId   uuid.UUID `json:"list_id"`
 	ListName string    `json:"list_name"`
 	Username string    `json:"username"`
+	Role     string    `json:"role"`
 	IsOwner  bool      `json:"is_owner"`
 }
@@ -0,0 +1,36 @@
+package structures
+
+import "github.com/google/uuid"
+
+// Cursor marks an item of a page together with the order the page was requested in.
+type Cursor struct {
+	SortBy     string    `json:"sort_by"`
+	Descending bool      `json:"descending"`
+	Value      string    `json:"value"`
+	Id         uuid.UUID `json:"id"`
+}
+
+// PageQuery selects a page the way Relay connections do: Limit items after After
+// or the Last items before Before. Zero limits return every item between the cursors.
+type PageQuery struct {
+	Limit  int
+	After  *Cursor
+	Last   int
+	Before *Cursor
+}
+
+type PageInfo struct {
+	TotalCount      int
+	StartCursor     *Cursor
+	EndCursor       *Cursor
+	HasNextPage     bool
+	HasPreviousPage bool
+}
+
+type PageInfoOutput struct {
+	TotalCount      int
+	StartCursor     string
+	EndCursor       string
+	HasNextPage     bool
+	HasPreviousPage bool
+}
@@ -0,0 +1,55 @@
+package structures
+
+import (
+	"github.com/google/uuid"
+)
+
+// For Resolver
+// SearchOutput is a list or todo matching the search. The highlights hold the matched text with the matching
+// words wrapped in <b> tags, the description ones are empty for lists.
+type SearchOutput struct {
+	Type                 string    `json:"type"`
+	Id                   uuid.UUID `json:"id"`
+	ListId               uuid.UUID `json:"list_id"`
+	ListName             string    `json:"list_name"`
+	Name                 string    `json:"name"`
+	Description          string    `json:"description"`
+	Rank                 float64   `json:"rank"`
+	NameHighlight        string    `json:"name_highlight"`
+	DescriptionHighlight string    `json:"description_highlight"`
+}
+
+// For Service
+// SearchQuery looks for Text in the names of lists and in the names and descriptions of todos. Only the lists
+// Username belongs to are searched, unless All is set.
+type SearchQuery struct {
+	Text     string
+	Username string
+	All      bool
+	Limit    int
+}
+
+type SearchModel struct {
+	Type                 string
+	Id                   uuid.UUID
+	ListId               uuid.UUID
+	ListName             string
+	Name                 string
+	Description          string
+	Rank                 float64
+	NameHighlight        string
+	DescriptionHighlight string
+}
+
+// For Repository
+type SearchEntity struct {
+	Type                 string    `db:"type"`
+	Id                   uuid.UUID `db:"id"`
+	ListId               uuid.UUID `db:"list_id"`
+	ListName             string    `db:"list_name"`
+	Name                 string    `db:"name"`
+	Description          string    `db:"description"`
+	Rank                 float64   `db:"rank"`
+	NameHighlight        string    `db:"name_highlight"`
+	DescriptionHighlight string    `db:"description_highlight"`
+}
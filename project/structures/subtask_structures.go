@@ -0,0 +1,44 @@
+package structures
+
+import (
+	"github.com/google/uuid"
+)
+
+// For Resolver
+type SubtaskInput struct {
+	Title    string `json:"title"`
+	Done     bool   `json:"done"`
+	Assignee string `json:"assignee"`
+	// Position is the zero-based place of the subtask in the checklist. When it is not set
+	// a new subtask goes to the end and an updated one keeps its place.
+	Position *int `json:"position"`
+}
+
+type SubtaskOutput struct {
+	Id       uuid.UUID `json:"id"`
+	TodoId   uuid.UUID `json:"todo_id"`
+	Title    string    `json:"title"`
+	Done     bool      `json:"done"`
+	Assignee string    `json:"assignee"`
+	Position int       `json:"position"`
+}
+
+// For Service
+type SubtaskModel struct {
+	Id       uuid.UUID
+	TodoId   uuid.UUID
+	Title    string
+	Done     bool
+	Assignee string
+	Position int
+}
+
+// For Repository
+type SubtaskEntity struct {
+	Id       uuid.UUID `db:"id"`
+	TodoId   uuid.UUID `db:"todo_id"`
+	Title    string    `db:"title"`
+	Done     bool      `db:"done"`
+	Assignee string    `db:"assignee"`
+	Position int       `db:"position"`
+}
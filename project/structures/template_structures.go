@@ -0,0 +1,185 @@
+package structures
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// For Resolver
+// TemplateInput saves a list as a template. The deadlines of its todos are kept as days after StartDate,
+// the earliest of them when it is left out.
+type TemplateInput struct {
+	Name      string     `json:"name"`
+	StartDate *time.Time `json:"start_date"`
+}
+
+// TemplateInstanceInput creates a list from a template, with the deadlines of the todos counted from StartDate.
+type TemplateInstanceInput struct {
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+}
+
+type TemplateOutput struct {
+	Id          uuid.UUID                  `json:"id"`
+	Name        string                     `json:"name"`
+	Owner       string                     `json:"owner"`
+	CreatedAt   time.Time                  `json:"created_at"`
+	States      []WorkflowStateOutput      `json:"states"`
+	Transitions []WorkflowTransitionOutput `json:"transitions"`
+	Labels      []TemplateLabelOutput      `json:"labels"`
+	Todos       []TemplateTodoOutput       `json:"todos"`
+}
+
+type TemplateLabelOutput struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type TemplateTodoOutput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Priority    string `json:"priority"`
+	Recurrence  string `json:"recurrence"`
+	// DeadlineOffset is the number of days from the start date of a list created from the template to the deadline.
+	DeadlineOffset int      `json:"deadline_offset"`
+	Labels         []string `json:"labels"`
+	Subtasks       []string `json:"subtasks"`
+}
+
+// For Service
+type TemplateModel struct {
+	Id          uuid.UUID
+	Name        string
+	Owner       string
+	CreatedAt   time.Time
+	States      []WorkflowStateModel
+	Transitions []WorkflowTransitionModel
+	Labels      []TemplateLabelModel
+	Todos       []TemplateTodoModel
+}
+
+type TemplateLabelModel struct {
+	Name  string
+	Color string
+}
+
+type TemplateTodoModel struct {
+	Name           string
+	Description    string
+	Priority       string
+	Recurrence     string
+	DeadlineOffset int
+	Labels         []string
+	Subtasks       []string
+}
+
+// For Repository
+// TemplateEntity is a row of list_template. Its todos are rows of list_template_todo, in the order of their position.
+type TemplateEntity struct {
+	Id        uuid.UUID            `db:"id"`
+	Name      string               `db:"name"`
+	Owner     string               `db:"owner"`
+	CreatedAt time.Time            `db:"created_at"`
+	Workflow  TemplateWorkflow     `db:"workflow"`
+	Labels    TemplateLabels       `db:"labels"`
+	Todos     []TemplateTodoEntity `db:"-"`
+}
+
+type TemplateTodoEntity struct {
+	TemplateId     uuid.UUID     `db:"template_id"`
+	Position       int           `db:"position"`
+	Name           string        `db:"name"`
+	Description    string        `db:"description"`
+	Priority       string        `db:"priority"`
+	Recurrence     string        `db:"recurrence"`
+	DeadlineOffset int           `db:"deadline_offset"`
+	Labels         TemplateNames `db:"labels"`
+	Subtasks       TemplateNames `db:"subtasks"`
+}
+
+// TemplateWorkflow is the workflow a template gives its lists, with the states in the order of their positions.
+// The database keeps it as one JSON document.
+type TemplateWorkflow struct {
+	States      []TemplateStateEntity      `json:"states"`
+	Transitions []TemplateTransitionEntity `json:"transitions"`
+}
+
+type TemplateStateEntity struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+type TemplateTransitionEntity struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func (w *TemplateWorkflow) Scan(src any) error {
+	return scanJSON(src, w, "workflow")
+}
+
+func (w TemplateWorkflow) Value() (driver.Value, error) {
+	return valueJSON(w)
+}
+
+type TemplateLabelEntity struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// TemplateLabels holds the label catalog of a template, which the database keeps as one JSON array.
+type TemplateLabels []TemplateLabelEntity
+
+func (l *TemplateLabels) Scan(src any) error {
+	return scanJSON(src, l, "labels")
+}
+
+func (l TemplateLabels) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+
+	return valueJSON(l)
+}
+
+// TemplateNames holds the label names or the subtask titles of a template todo as one JSON array.
+type TemplateNames []string
+
+func (n *TemplateNames) Scan(src any) error {
+	return scanJSON(src, n, "names")
+}
+
+func (n TemplateNames) Value() (driver.Value, error) {
+	if n == nil {
+		return "[]", nil
+	}
+
+	return valueJSON(n)
+}
+
+func scanJSON(src, dest any, what string) error {
+	switch value := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(value, dest)
+	case string:
+		return json.Unmarshal([]byte(value), dest)
+	default:
+		return errors.New(fmt.Sprintf("error scanning %s from %T", what, src))
+	}
+}
+
+// valueJSON writes the JSON form of value as text, which the driver passes on to JSONB columns as it is.
+func valueJSON(value any) (driver.Value, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(encoded), nil
+}
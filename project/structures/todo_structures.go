@@ -1,6 +1,9 @@
 package structures
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"time"
 )
@@ -11,6 +14,45 @@ type TodoInput struct {
 	Description string    `json:"description"`
 	Deadline    time.Time `json:"deadline"`
 	Priority    string    `json:"priority"`
+	// Recurrence is only read when the todo is created, the recurrence of a series is edited on its own.
+	Recurrence string `json:"recurrence"`
+}
+
+type TodoRecurrenceInput struct {
+	Rule string `json:"rule"`
+}
+
+// TodoDependencyInput names the todo that blocks another one, it may be in any list the user can read.
+type TodoDependencyInput struct {
+	TodoId uuid.UUID `json:"todo_id"`
+	ListId uuid.UUID `json:"list_id"`
+}
+
+// TodoTransferInput moves or copies todos to the list with ListId. Conflict is fail or rename and tells what
+// to do when that list already has a todo with the same name. TodoIds is only read by the bulk operations.
+type TodoTransferInput struct {
+	ListId   uuid.UUID   `json:"list_id"`
+	Conflict string      `json:"conflict"`
+	TodoIds  []uuid.UUID `json:"todo_ids"`
+}
+
+type TodoAssigneeInput struct {
+	Username string `json:"username"`
+}
+
+// TodoBulkInput applies one operation to the todos with TodoIds. Mode is atomic, where either all todos change or
+// none does, or best_effort, where every todo changes on its own. Status, Priority, Username, LabelId and ListId
+// with Conflict are the arguments of the operations that take them.
+type TodoBulkInput struct {
+	Operation string      `json:"operation"`
+	Mode      string      `json:"mode"`
+	TodoIds   []uuid.UUID `json:"todo_ids"`
+	Status    string      `json:"status"`
+	Priority  string      `json:"priority"`
+	Username  string      `json:"username"`
+	LabelId   uuid.UUID   `json:"label_id"`
+	ListId    uuid.UUID   `json:"list_id"`
+	Conflict  string      `json:"conflict"`
 }
 
 type TodoOutput struct {
@@ -19,23 +61,107 @@ type TodoOutput struct {
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
 	Deadline    time.Time `json:"deadline"`
-	Assignee    string    `json:"assignee"`
+	Assignees   []string  `json:"assignees"`
+	Watchers    []string  `json:"watchers"`
 	Status      string    `json:"status"`
-	Priority    string    `json:"priority"`
+	// Done tells whether the status counts as done in the workflow of the list.
+	Done     bool   `json:"done"`
+	Priority string `json:"priority"`
+	// Progress is the percentage of done subtasks, 0 for todos without subtasks.
+	Progress int           `json:"progress"`
+	Labels   []LabelOutput `json:"labels"`
+	// Recurrence is the rule completing the todo follows to create the next occurrence of its series.
+	Recurrence string     `json:"recurrence"`
+	SeriesId   *uuid.UUID `json:"series_id"`
+	// Blocked tells whether any of the todos in BlockedBy is not done yet.
+	Blocked   bool                   `json:"blocked"`
+	BlockedBy []TodoDependencyOutput `json:"blocked_by"`
+	Blocks    []TodoDependencyOutput `json:"blocks"`
 }
 
-// For Service
-type TodoModel struct {
-	Id           uuid.UUID
-	ListId       uuid.UUID
-	Name         string
-	Description  string
-	Deadline     time.Time
-	CreationDate time.Time
-	Assignee     string
-	Username     string
+type TodoDependencyOutput struct {
+	Id     uuid.UUID `json:"id"`
+	ListId uuid.UUID `json:"list_id"`
+	Name   string    `json:"name"`
+	Done   bool      `json:"done"`
+}
+
+// TodoBulkResult tells how the operation went for one todo. Todo is the todo after it, or before it when it was
+// deleted, and is null when the todo did not change.
+type TodoBulkResult struct {
+	TodoId  uuid.UUID   `json:"todo_id"`
+	Success bool        `json:"success"`
+	Error   string      `json:"error"`
+	Todo    *TodoOutput `json:"todo"`
+}
+
+type TodoBulkOutput struct {
+	Operation string           `json:"operation"`
+	Mode      string           `json:"mode"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []TodoBulkResult `json:"results"`
+}
+
+type TodoRecurrenceOutput struct {
+	Rule     string      `json:"rule"`
+	SeriesId uuid.UUID   `json:"series_id"`
+	Upcoming []time.Time `json:"upcoming"`
+}
+
+type TodoPageOutput struct {
+	Todos []TodoOutput
+	PageInfoOutput
+}
+
+// TodoQuery selects, orders and pages the todos of a list. Zero values leave a filter out.
+type TodoQuery struct {
 	Status       string
 	Priority     string
+	Assignee     string
+	Label        string
+	DeadlineFrom time.Time
+	DeadlineTo   time.Time
+	Text         string
+	SortBy       string
+	Descending   bool
+	PageQuery
+}
+
+// For Service
+type TodoPageModel struct {
+	Todos []TodoModel
+	PageInfo
+}
+
+type TodoModel struct {
+	Id            uuid.UUID
+	ListId        uuid.UUID
+	Name          string
+	Description   string
+	Deadline      time.Time
+	CreationDate  time.Time
+	Assignees     []string
+	Watchers      []string
+	Username      string
+	Status        string
+	Done          bool
+	Priority      string
+	SubtasksTotal int
+	SubtasksDone  int
+	Labels        []LabelModel
+	Recurrence    string
+	SeriesId      *uuid.UUID
+	Blocked       bool
+	BlockedBy     []TodoDependencyModel
+	Blocks        []TodoDependencyModel
+}
+
+type TodoDependencyModel struct {
+	Id     uuid.UUID
+	ListId uuid.UUID
+	Name   string
+	Done   bool
 }
 
 // For Repository
@@ -46,7 +172,76 @@ type TodoEntity struct {
 	Description  string    `db:"description"`
 	Deadline     time.Time `db:"deadline"`
 	CreationDate time.Time `db:"created_at"`
-	Assignee     string    `db:"assignee"`
 	Status       string    `db:"status"`
 	Priority     string    `db:"priority"`
+	// SeriesId is shared by the occurrences of a recurring todo and null for the other todos. Superseded occurrences
+	// gave their name over to the next occurrence of the series and are the only todos sharing a name in a list.
+	Recurrence string        `db:"recurrence"`
+	SeriesId   uuid.NullUUID `db:"series_id"`
+	Superseded bool          `db:"superseded"`
+	// The subtask counts, the labels, the assignees, the watchers, the dependencies and the place of
+	// the status in the workflow are computed when the todo is read and never written.
+	SubtasksTotal  int              `db:"subtasks_total"`
+	SubtasksDone   int              `db:"subtasks_done"`
+	Labels         LabelEntities    `db:"labels"`
+	Assignees      Usernames        `db:"assignees"`
+	Watchers       Usernames        `db:"watchers"`
+	StatusPosition int              `db:"status_position"`
+	Done           bool             `db:"done"`
+	BlockedBy      TodoDependencies `db:"blocked_by"`
+	Blocks         TodoDependencies `db:"blocks"`
+}
+
+// Usernames holds the assignees or the watchers of a todo, which the database reads as one JSON array.
+type Usernames []string
+
+func (u *Usernames) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*u = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, u)
+	case string:
+		return json.Unmarshal([]byte(value), u)
+	default:
+		return errors.New(fmt.Sprintf("error scanning usernames from %T", src))
+	}
+}
+
+// TodoUserEntity is a row of todo_assignee or todo_watcher.
+type TodoUserEntity struct {
+	TodoId   uuid.UUID `db:"todo_id"`
+	Username string    `db:"username"`
+}
+
+// TodoDependencyEntity is a row of todo_dependency, the todo waits for the one it is blocked by.
+type TodoDependencyEntity struct {
+	TodoId      uuid.UUID `db:"todo_id"`
+	BlockedById uuid.UUID `db:"blocked_by_id"`
+}
+
+// TodoDependencyRefEntity is a todo on the other side of a dependency, as the database reads it into JSON.
+type TodoDependencyRefEntity struct {
+	Id     uuid.UUID `json:"id"`
+	ListId uuid.UUID `json:"list_id"`
+	Name   string    `json:"name"`
+	Done   bool      `json:"done"`
+}
+
+// TodoDependencies holds the todos blocking or blocked by a todo, which the database reads as one JSON array.
+type TodoDependencies []TodoDependencyRefEntity
+
+func (d *TodoDependencies) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*d = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, d)
+	case string:
+		return json.Unmarshal([]byte(value), d)
+	default:
+		return errors.New(fmt.Sprintf("error scanning dependencies from %T", src))
+	}
 }
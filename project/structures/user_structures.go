@@ -0,0 +1,44 @@
+package structures
+
+import (
+	"time"
+)
+
+// For Resolver
+type UserAccountInput struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Role     string `json:"role"`
+}
+
+type UserRoleInput struct {
+	Role string `json:"role"`
+}
+
+type UserPasswordInput struct {
+	Password string `json:"password"`
+}
+
+// For Service
+type UserAccountModel struct {
+	Username     string
+	Role         string
+	IsActive     bool
+	CreationDate time.Time
+}
+
+// For Repository
+type UserAccountEntity struct {
+	Username     string    `db:"username"`
+	PasswordHash string    `db:"password_hash"`
+	Role         string    `db:"role"`
+	IsActive     bool      `db:"is_active"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// For Resolver
+type UserAccountOutput struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	IsActive bool   `json:"is_active"`
+}
@@ -0,0 +1,78 @@
+package structures
+
+import "github.com/google/uuid"
+
+// For Resolver
+// WorkflowInput replaces the workflow of a list. The first state is the one new todos start in.
+type WorkflowInput struct {
+	States      []WorkflowStateInput      `json:"states"`
+	Transitions []WorkflowTransitionInput `json:"transitions"`
+}
+
+type WorkflowStateInput struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+type WorkflowTransitionInput struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type WorkflowOutput struct {
+	ListId      uuid.UUID                  `json:"list_id"`
+	States      []WorkflowStateOutput      `json:"states"`
+	Transitions []WorkflowTransitionOutput `json:"transitions"`
+}
+
+type WorkflowStateOutput struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+type WorkflowTransitionOutput struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type TodoStatusInput struct {
+	Status string `json:"status"`
+}
+
+// For Service
+type WorkflowModel struct {
+	ListId      uuid.UUID
+	States      []WorkflowStateModel
+	Transitions []WorkflowTransitionModel
+}
+
+type WorkflowStateModel struct {
+	Name string
+	Done bool
+}
+
+type WorkflowTransitionModel struct {
+	From string
+	To   string
+}
+
+// For Repository
+// WorkflowEntity groups the rows that make up the workflow of a list.
+type WorkflowEntity struct {
+	ListId      uuid.UUID
+	States      []WorkflowStateEntity
+	Transitions []WorkflowTransitionEntity
+}
+
+type WorkflowStateEntity struct {
+	ListId   uuid.UUID `db:"list_id"`
+	Name     string    `db:"name"`
+	Position int       `db:"position"`
+	Done     bool      `db:"done"`
+}
+
+type WorkflowTransitionEntity struct {
+	ListId uuid.UUID `db:"list_id"`
+	From   string    `db:"from_state"`
+	To     string    `db:"to_state"`
+}
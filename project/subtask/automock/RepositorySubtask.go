@@ -0,0 +1,282 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RepositorySubtask is an autogenerated mock type for the RepositorySubtask type
+type RepositorySubtask struct {
+	mock.Mock
+}
+
+type RepositorySubtask_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepositorySubtask) EXPECT() *RepositorySubtask_Expecter {
+	return &RepositorySubtask_Expecter{mock: &_m.Mock}
+}
+
+// CreateSubtask provides a mock function with given fields: ctx, newSubtask, listId, position
+func (_m *RepositorySubtask) CreateSubtask(ctx context.Context, newSubtask structures.SubtaskEntity, listId uuid.UUID, position *int) (*structures.SubtaskModel, error) {
+	ret := _m.Called(ctx, newSubtask, listId, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubtask")
+	}
+
+	var r0 *structures.SubtaskModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SubtaskEntity, uuid.UUID, *int) (*structures.SubtaskModel, error)); ok {
+		return rf(ctx, newSubtask, listId, position)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SubtaskEntity, uuid.UUID, *int) *structures.SubtaskModel); ok {
+		r0 = rf(ctx, newSubtask, listId, position)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.SubtaskModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.SubtaskEntity, uuid.UUID, *int) error); ok {
+		r1 = rf(ctx, newSubtask, listId, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositorySubtask_CreateSubtask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubtask'
+type RepositorySubtask_CreateSubtask_Call struct {
+	*mock.Call
+}
+
+// CreateSubtask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newSubtask structures.SubtaskEntity
+//   - listId uuid.UUID
+//   - position *int
+func (_e *RepositorySubtask_Expecter) CreateSubtask(ctx interface{}, newSubtask interface{}, listId interface{}, position interface{}) *RepositorySubtask_CreateSubtask_Call {
+	return &RepositorySubtask_CreateSubtask_Call{Call: _e.mock.On("CreateSubtask", ctx, newSubtask, listId, position)}
+}
+
+func (_c *RepositorySubtask_CreateSubtask_Call) Run(run func(ctx context.Context, newSubtask structures.SubtaskEntity, listId uuid.UUID, position *int)) *RepositorySubtask_CreateSubtask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.SubtaskEntity), args[2].(uuid.UUID), args[3].(*int))
+	})
+	return _c
+}
+
+func (_c *RepositorySubtask_CreateSubtask_Call) Return(_a0 *structures.SubtaskModel, _a1 error) *RepositorySubtask_CreateSubtask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositorySubtask_CreateSubtask_Call) RunAndReturn(run func(context.Context, structures.SubtaskEntity, uuid.UUID, *int) (*structures.SubtaskModel, error)) *RepositorySubtask_CreateSubtask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubtask provides a mock function with given fields: ctx, subtaskId, todoId, listId
+func (_m *RepositorySubtask) DeleteSubtask(ctx context.Context, subtaskId uuid.UUID, todoId uuid.UUID, listId uuid.UUID) (*structures.SubtaskModel, error) {
+	ret := _m.Called(ctx, subtaskId, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubtask")
+	}
+
+	var r0 *structures.SubtaskModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*structures.SubtaskModel, error)); ok {
+		return rf(ctx, subtaskId, todoId, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) *structures.SubtaskModel); ok {
+		r0 = rf(ctx, subtaskId, todoId, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.SubtaskModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, subtaskId, todoId, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositorySubtask_DeleteSubtask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubtask'
+type RepositorySubtask_DeleteSubtask_Call struct {
+	*mock.Call
+}
+
+// DeleteSubtask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subtaskId uuid.UUID
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositorySubtask_Expecter) DeleteSubtask(ctx interface{}, subtaskId interface{}, todoId interface{}, listId interface{}) *RepositorySubtask_DeleteSubtask_Call {
+	return &RepositorySubtask_DeleteSubtask_Call{Call: _e.mock.On("DeleteSubtask", ctx, subtaskId, todoId, listId)}
+}
+
+func (_c *RepositorySubtask_DeleteSubtask_Call) Run(run func(ctx context.Context, subtaskId uuid.UUID, todoId uuid.UUID, listId uuid.UUID)) *RepositorySubtask_DeleteSubtask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositorySubtask_DeleteSubtask_Call) Return(_a0 *structures.SubtaskModel, _a1 error) *RepositorySubtask_DeleteSubtask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositorySubtask_DeleteSubtask_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*structures.SubtaskModel, error)) *RepositorySubtask_DeleteSubtask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubtasks provides a mock function with given fields: ctx, todoId, listId
+func (_m *RepositorySubtask) GetSubtasks(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) ([]structures.SubtaskModel, error) {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubtasks")
+	}
+
+	var r0 []structures.SubtaskModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]structures.SubtaskModel, error)); ok {
+		return rf(ctx, todoId, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []structures.SubtaskModel); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.SubtaskModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, todoId, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositorySubtask_GetSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubtasks'
+type RepositorySubtask_GetSubtasks_Call struct {
+	*mock.Call
+}
+
+// GetSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositorySubtask_Expecter) GetSubtasks(ctx interface{}, todoId interface{}, listId interface{}) *RepositorySubtask_GetSubtasks_Call {
+	return &RepositorySubtask_GetSubtasks_Call{Call: _e.mock.On("GetSubtasks", ctx, todoId, listId)}
+}
+
+func (_c *RepositorySubtask_GetSubtasks_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *RepositorySubtask_GetSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositorySubtask_GetSubtasks_Call) Return(_a0 []structures.SubtaskModel, _a1 error) *RepositorySubtask_GetSubtasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositorySubtask_GetSubtasks_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]structures.SubtaskModel, error)) *RepositorySubtask_GetSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubtask provides a mock function with given fields: ctx, updatedSubtask, listId, position
+func (_m *RepositorySubtask) UpdateSubtask(ctx context.Context, updatedSubtask structures.SubtaskEntity, listId uuid.UUID, position *int) (*structures.SubtaskModel, error) {
+	ret := _m.Called(ctx, updatedSubtask, listId, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubtask")
+	}
+
+	var r0 *structures.SubtaskModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SubtaskEntity, uuid.UUID, *int) (*structures.SubtaskModel, error)); ok {
+		return rf(ctx, updatedSubtask, listId, position)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.SubtaskEntity, uuid.UUID, *int) *structures.SubtaskModel); ok {
+		r0 = rf(ctx, updatedSubtask, listId, position)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.SubtaskModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.SubtaskEntity, uuid.UUID, *int) error); ok {
+		r1 = rf(ctx, updatedSubtask, listId, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositorySubtask_UpdateSubtask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubtask'
+type RepositorySubtask_UpdateSubtask_Call struct {
+	*mock.Call
+}
+
+// UpdateSubtask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updatedSubtask structures.SubtaskEntity
+//   - listId uuid.UUID
+//   - position *int
+func (_e *RepositorySubtask_Expecter) UpdateSubtask(ctx interface{}, updatedSubtask interface{}, listId interface{}, position interface{}) *RepositorySubtask_UpdateSubtask_Call {
+	return &RepositorySubtask_UpdateSubtask_Call{Call: _e.mock.On("UpdateSubtask", ctx, updatedSubtask, listId, position)}
+}
+
+func (_c *RepositorySubtask_UpdateSubtask_Call) Run(run func(ctx context.Context, updatedSubtask structures.SubtaskEntity, listId uuid.UUID, position *int)) *RepositorySubtask_UpdateSubtask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.SubtaskEntity), args[2].(uuid.UUID), args[3].(*int))
+	})
+	return _c
+}
+
+func (_c *RepositorySubtask_UpdateSubtask_Call) Return(_a0 *structures.SubtaskModel, _a1 error) *RepositorySubtask_UpdateSubtask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositorySubtask_UpdateSubtask_Call) RunAndReturn(run func(context.Context, structures.SubtaskEntity, uuid.UUID, *int) (*structures.SubtaskModel, error)) *RepositorySubtask_UpdateSubtask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepositorySubtask creates a new instance of RepositorySubtask. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepositorySubtask(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepositorySubtask {
+	mock := &RepositorySubtask{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
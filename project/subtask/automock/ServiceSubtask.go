@@ -0,0 +1,283 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServiceSubtask is an autogenerated mock type for the ServiceSubtask type
+type ServiceSubtask struct {
+	mock.Mock
+}
+
+type ServiceSubtask_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceSubtask) EXPECT() *ServiceSubtask_Expecter {
+	return &ServiceSubtask_Expecter{mock: &_m.Mock}
+}
+
+// CreateSubtask provides a mock function with given fields: ctx, todoId, listId, input
+func (_m *ServiceSubtask) CreateSubtask(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, input structures.SubtaskInput) (*structures.SubtaskOutput, error) {
+	ret := _m.Called(ctx, todoId, listId, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubtask")
+	}
+
+	var r0 *structures.SubtaskOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, structures.SubtaskInput) (*structures.SubtaskOutput, error)); ok {
+		return rf(ctx, todoId, listId, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, structures.SubtaskInput) *structures.SubtaskOutput); ok {
+		r0 = rf(ctx, todoId, listId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.SubtaskOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, structures.SubtaskInput) error); ok {
+		r1 = rf(ctx, todoId, listId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceSubtask_CreateSubtask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubtask'
+type ServiceSubtask_CreateSubtask_Call struct {
+	*mock.Call
+}
+
+// CreateSubtask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - input structures.SubtaskInput
+func (_e *ServiceSubtask_Expecter) CreateSubtask(ctx interface{}, todoId interface{}, listId interface{}, input interface{}) *ServiceSubtask_CreateSubtask_Call {
+	return &ServiceSubtask_CreateSubtask_Call{Call: _e.mock.On("CreateSubtask", ctx, todoId, listId, input)}
+}
+
+func (_c *ServiceSubtask_CreateSubtask_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, input structures.SubtaskInput)) *ServiceSubtask_CreateSubtask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(structures.SubtaskInput))
+	})
+	return _c
+}
+
+func (_c *ServiceSubtask_CreateSubtask_Call) Return(_a0 *structures.SubtaskOutput, _a1 error) *ServiceSubtask_CreateSubtask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceSubtask_CreateSubtask_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, structures.SubtaskInput) (*structures.SubtaskOutput, error)) *ServiceSubtask_CreateSubtask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubtask provides a mock function with given fields: ctx, subtaskId, todoId, listId
+func (_m *ServiceSubtask) DeleteSubtask(ctx context.Context, subtaskId uuid.UUID, todoId uuid.UUID, listId uuid.UUID) (*structures.SubtaskOutput, error) {
+	ret := _m.Called(ctx, subtaskId, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubtask")
+	}
+
+	var r0 *structures.SubtaskOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*structures.SubtaskOutput, error)); ok {
+		return rf(ctx, subtaskId, todoId, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) *structures.SubtaskOutput); ok {
+		r0 = rf(ctx, subtaskId, todoId, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.SubtaskOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, subtaskId, todoId, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceSubtask_DeleteSubtask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubtask'
+type ServiceSubtask_DeleteSubtask_Call struct {
+	*mock.Call
+}
+
+// DeleteSubtask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subtaskId uuid.UUID
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *ServiceSubtask_Expecter) DeleteSubtask(ctx interface{}, subtaskId interface{}, todoId interface{}, listId interface{}) *ServiceSubtask_DeleteSubtask_Call {
+	return &ServiceSubtask_DeleteSubtask_Call{Call: _e.mock.On("DeleteSubtask", ctx, subtaskId, todoId, listId)}
+}
+
+func (_c *ServiceSubtask_DeleteSubtask_Call) Run(run func(ctx context.Context, subtaskId uuid.UUID, todoId uuid.UUID, listId uuid.UUID)) *ServiceSubtask_DeleteSubtask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceSubtask_DeleteSubtask_Call) Return(_a0 *structures.SubtaskOutput, _a1 error) *ServiceSubtask_DeleteSubtask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceSubtask_DeleteSubtask_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (*structures.SubtaskOutput, error)) *ServiceSubtask_DeleteSubtask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubtasks provides a mock function with given fields: ctx, todoId, listId
+func (_m *ServiceSubtask) GetSubtasks(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) ([]structures.SubtaskOutput, error) {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubtasks")
+	}
+
+	var r0 []structures.SubtaskOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]structures.SubtaskOutput, error)); ok {
+		return rf(ctx, todoId, listId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []structures.SubtaskOutput); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.SubtaskOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, todoId, listId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceSubtask_GetSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubtasks'
+type ServiceSubtask_GetSubtasks_Call struct {
+	*mock.Call
+}
+
+// GetSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *ServiceSubtask_Expecter) GetSubtasks(ctx interface{}, todoId interface{}, listId interface{}) *ServiceSubtask_GetSubtasks_Call {
+	return &ServiceSubtask_GetSubtasks_Call{Call: _e.mock.On("GetSubtasks", ctx, todoId, listId)}
+}
+
+func (_c *ServiceSubtask_GetSubtasks_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *ServiceSubtask_GetSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceSubtask_GetSubtasks_Call) Return(_a0 []structures.SubtaskOutput, _a1 error) *ServiceSubtask_GetSubtasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceSubtask_GetSubtasks_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]structures.SubtaskOutput, error)) *ServiceSubtask_GetSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubtask provides a mock function with given fields: ctx, subtaskId, todoId, listId, input
+func (_m *ServiceSubtask) UpdateSubtask(ctx context.Context, subtaskId uuid.UUID, todoId uuid.UUID, listId uuid.UUID, input structures.SubtaskInput) (*structures.SubtaskOutput, error) {
+	ret := _m.Called(ctx, subtaskId, todoId, listId, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubtask")
+	}
+
+	var r0 *structures.SubtaskOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, structures.SubtaskInput) (*structures.SubtaskOutput, error)); ok {
+		return rf(ctx, subtaskId, todoId, listId, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, structures.SubtaskInput) *structures.SubtaskOutput); ok {
+		r0 = rf(ctx, subtaskId, todoId, listId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.SubtaskOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, structures.SubtaskInput) error); ok {
+		r1 = rf(ctx, subtaskId, todoId, listId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceSubtask_UpdateSubtask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubtask'
+type ServiceSubtask_UpdateSubtask_Call struct {
+	*mock.Call
+}
+
+// UpdateSubtask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subtaskId uuid.UUID
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - input structures.SubtaskInput
+func (_e *ServiceSubtask_Expecter) UpdateSubtask(ctx interface{}, subtaskId interface{}, todoId interface{}, listId interface{}, input interface{}) *ServiceSubtask_UpdateSubtask_Call {
+	return &ServiceSubtask_UpdateSubtask_Call{Call: _e.mock.On("UpdateSubtask", ctx, subtaskId, todoId, listId, input)}
+}
+
+func (_c *ServiceSubtask_UpdateSubtask_Call) Run(run func(ctx context.Context, subtaskId uuid.UUID, todoId uuid.UUID, listId uuid.UUID, input structures.SubtaskInput)) *ServiceSubtask_UpdateSubtask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(structures.SubtaskInput))
+	})
+	return _c
+}
+
+func (_c *ServiceSubtask_UpdateSubtask_Call) Return(_a0 *structures.SubtaskOutput, _a1 error) *ServiceSubtask_UpdateSubtask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceSubtask_UpdateSubtask_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, structures.SubtaskInput) (*structures.SubtaskOutput, error)) *ServiceSubtask_UpdateSubtask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceSubtask creates a new instance of ServiceSubtask. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceSubtask(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceSubtask {
+	mock := &ServiceSubtask{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
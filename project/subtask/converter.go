@@ -0,0 +1,63 @@
+package subtask
+
+import (
+	"project/structures"
+)
+
+type ServiceSubtaskConvertor struct{}
+
+func NewServiceSubtaskConvertor() *ServiceSubtaskConvertor {
+	return &ServiceSubtaskConvertor{}
+}
+
+func (s *ServiceSubtaskConvertor) ConvertSubtaskModelToOutput(subtaskModel *structures.SubtaskModel) *structures.SubtaskOutput {
+	subtaskOutput := structures.SubtaskOutput{
+		Id:       subtaskModel.Id,
+		TodoId:   subtaskModel.TodoId,
+		Title:    subtaskModel.Title,
+		Done:     subtaskModel.Done,
+		Assignee: subtaskModel.Assignee,
+		Position: subtaskModel.Position,
+	}
+
+	return &subtaskOutput
+}
+
+func (s *ServiceSubtaskConvertor) ConvertSubtaskModelToEntity(subtaskModel *structures.SubtaskModel) *structures.SubtaskEntity {
+	subtaskEntity := structures.SubtaskEntity{
+		Id:       subtaskModel.Id,
+		TodoId:   subtaskModel.TodoId,
+		Title:    subtaskModel.Title,
+		Done:     subtaskModel.Done,
+		Assignee: subtaskModel.Assignee,
+		Position: subtaskModel.Position,
+	}
+
+	return &subtaskEntity
+}
+
+type RepositorySubtaskConvertor struct{}
+
+func NewRepositorySubtaskConvertor() *RepositorySubtaskConvertor {
+	return &RepositorySubtaskConvertor{}
+}
+
+func (r *RepositorySubtaskConvertor) ConvertEntityToModel(entity structures.SubtaskEntity) structures.SubtaskModel {
+	return structures.SubtaskModel{
+		Id:       entity.Id,
+		TodoId:   entity.TodoId,
+		Title:    entity.Title,
+		Done:     entity.Done,
+		Assignee: entity.Assignee,
+		Position: entity.Position,
+	}
+}
+
+func (r *RepositorySubtaskConvertor) ConvertEntitiesToModels(entities []structures.SubtaskEntity) []structures.SubtaskModel {
+	models := make([]structures.SubtaskModel, len(entities))
+	for i, e := range entities {
+		models[i] = r.ConvertEntityToModel(e)
+	}
+
+	return models
+}
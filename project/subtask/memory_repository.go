@@ -0,0 +1,201 @@
+package subtask
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"project/memory"
+	"project/structures"
+	"project/utils"
+	"sort"
+)
+
+type MemoryRepositorySubtask struct {
+	store     *memory.Store
+	converter RepositorySubtaskConvertor
+}
+
+func NewMemoryRepositorySubtask(store *memory.Store, convertor RepositorySubtaskConvertor) *MemoryRepositorySubtask {
+	return &MemoryRepositorySubtask{store: store, converter: convertor}
+}
+
+func (r *MemoryRepositorySubtask) GetSubtasks(ctx context.Context, todoId, listId uuid.UUID) ([]structures.SubtaskModel, error) {
+	var entities []structures.SubtaskEntity
+	var err error
+	r.store.Read(ctx, func() {
+		err = r.findTodo(ctx, todoId, listId)
+		if err == nil {
+			entities = r.subtasksOf(todoId)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.ConvertEntitiesToModels(entities), nil
+}
+
+func (r *MemoryRepositorySubtask) CreateSubtask(ctx context.Context, newSubtask structures.SubtaskEntity, listId uuid.UUID, position *int) (*structures.SubtaskModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		err := r.findTodo(ctx, newSubtask.TodoId, listId)
+		if err != nil {
+			return err
+		}
+		err = r.checkAssignee(ctx, listId, newSubtask.Assignee)
+		if err != nil {
+			return err
+		}
+
+		count := len(r.subtasksOf(newSubtask.TodoId))
+		newSubtask.Position, err = resolvePosition(position, count, count)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+
+		r.shiftSubtasks(newSubtask.TodoId, newSubtask.Position, count-1, 1)
+		memory.Put(r.store, r.store.Subtasks, newSubtask.Id, newSubtask)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subtaskModel := r.converter.ConvertEntityToModel(newSubtask)
+	return &subtaskModel, nil
+}
+
+func (r *MemoryRepositorySubtask) UpdateSubtask(ctx context.Context, updatedSubtask structures.SubtaskEntity, listId uuid.UUID, position *int) (*structures.SubtaskModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		err := r.findTodo(ctx, updatedSubtask.TodoId, listId)
+		if err != nil {
+			return err
+		}
+		subtaskEntity, err := r.findSubtask(ctx, updatedSubtask.Id, updatedSubtask.TodoId)
+		if err != nil {
+			return err
+		}
+		err = r.checkAssignee(ctx, listId, updatedSubtask.Assignee)
+		if err != nil {
+			return err
+		}
+
+		count := len(r.subtasksOf(updatedSubtask.TodoId))
+		updatedSubtask.Position, err = resolvePosition(position, count-1, subtaskEntity.Position)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+
+		if updatedSubtask.Position < subtaskEntity.Position {
+			r.shiftSubtasks(updatedSubtask.TodoId, updatedSubtask.Position, subtaskEntity.Position-1, 1)
+		} else {
+			r.shiftSubtasks(updatedSubtask.TodoId, subtaskEntity.Position+1, updatedSubtask.Position, -1)
+		}
+		memory.Put(r.store, r.store.Subtasks, updatedSubtask.Id, updatedSubtask)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subtaskModel := r.converter.ConvertEntityToModel(updatedSubtask)
+	return &subtaskModel, nil
+}
+
+func (r *MemoryRepositorySubtask) DeleteSubtask(ctx context.Context, subtaskId, todoId, listId uuid.UUID) (*structures.SubtaskModel, error) {
+	var deletedSubtask *structures.SubtaskEntity
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		deletedSubtask, err = r.findSubtask(ctx, subtaskId, todoId)
+		if err != nil {
+			return err
+		}
+
+		memory.Delete(r.store, r.store.Subtasks, subtaskId)
+		r.shiftSubtasks(todoId, deletedSubtask.Position+1, len(r.subtasksOf(todoId)), -1)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subtaskModel := r.converter.ConvertEntityToModel(*deletedSubtask)
+	return &subtaskModel, nil
+}
+
+func (r *MemoryRepositorySubtask) findTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	todoEntity, ok := r.store.Todos[todoId]
+	if !ok || todoEntity.ListId != listId {
+		err := errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *MemoryRepositorySubtask) findSubtask(ctx context.Context, subtaskId, todoId uuid.UUID) (*structures.SubtaskEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	subtaskEntity, ok := r.store.Subtasks[subtaskId]
+	if !ok || subtaskEntity.TodoId != todoId {
+		err := errors.New(fmt.Sprintf("error not found subtask with id %s in todo with id: %s", subtaskId, todoId))
+		log.Error(err)
+		return nil, err
+	}
+
+	return &subtaskEntity, nil
+}
+
+func (r *MemoryRepositorySubtask) checkAssignee(ctx context.Context, listId uuid.UUID, assignee string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if assignee == "" {
+		return nil
+	}
+	for _, member := range r.store.UsersLists {
+		if member.ListId == listId && member.Username == assignee {
+			return nil
+		}
+	}
+
+	err := errors.New(fmt.Sprintf("error %s %s with id: %s", assignee, utils.NotMemberErrorMsg, listId))
+	log.Error(err)
+	return err
+}
+
+func (r *MemoryRepositorySubtask) subtasksOf(todoId uuid.UUID) []structures.SubtaskEntity {
+	var entities []structures.SubtaskEntity
+	for _, subtaskEntity := range r.store.Subtasks {
+		if subtaskEntity.TodoId == todoId {
+			entities = append(entities, subtaskEntity)
+		}
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Position < entities[j].Position
+	})
+
+	return entities
+}
+
+// shiftSubtasks moves the subtasks of the todo placed in [from, to] by offset.
+func (r *MemoryRepositorySubtask) shiftSubtasks(todoId uuid.UUID, from, to, offset int) {
+	for subtaskId, subtaskEntity := range r.store.Subtasks {
+		if subtaskEntity.TodoId == todoId && subtaskEntity.Position >= from && subtaskEntity.Position <= to {
+			subtaskEntity.Position += offset
+			memory.Put(r.store, r.store.Subtasks, subtaskId, subtaskEntity)
+		}
+	}
+}
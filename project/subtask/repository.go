@@ -0,0 +1,342 @@
+package subtask
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"project/structures"
+	"project/uow"
+	"project/utils"
+	"strings"
+)
+
+var (
+	subtaskTable            = "subtask"
+	subtaskTableId          = "id"
+	subtaskTableTodoId      = "todo_id"
+	subtaskTablePosition    = "position"
+	subtaskColumns          = []string{"id", "todo_id", "title", "done", "assignee", "position"}
+	updateSetSubtaskColumns = []string{"title = ?", "done = ?", "assignee = ?", "position = ?"}
+)
+
+type DBRepositorySubtask struct {
+	db        *sqlx.DB
+	converter RepositorySubtaskConvertor
+}
+
+func NewDBRepositorySubtask(db *sqlx.DB, convertor RepositorySubtaskConvertor) *DBRepositorySubtask {
+	return &DBRepositorySubtask{db: db, converter: convertor}
+}
+
+func (r *DBRepositorySubtask) executor(ctx context.Context) uow.Executor {
+	return uow.GetExecutor(ctx, r.db)
+}
+
+// lockTodo checks that the todo belongs to the list and locks its row, so the positions of its
+// subtasks cannot change until the end of the running unit of work.
+func (r *DBRepositorySubtask) lockTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := `SELECT id FROM todo WHERE id = ? AND list_id = ? FOR UPDATE`
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var id uuid.UUID
+	err := r.executor(ctx).Get(&id, query, todoId, listId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositorySubtask) checkAssignee(ctx context.Context, listId uuid.UUID, assignee string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if assignee == "" {
+		return nil
+	}
+
+	stmt := `SELECT COUNT(username) FROM users_lists WHERE list_id = ? AND username = ?`
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var count int
+	err := r.executor(ctx).Get(&count, query, listId, assignee)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if count == 0 {
+		err = errors.New(fmt.Sprintf("error %s %s with id: %s", assignee, utils.NotMemberErrorMsg, listId))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositorySubtask) countSubtasks(ctx context.Context, todoId uuid.UUID) (int, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ?`, subtaskTableTodoId)
+	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s WHERE %s`, subtaskTableId, subtaskTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var count int
+	err := r.executor(ctx).Get(&count, query, todoId)
+	if err != nil {
+		log.Error(err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *DBRepositorySubtask) getSubtask(ctx context.Context, subtaskId, todoId uuid.UUID) (*structures.SubtaskEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, subtaskTableId, subtaskTableTodoId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(subtaskColumns, ", "), subtaskTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var subtaskEntity structures.SubtaskEntity
+	err := r.executor(ctx).Get(&subtaskEntity, query, subtaskId, todoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found subtask with id %s in todo with id: %s", subtaskId, todoId))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	return &subtaskEntity, nil
+}
+
+// shiftSubtasks moves the subtasks of the todo placed in [from, to] by offset.
+func (r *DBRepositorySubtask) shiftSubtasks(ctx context.Context, todoId uuid.UUID, from, to, offset int) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s BETWEEN ? AND ?`, subtaskTableTodoId, subtaskTablePosition)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s = %s + ? WHERE %s`, subtaskTable, subtaskTablePosition, subtaskTablePosition, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	_, err := r.executor(ctx).Exec(query, offset, todoId, from, to)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositorySubtask) GetSubtasks(ctx context.Context, todoId, listId uuid.UUID) ([]structures.SubtaskModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := `SELECT COUNT(id) FROM todo WHERE id = ? AND list_id = ?`
+	var count int
+	err := r.executor(ctx).Get(&count, sqlx.Rebind(sqlx.DOLLAR, stmt), todoId, listId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if count == 0 {
+		err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
+		log.Error(err)
+		return nil, err
+	}
+
+	cond := fmt.Sprintf(`%s = ?`, subtaskTableTodoId)
+	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY %s`, strings.Join(subtaskColumns, ", "), subtaskTable, cond, subtaskTablePosition)
+	var entities []structures.SubtaskEntity
+	err = r.executor(ctx).Select(&entities, sqlx.Rebind(sqlx.DOLLAR, stmt), todoId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.converter.ConvertEntitiesToModels(entities), nil
+}
+
+func (r *DBRepositorySubtask) CreateSubtask(ctx context.Context, newSubtask structures.SubtaskEntity, listId uuid.UUID, position *int) (*structures.SubtaskModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.lockTodo(ctx, newSubtask.TodoId, listId)
+	if err != nil {
+		return nil, err
+	}
+	err = r.checkAssignee(ctx, listId, newSubtask.Assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := r.countSubtasks(ctx, newSubtask.TodoId)
+	if err != nil {
+		return nil, err
+	}
+	newSubtask.Position, err = resolvePosition(position, count, count)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if newSubtask.Position < count {
+		err = r.shiftSubtasks(ctx, newSubtask.TodoId, newSubtask.Position, count-1, 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES(?, ?, ?, ?, ?, ?)`, subtaskTable, strings.Join(subtaskColumns, ", "))
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, newSubtask.Id, newSubtask.TodoId, newSubtask.Title, newSubtask.Done,
+		newSubtask.Assignee, newSubtask.Position)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", newSubtask.TodoId, listId))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affected != 1 {
+		err = errors.New(fmt.Sprintf("error creating subtask with title %s", newSubtask.Title))
+		log.Error(err)
+		return nil, err
+	}
+
+	subtaskModel := r.converter.ConvertEntityToModel(newSubtask)
+	return &subtaskModel, nil
+}
+
+func (r *DBRepositorySubtask) UpdateSubtask(ctx context.Context, updatedSubtask structures.SubtaskEntity, listId uuid.UUID, position *int) (*structures.SubtaskModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.lockTodo(ctx, updatedSubtask.TodoId, listId)
+	if err != nil {
+		return nil, err
+	}
+	subtaskEntity, err := r.getSubtask(ctx, updatedSubtask.Id, updatedSubtask.TodoId)
+	if err != nil {
+		return nil, err
+	}
+	err = r.checkAssignee(ctx, listId, updatedSubtask.Assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedSubtask.Position = subtaskEntity.Position
+	if position != nil && *position != subtaskEntity.Position {
+		count, err := r.countSubtasks(ctx, updatedSubtask.TodoId)
+		if err != nil {
+			return nil, err
+		}
+		updatedSubtask.Position, err = resolvePosition(position, count-1, subtaskEntity.Position)
+		if err != nil {
+			log.Error(err)
+			return nil, err
+		}
+
+		if updatedSubtask.Position < subtaskEntity.Position {
+			err = r.shiftSubtasks(ctx, updatedSubtask.TodoId, updatedSubtask.Position, subtaskEntity.Position-1, 1)
+		} else {
+			err = r.shiftSubtasks(ctx, updatedSubtask.TodoId, subtaskEntity.Position+1, updatedSubtask.Position, -1)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cond := fmt.Sprintf(`%s = ?`, subtaskTableId)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s WHERE %s`, subtaskTable, strings.Join(updateSetSubtaskColumns, ", "), cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, updatedSubtask.Title, updatedSubtask.Done, updatedSubtask.Assignee,
+		updatedSubtask.Position, updatedSubtask.Id)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error updating subtask with id: %s", updatedSubtask.Id))
+		log.Error(err)
+		return nil, err
+	}
+
+	subtaskModel := r.converter.ConvertEntityToModel(updatedSubtask)
+	return &subtaskModel, nil
+}
+
+func (r *DBRepositorySubtask) DeleteSubtask(ctx context.Context, subtaskId, todoId, listId uuid.UUID) (*structures.SubtaskModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return nil, err
+	}
+	deletedSubtask, err := r.getSubtask(ctx, subtaskId, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := fmt.Sprintf(`%s = ?`, subtaskTableId)
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, subtaskTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := r.executor(ctx).Exec(query, subtaskId)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error deleting subtask with id: %s", subtaskId))
+		log.Error(err)
+		return nil, err
+	}
+
+	count, err := r.countSubtasks(ctx, todoId)
+	if err != nil {
+		return nil, err
+	}
+	if deletedSubtask.Position < count {
+		err = r.shiftSubtasks(ctx, todoId, deletedSubtask.Position+1, count, -1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subtaskModel := r.converter.ConvertEntityToModel(*deletedSubtask)
+	return &subtaskModel, nil
+}
+
+// resolvePosition returns the requested position, or fallback when none is requested,
+// after checking that it is between 0 and last.
+func resolvePosition(position *int, last, fallback int) (int, error) {
+	if position == nil {
+		return fallback, nil
+	}
+	if *position < 0 || *position > last {
+		return 0, errors.New(fmt.Sprintf("%s %d, expected a number from 0 to %d", utils.InvalidPositionErrorMsg, *position, last))
+	}
+
+	return *position, nil
+}
@@ -0,0 +1,363 @@
+package subtask_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"project/structures"
+	"project/subtask"
+	"project/uow"
+	"project/utils"
+	"regexp"
+	"testing"
+)
+
+var subtaskColumns = []string{"id", "todo_id", "title", "done", "assignee", "position"}
+
+func expectLockTodo(mock sqlxmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT id FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+		WithArgs(utils.TestTodoId, utils.TestListId).
+		WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow(utils.TestTodoId))
+}
+
+func requireMatchingError(t *testing.T, expectedErr, err error) {
+	if err != nil {
+		require.NotNil(t, expectedErr, err.Error())
+		result, regErr := regexp.MatchString(expectedErr.Error(), err.Error())
+		require.NoError(t, regErr)
+		require.True(t, result, err.Error())
+	} else if expectedErr != nil {
+		t.Error("Expected error but got nil")
+	}
+}
+
+func TestRepositoryGetSubtasks(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := subtask.NewDBRepositorySubtask(db, *subtask.NewRepositorySubtaskConvertor())
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expected    []structures.SubtaskModel
+		expectedErr error
+	}{
+		{
+			name: "get subtasks in order",
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(1))
+				rows := sqlxmock.NewRows(subtaskColumns).
+					AddRow(utils.TestSubtaskId, utils.TestTodoId, utils.TestSubtaskTitle, true, utils.TestUsername, 0)
+				mock.ExpectQuery(`SELECT id, todo_id, title, done, assignee, position FROM subtask WHERE todo_id = \$1 ORDER BY position$`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(rows)
+			},
+			expected: []structures.SubtaskModel{{
+				Id:       utils.TestSubtaskId,
+				TodoId:   utils.TestTodoId,
+				Title:    utils.TestSubtaskTitle,
+				Done:     true,
+				Assignee: utils.TestUsername,
+			}},
+		}, {
+			name: "todo is not in the list",
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+			},
+			expectedErr: errors.New("error not found todo with id .+ in the list with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.GetSubtasks(ctx, utils.TestTodoId, utils.TestListId)
+			requireMatchingError(t, testCase.expectedErr, err)
+			require.Equal(t, testCase.expected, actual)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryCreateSubtask(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := subtask.NewDBRepositorySubtask(db, *subtask.NewRepositorySubtaskConvertor())
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+	first, outOfRange := 0, 3
+
+	testCases := []struct {
+		name             string
+		inputAssignee    string
+		inputPosition    *int
+		mock             func()
+		expectedPosition int
+		expectedErr      error
+	}{
+		{
+			name: "append subtask",
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM subtask WHERE todo_id = \$1`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectExec(`INSERT INTO subtask\(id, todo_id, title, done, assignee, position\) VALUES\(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+					WithArgs(sqlxmock.AnyArg(), utils.TestTodoId, utils.TestSubtaskTitle, false, "", 2).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedPosition: 2,
+		}, {
+			name:          "insert assigned subtask first",
+			inputAssignee: utils.TestUsername,
+			inputPosition: &first,
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT COUNT\(username\) FROM users_lists WHERE list_id = \$1 AND username = \$2`).
+					WithArgs(utils.TestListId, utils.TestUsername).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM subtask WHERE todo_id = \$1`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectExec(`UPDATE subtask SET position = position \+ \$1 WHERE todo_id = \$2 AND position BETWEEN \$3 AND \$4`).
+					WithArgs(1, utils.TestTodoId, 0, 1).
+					WillReturnResult(sqlxmock.NewResult(0, 2))
+				mock.ExpectExec(`INSERT INTO subtask`).
+					WithArgs(sqlxmock.AnyArg(), utils.TestTodoId, utils.TestSubtaskTitle, false, utils.TestUsername, 0).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name:          "assignee is not a member of the list",
+			inputAssignee: utils.TestUsername,
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT COUNT\(username\) FROM users_lists WHERE list_id = \$1 AND username = \$2`).
+					WithArgs(utils.TestListId, utils.TestUsername).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error .+ is not a member of list with id: .+"),
+		}, {
+			name:          "position out of range",
+			inputPosition: &outOfRange,
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM subtask WHERE todo_id = \$1`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error invalid position 3, expected a number from 0 to 2"),
+		}, {
+			name: "todo does not exist",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found todo with id .+ in the list with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			var created *structures.SubtaskModel
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				var err error
+				created, err = repo.CreateSubtask(ctx, structures.SubtaskEntity{
+					Id:       uuid.New(),
+					TodoId:   utils.TestTodoId,
+					Title:    utils.TestSubtaskTitle,
+					Assignee: testCase.inputAssignee,
+				}, utils.TestListId, testCase.inputPosition)
+				return err
+			})
+			requireMatchingError(t, testCase.expectedErr, err)
+			if testCase.expectedErr == nil {
+				require.Equal(t, testCase.expectedPosition, created.Position)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryUpdateSubtask(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := subtask.NewDBRepositorySubtask(db, *subtask.NewRepositorySubtaskConvertor())
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+	first := 0
+
+	testCases := []struct {
+		name          string
+		inputPosition *int
+		mock          func()
+		expectedErr   error
+	}{
+		{
+			name: "mark subtask as done",
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT id, todo_id, title, done, assignee, position FROM subtask WHERE id = \$1 AND todo_id = \$2`).
+					WithArgs(utils.TestSubtaskId, utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows(subtaskColumns).
+						AddRow(utils.TestSubtaskId, utils.TestTodoId, utils.TestSubtaskTitle, false, "", 2))
+				mock.ExpectExec(`UPDATE subtask SET title = \$1, done = \$2, assignee = \$3, position = \$4 WHERE id = \$5`).
+					WithArgs(utils.TestSubtaskTitle, true, "", 2, utils.TestSubtaskId).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name:          "move subtask up",
+			inputPosition: &first,
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT id, todo_id, title, done, assignee, position FROM subtask WHERE id = \$1 AND todo_id = \$2`).
+					WithArgs(utils.TestSubtaskId, utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows(subtaskColumns).
+						AddRow(utils.TestSubtaskId, utils.TestTodoId, utils.TestSubtaskTitle, false, "", 2))
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM subtask WHERE todo_id = \$1`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectExec(`UPDATE subtask SET position = position \+ \$1 WHERE todo_id = \$2 AND position BETWEEN \$3 AND \$4`).
+					WithArgs(1, utils.TestTodoId, 0, 1).
+					WillReturnResult(sqlxmock.NewResult(0, 2))
+				mock.ExpectExec(`UPDATE subtask SET title = \$1, done = \$2, assignee = \$3, position = \$4 WHERE id = \$5`).
+					WithArgs(utils.TestSubtaskTitle, true, "", 0, utils.TestSubtaskId).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "subtask does not exist",
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT id, todo_id, title, done, assignee, position FROM subtask WHERE id = \$1 AND todo_id = \$2`).
+					WithArgs(utils.TestSubtaskId, utils.TestTodoId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found subtask with id .+ in todo with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				_, err := repo.UpdateSubtask(ctx, structures.SubtaskEntity{
+					Id:     utils.TestSubtaskId,
+					TodoId: utils.TestTodoId,
+					Title:  utils.TestSubtaskTitle,
+					Done:   true,
+				}, utils.TestListId, testCase.inputPosition)
+				return err
+			})
+			requireMatchingError(t, testCase.expectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryDeleteSubtask(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := subtask.NewDBRepositorySubtask(db, *subtask.NewRepositorySubtaskConvertor())
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "delete subtask and close the gap",
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT id, todo_id, title, done, assignee, position FROM subtask WHERE id = \$1 AND todo_id = \$2`).
+					WithArgs(utils.TestSubtaskId, utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows(subtaskColumns).
+						AddRow(utils.TestSubtaskId, utils.TestTodoId, utils.TestSubtaskTitle, false, "", 0))
+				mock.ExpectExec(`DELETE FROM subtask WHERE id = \$1`).
+					WithArgs(utils.TestSubtaskId).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM subtask WHERE todo_id = \$1`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectExec(`UPDATE subtask SET position = position \+ \$1 WHERE todo_id = \$2 AND position BETWEEN \$3 AND \$4`).
+					WithArgs(-1, utils.TestTodoId, 1, 2).
+					WillReturnResult(sqlxmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "delete subtask fails deleting it from table",
+			mock: func() {
+				mock.ExpectBegin()
+				expectLockTodo(mock)
+				mock.ExpectQuery(`SELECT id, todo_id, title, done, assignee, position FROM subtask WHERE id = \$1 AND todo_id = \$2`).
+					WithArgs(utils.TestSubtaskId, utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows(subtaskColumns).
+						AddRow(utils.TestSubtaskId, utils.TestTodoId, utils.TestSubtaskTitle, false, "", 0))
+				mock.ExpectExec(`DELETE FROM subtask WHERE id = \$1`).
+					WithArgs(utils.TestSubtaskId).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error deleting subtask with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				_, err := repo.DeleteSubtask(ctx, utils.TestSubtaskId, utils.TestTodoId, utils.TestListId)
+				return err
+			})
+			requireMatchingError(t, testCase.expectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
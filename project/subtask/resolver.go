@@ -0,0 +1,246 @@
+package subtask
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/structures"
+	"project/utils"
+	"strings"
+)
+
+const (
+	listId    = "listId"
+	todoId    = "todoId"
+	subtaskId = "subtaskId"
+
+	maxTitleLength = 255
+)
+
+//go:generate mockery --name ServiceSubtask --output=automock --with-expecter=true
+type ServiceSubtask interface {
+	GetSubtasks(ctx context.Context, todoId, listId uuid.UUID) ([]structures.SubtaskOutput, error)
+	CreateSubtask(ctx context.Context, todoId, listId uuid.UUID, input structures.SubtaskInput) (*structures.SubtaskOutput, error)
+	UpdateSubtask(ctx context.Context, subtaskId, todoId, listId uuid.UUID, input structures.SubtaskInput) (*structures.SubtaskOutput, error)
+	DeleteSubtask(ctx context.Context, subtaskId, todoId, listId uuid.UUID) (*structures.SubtaskOutput, error)
+}
+
+type ResolverSubtask struct {
+	service ServiceSubtask
+}
+
+func NewResolverSubtask(service ServiceSubtask) *ResolverSubtask {
+	return &ResolverSubtask{
+		service: service,
+	}
+}
+
+func (r *ResolverSubtask) validateSubtask(input structures.SubtaskInput) bool {
+	return strings.TrimSpace(input.Title) != "" && len(input.Title) <= maxTitleLength
+}
+
+// subtaskErrorStatus maps the errors shared by the subtask writes to a response status.
+func subtaskErrorStatus(err error) int {
+	if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+		return http.StatusNotFound
+	} else if strings.Contains(err.Error(), utils.InvalidPositionErrorMsg) ||
+		strings.Contains(err.Error(), utils.NotMemberErrorMsg) ||
+		strings.Contains(err.Error(), utils.CreateErrorMsg) ||
+		strings.Contains(err.Error(), utils.UpdateErrorMsg) ||
+		strings.Contains(err.Error(), utils.DeletingErrorMsg) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
+
+func (r *ResolverSubtask) GetSubtasks(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	subtasks, err := r.service.GetSubtasks(ctx, *todoId, *listId)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to get subtasks of todo with id: %s", todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success getting subtasks of todo with id: %s", todoId))
+	utils.ResponseHandling(req, w, subtasks)
+}
+
+func (r *ResolverSubtask) CreateSubtask(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var input structures.SubtaskInput
+	err := json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := "error decoding body"
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+	if !r.validateSubtask(input) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := "missing or too long subtask title"
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	newSubtask, err := r.service.CreateSubtask(ctx, *todoId, *listId, input)
+	if err != nil {
+		msg := err.Error()
+		status := subtaskErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to create subtask in todo with id: %s", todoId)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	log.Info(fmt.Sprintf("success creating subtask with id: %s", newSubtask.Id))
+	utils.ResponseHandling(req, w, newSubtask)
+}
+
+func (r *ResolverSubtask) UpdateSubtask(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	subtaskId, err := utils.GetID(vars, subtaskId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	var input structures.SubtaskInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := fmt.Sprintf("error decoding subtask body with id: %s", subtaskId)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+	if !r.validateSubtask(input) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := "missing or too long subtask title"
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	updatedSubtask, err := r.service.UpdateSubtask(ctx, *subtaskId, *todoId, *listId, input)
+	if err != nil {
+		msg := err.Error()
+		status := subtaskErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to update subtask with id: %s", subtaskId)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success updating subtask with id: %s", subtaskId))
+	utils.ResponseHandling(req, w, updatedSubtask)
+}
+
+func (r *ResolverSubtask) DeleteSubtask(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	subtaskId, err := utils.GetID(vars, subtaskId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	deletedSubtask, err := r.service.DeleteSubtask(ctx, *subtaskId, *todoId, *listId)
+	if err != nil {
+		msg := err.Error()
+		status := subtaskErrorStatus(err)
+		if status == http.StatusInternalServerError {
+			msg = fmt.Sprintf("failed to delete subtask with id: %s", subtaskId)
+		}
+
+		w.WriteHeader(status)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success deleting subtask with id: %s", subtaskId))
+	utils.ResponseHandling(req, w, deletedSubtask)
+}
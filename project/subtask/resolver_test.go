@@ -0,0 +1,299 @@
+package subtask_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"project/structures"
+	"project/subtask"
+	mocks "project/subtask/automock"
+	"project/utils"
+	"strings"
+	"testing"
+)
+
+func helperSubtaskRequest(t *testing.T, method string, body []byte) *http.Request {
+	req, err := http.NewRequest(method, fmt.Sprintf("/todo/api/list/%s/todo/%s/subtasks", utils.TestListId, utils.TestTodoId),
+		bytes.NewReader(body))
+	require.NoError(t, err)
+	req = req.WithContext(utils.HelperGetContext())
+
+	return mux.SetURLVars(req, map[string]string{
+		"listId":    utils.TestListId.String(),
+		"todoId":    utils.TestTodoId.String(),
+		"subtaskId": utils.TestSubtaskId.String(),
+	})
+}
+
+func TestResolverGetSubtasks(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceSubtask
+		expected       string
+		expectedStatus int
+	}{
+		{
+			name: "get subtasks",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().GetSubtasks(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return([]structures.SubtaskOutput{{
+						Id:     utils.TestSubtaskId,
+						TodoId: utils.TestTodoId,
+						Title:  utils.TestSubtaskTitle,
+					}}, nil).
+					Once()
+				return service
+			},
+			expected:       utils.TestSubtaskTitle,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "get subtasks of not existing todo",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().GetSubtasks(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(nil, errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", utils.TestTodoId, utils.TestListId))).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "error getting subtasks",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().GetSubtasks(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(nil, errors.New("connection refused")).
+					Once()
+				return service
+			},
+			expected:       "failed to get subtasks",
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := subtask.NewResolverSubtask(testCase.service())
+			rr := httptest.NewRecorder()
+
+			resolver.GetSubtasks(rr, helperSubtaskRequest(t, http.MethodGet, nil))
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			require.True(t, strings.Contains(rr.Body.String(), testCase.expected))
+		})
+	}
+}
+
+func TestResolverCreateSubtask(t *testing.T) {
+	position := 0
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceSubtask
+		inputSubtask   []byte
+		expectedStatus int
+	}{
+		{
+			name: "create subtask",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().CreateSubtask(mock.Anything, utils.TestTodoId, utils.TestListId, structures.SubtaskInput{
+					Title:    utils.TestSubtaskTitle,
+					Assignee: utils.TestUsername,
+					Position: &position,
+				}).
+					Return(&structures.SubtaskOutput{
+						Id:       utils.TestSubtaskId,
+						TodoId:   utils.TestTodoId,
+						Title:    utils.TestSubtaskTitle,
+						Assignee: utils.TestUsername,
+					}, nil).
+					Once()
+				return service
+			},
+			inputSubtask:   []byte(fmt.Sprintf(`{"title": "%s", "assignee": "%s", "position": 0}`, utils.TestSubtaskTitle, utils.TestUsername)),
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "create subtask without title",
+			service: func() *mocks.ServiceSubtask {
+				return nil
+			},
+			inputSubtask:   []byte(`{"title": " ", "done": true}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "create subtask with invalid body",
+			service: func() *mocks.ServiceSubtask {
+				return nil
+			},
+			inputSubtask:   []byte(`{"title": `),
+			expectedStatus: http.StatusInternalServerError,
+		}, {
+			name: "create subtask at invalid position",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().CreateSubtask(mock.Anything, utils.TestTodoId, utils.TestListId, mock.Anything).
+					Return(nil, errors.New(fmt.Sprintf("%s 5, expected a number from 0 to 0", utils.InvalidPositionErrorMsg))).
+					Once()
+				return service
+			},
+			inputSubtask:   []byte(fmt.Sprintf(`{"title": "%s", "position": 5}`, utils.TestSubtaskTitle)),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "create subtask assigned to user outside the list",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().CreateSubtask(mock.Anything, utils.TestTodoId, utils.TestListId, mock.Anything).
+					Return(nil, errors.New(fmt.Sprintf("error %s %s with id: %s", utils.TestUsername, utils.NotMemberErrorMsg, utils.TestListId))).
+					Once()
+				return service
+			},
+			inputSubtask:   []byte(fmt.Sprintf(`{"title": "%s", "assignee": "%s"}`, utils.TestSubtaskTitle, utils.TestUsername)),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "create subtask in not existing todo",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().CreateSubtask(mock.Anything, utils.TestTodoId, utils.TestListId, mock.Anything).
+					Return(nil, errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", utils.TestTodoId, utils.TestListId))).
+					Once()
+				return service
+			},
+			inputSubtask:   []byte(fmt.Sprintf(`{"title": "%s"}`, utils.TestSubtaskTitle)),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := subtask.NewResolverSubtask(testCase.service())
+			rr := httptest.NewRecorder()
+
+			resolver.CreateSubtask(rr, helperSubtaskRequest(t, http.MethodPost, testCase.inputSubtask))
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverUpdateSubtask(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceSubtask
+		inputSubtask   []byte
+		expectedStatus int
+	}{
+		{
+			name: "update subtask",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().UpdateSubtask(mock.Anything, utils.TestSubtaskId, utils.TestTodoId, utils.TestListId,
+					structures.SubtaskInput{Title: utils.TestSubtaskTitle, Done: true}).
+					Return(&structures.SubtaskOutput{
+						Id:     utils.TestSubtaskId,
+						TodoId: utils.TestTodoId,
+						Title:  utils.TestSubtaskTitle,
+						Done:   true,
+					}, nil).
+					Once()
+				return service
+			},
+			inputSubtask:   []byte(fmt.Sprintf(`{"title": "%s", "done": true}`, utils.TestSubtaskTitle)),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "update subtask with too long title",
+			service: func() *mocks.ServiceSubtask {
+				return nil
+			},
+			inputSubtask:   []byte(fmt.Sprintf(`{"title": "%s"}`, strings.Repeat("a", 256))),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "update not existing subtask",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().UpdateSubtask(mock.Anything, utils.TestSubtaskId, utils.TestTodoId, utils.TestListId, mock.Anything).
+					Return(nil, errors.New(fmt.Sprintf("error not found subtask with id %s in todo with id: %s", utils.TestSubtaskId, utils.TestTodoId))).
+					Once()
+				return service
+			},
+			inputSubtask:   []byte(fmt.Sprintf(`{"title": "%s"}`, utils.TestSubtaskTitle)),
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "error updating subtask",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().UpdateSubtask(mock.Anything, utils.TestSubtaskId, utils.TestTodoId, utils.TestListId, mock.Anything).
+					Return(nil, errors.New("connection refused")).
+					Once()
+				return service
+			},
+			inputSubtask:   []byte(fmt.Sprintf(`{"title": "%s"}`, utils.TestSubtaskTitle)),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := subtask.NewResolverSubtask(testCase.service())
+			rr := httptest.NewRecorder()
+
+			resolver.UpdateSubtask(rr, helperSubtaskRequest(t, http.MethodPut, testCase.inputSubtask))
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverDeleteSubtask(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceSubtask
+		expectedStatus int
+	}{
+		{
+			name: "delete subtask",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().DeleteSubtask(mock.Anything, utils.TestSubtaskId, utils.TestTodoId, utils.TestListId).
+					Return(&structures.SubtaskOutput{Id: utils.TestSubtaskId, TodoId: utils.TestTodoId}, nil).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "delete not existing subtask",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().DeleteSubtask(mock.Anything, utils.TestSubtaskId, utils.TestTodoId, utils.TestListId).
+					Return(nil, errors.New(fmt.Sprintf("error not found subtask with id %s in todo with id: %s", utils.TestSubtaskId, utils.TestTodoId))).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "delete subtask but not from table",
+			service: func() *mocks.ServiceSubtask {
+				service := &mocks.ServiceSubtask{}
+				service.EXPECT().DeleteSubtask(mock.Anything, utils.TestSubtaskId, utils.TestTodoId, utils.TestListId).
+					Return(nil, errors.New(fmt.Sprintf("error deleting subtask with id: %s", utils.TestSubtaskId))).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := subtask.NewResolverSubtask(testCase.service())
+			rr := httptest.NewRecorder()
+
+			resolver.DeleteSubtask(rr, helperSubtaskRequest(t, http.MethodDelete, nil))
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package subtask
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"project/structures"
+	"project/uow"
+)
+
+//go:generate mockery --name RepositorySubtask --output=automock --with-expecter=true
+type RepositorySubtask interface {
+	GetSubtasks(ctx context.Context, todoId, listId uuid.UUID) ([]structures.SubtaskModel, error)
+	CreateSubtask(ctx context.Context, newSubtask structures.SubtaskEntity, listId uuid.UUID, position *int) (*structures.SubtaskModel, error)
+	UpdateSubtask(ctx context.Context, updatedSubtask structures.SubtaskEntity, listId uuid.UUID, position *int) (*structures.SubtaskModel, error)
+	DeleteSubtask(ctx context.Context, subtaskId, todoId, listId uuid.UUID) (*structures.SubtaskModel, error)
+}
+
+type ServiceSubtaskImpl struct {
+	repo       RepositorySubtask
+	convertor  ServiceSubtaskConvertor
+	unitOfWork uow.UnitOfWork
+}
+
+func NewServiceSubtask(repo RepositorySubtask, convertor ServiceSubtaskConvertor, unitOfWork uow.UnitOfWork) *ServiceSubtaskImpl {
+	return &ServiceSubtaskImpl{repo: repo, convertor: convertor, unitOfWork: unitOfWork}
+}
+
+func (s *ServiceSubtaskImpl) GetSubtasks(ctx context.Context, todoId, listId uuid.UUID) ([]structures.SubtaskOutput, error) {
+	subtaskModels, err := s.repo.GetSubtasks(ctx, todoId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]structures.SubtaskOutput, len(subtaskModels))
+	for i, model := range subtaskModels {
+		result[i] = *s.convertor.ConvertSubtaskModelToOutput(&model)
+	}
+
+	return result, nil
+}
+
+func (s *ServiceSubtaskImpl) CreateSubtask(ctx context.Context, todoId, listId uuid.UUID, input structures.SubtaskInput) (*structures.SubtaskOutput, error) {
+	subtaskModel := structures.SubtaskModel{
+		Id:       uuid.New(),
+		TodoId:   todoId,
+		Title:    input.Title,
+		Done:     input.Done,
+		Assignee: input.Assignee,
+	}
+
+	var createdSubtask *structures.SubtaskModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		createdSubtask, err = s.repo.CreateSubtask(ctx, *s.convertor.ConvertSubtaskModelToEntity(&subtaskModel), listId, input.Position)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertor.ConvertSubtaskModelToOutput(createdSubtask), nil
+}
+
+func (s *ServiceSubtaskImpl) UpdateSubtask(ctx context.Context, subtaskId, todoId, listId uuid.UUID, input structures.SubtaskInput) (*structures.SubtaskOutput, error) {
+	subtaskModel := structures.SubtaskModel{
+		Id:       subtaskId,
+		TodoId:   todoId,
+		Title:    input.Title,
+		Done:     input.Done,
+		Assignee: input.Assignee,
+	}
+
+	var updatedSubtask *structures.SubtaskModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updatedSubtask, err = s.repo.UpdateSubtask(ctx, *s.convertor.ConvertSubtaskModelToEntity(&subtaskModel), listId, input.Position)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertor.ConvertSubtaskModelToOutput(updatedSubtask), nil
+}
+
+func (s *ServiceSubtaskImpl) DeleteSubtask(ctx context.Context, subtaskId, todoId, listId uuid.UUID) (*structures.SubtaskOutput, error) {
+	var deletedSubtask *structures.SubtaskModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deletedSubtask, err = s.repo.DeleteSubtask(ctx, subtaskId, todoId, listId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertor.ConvertSubtaskModelToOutput(deletedSubtask), nil
+}
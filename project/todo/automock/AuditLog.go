@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditLog is an autogenerated mock type for the AuditLog type
+type AuditLog struct {
+	mock.Mock
+}
+
+type AuditLog_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditLog) EXPECT() *AuditLog_Expecter {
+	return &AuditLog_Expecter{mock: &_m.Mock}
+}
+
+// AddEntry provides a mock function with given fields: ctx, entry
+func (_m *AuditLog) AddEntry(ctx context.Context, entry structures.AuditEntity) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.AuditEntity) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditLog_AddEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEntry'
+type AuditLog_AddEntry_Call struct {
+	*mock.Call
+}
+
+// AddEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry structures.AuditEntity
+func (_e *AuditLog_Expecter) AddEntry(ctx interface{}, entry interface{}) *AuditLog_AddEntry_Call {
+	return &AuditLog_AddEntry_Call{Call: _e.mock.On("AddEntry", ctx, entry)}
+}
+
+func (_c *AuditLog_AddEntry_Call) Run(run func(ctx context.Context, entry structures.AuditEntity)) *AuditLog_AddEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.AuditEntity))
+	})
+	return _c
+}
+
+func (_c *AuditLog_AddEntry_Call) Return(_a0 error) *AuditLog_AddEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditLog_AddEntry_Call) RunAndReturn(run func(context.Context, structures.AuditEntity) error) *AuditLog_AddEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditLog creates a new instance of AuditLog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditLog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditLog {
+	mock := &AuditLog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
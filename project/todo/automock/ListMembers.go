@@ -0,0 +1,134 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ListMembers is an autogenerated mock type for the ListMembers type
+type ListMembers struct {
+	mock.Mock
+}
+
+type ListMembers_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListMembers) EXPECT() *ListMembers_Expecter {
+	return &ListMembers_Expecter{mock: &_m.Mock}
+}
+
+// ContainUserInList provides a mock function with given fields: ctx, listId, username
+func (_m *ListMembers) ContainUserInList(ctx context.Context, listId uuid.UUID, username string) bool {
+	ret := _m.Called(ctx, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContainUserInList")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) bool); ok {
+		r0 = rf(ctx, listId, username)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ListMembers_ContainUserInList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContainUserInList'
+type ListMembers_ContainUserInList_Call struct {
+	*mock.Call
+}
+
+// ContainUserInList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - username string
+func (_e *ListMembers_Expecter) ContainUserInList(ctx interface{}, listId interface{}, username interface{}) *ListMembers_ContainUserInList_Call {
+	return &ListMembers_ContainUserInList_Call{Call: _e.mock.On("ContainUserInList", ctx, listId, username)}
+}
+
+func (_c *ListMembers_ContainUserInList_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ListMembers_ContainUserInList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ListMembers_ContainUserInList_Call) Return(_a0 bool) *ListMembers_ContainUserInList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListMembers_ContainUserInList_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) bool) *ListMembers_ContainUserInList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserListRole provides a mock function with given fields: ctx, listId, username
+func (_m *ListMembers) GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string {
+	ret := _m.Called(ctx, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserListRole")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
+		r0 = rf(ctx, listId, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ListMembers_GetUserListRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserListRole'
+type ListMembers_GetUserListRole_Call struct {
+	*mock.Call
+}
+
+// GetUserListRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - username string
+func (_e *ListMembers_Expecter) GetUserListRole(ctx interface{}, listId interface{}, username interface{}) *ListMembers_GetUserListRole_Call {
+	return &ListMembers_GetUserListRole_Call{Call: _e.mock.On("GetUserListRole", ctx, listId, username)}
+}
+
+func (_c *ListMembers_GetUserListRole_Call) Run(run func(ctx context.Context, listId uuid.UUID, username string)) *ListMembers_GetUserListRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ListMembers_GetUserListRole_Call) Return(_a0 string) *ListMembers_GetUserListRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListMembers_GetUserListRole_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) string) *ListMembers_GetUserListRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListMembers creates a new instance of ListMembers. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListMembers(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListMembers {
+	mock := &ListMembers{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.51.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -24,6 +24,105 @@ func (_m *RepositoryTodo) EXPECT() *RepositoryTodo_Expecter {
 	return &RepositoryTodo_Expecter{mock: &_m.Mock}
 }
 
+// AddTodoDependency provides a mock function with given fields: ctx, todoId, listId, blockerId, blockerListId
+func (_m *RepositoryTodo) AddTodoDependency(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, blockerId uuid.UUID, blockerListId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId, blockerId, blockerListId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTodoDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId, blockerId, blockerListId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_AddTodoDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTodoDependency'
+type RepositoryTodo_AddTodoDependency_Call struct {
+	*mock.Call
+}
+
+// AddTodoDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - blockerId uuid.UUID
+//   - blockerListId uuid.UUID
+func (_e *RepositoryTodo_Expecter) AddTodoDependency(ctx interface{}, todoId interface{}, listId interface{}, blockerId interface{}, blockerListId interface{}) *RepositoryTodo_AddTodoDependency_Call {
+	return &RepositoryTodo_AddTodoDependency_Call{Call: _e.mock.On("AddTodoDependency", ctx, todoId, listId, blockerId, blockerListId)}
+}
+
+func (_c *RepositoryTodo_AddTodoDependency_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, blockerId uuid.UUID, blockerListId uuid.UUID)) *RepositoryTodo_AddTodoDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_AddTodoDependency_Call) Return(_a0 error) *RepositoryTodo_AddTodoDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_AddTodoDependency_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error) *RepositoryTodo_AddTodoDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTodoWatcher provides a mock function with given fields: ctx, todoId, listId, username
+func (_m *RepositoryTodo) AddTodoWatcher(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, todoId, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTodoWatcher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_AddTodoWatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTodoWatcher'
+type RepositoryTodo_AddTodoWatcher_Call struct {
+	*mock.Call
+}
+
+// AddTodoWatcher is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+func (_e *RepositoryTodo_Expecter) AddTodoWatcher(ctx interface{}, todoId interface{}, listId interface{}, username interface{}) *RepositoryTodo_AddTodoWatcher_Call {
+	return &RepositoryTodo_AddTodoWatcher_Call{Call: _e.mock.On("AddTodoWatcher", ctx, todoId, listId, username)}
+}
+
+func (_c *RepositoryTodo_AddTodoWatcher_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string)) *RepositoryTodo_AddTodoWatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_AddTodoWatcher_Call) Return(_a0 error) *RepositoryTodo_AddTodoWatcher_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_AddTodoWatcher_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *RepositoryTodo_AddTodoWatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AssignTodoToUser provides a mock function with given fields: ctx, todoId, listId, username
 func (_m *RepositoryTodo) AssignTodoToUser(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
 	ret := _m.Called(ctx, todoId, listId, username)
@@ -73,17 +172,66 @@ func (_c *RepositoryTodo_AssignTodoToUser_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// ChangeTodoStatus provides a mock function with given fields: ctx, todoId, listId
-func (_m *RepositoryTodo) ChangeTodoStatus(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
-	ret := _m.Called(ctx, todoId, listId)
+// AttachLabel provides a mock function with given fields: ctx, todoId, listId, labelId
+func (_m *RepositoryTodo) AttachLabel(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, labelId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId, labelId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId, labelId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_AttachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachLabel'
+type RepositoryTodo_AttachLabel_Call struct {
+	*mock.Call
+}
+
+// AttachLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - labelId uuid.UUID
+func (_e *RepositoryTodo_Expecter) AttachLabel(ctx interface{}, todoId interface{}, listId interface{}, labelId interface{}) *RepositoryTodo_AttachLabel_Call {
+	return &RepositoryTodo_AttachLabel_Call{Call: _e.mock.On("AttachLabel", ctx, todoId, listId, labelId)}
+}
+
+func (_c *RepositoryTodo_AttachLabel_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, labelId uuid.UUID)) *RepositoryTodo_AttachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_AttachLabel_Call) Return(_a0 error) *RepositoryTodo_AttachLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_AttachLabel_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *RepositoryTodo_AttachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeTodoStatus provides a mock function with given fields: ctx, todoId, listId, status
+func (_m *RepositoryTodo) ChangeTodoStatus(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, status string) error {
+	ret := _m.Called(ctx, todoId, listId, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ChangeTodoStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, todoId, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, status)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -100,13 +248,14 @@ type RepositoryTodo_ChangeTodoStatus_Call struct {
 //   - ctx context.Context
 //   - todoId uuid.UUID
 //   - listId uuid.UUID
-func (_e *RepositoryTodo_Expecter) ChangeTodoStatus(ctx interface{}, todoId interface{}, listId interface{}) *RepositoryTodo_ChangeTodoStatus_Call {
-	return &RepositoryTodo_ChangeTodoStatus_Call{Call: _e.mock.On("ChangeTodoStatus", ctx, todoId, listId)}
+//   - status string
+func (_e *RepositoryTodo_Expecter) ChangeTodoStatus(ctx interface{}, todoId interface{}, listId interface{}, status interface{}) *RepositoryTodo_ChangeTodoStatus_Call {
+	return &RepositoryTodo_ChangeTodoStatus_Call{Call: _e.mock.On("ChangeTodoStatus", ctx, todoId, listId, status)}
 }
 
-func (_c *RepositoryTodo_ChangeTodoStatus_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *RepositoryTodo_ChangeTodoStatus_Call {
+func (_c *RepositoryTodo_ChangeTodoStatus_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, status string)) *RepositoryTodo_ChangeTodoStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
 	})
 	return _c
 }
@@ -116,7 +265,7 @@ func (_c *RepositoryTodo_ChangeTodoStatus_Call) Return(_a0 error) *RepositoryTod
 	return _c
 }
 
-func (_c *RepositoryTodo_ChangeTodoStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *RepositoryTodo_ChangeTodoStatus_Call {
+func (_c *RepositoryTodo_ChangeTodoStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *RepositoryTodo_ChangeTodoStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -169,6 +318,115 @@ func (_c *RepositoryTodo_CheckIfListContainsTodo_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ContainsTodoName provides a mock function with given fields: ctx, listId, name
+func (_m *RepositoryTodo) ContainsTodoName(ctx context.Context, listId uuid.UUID, name string) (bool, error) {
+	ret := _m.Called(ctx, listId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContainsTodoName")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (bool, error)); ok {
+		return rf(ctx, listId, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) bool); ok {
+		r0 = rf(ctx, listId, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, listId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryTodo_ContainsTodoName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContainsTodoName'
+type RepositoryTodo_ContainsTodoName_Call struct {
+	*mock.Call
+}
+
+// ContainsTodoName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - name string
+func (_e *RepositoryTodo_Expecter) ContainsTodoName(ctx interface{}, listId interface{}, name interface{}) *RepositoryTodo_ContainsTodoName_Call {
+	return &RepositoryTodo_ContainsTodoName_Call{Call: _e.mock.On("ContainsTodoName", ctx, listId, name)}
+}
+
+func (_c *RepositoryTodo_ContainsTodoName_Call) Run(run func(ctx context.Context, listId uuid.UUID, name string)) *RepositoryTodo_ContainsTodoName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_ContainsTodoName_Call) Return(_a0 bool, _a1 error) *RepositoryTodo_ContainsTodoName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryTodo_ContainsTodoName_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (bool, error)) *RepositoryTodo_ContainsTodoName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyTodo provides a mock function with given fields: ctx, todoId, listId, copyId, targetListId, name
+func (_m *RepositoryTodo) CopyTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, copyId uuid.UUID, targetListId uuid.UUID, name string) error {
+	ret := _m.Called(ctx, todoId, listId, copyId, targetListId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, copyId, targetListId, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_CopyTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyTodo'
+type RepositoryTodo_CopyTodo_Call struct {
+	*mock.Call
+}
+
+// CopyTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - copyId uuid.UUID
+//   - targetListId uuid.UUID
+//   - name string
+func (_e *RepositoryTodo_Expecter) CopyTodo(ctx interface{}, todoId interface{}, listId interface{}, copyId interface{}, targetListId interface{}, name interface{}) *RepositoryTodo_CopyTodo_Call {
+	return &RepositoryTodo_CopyTodo_Call{Call: _e.mock.On("CopyTodo", ctx, todoId, listId, copyId, targetListId, name)}
+}
+
+func (_c *RepositoryTodo_CopyTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, copyId uuid.UUID, targetListId uuid.UUID, name string)) *RepositoryTodo_CopyTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_CopyTodo_Call) Return(_a0 error) *RepositoryTodo_CopyTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_CopyTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, string) error) *RepositoryTodo_CopyTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTodo provides a mock function with given fields: ctx, newTask
 func (_m *RepositoryTodo) CreateTodo(ctx context.Context, newTask structures.TodoEntity) error {
 	ret := _m.Called(ctx, newTask)
@@ -276,24 +534,83 @@ func (_c *RepositoryTodo_DeleteTodo_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// GetAllTasks provides a mock function with given fields: ctx, listId
-func (_m *RepositoryTodo) GetAllTasks(ctx context.Context, listId uuid.UUID) []structures.TodoModel {
-	ret := _m.Called(ctx, listId)
+// DetachLabel provides a mock function with given fields: ctx, todoId, listId, labelId
+func (_m *RepositoryTodo) DetachLabel(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, labelId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId, labelId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId, labelId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_DetachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachLabel'
+type RepositoryTodo_DetachLabel_Call struct {
+	*mock.Call
+}
+
+// DetachLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - labelId uuid.UUID
+func (_e *RepositoryTodo_Expecter) DetachLabel(ctx interface{}, todoId interface{}, listId interface{}, labelId interface{}) *RepositoryTodo_DetachLabel_Call {
+	return &RepositoryTodo_DetachLabel_Call{Call: _e.mock.On("DetachLabel", ctx, todoId, listId, labelId)}
+}
+
+func (_c *RepositoryTodo_DetachLabel_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, labelId uuid.UUID)) *RepositoryTodo_DetachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_DetachLabel_Call) Return(_a0 error) *RepositoryTodo_DetachLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_DetachLabel_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *RepositoryTodo_DetachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllTasks provides a mock function with given fields: ctx, listId, query
+func (_m *RepositoryTodo) GetAllTasks(ctx context.Context, listId uuid.UUID, query structures.TodoQuery) (*structures.TodoPageModel, error) {
+	ret := _m.Called(ctx, listId, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllTasks")
 	}
 
-	var r0 []structures.TodoModel
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []structures.TodoModel); ok {
-		r0 = rf(ctx, listId)
+	var r0 *structures.TodoPageModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TodoQuery) (*structures.TodoPageModel, error)); ok {
+		return rf(ctx, listId, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TodoQuery) *structures.TodoPageModel); ok {
+		r0 = rf(ctx, listId, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]structures.TodoModel)
+			r0 = ret.Get(0).(*structures.TodoPageModel)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.TodoQuery) error); ok {
+		r1 = rf(ctx, listId, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // RepositoryTodo_GetAllTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllTasks'
@@ -304,23 +621,24 @@ type RepositoryTodo_GetAllTasks_Call struct {
 // GetAllTasks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-func (_e *RepositoryTodo_Expecter) GetAllTasks(ctx interface{}, listId interface{}) *RepositoryTodo_GetAllTasks_Call {
-	return &RepositoryTodo_GetAllTasks_Call{Call: _e.mock.On("GetAllTasks", ctx, listId)}
+//   - query structures.TodoQuery
+func (_e *RepositoryTodo_Expecter) GetAllTasks(ctx interface{}, listId interface{}, query interface{}) *RepositoryTodo_GetAllTasks_Call {
+	return &RepositoryTodo_GetAllTasks_Call{Call: _e.mock.On("GetAllTasks", ctx, listId, query)}
 }
 
-func (_c *RepositoryTodo_GetAllTasks_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *RepositoryTodo_GetAllTasks_Call {
+func (_c *RepositoryTodo_GetAllTasks_Call) Run(run func(ctx context.Context, listId uuid.UUID, query structures.TodoQuery)) *RepositoryTodo_GetAllTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.TodoQuery))
 	})
 	return _c
 }
 
-func (_c *RepositoryTodo_GetAllTasks_Call) Return(_a0 []structures.TodoModel) *RepositoryTodo_GetAllTasks_Call {
-	_c.Call.Return(_a0)
+func (_c *RepositoryTodo_GetAllTasks_Call) Return(_a0 *structures.TodoPageModel, _a1 error) *RepositoryTodo_GetAllTasks_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RepositoryTodo_GetAllTasks_Call) RunAndReturn(run func(context.Context, uuid.UUID) []structures.TodoModel) *RepositoryTodo_GetAllTasks_Call {
+func (_c *RepositoryTodo_GetAllTasks_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.TodoQuery) (*structures.TodoPageModel, error)) *RepositoryTodo_GetAllTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -385,49 +703,645 @@ func (_c *RepositoryTodo_GetTodo_Call) RunAndReturn(run func(context.Context, uu
 	return _c
 }
 
-// GetTodoAssignee provides a mock function with given fields: ctx, todoId
-func (_m *RepositoryTodo) GetTodoAssignee(ctx context.Context, todoId uuid.UUID) string {
+// GetTodoAssignees provides a mock function with given fields: ctx, todoId
+func (_m *RepositoryTodo) GetTodoAssignees(ctx context.Context, todoId uuid.UUID) []string {
 	ret := _m.Called(ctx, todoId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTodoAssignee")
+		panic("no return value specified for GetTodoAssignees")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
 		r0 = rf(ctx, todoId)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
 
 	return r0
 }
 
-// RepositoryTodo_GetTodoAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoAssignee'
-type RepositoryTodo_GetTodoAssignee_Call struct {
+// RepositoryTodo_GetTodoAssignees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoAssignees'
+type RepositoryTodo_GetTodoAssignees_Call struct {
 	*mock.Call
 }
 
-// GetTodoAssignee is a helper method to define mock.On call
+// GetTodoAssignees is a helper method to define mock.On call
 //   - ctx context.Context
 //   - todoId uuid.UUID
-func (_e *RepositoryTodo_Expecter) GetTodoAssignee(ctx interface{}, todoId interface{}) *RepositoryTodo_GetTodoAssignee_Call {
-	return &RepositoryTodo_GetTodoAssignee_Call{Call: _e.mock.On("GetTodoAssignee", ctx, todoId)}
+func (_e *RepositoryTodo_Expecter) GetTodoAssignees(ctx interface{}, todoId interface{}) *RepositoryTodo_GetTodoAssignees_Call {
+	return &RepositoryTodo_GetTodoAssignees_Call{Call: _e.mock.On("GetTodoAssignees", ctx, todoId)}
 }
 
-func (_c *RepositoryTodo_GetTodoAssignee_Call) Run(run func(ctx context.Context, todoId uuid.UUID)) *RepositoryTodo_GetTodoAssignee_Call {
+func (_c *RepositoryTodo_GetTodoAssignees_Call) Run(run func(ctx context.Context, todoId uuid.UUID)) *RepositoryTodo_GetTodoAssignees_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *RepositoryTodo_GetTodoAssignee_Call) Return(_a0 string) *RepositoryTodo_GetTodoAssignee_Call {
+func (_c *RepositoryTodo_GetTodoAssignees_Call) Return(_a0 []string) *RepositoryTodo_GetTodoAssignees_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_GetTodoAssignees_Call) RunAndReturn(run func(context.Context, uuid.UUID) []string) *RepositoryTodo_GetTodoAssignees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserListIds provides a mock function with given fields: ctx, username
+func (_m *RepositoryTodo) GetUserListIds(ctx context.Context, username string) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserListIds")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]uuid.UUID, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []uuid.UUID); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryTodo_GetUserListIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserListIds'
+type RepositoryTodo_GetUserListIds_Call struct {
+	*mock.Call
+}
+
+// GetUserListIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *RepositoryTodo_Expecter) GetUserListIds(ctx interface{}, username interface{}) *RepositoryTodo_GetUserListIds_Call {
+	return &RepositoryTodo_GetUserListIds_Call{Call: _e.mock.On("GetUserListIds", ctx, username)}
+}
+
+func (_c *RepositoryTodo_GetUserListIds_Call) Run(run func(ctx context.Context, username string)) *RepositoryTodo_GetUserListIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_GetUserListIds_Call) Return(_a0 []uuid.UUID, _a1 error) *RepositoryTodo_GetUserListIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryTodo_GetUserListIds_Call) RunAndReturn(run func(context.Context, string) ([]uuid.UUID, error)) *RepositoryTodo_GetUserListIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTodo provides a mock function with given fields: ctx, todoId, listId, targetListId, name
+func (_m *RepositoryTodo) MoveTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, targetListId uuid.UUID, name string) error {
+	ret := _m.Called(ctx, todoId, listId, targetListId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, targetListId, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_MoveTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodo'
+type RepositoryTodo_MoveTodo_Call struct {
+	*mock.Call
+}
+
+// MoveTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - targetListId uuid.UUID
+//   - name string
+func (_e *RepositoryTodo_Expecter) MoveTodo(ctx interface{}, todoId interface{}, listId interface{}, targetListId interface{}, name interface{}) *RepositoryTodo_MoveTodo_Call {
+	return &RepositoryTodo_MoveTodo_Call{Call: _e.mock.On("MoveTodo", ctx, todoId, listId, targetListId, name)}
+}
+
+func (_c *RepositoryTodo_MoveTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, targetListId uuid.UUID, name string)) *RepositoryTodo_MoveTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_MoveTodo_Call) Return(_a0 error) *RepositoryTodo_MoveTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_MoveTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error) *RepositoryTodo_MoveTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignTodo provides a mock function with given fields: ctx, todoId, listId, username
+func (_m *RepositoryTodo) ReassignTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, todoId, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_ReassignTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignTodo'
+type RepositoryTodo_ReassignTodo_Call struct {
+	*mock.Call
+}
+
+// ReassignTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+func (_e *RepositoryTodo_Expecter) ReassignTodo(ctx interface{}, todoId interface{}, listId interface{}, username interface{}) *RepositoryTodo_ReassignTodo_Call {
+	return &RepositoryTodo_ReassignTodo_Call{Call: _e.mock.On("ReassignTodo", ctx, todoId, listId, username)}
+}
+
+func (_c *RepositoryTodo_ReassignTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string)) *RepositoryTodo_ReassignTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_ReassignTodo_Call) Return(_a0 error) *RepositoryTodo_ReassignTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_ReassignTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *RepositoryTodo_ReassignTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTodoAssignee provides a mock function with given fields: ctx, todoId, listId, username
+func (_m *RepositoryTodo) RemoveTodoAssignee(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, todoId, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTodoAssignee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_RemoveTodoAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTodoAssignee'
+type RepositoryTodo_RemoveTodoAssignee_Call struct {
+	*mock.Call
+}
+
+// RemoveTodoAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+func (_e *RepositoryTodo_Expecter) RemoveTodoAssignee(ctx interface{}, todoId interface{}, listId interface{}, username interface{}) *RepositoryTodo_RemoveTodoAssignee_Call {
+	return &RepositoryTodo_RemoveTodoAssignee_Call{Call: _e.mock.On("RemoveTodoAssignee", ctx, todoId, listId, username)}
+}
+
+func (_c *RepositoryTodo_RemoveTodoAssignee_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string)) *RepositoryTodo_RemoveTodoAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_RemoveTodoAssignee_Call) Return(_a0 error) *RepositoryTodo_RemoveTodoAssignee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_RemoveTodoAssignee_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *RepositoryTodo_RemoveTodoAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTodoDependency provides a mock function with given fields: ctx, todoId, listId, blockerId
+func (_m *RepositoryTodo) RemoveTodoDependency(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, blockerId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId, blockerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTodoDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId, blockerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_RemoveTodoDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTodoDependency'
+type RepositoryTodo_RemoveTodoDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveTodoDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - blockerId uuid.UUID
+func (_e *RepositoryTodo_Expecter) RemoveTodoDependency(ctx interface{}, todoId interface{}, listId interface{}, blockerId interface{}) *RepositoryTodo_RemoveTodoDependency_Call {
+	return &RepositoryTodo_RemoveTodoDependency_Call{Call: _e.mock.On("RemoveTodoDependency", ctx, todoId, listId, blockerId)}
+}
+
+func (_c *RepositoryTodo_RemoveTodoDependency_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, blockerId uuid.UUID)) *RepositoryTodo_RemoveTodoDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_RemoveTodoDependency_Call) Return(_a0 error) *RepositoryTodo_RemoveTodoDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_RemoveTodoDependency_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *RepositoryTodo_RemoveTodoDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTodoWatcher provides a mock function with given fields: ctx, todoId, listId, username
+func (_m *RepositoryTodo) RemoveTodoWatcher(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, todoId, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTodoWatcher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_RemoveTodoWatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTodoWatcher'
+type RepositoryTodo_RemoveTodoWatcher_Call struct {
+	*mock.Call
+}
+
+// RemoveTodoWatcher is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+func (_e *RepositoryTodo_Expecter) RemoveTodoWatcher(ctx interface{}, todoId interface{}, listId interface{}, username interface{}) *RepositoryTodo_RemoveTodoWatcher_Call {
+	return &RepositoryTodo_RemoveTodoWatcher_Call{Call: _e.mock.On("RemoveTodoWatcher", ctx, todoId, listId, username)}
+}
+
+func (_c *RepositoryTodo_RemoveTodoWatcher_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string)) *RepositoryTodo_RemoveTodoWatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_RemoveTodoWatcher_Call) Return(_a0 error) *RepositoryTodo_RemoveTodoWatcher_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_RemoveTodoWatcher_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *RepositoryTodo_RemoveTodoWatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenTodo provides a mock function with given fields: ctx, todoId, listId
+func (_m *RepositoryTodo) ReopenTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_ReopenTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenTodo'
+type RepositoryTodo_ReopenTodo_Call struct {
+	*mock.Call
+}
+
+// ReopenTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositoryTodo_Expecter) ReopenTodo(ctx interface{}, todoId interface{}, listId interface{}) *RepositoryTodo_ReopenTodo_Call {
+	return &RepositoryTodo_ReopenTodo_Call{Call: _e.mock.On("ReopenTodo", ctx, todoId, listId)}
+}
+
+func (_c *RepositoryTodo_ReopenTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *RepositoryTodo_ReopenTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_ReopenTodo_Call) Return(_a0 error) *RepositoryTodo_ReopenTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_ReopenTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *RepositoryTodo_ReopenTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTodoRecurrence provides a mock function with given fields: ctx, todoId, listId, rule
+func (_m *RepositoryTodo) SetTodoRecurrence(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, rule string) error {
+	ret := _m.Called(ctx, todoId, listId, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTodoRecurrence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_SetTodoRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTodoRecurrence'
+type RepositoryTodo_SetTodoRecurrence_Call struct {
+	*mock.Call
+}
+
+// SetTodoRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - rule string
+func (_e *RepositoryTodo_Expecter) SetTodoRecurrence(ctx interface{}, todoId interface{}, listId interface{}, rule interface{}) *RepositoryTodo_SetTodoRecurrence_Call {
+	return &RepositoryTodo_SetTodoRecurrence_Call{Call: _e.mock.On("SetTodoRecurrence", ctx, todoId, listId, rule)}
+}
+
+func (_c *RepositoryTodo_SetTodoRecurrence_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, rule string)) *RepositoryTodo_SetTodoRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_SetTodoRecurrence_Call) Return(_a0 error) *RepositoryTodo_SetTodoRecurrence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_SetTodoRecurrence_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *RepositoryTodo_SetTodoRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StepBackTodo provides a mock function with given fields: ctx, todoId, listId
+func (_m *RepositoryTodo) StepBackTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StepBackTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_StepBackTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepBackTodo'
+type RepositoryTodo_StepBackTodo_Call struct {
+	*mock.Call
+}
+
+// StepBackTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositoryTodo_Expecter) StepBackTodo(ctx interface{}, todoId interface{}, listId interface{}) *RepositoryTodo_StepBackTodo_Call {
+	return &RepositoryTodo_StepBackTodo_Call{Call: _e.mock.On("StepBackTodo", ctx, todoId, listId)}
+}
+
+func (_c *RepositoryTodo_StepBackTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *RepositoryTodo_StepBackTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_StepBackTodo_Call) Return(_a0 error) *RepositoryTodo_StepBackTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_StepBackTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *RepositoryTodo_StepBackTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopTodoRecurrence provides a mock function with given fields: ctx, todoId, listId
+func (_m *RepositoryTodo) StopTodoRecurrence(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopTodoRecurrence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_StopTodoRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopTodoRecurrence'
+type RepositoryTodo_StopTodoRecurrence_Call struct {
+	*mock.Call
+}
+
+// StopTodoRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositoryTodo_Expecter) StopTodoRecurrence(ctx interface{}, todoId interface{}, listId interface{}) *RepositoryTodo_StopTodoRecurrence_Call {
+	return &RepositoryTodo_StopTodoRecurrence_Call{Call: _e.mock.On("StopTodoRecurrence", ctx, todoId, listId)}
+}
+
+func (_c *RepositoryTodo_StopTodoRecurrence_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *RepositoryTodo_StopTodoRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_StopTodoRecurrence_Call) Return(_a0 error) *RepositoryTodo_StopTodoRecurrence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_StopTodoRecurrence_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *RepositoryTodo_StopTodoRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SupersedeTodo provides a mock function with given fields: ctx, todoId, listId
+func (_m *RepositoryTodo) SupersedeTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SupersedeTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_SupersedeTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SupersedeTodo'
+type RepositoryTodo_SupersedeTodo_Call struct {
+	*mock.Call
+}
+
+// SupersedeTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositoryTodo_Expecter) SupersedeTodo(ctx interface{}, todoId interface{}, listId interface{}) *RepositoryTodo_SupersedeTodo_Call {
+	return &RepositoryTodo_SupersedeTodo_Call{Call: _e.mock.On("SupersedeTodo", ctx, todoId, listId)}
+}
+
+func (_c *RepositoryTodo_SupersedeTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *RepositoryTodo_SupersedeTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_SupersedeTodo_Call) Return(_a0 error) *RepositoryTodo_SupersedeTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryTodo_SupersedeTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *RepositoryTodo_SupersedeTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignTodo provides a mock function with given fields: ctx, todoId, listId
+func (_m *RepositoryTodo) UnassignTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryTodo_UnassignTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignTodo'
+type RepositoryTodo_UnassignTodo_Call struct {
+	*mock.Call
+}
+
+// UnassignTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *RepositoryTodo_Expecter) UnassignTodo(ctx interface{}, todoId interface{}, listId interface{}) *RepositoryTodo_UnassignTodo_Call {
+	return &RepositoryTodo_UnassignTodo_Call{Call: _e.mock.On("UnassignTodo", ctx, todoId, listId)}
+}
+
+func (_c *RepositoryTodo_UnassignTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *RepositoryTodo_UnassignTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryTodo_UnassignTodo_Call) Return(_a0 error) *RepositoryTodo_UnassignTodo_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *RepositoryTodo_GetTodoAssignee_Call) RunAndReturn(run func(context.Context, uuid.UUID) string) *RepositoryTodo_GetTodoAssignee_Call {
+func (_c *RepositoryTodo_UnassignTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *RepositoryTodo_UnassignTodo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.51.1. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package mocks
 
@@ -24,6 +24,105 @@ func (_m *ServiceTodo) EXPECT() *ServiceTodo_Expecter {
 	return &ServiceTodo_Expecter{mock: &_m.Mock}
 }
 
+// AddTodoDependency provides a mock function with given fields: ctx, todoId, listId, blockerId, blockerListId
+func (_m *ServiceTodo) AddTodoDependency(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, blockerId uuid.UUID, blockerListId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId, blockerId, blockerListId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTodoDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId, blockerId, blockerListId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_AddTodoDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTodoDependency'
+type ServiceTodo_AddTodoDependency_Call struct {
+	*mock.Call
+}
+
+// AddTodoDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - blockerId uuid.UUID
+//   - blockerListId uuid.UUID
+func (_e *ServiceTodo_Expecter) AddTodoDependency(ctx interface{}, todoId interface{}, listId interface{}, blockerId interface{}, blockerListId interface{}) *ServiceTodo_AddTodoDependency_Call {
+	return &ServiceTodo_AddTodoDependency_Call{Call: _e.mock.On("AddTodoDependency", ctx, todoId, listId, blockerId, blockerListId)}
+}
+
+func (_c *ServiceTodo_AddTodoDependency_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, blockerId uuid.UUID, blockerListId uuid.UUID)) *ServiceTodo_AddTodoDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_AddTodoDependency_Call) Return(_a0 error) *ServiceTodo_AddTodoDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_AddTodoDependency_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error) *ServiceTodo_AddTodoDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTodoWatcher provides a mock function with given fields: ctx, todoId, listId, username
+func (_m *ServiceTodo) AddTodoWatcher(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, todoId, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTodoWatcher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_AddTodoWatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTodoWatcher'
+type ServiceTodo_AddTodoWatcher_Call struct {
+	*mock.Call
+}
+
+// AddTodoWatcher is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+func (_e *ServiceTodo_Expecter) AddTodoWatcher(ctx interface{}, todoId interface{}, listId interface{}, username interface{}) *ServiceTodo_AddTodoWatcher_Call {
+	return &ServiceTodo_AddTodoWatcher_Call{Call: _e.mock.On("AddTodoWatcher", ctx, todoId, listId, username)}
+}
+
+func (_c *ServiceTodo_AddTodoWatcher_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string)) *ServiceTodo_AddTodoWatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_AddTodoWatcher_Call) Return(_a0 error) *ServiceTodo_AddTodoWatcher_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_AddTodoWatcher_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *ServiceTodo_AddTodoWatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AssignUserToTodo provides a mock function with given fields: ctx, todoId, listId, username
 func (_m *ServiceTodo) AssignUserToTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
 	ret := _m.Called(ctx, todoId, listId, username)
@@ -73,17 +172,126 @@ func (_c *ServiceTodo_AssignUserToTodo_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// ChangeTodoStatus provides a mock function with given fields: ctx, todoId, listId
-func (_m *ServiceTodo) ChangeTodoStatus(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
-	ret := _m.Called(ctx, todoId, listId)
+// AttachLabel provides a mock function with given fields: ctx, todoId, listId, labelId
+func (_m *ServiceTodo) AttachLabel(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, labelId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId, labelId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId, labelId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_AttachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachLabel'
+type ServiceTodo_AttachLabel_Call struct {
+	*mock.Call
+}
+
+// AttachLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - labelId uuid.UUID
+func (_e *ServiceTodo_Expecter) AttachLabel(ctx interface{}, todoId interface{}, listId interface{}, labelId interface{}) *ServiceTodo_AttachLabel_Call {
+	return &ServiceTodo_AttachLabel_Call{Call: _e.mock.On("AttachLabel", ctx, todoId, listId, labelId)}
+}
+
+func (_c *ServiceTodo_AttachLabel_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, labelId uuid.UUID)) *ServiceTodo_AttachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_AttachLabel_Call) Return(_a0 error) *ServiceTodo_AttachLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_AttachLabel_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *ServiceTodo_AttachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdateTodos provides a mock function with given fields: ctx, listId, input
+func (_m *ServiceTodo) BulkUpdateTodos(ctx context.Context, listId uuid.UUID, input structures.TodoBulkInput) (*structures.TodoBulkOutput, error) {
+	ret := _m.Called(ctx, listId, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateTodos")
+	}
+
+	var r0 *structures.TodoBulkOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TodoBulkInput) (*structures.TodoBulkOutput, error)); ok {
+		return rf(ctx, listId, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TodoBulkInput) *structures.TodoBulkOutput); ok {
+		r0 = rf(ctx, listId, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.TodoBulkOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.TodoBulkInput) error); ok {
+		r1 = rf(ctx, listId, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceTodo_BulkUpdateTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateTodos'
+type ServiceTodo_BulkUpdateTodos_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listId uuid.UUID
+//   - input structures.TodoBulkInput
+func (_e *ServiceTodo_Expecter) BulkUpdateTodos(ctx interface{}, listId interface{}, input interface{}) *ServiceTodo_BulkUpdateTodos_Call {
+	return &ServiceTodo_BulkUpdateTodos_Call{Call: _e.mock.On("BulkUpdateTodos", ctx, listId, input)}
+}
+
+func (_c *ServiceTodo_BulkUpdateTodos_Call) Run(run func(ctx context.Context, listId uuid.UUID, input structures.TodoBulkInput)) *ServiceTodo_BulkUpdateTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.TodoBulkInput))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_BulkUpdateTodos_Call) Return(_a0 *structures.TodoBulkOutput, _a1 error) *ServiceTodo_BulkUpdateTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceTodo_BulkUpdateTodos_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.TodoBulkInput) (*structures.TodoBulkOutput, error)) *ServiceTodo_BulkUpdateTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeTodoStatus provides a mock function with given fields: ctx, todoId, listId, status
+func (_m *ServiceTodo) ChangeTodoStatus(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, status string) error {
+	ret := _m.Called(ctx, todoId, listId, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ChangeTodoStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, todoId, listId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, status)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -100,13 +308,14 @@ type ServiceTodo_ChangeTodoStatus_Call struct {
 //   - ctx context.Context
 //   - todoId uuid.UUID
 //   - listId uuid.UUID
-func (_e *ServiceTodo_Expecter) ChangeTodoStatus(ctx interface{}, todoId interface{}, listId interface{}) *ServiceTodo_ChangeTodoStatus_Call {
-	return &ServiceTodo_ChangeTodoStatus_Call{Call: _e.mock.On("ChangeTodoStatus", ctx, todoId, listId)}
+//   - status string
+func (_e *ServiceTodo_Expecter) ChangeTodoStatus(ctx interface{}, todoId interface{}, listId interface{}, status interface{}) *ServiceTodo_ChangeTodoStatus_Call {
+	return &ServiceTodo_ChangeTodoStatus_Call{Call: _e.mock.On("ChangeTodoStatus", ctx, todoId, listId, status)}
 }
 
-func (_c *ServiceTodo_ChangeTodoStatus_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *ServiceTodo_ChangeTodoStatus_Call {
+func (_c *ServiceTodo_ChangeTodoStatus_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, status string)) *ServiceTodo_ChangeTodoStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
 	})
 	return _c
 }
@@ -116,7 +325,7 @@ func (_c *ServiceTodo_ChangeTodoStatus_Call) Return(_a0 error) *ServiceTodo_Chan
 	return _c
 }
 
-func (_c *ServiceTodo_ChangeTodoStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ServiceTodo_ChangeTodoStatus_Call {
+func (_c *ServiceTodo_ChangeTodoStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *ServiceTodo_ChangeTodoStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -169,6 +378,68 @@ func (_c *ServiceTodo_CheckIfListContainsTodo_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CopyTodos provides a mock function with given fields: ctx, todoIds, listId, targetListId, conflict
+func (_m *ServiceTodo) CopyTodos(ctx context.Context, todoIds []uuid.UUID, listId uuid.UUID, targetListId uuid.UUID, conflict string) ([]structures.TodoOutput, error) {
+	ret := _m.Called(ctx, todoIds, listId, targetListId, conflict)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyTodos")
+	}
+
+	var r0 []structures.TodoOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID, string) ([]structures.TodoOutput, error)); ok {
+		return rf(ctx, todoIds, listId, targetListId, conflict)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID, string) []structures.TodoOutput); ok {
+		r0 = rf(ctx, todoIds, listId, targetListId, conflict)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.TodoOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, todoIds, listId, targetListId, conflict)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceTodo_CopyTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyTodos'
+type ServiceTodo_CopyTodos_Call struct {
+	*mock.Call
+}
+
+// CopyTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoIds []uuid.UUID
+//   - listId uuid.UUID
+//   - targetListId uuid.UUID
+//   - conflict string
+func (_e *ServiceTodo_Expecter) CopyTodos(ctx interface{}, todoIds interface{}, listId interface{}, targetListId interface{}, conflict interface{}) *ServiceTodo_CopyTodos_Call {
+	return &ServiceTodo_CopyTodos_Call{Call: _e.mock.On("CopyTodos", ctx, todoIds, listId, targetListId, conflict)}
+}
+
+func (_c *ServiceTodo_CopyTodos_Call) Run(run func(ctx context.Context, todoIds []uuid.UUID, listId uuid.UUID, targetListId uuid.UUID, conflict string)) *ServiceTodo_CopyTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_CopyTodos_Call) Return(_a0 []structures.TodoOutput, _a1 error) *ServiceTodo_CopyTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceTodo_CopyTodos_Call) RunAndReturn(run func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID, string) ([]structures.TodoOutput, error)) *ServiceTodo_CopyTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTodo provides a mock function with given fields: ctx, todoInput, listId
 func (_m *ServiceTodo) CreateTodo(ctx context.Context, todoInput structures.TodoInput, listId uuid.UUID) (*structures.TodoOutput, error) {
 	ret := _m.Called(ctx, todoInput, listId)
@@ -289,24 +560,83 @@ func (_c *ServiceTodo_DeleteTodo_Call) RunAndReturn(run func(context.Context, uu
 	return _c
 }
 
-// GetAllTasks provides a mock function with given fields: ctx, listId
-func (_m *ServiceTodo) GetAllTasks(ctx context.Context, listId uuid.UUID) []structures.TodoOutput {
-	ret := _m.Called(ctx, listId)
+// DetachLabel provides a mock function with given fields: ctx, todoId, listId, labelId
+func (_m *ServiceTodo) DetachLabel(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, labelId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId, labelId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId, labelId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_DetachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachLabel'
+type ServiceTodo_DetachLabel_Call struct {
+	*mock.Call
+}
+
+// DetachLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - labelId uuid.UUID
+func (_e *ServiceTodo_Expecter) DetachLabel(ctx interface{}, todoId interface{}, listId interface{}, labelId interface{}) *ServiceTodo_DetachLabel_Call {
+	return &ServiceTodo_DetachLabel_Call{Call: _e.mock.On("DetachLabel", ctx, todoId, listId, labelId)}
+}
+
+func (_c *ServiceTodo_DetachLabel_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, labelId uuid.UUID)) *ServiceTodo_DetachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_DetachLabel_Call) Return(_a0 error) *ServiceTodo_DetachLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_DetachLabel_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *ServiceTodo_DetachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllTasks provides a mock function with given fields: ctx, listId, query
+func (_m *ServiceTodo) GetAllTasks(ctx context.Context, listId uuid.UUID, query structures.TodoQuery) (*structures.TodoPageOutput, error) {
+	ret := _m.Called(ctx, listId, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllTasks")
 	}
 
-	var r0 []structures.TodoOutput
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []structures.TodoOutput); ok {
-		r0 = rf(ctx, listId)
+	var r0 *structures.TodoPageOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TodoQuery) (*structures.TodoPageOutput, error)); ok {
+		return rf(ctx, listId, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, structures.TodoQuery) *structures.TodoPageOutput); ok {
+		r0 = rf(ctx, listId, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]structures.TodoOutput)
+			r0 = ret.Get(0).(*structures.TodoPageOutput)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, structures.TodoQuery) error); ok {
+		r1 = rf(ctx, listId, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // ServiceTodo_GetAllTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllTasks'
@@ -317,23 +647,24 @@ type ServiceTodo_GetAllTasks_Call struct {
 // GetAllTasks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - listId uuid.UUID
-func (_e *ServiceTodo_Expecter) GetAllTasks(ctx interface{}, listId interface{}) *ServiceTodo_GetAllTasks_Call {
-	return &ServiceTodo_GetAllTasks_Call{Call: _e.mock.On("GetAllTasks", ctx, listId)}
+//   - query structures.TodoQuery
+func (_e *ServiceTodo_Expecter) GetAllTasks(ctx interface{}, listId interface{}, query interface{}) *ServiceTodo_GetAllTasks_Call {
+	return &ServiceTodo_GetAllTasks_Call{Call: _e.mock.On("GetAllTasks", ctx, listId, query)}
 }
 
-func (_c *ServiceTodo_GetAllTasks_Call) Run(run func(ctx context.Context, listId uuid.UUID)) *ServiceTodo_GetAllTasks_Call {
+func (_c *ServiceTodo_GetAllTasks_Call) Run(run func(ctx context.Context, listId uuid.UUID, query structures.TodoQuery)) *ServiceTodo_GetAllTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(structures.TodoQuery))
 	})
 	return _c
 }
 
-func (_c *ServiceTodo_GetAllTasks_Call) Return(_a0 []structures.TodoOutput) *ServiceTodo_GetAllTasks_Call {
-	_c.Call.Return(_a0)
+func (_c *ServiceTodo_GetAllTasks_Call) Return(_a0 *structures.TodoPageOutput, _a1 error) *ServiceTodo_GetAllTasks_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ServiceTodo_GetAllTasks_Call) RunAndReturn(run func(context.Context, uuid.UUID) []structures.TodoOutput) *ServiceTodo_GetAllTasks_Call {
+func (_c *ServiceTodo_GetAllTasks_Call) RunAndReturn(run func(context.Context, uuid.UUID, structures.TodoQuery) (*structures.TodoPageOutput, error)) *ServiceTodo_GetAllTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -398,49 +729,611 @@ func (_c *ServiceTodo_GetTodo_Call) RunAndReturn(run func(context.Context, uuid.
 	return _c
 }
 
-// GetTodoAssignee provides a mock function with given fields: ctx, todoId
-func (_m *ServiceTodo) GetTodoAssignee(ctx context.Context, todoId uuid.UUID) string {
+// GetTodoAssignees provides a mock function with given fields: ctx, todoId
+func (_m *ServiceTodo) GetTodoAssignees(ctx context.Context, todoId uuid.UUID) []string {
 	ret := _m.Called(ctx, todoId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTodoAssignee")
+		panic("no return value specified for GetTodoAssignees")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
 		r0 = rf(ctx, todoId)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
 
 	return r0
 }
 
-// ServiceTodo_GetTodoAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoAssignee'
-type ServiceTodo_GetTodoAssignee_Call struct {
+// ServiceTodo_GetTodoAssignees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoAssignees'
+type ServiceTodo_GetTodoAssignees_Call struct {
 	*mock.Call
 }
 
-// GetTodoAssignee is a helper method to define mock.On call
+// GetTodoAssignees is a helper method to define mock.On call
 //   - ctx context.Context
 //   - todoId uuid.UUID
-func (_e *ServiceTodo_Expecter) GetTodoAssignee(ctx interface{}, todoId interface{}) *ServiceTodo_GetTodoAssignee_Call {
-	return &ServiceTodo_GetTodoAssignee_Call{Call: _e.mock.On("GetTodoAssignee", ctx, todoId)}
+func (_e *ServiceTodo_Expecter) GetTodoAssignees(ctx interface{}, todoId interface{}) *ServiceTodo_GetTodoAssignees_Call {
+	return &ServiceTodo_GetTodoAssignees_Call{Call: _e.mock.On("GetTodoAssignees", ctx, todoId)}
 }
 
-func (_c *ServiceTodo_GetTodoAssignee_Call) Run(run func(ctx context.Context, todoId uuid.UUID)) *ServiceTodo_GetTodoAssignee_Call {
+func (_c *ServiceTodo_GetTodoAssignees_Call) Run(run func(ctx context.Context, todoId uuid.UUID)) *ServiceTodo_GetTodoAssignees_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *ServiceTodo_GetTodoAssignee_Call) Return(_a0 string) *ServiceTodo_GetTodoAssignee_Call {
+func (_c *ServiceTodo_GetTodoAssignees_Call) Return(_a0 []string) *ServiceTodo_GetTodoAssignees_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_GetTodoAssignees_Call) RunAndReturn(run func(context.Context, uuid.UUID) []string) *ServiceTodo_GetTodoAssignees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodoRecurrence provides a mock function with given fields: ctx, todoId, listId, limit
+func (_m *ServiceTodo) GetTodoRecurrence(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, limit int) (*structures.TodoRecurrenceOutput, error) {
+	ret := _m.Called(ctx, todoId, listId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodoRecurrence")
+	}
+
+	var r0 *structures.TodoRecurrenceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) (*structures.TodoRecurrenceOutput, error)); ok {
+		return rf(ctx, todoId, listId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) *structures.TodoRecurrenceOutput); ok {
+		r0 = rf(ctx, todoId, listId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.TodoRecurrenceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, todoId, listId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceTodo_GetTodoRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoRecurrence'
+type ServiceTodo_GetTodoRecurrence_Call struct {
+	*mock.Call
+}
+
+// GetTodoRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - limit int
+func (_e *ServiceTodo_Expecter) GetTodoRecurrence(ctx interface{}, todoId interface{}, listId interface{}, limit interface{}) *ServiceTodo_GetTodoRecurrence_Call {
+	return &ServiceTodo_GetTodoRecurrence_Call{Call: _e.mock.On("GetTodoRecurrence", ctx, todoId, listId, limit)}
+}
+
+func (_c *ServiceTodo_GetTodoRecurrence_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, limit int)) *ServiceTodo_GetTodoRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_GetTodoRecurrence_Call) Return(_a0 *structures.TodoRecurrenceOutput, _a1 error) *ServiceTodo_GetTodoRecurrence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceTodo_GetTodoRecurrence_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, int) (*structures.TodoRecurrenceOutput, error)) *ServiceTodo_GetTodoRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTodos provides a mock function with given fields: ctx, todoIds, listId, targetListId, conflict
+func (_m *ServiceTodo) MoveTodos(ctx context.Context, todoIds []uuid.UUID, listId uuid.UUID, targetListId uuid.UUID, conflict string) ([]structures.TodoOutput, error) {
+	ret := _m.Called(ctx, todoIds, listId, targetListId, conflict)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTodos")
+	}
+
+	var r0 []structures.TodoOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID, string) ([]structures.TodoOutput, error)); ok {
+		return rf(ctx, todoIds, listId, targetListId, conflict)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID, string) []structures.TodoOutput); ok {
+		r0 = rf(ctx, todoIds, listId, targetListId, conflict)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]structures.TodoOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, todoIds, listId, targetListId, conflict)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceTodo_MoveTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodos'
+type ServiceTodo_MoveTodos_Call struct {
+	*mock.Call
+}
+
+// MoveTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoIds []uuid.UUID
+//   - listId uuid.UUID
+//   - targetListId uuid.UUID
+//   - conflict string
+func (_e *ServiceTodo_Expecter) MoveTodos(ctx interface{}, todoIds interface{}, listId interface{}, targetListId interface{}, conflict interface{}) *ServiceTodo_MoveTodos_Call {
+	return &ServiceTodo_MoveTodos_Call{Call: _e.mock.On("MoveTodos", ctx, todoIds, listId, targetListId, conflict)}
+}
+
+func (_c *ServiceTodo_MoveTodos_Call) Run(run func(ctx context.Context, todoIds []uuid.UUID, listId uuid.UUID, targetListId uuid.UUID, conflict string)) *ServiceTodo_MoveTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_MoveTodos_Call) Return(_a0 []structures.TodoOutput, _a1 error) *ServiceTodo_MoveTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceTodo_MoveTodos_Call) RunAndReturn(run func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID, string) ([]structures.TodoOutput, error)) *ServiceTodo_MoveTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignTodo provides a mock function with given fields: ctx, todoId, listId, username
+func (_m *ServiceTodo) ReassignTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, todoId, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_ReassignTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignTodo'
+type ServiceTodo_ReassignTodo_Call struct {
+	*mock.Call
+}
+
+// ReassignTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+func (_e *ServiceTodo_Expecter) ReassignTodo(ctx interface{}, todoId interface{}, listId interface{}, username interface{}) *ServiceTodo_ReassignTodo_Call {
+	return &ServiceTodo_ReassignTodo_Call{Call: _e.mock.On("ReassignTodo", ctx, todoId, listId, username)}
+}
+
+func (_c *ServiceTodo_ReassignTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string)) *ServiceTodo_ReassignTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_ReassignTodo_Call) Return(_a0 error) *ServiceTodo_ReassignTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_ReassignTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *ServiceTodo_ReassignTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTodoAssignee provides a mock function with given fields: ctx, todoId, listId, username
+func (_m *ServiceTodo) RemoveTodoAssignee(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, todoId, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTodoAssignee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_RemoveTodoAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTodoAssignee'
+type ServiceTodo_RemoveTodoAssignee_Call struct {
+	*mock.Call
+}
+
+// RemoveTodoAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+func (_e *ServiceTodo_Expecter) RemoveTodoAssignee(ctx interface{}, todoId interface{}, listId interface{}, username interface{}) *ServiceTodo_RemoveTodoAssignee_Call {
+	return &ServiceTodo_RemoveTodoAssignee_Call{Call: _e.mock.On("RemoveTodoAssignee", ctx, todoId, listId, username)}
+}
+
+func (_c *ServiceTodo_RemoveTodoAssignee_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string)) *ServiceTodo_RemoveTodoAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_RemoveTodoAssignee_Call) Return(_a0 error) *ServiceTodo_RemoveTodoAssignee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_RemoveTodoAssignee_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *ServiceTodo_RemoveTodoAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTodoDependency provides a mock function with given fields: ctx, todoId, listId, blockerId
+func (_m *ServiceTodo) RemoveTodoDependency(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, blockerId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId, blockerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTodoDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId, blockerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_RemoveTodoDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTodoDependency'
+type ServiceTodo_RemoveTodoDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveTodoDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - blockerId uuid.UUID
+func (_e *ServiceTodo_Expecter) RemoveTodoDependency(ctx interface{}, todoId interface{}, listId interface{}, blockerId interface{}) *ServiceTodo_RemoveTodoDependency_Call {
+	return &ServiceTodo_RemoveTodoDependency_Call{Call: _e.mock.On("RemoveTodoDependency", ctx, todoId, listId, blockerId)}
+}
+
+func (_c *ServiceTodo_RemoveTodoDependency_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, blockerId uuid.UUID)) *ServiceTodo_RemoveTodoDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_RemoveTodoDependency_Call) Return(_a0 error) *ServiceTodo_RemoveTodoDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_RemoveTodoDependency_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *ServiceTodo_RemoveTodoDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTodoWatcher provides a mock function with given fields: ctx, todoId, listId, username
+func (_m *ServiceTodo) RemoveTodoWatcher(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string) error {
+	ret := _m.Called(ctx, todoId, listId, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTodoWatcher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_RemoveTodoWatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTodoWatcher'
+type ServiceTodo_RemoveTodoWatcher_Call struct {
+	*mock.Call
+}
+
+// RemoveTodoWatcher is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - username string
+func (_e *ServiceTodo_Expecter) RemoveTodoWatcher(ctx interface{}, todoId interface{}, listId interface{}, username interface{}) *ServiceTodo_RemoveTodoWatcher_Call {
+	return &ServiceTodo_RemoveTodoWatcher_Call{Call: _e.mock.On("RemoveTodoWatcher", ctx, todoId, listId, username)}
+}
+
+func (_c *ServiceTodo_RemoveTodoWatcher_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, username string)) *ServiceTodo_RemoveTodoWatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_RemoveTodoWatcher_Call) Return(_a0 error) *ServiceTodo_RemoveTodoWatcher_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_RemoveTodoWatcher_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *ServiceTodo_RemoveTodoWatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenTodo provides a mock function with given fields: ctx, todoId, listId
+func (_m *ServiceTodo) ReopenTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_ReopenTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenTodo'
+type ServiceTodo_ReopenTodo_Call struct {
+	*mock.Call
+}
+
+// ReopenTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *ServiceTodo_Expecter) ReopenTodo(ctx interface{}, todoId interface{}, listId interface{}) *ServiceTodo_ReopenTodo_Call {
+	return &ServiceTodo_ReopenTodo_Call{Call: _e.mock.On("ReopenTodo", ctx, todoId, listId)}
+}
+
+func (_c *ServiceTodo_ReopenTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *ServiceTodo_ReopenTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_ReopenTodo_Call) Return(_a0 error) *ServiceTodo_ReopenTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_ReopenTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ServiceTodo_ReopenTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTodoRecurrence provides a mock function with given fields: ctx, todoId, listId, rule
+func (_m *ServiceTodo) SetTodoRecurrence(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, rule string) error {
+	ret := _m.Called(ctx, todoId, listId, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTodoRecurrence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, todoId, listId, rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_SetTodoRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTodoRecurrence'
+type ServiceTodo_SetTodoRecurrence_Call struct {
+	*mock.Call
+}
+
+// SetTodoRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+//   - rule string
+func (_e *ServiceTodo_Expecter) SetTodoRecurrence(ctx interface{}, todoId interface{}, listId interface{}, rule interface{}) *ServiceTodo_SetTodoRecurrence_Call {
+	return &ServiceTodo_SetTodoRecurrence_Call{Call: _e.mock.On("SetTodoRecurrence", ctx, todoId, listId, rule)}
+}
+
+func (_c *ServiceTodo_SetTodoRecurrence_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID, rule string)) *ServiceTodo_SetTodoRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_SetTodoRecurrence_Call) Return(_a0 error) *ServiceTodo_SetTodoRecurrence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_SetTodoRecurrence_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *ServiceTodo_SetTodoRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StepBackTodo provides a mock function with given fields: ctx, todoId, listId
+func (_m *ServiceTodo) StepBackTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StepBackTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_StepBackTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepBackTodo'
+type ServiceTodo_StepBackTodo_Call struct {
+	*mock.Call
+}
+
+// StepBackTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *ServiceTodo_Expecter) StepBackTodo(ctx interface{}, todoId interface{}, listId interface{}) *ServiceTodo_StepBackTodo_Call {
+	return &ServiceTodo_StepBackTodo_Call{Call: _e.mock.On("StepBackTodo", ctx, todoId, listId)}
+}
+
+func (_c *ServiceTodo_StepBackTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *ServiceTodo_StepBackTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_StepBackTodo_Call) Return(_a0 error) *ServiceTodo_StepBackTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_StepBackTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ServiceTodo_StepBackTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopTodoRecurrence provides a mock function with given fields: ctx, todoId, listId
+func (_m *ServiceTodo) StopTodoRecurrence(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopTodoRecurrence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_StopTodoRecurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopTodoRecurrence'
+type ServiceTodo_StopTodoRecurrence_Call struct {
+	*mock.Call
+}
+
+// StopTodoRecurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *ServiceTodo_Expecter) StopTodoRecurrence(ctx interface{}, todoId interface{}, listId interface{}) *ServiceTodo_StopTodoRecurrence_Call {
+	return &ServiceTodo_StopTodoRecurrence_Call{Call: _e.mock.On("StopTodoRecurrence", ctx, todoId, listId)}
+}
+
+func (_c *ServiceTodo_StopTodoRecurrence_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *ServiceTodo_StopTodoRecurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_StopTodoRecurrence_Call) Return(_a0 error) *ServiceTodo_StopTodoRecurrence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceTodo_StopTodoRecurrence_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ServiceTodo_StopTodoRecurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignTodo provides a mock function with given fields: ctx, todoId, listId
+func (_m *ServiceTodo) UnassignTodo(ctx context.Context, todoId uuid.UUID, listId uuid.UUID) error {
+	ret := _m.Called(ctx, todoId, listId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, todoId, listId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceTodo_UnassignTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignTodo'
+type ServiceTodo_UnassignTodo_Call struct {
+	*mock.Call
+}
+
+// UnassignTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoId uuid.UUID
+//   - listId uuid.UUID
+func (_e *ServiceTodo_Expecter) UnassignTodo(ctx interface{}, todoId interface{}, listId interface{}) *ServiceTodo_UnassignTodo_Call {
+	return &ServiceTodo_UnassignTodo_Call{Call: _e.mock.On("UnassignTodo", ctx, todoId, listId)}
+}
+
+func (_c *ServiceTodo_UnassignTodo_Call) Run(run func(ctx context.Context, todoId uuid.UUID, listId uuid.UUID)) *ServiceTodo_UnassignTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceTodo_UnassignTodo_Call) Return(_a0 error) *ServiceTodo_UnassignTodo_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ServiceTodo_GetTodoAssignee_Call) RunAndReturn(run func(context.Context, uuid.UUID) string) *ServiceTodo_GetTodoAssignee_Call {
+func (_c *ServiceTodo_UnassignTodo_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ServiceTodo_UnassignTodo_Call {
 	_c.Call.Return(run)
 	return _c
 }
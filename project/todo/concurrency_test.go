@@ -0,0 +1,98 @@
+package todo_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"project/audit"
+	"project/list"
+	"project/memory"
+	"project/repositorytest"
+	"project/structures"
+	"project/todo"
+	"project/uow"
+	"project/utils"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestServiceAssignUserToTodoConcurrently(t *testing.T) {
+	db := repositorytest.ConnectTestDB(t)
+
+	listId := uuid.New()
+	_, err := db.Exec(`INSERT INTO list(id, name) VALUES ($1, $2)`, listId, "concurrency-"+listId.String())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM list WHERE id = $1`, listId)
+	})
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	require.NoError(t, unitOfWork.Do(utils.HelperGetContext(), func(ctx context.Context) error {
+		_, err := list.NewDBRepositoryList(db, *list.NewRepositoryListConvertor()).UpdateWorkflow(ctx, utils.DefaultWorkflow(listId))
+		return err
+	}))
+
+	repo := todo.NewDBRepositoryTodo(db, *todo.NewRepositoryTodoConvertor())
+	auditLog := audit.NewDBRepositoryAudit(db, *audit.NewRepositoryAuditConvertor())
+	service := todo.NewServiceTodo(repo, *todo.NewServiceTodoConvertor(), unitOfWork, auditLog)
+	helperAssignConcurrently(t, service, listId)
+}
+
+func TestServiceAssignUserToTodoConcurrentlyInMemory(t *testing.T) {
+	store := memory.NewStore()
+	listId := uuid.New()
+	require.NoError(t, store.Do(utils.HelperGetContext(), func(_ context.Context) error {
+		store.Lists[listId] = structures.ListEntity{Id: listId, Name: utils.TestListName}
+		return nil
+	}))
+	require.NoError(t, store.Do(utils.HelperGetContext(), func(ctx context.Context) error {
+		_, err := list.NewMemoryRepositoryList(store, *list.NewRepositoryListConvertor()).UpdateWorkflow(ctx, utils.DefaultWorkflow(listId))
+		return err
+	}))
+
+	repo := todo.NewMemoryRepositoryTodo(store, *todo.NewRepositoryTodoConvertor())
+	auditLog := audit.NewMemoryRepositoryAudit(store, *audit.NewRepositoryAuditConvertor())
+	service := todo.NewServiceTodo(repo, *todo.NewServiceTodoConvertor(), store, auditLog)
+	helperAssignConcurrently(t, service, listId)
+}
+
+func helperAssignConcurrently(t *testing.T, service *todo.ServiceTodoImpl, listId uuid.UUID) {
+	ctx := utils.HelperGetContext()
+	created, err := service.CreateTodo(ctx, structures.TodoInput{
+		Name:     utils.TestTodoName,
+		Deadline: time.Now().Add(24 * time.Hour),
+		Priority: utils.MediumPriority,
+	}, listId)
+	require.NoError(t, err)
+
+	const assigners = 8
+	start := make(chan struct{})
+	results := make([]error, 2*assigners)
+	var wg sync.WaitGroup
+	for i := 0; i < 2*assigners; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			results[i] = service.AssignUserToTodo(utils.HelperGetContext(), created.Id, listId, fmt.Sprintf("user-%d", i%assigners))
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	succeeded := make(map[string]bool)
+	for i, err := range results {
+		username := fmt.Sprintf("user-%d", i%assigners)
+		if err == nil {
+			require.False(t, succeeded[username], "more than one assignment of %s succeeded", username)
+			succeeded[username] = true
+			continue
+		}
+
+		require.True(t, strings.Contains(err.Error(), "is already assigned"), err.Error())
+	}
+	require.Len(t, succeeded, assigners)
+	require.Len(t, service.GetTodoAssignees(ctx, created.Id), assigners)
+}
@@ -1,7 +1,9 @@
 package todo
 
 import (
+	"github.com/google/uuid"
 	"project/structures"
+	"slices"
 )
 
 type ServiceTodoConvertor struct{}
@@ -17,9 +19,26 @@ func (s *ServiceTodoConvertor) ConvertTodoModelToOutput(todoModel *structures.To
 		Name:        todoModel.Name,
 		Description: todoModel.Description,
 		Deadline:    todoModel.Deadline,
-		Assignee:    todoModel.Assignee,
+		Assignees:   append([]string{}, todoModel.Assignees...),
+		Watchers:    append([]string{}, todoModel.Watchers...),
 		Status:      todoModel.Status,
+		Done:        todoModel.Done,
 		Priority:    todoModel.Priority,
+		Progress:    todoProgress(todoModel.SubtasksDone, todoModel.SubtasksTotal),
+		Labels:      make([]structures.LabelOutput, len(todoModel.Labels)),
+		Recurrence:  todoModel.Recurrence,
+		SeriesId:    todoModel.SeriesId,
+		Blocked:     todoModel.Blocked,
+		BlockedBy:   convertDependencyModelsToOutputs(todoModel.BlockedBy),
+		Blocks:      convertDependencyModelsToOutputs(todoModel.Blocks),
+	}
+	for i, labelModel := range todoModel.Labels {
+		todoOutput.Labels[i] = structures.LabelOutput{
+			Id:     labelModel.Id,
+			ListId: labelModel.ListId,
+			Name:   labelModel.Name,
+			Color:  labelModel.Color,
+		}
 	}
 
 	return &todoOutput
@@ -32,14 +51,39 @@ func (s *ServiceTodoConvertor) ConvertTodoModelToEntity(todoModel *structures.To
 		Name:        todoModel.Name,
 		Description: todoModel.Description,
 		Deadline:    todoModel.Deadline,
-		Assignee:    todoModel.Assignee,
 		Priority:    todoModel.Priority,
 		Status:      todoModel.Status,
+		Recurrence:  todoModel.Recurrence,
+	}
+	if todoModel.SeriesId != nil {
+		todoEntity.SeriesId = uuid.NullUUID{UUID: *todoModel.SeriesId, Valid: true}
 	}
 
 	return &todoEntity
 }
 
+func convertDependencyModelsToOutputs(dependencyModels []structures.TodoDependencyModel) []structures.TodoDependencyOutput {
+	dependencyOutputs := make([]structures.TodoDependencyOutput, len(dependencyModels))
+	for i, dependencyModel := range dependencyModels {
+		dependencyOutputs[i] = structures.TodoDependencyOutput{
+			Id:     dependencyModel.Id,
+			ListId: dependencyModel.ListId,
+			Name:   dependencyModel.Name,
+			Done:   dependencyModel.Done,
+		}
+	}
+
+	return dependencyOutputs
+}
+
+func todoProgress(done, total int) int {
+	if total == 0 {
+		return 0
+	}
+
+	return done * 100 / total
+}
+
 type RepositoryTodoConvertor struct{}
 
 func NewRepositoryTodoConvertor() *RepositoryTodoConvertor {
@@ -47,17 +91,60 @@ func NewRepositoryTodoConvertor() *RepositoryTodoConvertor {
 }
 
 func (r *RepositoryTodoConvertor) ConvertEntityToModel(entity structures.TodoEntity) structures.TodoModel {
-	return structures.TodoModel{
-		Id:           entity.Id,
-		Name:         entity.Name,
-		ListId:       entity.ListId,
-		Description:  entity.Description,
-		Deadline:     entity.Deadline,
-		CreationDate: entity.CreationDate,
-		Assignee:     entity.Assignee,
-		Priority:     entity.Priority,
-		Status:       entity.Status,
+	todoModel := structures.TodoModel{
+		Id:            entity.Id,
+		Name:          entity.Name,
+		ListId:        entity.ListId,
+		Description:   entity.Description,
+		Deadline:      entity.Deadline,
+		CreationDate:  entity.CreationDate,
+		Assignees:     entity.Assignees,
+		Watchers:      entity.Watchers,
+		Priority:      entity.Priority,
+		Status:        entity.Status,
+		Done:          entity.Done,
+		SubtasksTotal: entity.SubtasksTotal,
+		SubtasksDone:  entity.SubtasksDone,
+		Labels:        make([]structures.LabelModel, len(entity.Labels)),
+		Recurrence:    entity.Recurrence,
+		Blocked:       isBlocked(entity.BlockedBy),
+		BlockedBy:     convertDependencyEntitiesToModels(entity.BlockedBy),
+		Blocks:        convertDependencyEntitiesToModels(entity.Blocks),
 	}
+	if entity.SeriesId.Valid {
+		todoModel.SeriesId = &entity.SeriesId.UUID
+	}
+	for i, labelEntity := range entity.Labels {
+		todoModel.Labels[i] = structures.LabelModel{
+			Id:     labelEntity.Id,
+			ListId: labelEntity.ListId,
+			Name:   labelEntity.Name,
+			Color:  labelEntity.Color,
+		}
+	}
+
+	return todoModel
+}
+
+func convertDependencyEntitiesToModels(dependencyEntities structures.TodoDependencies) []structures.TodoDependencyModel {
+	dependencyModels := make([]structures.TodoDependencyModel, len(dependencyEntities))
+	for i, dependencyEntity := range dependencyEntities {
+		dependencyModels[i] = structures.TodoDependencyModel{
+			Id:     dependencyEntity.Id,
+			ListId: dependencyEntity.ListId,
+			Name:   dependencyEntity.Name,
+			Done:   dependencyEntity.Done,
+		}
+	}
+
+	return dependencyModels
+}
+
+// isBlocked tells whether any of the todos the todo waits for is not done yet.
+func isBlocked(blockedBy structures.TodoDependencies) bool {
+	return slices.ContainsFunc(blockedBy, func(blocker structures.TodoDependencyRefEntity) bool {
+		return !blocker.Done
+	})
 }
 
 func (r *RepositoryTodoConvertor) ConvertEntitiesToModels(entities []structures.TodoEntity) []structures.TodoModel {
@@ -0,0 +1,808 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"project/memory"
+	"project/structures"
+	"project/utils"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+type MemoryRepositoryTodo struct {
+	store     *memory.Store
+	converter RepositoryTodoConvertor
+}
+
+func NewMemoryRepositoryTodo(store *memory.Store, convertor RepositoryTodoConvertor) *MemoryRepositoryTodo {
+	return &MemoryRepositoryTodo{store: store, converter: convertor}
+}
+
+func (r *MemoryRepositoryTodo) GetTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var todoEntity structures.TodoEntity
+	var ok bool
+	r.store.Read(ctx, func() {
+		todoEntity, ok = r.store.Todos[todoId]
+		todoEntity = r.store.WithComputedColumns(todoEntity)
+	})
+	if !ok || todoEntity.ListId != listId {
+		err := errors.New(fmt.Sprintf("error getting todo with id: %s", todoId))
+		log.Error(err)
+		return nil, err
+	}
+
+	todoModel := r.converter.ConvertEntityToModel(todoEntity)
+	return &todoModel, nil
+}
+
+func (r *MemoryRepositoryTodo) GetAllTasks(ctx context.Context, listId uuid.UUID, query structures.TodoQuery) (*structures.TodoPageModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := validateTodoQuery(query)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	var entities []structures.TodoEntity
+	r.store.Read(ctx, func() {
+		for _, todoEntity := range r.store.Todos {
+			if todoEntity.ListId != listId {
+				continue
+			}
+
+			todoEntity = r.store.WithComputedColumns(todoEntity)
+			if matchesTodoQuery(todoEntity, query) {
+				entities = append(entities, todoEntity)
+			}
+		}
+	})
+	totalCount := len(entities)
+
+	sort.Slice(entities, func(i, j int) bool {
+		return compareTodoToCursor(entities[i], todoCursor(entities[j], query)) < 0
+	})
+	if query.After != nil {
+		start := sort.Search(len(entities), func(i int) bool {
+			return compareTodoToCursor(entities[i], *query.After) > 0
+		})
+		entities = entities[start:]
+	}
+	if query.Before != nil {
+		end := sort.Search(len(entities), func(i int) bool {
+			return compareTodoToCursor(entities[i], *query.Before) >= 0
+		})
+		entities = entities[:end]
+	}
+
+	entities, pageInfo := utils.PageItems(query.PageQuery, entities, func(entity structures.TodoEntity) structures.Cursor {
+		return todoCursor(entity, query)
+	})
+	pageInfo.TotalCount = totalCount
+	return &structures.TodoPageModel{
+		Todos:    r.converter.ConvertEntitiesToModels(entities),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (r *MemoryRepositoryTodo) CreateTodo(ctx context.Context, input structures.TodoEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		if !input.Superseded && r.findTodoByName(input.ListId, input.Name) != nil {
+			err := errors.New(fmt.Sprintf("error already exists todo with the same name %s in list with id: %s", input.Name, input.ListId))
+			log.Error(err)
+			return err
+		}
+		initialState, ok := r.store.InitialState(input.ListId)
+		if !ok {
+			err := errors.New(fmt.Sprintf("error not found list with id: %s", input.ListId))
+			log.Error(err)
+			return err
+		}
+
+		input.CreationDate = time.Now()
+		input.Status = initialState.Name
+		memory.Put(r.store, r.store.Todos, input.Id, input)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) DeleteTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoModel, error) {
+	var deletedTodo *structures.TodoEntity
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deletedTodo, err = r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+
+		r.store.DeleteTodo(todoId)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	todoModel := r.converter.ConvertEntityToModel(*deletedTodo)
+	return &todoModel, nil
+}
+
+func (r *MemoryRepositoryTodo) UpdateTodo(ctx context.Context, updatedTask structures.TodoEntity, listId uuid.UUID) (*structures.TodoModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var todoEntity *structures.TodoEntity
+	err := r.store.Do(ctx, func(ctx context.Context) error {
+		var err error
+		todoEntity, err = r.findTodo(ctx, updatedTask.Id, listId)
+		if err != nil {
+			return err
+		}
+		applyTodoUpdate(todoEntity, updatedTask)
+
+		sameName := r.findTodoByName(listId, todoEntity.Name)
+		if !todoEntity.Superseded && sameName != nil && sameName.Id != todoEntity.Id {
+			err = errors.New("error todo with this name is already created")
+			log.Error(err)
+			return err
+		}
+
+		memory.Put(r.store, r.store.Todos, todoEntity.Id, *todoEntity)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	todoModel := r.converter.ConvertEntityToModel(*todoEntity)
+	return &todoModel, nil
+}
+
+func (r *MemoryRepositoryTodo) AssignTodoToUser(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if username == "" {
+		err := errors.New("username is required")
+		log.Error(err)
+		return err
+	}
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(todoEntity.Assignees, username) {
+			err = errors.New(fmt.Sprintf("error assigning %s because the user is already assigned to todo with id: %s", username, todoId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Append(r.store, &r.store.TodoAssignees, structures.TodoUserEntity{TodoId: todoId, Username: username})
+		if r.store.AllowsTransition(listId, todoEntity.Status, utils.Assigned) {
+			todoEntity.Status = utils.Assigned
+		}
+		memory.Put(r.store, r.store.Todos, todoId, *todoEntity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID, status string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		if todoEntity.Status == status {
+			err = errors.New(fmt.Sprintf("error changing status of todo with id: %s because it is already %s", todoId, status))
+			log.Error(err)
+			return err
+		}
+		if _, ok := r.store.WorkflowState(listId, status); !ok {
+			err = errors.New(fmt.Sprintf("error changing status of todo with id: %s because %s is not a status in the workflow of list with id: %s", todoId, status, listId))
+			log.Error(err)
+			return err
+		}
+		if !r.store.AllowsTransition(listId, todoEntity.Status, status) {
+			err = errors.New(fmt.Sprintf("error changing status of todo with id: %s from %s to %s is not allowed by the workflow", todoId, todoEntity.Status, status))
+			log.Error(err)
+			return err
+		}
+		if isBlocked(todoEntity.BlockedBy) && r.isPastInProgress(listId, status) {
+			err = errors.New(fmt.Sprintf("error changing status of todo with id: %s to %s because it is blocked by todos that are not done", todoId, status))
+			log.Error(err)
+			return err
+		}
+
+		todoEntity.Status = status
+		memory.Put(r.store, r.store.Todos, todoId, *todoEntity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) isPastInProgress(listId uuid.UUID, status string) bool {
+	target, _ := r.store.WorkflowState(listId, status)
+	inProgress, ok := r.store.WorkflowState(listId, utils.InProgress)
+
+	return target.Done || (ok && target.Position > inProgress.Position)
+}
+
+func (r *MemoryRepositoryTodo) ReopenTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		current, _ := r.store.WorkflowState(listId, todoEntity.Status)
+		if !current.Done {
+			err = errors.New(fmt.Sprintf("error reopening todo with id: %s because %s is not a done status", todoId, todoEntity.Status))
+			log.Error(err)
+			return err
+		}
+		reopened, ok := r.store.StateBefore(listId, current.Position, true)
+		if !ok {
+			err = errors.New(fmt.Sprintf("error reopening todo with id: %s because no open status comes before %s", todoId, todoEntity.Status))
+			log.Error(err)
+			return err
+		}
+
+		todoEntity.Status = reopened.Name
+		memory.Put(r.store, r.store.Todos, todoId, *todoEntity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) StepBackTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		current, _ := r.store.WorkflowState(listId, todoEntity.Status)
+		if current.Done {
+			err = errors.New(fmt.Sprintf("error stepping back todo with id: %s because it is done, reopen it instead", todoId))
+			log.Error(err)
+			return err
+		}
+		previous, ok := r.store.StateBefore(listId, current.Position, false)
+		if !ok {
+			err = errors.New(fmt.Sprintf("error stepping back todo with id: %s because %s is the first status of the workflow", todoId, todoEntity.Status))
+			log.Error(err)
+			return err
+		}
+
+		todoEntity.Status = previous.Name
+		memory.Put(r.store, r.store.Todos, todoId, *todoEntity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) UnassignTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		if len(todoEntity.Assignees) == 0 {
+			err = errors.New(fmt.Sprintf("error unassigning todo with id: %s because it is not assigned", todoId))
+			log.Error(err)
+			return err
+		}
+
+		r.store.RemoveTodoUsers(&r.store.TodoAssignees, todoId)
+		r.leaveAssigned(todoEntity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) RemoveTodoAssignee(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(todoEntity.Assignees, username) {
+			err = errors.New(fmt.Sprintf("error not found assignee %s on todo with id: %s", username, todoId))
+			log.Error(err)
+			return err
+		}
+
+		r.store.RemoveTodoUsers(&r.store.TodoAssignees, todoId, username)
+		if len(todoEntity.Assignees) == 1 {
+			r.leaveAssigned(todoEntity)
+		}
+		return nil
+	})
+}
+
+// leaveAssigned moves a todo that lost all of its assignees from Assigned back to the first status of the workflow.
+func (r *MemoryRepositoryTodo) leaveAssigned(todoEntity *structures.TodoEntity) {
+	if initial, ok := r.store.InitialState(todoEntity.ListId); ok && todoEntity.Status == utils.Assigned {
+		todoEntity.Status = initial.Name
+		memory.Put(r.store, r.store.Todos, todoEntity.Id, *todoEntity)
+	}
+}
+
+func (r *MemoryRepositoryTodo) ReassignTodo(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if username == "" {
+		err := errors.New("username is required")
+		log.Error(err)
+		return err
+	}
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		if len(todoEntity.Assignees) == 0 {
+			err = errors.New(fmt.Sprintf("error reassigning todo with id: %s because it is not assigned", todoId))
+			log.Error(err)
+			return err
+		}
+		if slices.Equal(todoEntity.Assignees, []string{username}) {
+			err = errors.New(fmt.Sprintf("error reassigning todo with id: %s because it is already assigned to %s", todoId, username))
+			log.Error(err)
+			return err
+		}
+
+		r.store.RemoveTodoUsers(&r.store.TodoAssignees, todoId)
+		memory.Append(r.store, &r.store.TodoAssignees, structures.TodoUserEntity{TodoId: todoId, Username: username})
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) CheckIfListContainsTodo(ctx context.Context, todoId, listId uuid.UUID) bool {
+	var contains bool
+	r.store.Read(ctx, func() {
+		todoEntity, ok := r.store.Todos[todoId]
+		contains = ok && todoEntity.ListId == listId
+	})
+
+	return contains
+}
+
+func (r *MemoryRepositoryTodo) GetTodoAssignees(ctx context.Context, todoId uuid.UUID) []string {
+	var assignees []string
+	r.store.Read(ctx, func() {
+		assignees = memory.TodoUsers(r.store.TodoAssignees, todoId)
+	})
+
+	return assignees
+}
+
+func (r *MemoryRepositoryTodo) GetUserListIds(ctx context.Context, username string) ([]uuid.UUID, error) {
+	var listIds []uuid.UUID
+	r.store.Read(ctx, func() {
+		for _, member := range r.store.UsersLists {
+			if member.Username == username {
+				listIds = append(listIds, member.ListId)
+			}
+		}
+	})
+
+	return listIds, nil
+}
+
+func (r *MemoryRepositoryTodo) AddTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if username == "" {
+		err := errors.New("username is required")
+		log.Error(err)
+		return err
+	}
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(todoEntity.Watchers, username) {
+			err = errors.New(fmt.Sprintf("error already exists watcher %s on todo with id: %s", username, todoId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Append(r.store, &r.store.TodoWatchers, structures.TodoUserEntity{TodoId: todoId, Username: username})
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) RemoveTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(todoEntity.Watchers, username) {
+			err = errors.New(fmt.Sprintf("error not found watcher %s on todo with id: %s", username, todoId))
+			log.Error(err)
+			return err
+		}
+
+		r.store.RemoveTodoUsers(&r.store.TodoWatchers, todoId, username)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) AttachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		_, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+
+		labelEntity, ok := r.store.Labels[labelId]
+		if !ok || labelEntity.ListId != listId {
+			err = errors.New(fmt.Sprintf("error not found label with id %s in list with id: %s", labelId, listId))
+			log.Error(err)
+			return err
+		}
+
+		todoLabel := structures.TodoLabelEntity{TodoId: todoId, LabelId: labelId}
+		if slices.Contains(r.store.TodoLabels, todoLabel) {
+			err = errors.New(fmt.Sprintf("error already exists label with id %s on todo with id: %s", labelId, todoId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Append(r.store, &r.store.TodoLabels, todoLabel)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) DetachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		_, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+
+		index := slices.Index(r.store.TodoLabels, structures.TodoLabelEntity{TodoId: todoId, LabelId: labelId})
+		if index < 0 {
+			err = errors.New(fmt.Sprintf("error not found label with id %s on todo with id: %s", labelId, todoId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Change(r.store, &r.store.TodoLabels)
+
+		r.store.TodoLabels = slices.Delete(r.store.TodoLabels, index, index+1)
+		return nil
+	})
+}
+
+func matchesTodoQuery(todoEntity structures.TodoEntity, query structures.TodoQuery) bool {
+	if query.Status != "" && todoEntity.Status != query.Status {
+		return false
+	}
+	if query.Priority != "" && todoEntity.Priority != query.Priority {
+		return false
+	}
+	if query.Assignee != "" && !slices.Contains(todoEntity.Assignees, query.Assignee) {
+		return false
+	}
+
+	deadline := todoEntity.Deadline.Format(time.DateOnly)
+	if !query.DeadlineFrom.IsZero() && deadline < query.DeadlineFrom.Format(time.DateOnly) {
+		return false
+	}
+	if !query.DeadlineTo.IsZero() && deadline > query.DeadlineTo.Format(time.DateOnly) {
+		return false
+	}
+	if query.Label != "" && !slices.ContainsFunc(todoEntity.Labels, func(labelEntity structures.LabelEntity) bool {
+		return labelEntity.Name == query.Label
+	}) {
+		return false
+	}
+	if query.Text != "" {
+		text := strings.ToLower(query.Text)
+		return strings.Contains(strings.ToLower(todoEntity.Name), text) ||
+			strings.Contains(strings.ToLower(todoEntity.Description), text)
+	}
+
+	return true
+}
+
+func (r *MemoryRepositoryTodo) SetTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID, rule string) error {
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+
+		todoEntity.Recurrence = rule
+		if !todoEntity.SeriesId.Valid {
+			todoEntity.SeriesId = uuid.NullUUID{UUID: todoId, Valid: true}
+		}
+		memory.Put(r.store, r.store.Todos, todoId, *todoEntity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) StopTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		if todoEntity.Recurrence == "" {
+			err = errors.New(fmt.Sprintf("error not found recurrence on todo with id: %s", todoId))
+			log.Error(err)
+			return err
+		}
+
+		todoEntity.Recurrence = ""
+		memory.Put(r.store, r.store.Todos, todoId, *todoEntity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) SupersedeTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+
+		todoEntity.Recurrence, todoEntity.Superseded = "", true
+		memory.Put(r.store, r.store.Todos, todoId, *todoEntity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) AddTodoDependency(ctx context.Context, todoId, listId, blockerId, blockerListId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if todoId == blockerId {
+		err := errors.New(fmt.Sprintf("%s of todo with id: %s on itself", dependencyErrorMsg, todoId))
+		log.Error(err)
+		return err
+	}
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		_, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		_, err = r.findTodo(ctx, blockerId, blockerListId)
+		if err != nil {
+			return err
+		}
+		if r.store.DependsOn(blockerId, todoId) {
+			err = errors.New(fmt.Sprintf("%s of todo with id: %s on todo with id: %s would create a cycle", dependencyErrorMsg, todoId, blockerId))
+			log.Error(err)
+			return err
+		}
+
+		dependency := structures.TodoDependencyEntity{TodoId: todoId, BlockedById: blockerId}
+		if slices.Contains(r.store.TodoDependencies, dependency) {
+			err = errors.New(fmt.Sprintf("error already exists dependency on todo with id %s in todo with id: %s", blockerId, todoId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Append(r.store, &r.store.TodoDependencies, dependency)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) RemoveTodoDependency(ctx context.Context, todoId, listId, blockerId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		_, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+
+		index := slices.Index(r.store.TodoDependencies, structures.TodoDependencyEntity{TodoId: todoId, BlockedById: blockerId})
+		if index < 0 {
+			err = errors.New(fmt.Sprintf("error not found dependency on todo with id %s in todo with id: %s", blockerId, todoId))
+			log.Error(err)
+			return err
+		}
+
+		memory.Change(r.store, &r.store.TodoDependencies)
+
+		r.store.TodoDependencies = slices.Delete(r.store.TodoDependencies, index, index+1)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) ContainsTodoName(ctx context.Context, listId uuid.UUID, name string) (bool, error) {
+	var contains bool
+	r.store.Read(ctx, func() {
+		for _, todoEntity := range r.store.Todos {
+			if todoEntity.ListId == listId && todoEntity.Name == name && !todoEntity.Superseded {
+				contains = true
+				return
+			}
+		}
+	})
+
+	return contains, nil
+}
+
+func (r *MemoryRepositoryTodo) MoveTodo(ctx context.Context, todoId, listId, targetListId uuid.UUID, name string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		status, err := r.targetStatus(ctx, targetListId, todoEntity.Status)
+		if err != nil {
+			return err
+		}
+		if other := r.findTodoByName(targetListId, name); other != nil && other.Id != todoId && !todoEntity.Superseded {
+			err = errors.New(fmt.Sprintf("error already exists todo with the same name %s in list with id: %s", name, targetListId))
+			log.Error(err)
+			return err
+		}
+
+		todoEntity.ListId, todoEntity.Name, todoEntity.Status = targetListId, name, status
+		memory.Put(r.store, r.store.Todos, todoId, *todoEntity)
+		memory.Change(r.store, &r.store.TodoLabels)
+		r.store.TodoLabels = slices.DeleteFunc(r.store.TodoLabels, func(todoLabel structures.TodoLabelEntity) bool {
+			return todoLabel.TodoId == todoId
+		})
+		notMember := func(todoUser structures.TodoUserEntity) bool {
+			return todoUser.TodoId == todoId && !r.store.IsListMember(targetListId, todoUser.Username)
+		}
+		memory.Change(r.store, &r.store.TodoAssignees)
+		r.store.TodoAssignees = slices.DeleteFunc(r.store.TodoAssignees, notMember)
+		memory.Change(r.store, &r.store.TodoWatchers)
+		r.store.TodoWatchers = slices.DeleteFunc(r.store.TodoWatchers, notMember)
+		for subtaskId, subtaskEntity := range r.store.Subtasks {
+			if subtaskEntity.TodoId == todoId && subtaskEntity.Assignee != "" && !r.store.IsListMember(targetListId, subtaskEntity.Assignee) {
+				subtaskEntity.Assignee = ""
+				memory.Put(r.store, r.store.Subtasks, subtaskId, subtaskEntity)
+			}
+		}
+
+		if len(memory.TodoUsers(r.store.TodoAssignees, todoId)) == 0 {
+			r.leaveAssigned(todoEntity)
+		}
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) CopyTodo(ctx context.Context, todoId, listId, copyId, targetListId uuid.UUID, name string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		todoEntity, err := r.findTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		status, err := r.targetStatus(ctx, targetListId, todoEntity.Status)
+		if err != nil {
+			return err
+		}
+		if r.findTodoByName(targetListId, name) != nil {
+			err = errors.New(fmt.Sprintf("error already exists todo with the same name %s in list with id: %s", name, targetListId))
+			log.Error(err)
+			return err
+		}
+
+		copyEntity := structures.TodoEntity{
+			Id:           copyId,
+			ListId:       targetListId,
+			Name:         name,
+			Description:  todoEntity.Description,
+			Deadline:     todoEntity.Deadline,
+			CreationDate: time.Now(),
+			Status:       status,
+			Priority:     todoEntity.Priority,
+			Recurrence:   todoEntity.Recurrence,
+		}
+		if todoEntity.Recurrence != "" {
+			copyEntity.SeriesId = uuid.NullUUID{UUID: copyId, Valid: true}
+		}
+		memory.Put(r.store, r.store.Todos, copyId, copyEntity)
+
+		for _, assignee := range todoEntity.Assignees {
+			if r.store.IsListMember(targetListId, assignee) {
+				memory.Append(r.store, &r.store.TodoAssignees, structures.TodoUserEntity{TodoId: copyId, Username: assignee})
+			}
+		}
+		for _, watcher := range todoEntity.Watchers {
+			if r.store.IsListMember(targetListId, watcher) {
+				memory.Append(r.store, &r.store.TodoWatchers, structures.TodoUserEntity{TodoId: copyId, Username: watcher})
+			}
+		}
+		if targetListId == listId {
+			for _, label := range todoEntity.Labels {
+				memory.Append(r.store, &r.store.TodoLabels, structures.TodoLabelEntity{TodoId: copyId, LabelId: label.Id})
+			}
+		}
+		for _, subtaskEntity := range r.store.Subtasks {
+			if subtaskEntity.TodoId != todoId {
+				continue
+			}
+
+			subtaskEntity.Id, subtaskEntity.TodoId = uuid.New(), copyId
+			if !r.store.IsListMember(targetListId, subtaskEntity.Assignee) {
+				subtaskEntity.Assignee = ""
+			}
+			memory.Put(r.store, r.store.Subtasks, subtaskEntity.Id, subtaskEntity)
+		}
+
+		if len(memory.TodoUsers(r.store.TodoAssignees, copyId)) == 0 {
+			r.leaveAssigned(&copyEntity)
+		}
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryTodo) targetStatus(ctx context.Context, listId uuid.UUID, status string) (string, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if _, ok := r.store.WorkflowState(listId, status); ok {
+		return status, nil
+	}
+	initial, ok := r.store.InitialState(listId)
+	if !ok {
+		err := errors.New(fmt.Sprintf("error not found list with id: %s", listId))
+		log.Error(err)
+		return "", err
+	}
+
+	return initial.Name, nil
+}
+
+func (r *MemoryRepositoryTodo) findTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	todoEntity, ok := r.store.Todos[todoId]
+	if !ok || todoEntity.ListId != listId {
+		err := errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
+		log.Error(err)
+		return nil, err
+	}
+
+	todoEntity = r.store.WithComputedColumns(todoEntity)
+	return &todoEntity, nil
+}
+
+// findTodoByName does not look at the superseded occurrences of a series, they share the name of the next one.
+func (r *MemoryRepositoryTodo) findTodoByName(listId uuid.UUID, name string) *structures.TodoEntity {
+	for _, todoEntity := range r.store.Todos {
+		if todoEntity.ListId == listId && todoEntity.Name == name && !todoEntity.Superseded {
+			return &todoEntity
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package todo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"project/structures"
+	"project/utils"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func todoSortBy(query structures.TodoQuery) string {
+	if query.SortBy == "" {
+		return utils.SortByName
+	}
+
+	return query.SortBy
+}
+
+func validateTodoQuery(query structures.TodoQuery) error {
+	sortBy := todoSortBy(query)
+	if !slices.Contains(utils.TodoSortKeys, sortBy) {
+		return errors.New(fmt.Sprintf("error unknown sort key %s", sortBy))
+	}
+
+	return utils.ValidatePageQuery(query.PageQuery, sortBy, query.Descending)
+}
+
+// todoSortValue is the value a todo is ordered by, in the form it is kept in cursors.
+// Dates are compared by day only because the database stores them as dates, statuses by their place in the workflow.
+func todoSortValue(entity structures.TodoEntity, sortBy string) string {
+	switch sortBy {
+	case utils.SortByDeadline:
+		return entity.Deadline.Format(time.DateOnly)
+	case utils.SortByCreatedAt:
+		return entity.CreationDate.Format(time.DateOnly)
+	case utils.SortByPriority:
+		return entity.Priority
+	case utils.SortByStatus:
+		return strconv.Itoa(entity.StatusPosition)
+	default:
+		return entity.Name
+	}
+}
+
+func compareTodoSortValues(sortBy, a, b string) int {
+	switch sortBy {
+	case utils.SortByPriority:
+		return utils.PriorityRank[a] - utils.PriorityRank[b]
+	case utils.SortByStatus:
+		positionA, _ := strconv.Atoi(a)
+		positionB, _ := strconv.Atoi(b)
+		return positionA - positionB
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// compareTodoToCursor orders the todo against the cursor the same way the database orders
+// the (sort column, id) pair, flipped for descending queries.
+func compareTodoToCursor(entity structures.TodoEntity, cursor structures.Cursor) int {
+	result := compareTodoSortValues(cursor.SortBy, todoSortValue(entity, cursor.SortBy), cursor.Value)
+	if result == 0 {
+		result = bytes.Compare(entity.Id[:], cursor.Id[:])
+	}
+	if cursor.Descending {
+		return -result
+	}
+
+	return result
+}
+
+func todoCursor(entity structures.TodoEntity, query structures.TodoQuery) structures.Cursor {
+	sortBy := todoSortBy(query)
+	return structures.Cursor{
+		SortBy:     sortBy,
+		Descending: query.Descending,
+		Value:      todoSortValue(entity, sortBy),
+		Id:         entity.Id,
+	}
+}
@@ -0,0 +1,237 @@
+package todo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	invalidRecurrenceErrorMsg = "error invalid recurrence"
+
+	dailyFrequency   = "DAILY"
+	weeklyFrequency  = "WEEKLY"
+	monthlyFrequency = "MONTHLY"
+	untilLayout      = "20060102"
+)
+
+var (
+	recurrencePresets = map[string]string{
+		"daily":   "FREQ=" + dailyFrequency,
+		"weekly":  "FREQ=" + weeklyFrequency,
+		"monthly": "FREQ=" + monthlyFrequency,
+	}
+	recurrenceWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+)
+
+// Recurrence is the subset of RFC 5545 recurrence rules the todos support: a DAILY, WEEKLY or MONTHLY
+// frequency with an optional INTERVAL, BYDAY for weekly rules, BYMONTHDAY for monthly rules and either
+// COUNT or UNTIL to end the series.
+type Recurrence struct {
+	Frequency string
+	Interval  int
+	Weekdays  []time.Weekday
+	// MonthDay is the day of the month monthly occurrences are due on, 0 until the series is anchored.
+	MonthDay int
+	// Count is the number of occurrences left including the current one, 0 for no limit.
+	Count int
+	Until time.Time
+}
+
+// ParseRecurrence reads a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH" or one of the
+// daily, weekly and monthly shortcuts.
+func ParseRecurrence(rule string) (*Recurrence, error) {
+	rule = strings.TrimSpace(rule)
+	if preset, ok := recurrencePresets[strings.ToLower(rule)]; ok {
+		rule = preset
+	}
+	rule = strings.TrimPrefix(strings.ToUpper(rule), "RRULE:")
+	if rule == "" {
+		return nil, errors.New(fmt.Sprintf("%s, the rule is empty", invalidRecurrenceErrorMsg))
+	}
+
+	recurrence := Recurrence{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, errors.New(fmt.Sprintf("%s, %s is not a NAME=VALUE pair", invalidRecurrenceErrorMsg, part))
+		}
+		if seen[name] {
+			return nil, errors.New(fmt.Sprintf("%s, %s is given twice", invalidRecurrenceErrorMsg, name))
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			if value != dailyFrequency && value != weeklyFrequency && value != monthlyFrequency {
+				return nil, errors.New(fmt.Sprintf("%s, FREQ must be %s, %s or %s", invalidRecurrenceErrorMsg, dailyFrequency, weeklyFrequency, monthlyFrequency))
+			}
+			recurrence.Frequency = value
+		case "INTERVAL":
+			recurrence.Interval, err = strconv.Atoi(value)
+			if err != nil || recurrence.Interval < 1 {
+				return nil, errors.New(fmt.Sprintf("%s, INTERVAL must be a positive number", invalidRecurrenceErrorMsg))
+			}
+		case "COUNT":
+			recurrence.Count, err = strconv.Atoi(value)
+			if err != nil || recurrence.Count < 1 {
+				return nil, errors.New(fmt.Sprintf("%s, COUNT must be a positive number", invalidRecurrenceErrorMsg))
+			}
+		case "UNTIL":
+			recurrence.Until, err = time.Parse(untilLayout, strings.SplitN(value, "T", 2)[0])
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("%s, UNTIL must be a date such as 20260131", invalidRecurrenceErrorMsg))
+			}
+		case "BYMONTHDAY":
+			recurrence.MonthDay, err = strconv.Atoi(value)
+			if err != nil || recurrence.MonthDay < 1 || recurrence.MonthDay > 31 {
+				return nil, errors.New(fmt.Sprintf("%s, BYMONTHDAY must be a day of the month", invalidRecurrenceErrorMsg))
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday := slices.Index(recurrenceWeekdays, day)
+				if weekday < 0 {
+					return nil, errors.New(fmt.Sprintf("%s, %s is not a day of the week", invalidRecurrenceErrorMsg, day))
+				}
+				if !slices.Contains(recurrence.Weekdays, time.Weekday(weekday)) {
+					recurrence.Weekdays = append(recurrence.Weekdays, time.Weekday(weekday))
+				}
+			}
+			slices.SortFunc(recurrence.Weekdays, func(a, b time.Weekday) int {
+				return weekdayOffset(a) - weekdayOffset(b)
+			})
+		default:
+			return nil, errors.New(fmt.Sprintf("%s, %s is not supported", invalidRecurrenceErrorMsg, name))
+		}
+	}
+
+	if recurrence.Frequency == "" {
+		return nil, errors.New(fmt.Sprintf("%s, FREQ is required", invalidRecurrenceErrorMsg))
+	}
+	if len(recurrence.Weekdays) > 0 && recurrence.Frequency != weeklyFrequency {
+		return nil, errors.New(fmt.Sprintf("%s, BYDAY is only supported for %s rules", invalidRecurrenceErrorMsg, weeklyFrequency))
+	}
+	if recurrence.MonthDay > 0 && recurrence.Frequency != monthlyFrequency {
+		return nil, errors.New(fmt.Sprintf("%s, BYMONTHDAY is only supported for %s rules", invalidRecurrenceErrorMsg, monthlyFrequency))
+	}
+	if recurrence.Count > 0 && !recurrence.Until.IsZero() {
+		return nil, errors.New(fmt.Sprintf("%s, COUNT and UNTIL cannot be combined", invalidRecurrenceErrorMsg))
+	}
+
+	return &recurrence, nil
+}
+
+// String writes the rule in the canonical form the todos store.
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + r.Frequency}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		days := make([]string, len(r.Weekdays))
+		for i, weekday := range r.Weekdays {
+			days[i] = recurrenceWeekdays[weekday]
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.MonthDay > 0 {
+		parts = append(parts, fmt.Sprintf("BYMONTHDAY=%d", r.MonthDay))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.Format(untilLayout))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Next finds the deadline of the occurrence after the one due on deadline and the rule that occurrence
+// carries on with. It returns false when the occurrence due on deadline is the last one of the series.
+func (r Recurrence) Next(deadline time.Time) (time.Time, Recurrence, bool) {
+	r = r.Anchored(deadline)
+	if r.Count == 1 {
+		return time.Time{}, r, false
+	}
+
+	var next time.Time
+	switch r.Frequency {
+	case dailyFrequency:
+		next = deadline.AddDate(0, 0, r.Interval)
+	case monthlyFrequency:
+		next = addMonths(deadline, r.Interval, r.MonthDay)
+	default:
+		next = r.nextWeekly(deadline)
+	}
+	if !r.Until.IsZero() && !next.Before(r.Until.AddDate(0, 0, 1)) {
+		return time.Time{}, r, false
+	}
+
+	following := r
+	if following.Count > 0 {
+		following.Count--
+	}
+	return next, following, true
+}
+
+// Anchored keeps monthly rules on the day of the month of deadline, so an occurrence moved to the end of a
+// shorter month does not move the ones after it.
+func (r Recurrence) Anchored(deadline time.Time) Recurrence {
+	if r.Frequency == monthlyFrequency && r.MonthDay == 0 {
+		r.MonthDay = deadline.Day()
+	}
+
+	return r
+}
+
+// Upcoming lists the deadlines of at most limit occurrences that follow the one due on deadline.
+func (r Recurrence) Upcoming(deadline time.Time, limit int) []time.Time {
+	upcoming := []time.Time{}
+	for len(upcoming) < limit {
+		var ok bool
+		deadline, r, ok = r.Next(deadline)
+		if !ok {
+			break
+		}
+		upcoming = append(upcoming, deadline)
+	}
+
+	return upcoming
+}
+
+// nextWeekly moves to the next of the chosen days in the same week or, when none is left, to the
+// first of them in the week INTERVAL weeks later. Weeks start on Monday.
+func (r Recurrence) nextWeekly(deadline time.Time) time.Time {
+	if len(r.Weekdays) == 0 {
+		return deadline.AddDate(0, 0, 7*r.Interval)
+	}
+
+	offset := weekdayOffset(deadline.Weekday())
+	for _, weekday := range r.Weekdays {
+		if weekdayOffset(weekday) > offset {
+			return deadline.AddDate(0, 0, weekdayOffset(weekday)-offset)
+		}
+	}
+
+	weekStart := deadline.AddDate(0, 0, -offset)
+	return weekStart.AddDate(0, 0, 7*r.Interval+weekdayOffset(r.Weekdays[0]))
+}
+
+func weekdayOffset(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
+
+// addMonths moves to the day of the month, falling back to the last day of shorter months.
+func addMonths(deadline time.Time, months, day int) time.Time {
+	year, month, _ := deadline.Date()
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, deadline.Hour(), deadline.Minute(), deadline.Second(), deadline.Nanosecond(), deadline.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(day, lastDay)-1)
+}
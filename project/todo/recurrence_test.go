@@ -0,0 +1,152 @@
+package todo_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"project/todo"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	testCases := []struct {
+		name        string
+		inputRule   string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:      "weekly shortcut",
+			inputRule: "Weekly",
+			expected:  "FREQ=WEEKLY",
+		}, {
+			name:      "custom rule in canonical order",
+			inputRule: "RRULE:byday=th,mo,th;freq=weekly;interval=2;count=3",
+			expected:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=3",
+		}, {
+			name:      "rule with an end date",
+			inputRule: "FREQ=MONTHLY;UNTIL=20261231T000000Z",
+			expected:  "FREQ=MONTHLY;UNTIL=20261231",
+		}, {
+			name:      "monthly rule on a day of the month",
+			inputRule: "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=4",
+			expected:  "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=4",
+		}, {
+			name:        "day of the month for a weekly rule",
+			inputRule:   "FREQ=WEEKLY;BYMONTHDAY=3",
+			expectedErr: true,
+		}, {
+			name:        "invalid day of the month",
+			inputRule:   "FREQ=MONTHLY;BYMONTHDAY=32",
+			expectedErr: true,
+		}, {
+			name:        "empty rule",
+			inputRule:   " ",
+			expectedErr: true,
+		}, {
+			name:        "unsupported frequency",
+			inputRule:   "FREQ=YEARLY",
+			expectedErr: true,
+		}, {
+			name:        "days of the week for a daily rule",
+			inputRule:   "FREQ=DAILY;BYDAY=MO",
+			expectedErr: true,
+		}, {
+			name:        "count and until together",
+			inputRule:   "FREQ=DAILY;COUNT=2;UNTIL=20261231",
+			expectedErr: true,
+		}, {
+			name:        "unsupported part",
+			inputRule:   "FREQ=DAILY;BYHOUR=9",
+			expectedErr: true,
+		}, {
+			name:        "invalid interval",
+			inputRule:   "FREQ=DAILY;INTERVAL=0",
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recurrence, err := todo.ParseRecurrence(testCase.inputRule)
+			if testCase.expectedErr {
+				require.ErrorContains(t, err, "error invalid recurrence")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, recurrence.String())
+		})
+	}
+}
+
+func TestRecurrenceUpcoming(t *testing.T) {
+	// 2026-01-29 is a Thursday.
+	deadline := time.Date(2026, 1, 29, 0, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name          string
+		inputRule     string
+		inputDeadline time.Time
+		inputLimit    int
+		expected      []time.Time
+	}{
+		{
+			name:       "daily every other day",
+			inputRule:  "FREQ=DAILY;INTERVAL=2",
+			inputLimit: 3,
+			expected:   []time.Time{date(1, 31), date(2, 2), date(2, 4)},
+		}, {
+			name:       "weekly on chosen days every other week",
+			inputRule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			inputLimit: 4,
+			expected:   []time.Time{date(1, 30), date(2, 9), date(2, 13), date(2, 23)},
+		}, {
+			name:       "monthly on the last day of shorter months",
+			inputRule:  "FREQ=MONTHLY",
+			inputLimit: 2,
+			expected:   []time.Time{date(2, 28), date(3, 29)},
+		}, {
+			name:          "monthly from the last day of the month",
+			inputRule:     "FREQ=MONTHLY",
+			inputDeadline: date(1, 31),
+			inputLimit:    3,
+			expected:      []time.Time{date(2, 28), date(3, 31), date(4, 30)},
+		}, {
+			name:       "monthly on a day of the month",
+			inputRule:  "FREQ=MONTHLY;BYMONTHDAY=30",
+			inputLimit: 2,
+			expected:   []time.Time{date(2, 28), date(3, 30)},
+		}, {
+			name:       "series ending after a number of occurrences",
+			inputRule:  "FREQ=WEEKLY;COUNT=3",
+			inputLimit: 5,
+			expected:   []time.Time{date(2, 5), date(2, 12)},
+		}, {
+			name:       "series ending on a date",
+			inputRule:  "FREQ=WEEKLY;UNTIL=20260212",
+			inputLimit: 5,
+			expected:   []time.Time{date(2, 5), date(2, 12)},
+		}, {
+			name:       "last occurrence of the series",
+			inputRule:  "FREQ=DAILY;COUNT=1",
+			inputLimit: 5,
+			expected:   []time.Time{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recurrence, err := todo.ParseRecurrence(testCase.inputRule)
+			require.NoError(t, err)
+
+			inputDeadline := deadline
+			if !testCase.inputDeadline.IsZero() {
+				inputDeadline = testCase.inputDeadline
+			}
+			require.Equal(t, testCase.expected, recurrence.Upcoming(inputDeadline, testCase.inputLimit))
+		})
+	}
+}
@@ -10,7 +10,9 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 	"project/structures"
+	"project/uow"
 	"project/utils"
+	"slices"
 	"strings"
 	"time"
 )
@@ -21,13 +23,74 @@ var (
 	todoTableName        = "name"
 	todoTableListId      = "list_id"
 	todoTableStatus      = "status"
-	todoTableAssignee    = "assignee"
-	todoColumns          = []string{"id", "list_id", "name", "description", "deadline", "created_at", "assignee", "status", "priority"}
-	insertTodoColumns    = []string{"id", "list_id", "name", "description", "deadline", "priority"}
+	todoTablePriority    = "priority"
+	todoTableDeadline    = "deadline"
+	todoTableDescription = "description"
+	todoTableRecurrence  = "recurrence"
+	todoTableSeriesId    = "series_id"
+	todoTableSuperseded  = "superseded"
+	todoColumns          = []string{"id", "list_id", "name", "description", "deadline", "created_at", "status", "priority", "recurrence", "series_id", "superseded"}
+	selectTodoColumns    = slices.Concat(todoColumns, []string{
+		"(SELECT COUNT(subtask.id) FROM subtask WHERE subtask.todo_id = todo.id) AS subtasks_total",
+		"(SELECT COUNT(subtask.id) FROM subtask WHERE subtask.todo_id = todo.id AND subtask.done) AS subtasks_done",
+		"(SELECT COALESCE(json_agg(json_build_object('id', label.id, 'list_id', label.list_id, 'name', label.name, 'color', label.color) " +
+			"ORDER BY label.name), '[]') FROM todo_label JOIN label ON label.id = todo_label.label_id WHERE todo_label.todo_id = todo.id) AS labels",
+		"(SELECT COALESCE(json_agg(todo_assignee.username ORDER BY todo_assignee.username), '[]') FROM todo_assignee WHERE todo_assignee.todo_id = todo.id) AS assignees",
+		"(SELECT COALESCE(json_agg(todo_watcher.username ORDER BY todo_watcher.username), '[]') FROM todo_watcher WHERE todo_watcher.todo_id = todo.id) AS watchers",
+		todoStatusPosition + " AS status_position",
+		"(SELECT workflow_state.done FROM workflow_state WHERE workflow_state.list_id = todo.list_id AND workflow_state.name = todo.status) AS done",
+		todoDependencyColumn("blocked_by_id", "todo_id") + " AS blocked_by",
+		todoDependencyColumn("todo_id", "blocked_by_id") + " AS blocks",
+	})
+	// todoStatusPosition orders todos by the place of their status in the workflow of the list.
+	todoStatusPosition   = "(SELECT workflow_state.position FROM workflow_state WHERE workflow_state.list_id = todo.list_id AND workflow_state.name = todo.status)"
+	insertTodoColumns    = []string{"id", "list_id", "name", "description", "deadline", "priority", "status", "recurrence", "series_id"}
 	updateSetTodoColumns = []string{"name = ?", "description = ?", "deadline = ?", "priority = ?"}
-	assignTodoColumn     = []string{"assignee = ?", "status = ?"}
+	todoSortColumns      = map[string]string{
+		utils.SortByName:      "name",
+		utils.SortByDeadline:  "deadline",
+		utils.SortByCreatedAt: "created_at",
+		utils.SortByPriority:  "priority",
+		utils.SortByStatus:    todoStatusPosition,
+	}
+	todoLabelTable        = "todo_label"
+	todoLabelTableTodoId  = "todo_id"
+	todoLabelTableLabelId = "label_id"
+	todoLabelColumns      = []string{"todo_id", "label_id"}
+	todoAssigneeTable     = "todo_assignee"
+	todoWatcherTable      = "todo_watcher"
+	todoUserTableTodoId   = "todo_id"
+	todoUserTableUsername = "username"
+	todoUserColumns       = []string{"todo_id", "username"}
+	todoDependencyTable   = "todo_dependency"
+	todoDependencyTodoId  = "todo_id"
+	todoDependencyBlocker = "blocked_by_id"
+	todoDependencyColumns = []string{"todo_id", "blocked_by_id"}
+	subtaskTable          = "subtask"
+	subtaskTableTodoId    = "todo_id"
+	subtaskTableAssignee  = "assignee"
+	subtaskTablePosition  = "position"
+	subtaskColumns        = []string{"id", "todo_id", "title", "done", "assignee", "position"}
+	// todoDependencyLockKey is the advisory lock every new dependency takes, it spells "tdep" in ASCII.
+	todoDependencyLockKey = 0x74646570
+	likeEscaper           = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	// listMembersQuery reads the usernames of the members of a list, only they may stay on its todos.
+	listMembersQuery = "SELECT username FROM users_lists WHERE list_id = ?"
+	// userListsQuery reads the ids of the lists a user is a member of.
+	userListsQuery = "SELECT list_id FROM users_lists WHERE username = ?"
 )
 
+// todoDependencyColumn reads the todos on the other side of the dependencies of the todo as one JSON array,
+// with otherColumn pointing at them and ownColumn at the todo. It reads the todos of every list, so Blocked counts all
+// blockers; the service leaves out the ones in lists the user is not a member of.
+func todoDependencyColumn(otherColumn, ownColumn string) string {
+	return fmt.Sprintf("(SELECT COALESCE(json_agg(json_build_object('id', other.id, 'list_id', other.list_id, 'name', other.name, "+
+		"'done', COALESCE(other_state.done, FALSE)) ORDER BY other.name, other.id), '[]') "+
+		"FROM todo_dependency JOIN todo AS other ON other.id = todo_dependency.%s "+
+		"LEFT JOIN workflow_state AS other_state ON other_state.list_id = other.list_id AND other_state.name = other.status "+
+		"WHERE todo_dependency.%s = todo.id)", otherColumn, ownColumn)
+}
+
 type DBRepositoryTodo struct {
 	db        *sqlx.DB
 	converter RepositoryTodoConvertor
@@ -37,16 +100,44 @@ func NewDBRepositoryTodo(db *sqlx.DB, convertor RepositoryTodoConvertor) *DBRepo
 	return &DBRepositoryTodo{db: db, converter: convertor}
 }
 
+func (r *DBRepositoryTodo) executor(ctx context.Context) uow.Executor {
+	return uow.GetExecutor(ctx, r.db)
+}
+
+// lockTodo reads the todo and locks its row until the end of the running unit of work.
+func (r *DBRepositoryTodo) lockTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoEntity, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s FOR UPDATE`, strings.Join(selectTodoColumns, ", "), todoTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var todoEntity structures.TodoEntity
+	err := r.executor(ctx).Get(&todoEntity, query, todoId, listId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	return &todoEntity, nil
+}
+
 func (r *DBRepositoryTodo) GetTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoModel, error) {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
 	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
-	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(todoColumns, ", "), todoTable, cond)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(selectTodoColumns, ", "), todoTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
 	var todoEntity structures.TodoEntity
-	err := r.db.Get(&todoEntity, query, todoId, listId)
-	if errors.Is(err, sql.ErrNoRows) {
-		err = errors.New(fmt.Sprintf("error getting todo with id: %s", todoId))
+	err := r.executor(ctx).Get(&todoEntity, query, todoId, listId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error getting todo with id: %s", todoId))
+		}
+
 		log.Error(err)
 		return nil, err
 	}
@@ -55,33 +146,105 @@ func (r *DBRepositoryTodo) GetTodo(ctx context.Context, todoId, listId uuid.UUID
 	return &todoModel, nil
 }
 
-func (r *DBRepositoryTodo) GetAllTasks(ctx context.Context, listId uuid.UUID) []structures.TodoModel {
-	cond := fmt.Sprintf(`%s = ?`, todoTableListId)
-	sortBy := fmt.Sprintf(`ORDER BY %s`, todoTableName)
-	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s %s`, strings.Join(todoColumns, ", "), todoTable, cond, sortBy)
-	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+func (r *DBRepositoryTodo) GetAllTasks(ctx context.Context, listId uuid.UUID, query structures.TodoQuery) (*structures.TodoPageModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	err := validateTodoQuery(query)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	conds, args := todoQueryConditions(listId, query)
+	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s WHERE %s`, todoTableId, todoTable, strings.Join(conds, " AND "))
+	var totalCount int
+	err = r.executor(ctx).Get(&totalCount, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	pageConds, pageArgs, sortBy := utils.KeysetConditions(query.PageQuery, todoSortColumns[todoSortBy(query)], todoTableId, query.Descending)
+	conds, args = append(conds, pageConds...), append(args, pageArgs...)
+	stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s %s`, strings.Join(selectTodoColumns, ", "), todoTable, strings.Join(conds, " AND "), sortBy)
+	if rowLimit := utils.RowLimit(query.PageQuery); rowLimit > 0 {
+		stmt += ` LIMIT ?`
+		args = append(args, rowLimit)
+	}
 	var entities []structures.TodoEntity
-	err := r.db.Select(&entities, query, listId)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil
+	err = r.executor(ctx).Select(&entities, sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	utils.ReverseLastPage(query.PageQuery, entities)
+
+	entities, pageInfo := utils.PageItems(query.PageQuery, entities, func(entity structures.TodoEntity) structures.Cursor {
+		return todoCursor(entity, query)
+	})
+	pageInfo.TotalCount = totalCount
+	return &structures.TodoPageModel{
+		Todos:    r.converter.ConvertEntitiesToModels(entities),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func todoQueryConditions(listId uuid.UUID, query structures.TodoQuery) ([]string, []any) {
+	conds := []string{fmt.Sprintf(`%s = ?`, todoTableListId)}
+	args := []any{listId}
+	if query.Status != "" {
+		conds = append(conds, fmt.Sprintf(`%s = ?`, todoTableStatus))
+		args = append(args, query.Status)
+	}
+	if query.Priority != "" {
+		conds = append(conds, fmt.Sprintf(`%s = ?`, todoTablePriority))
+		args = append(args, query.Priority)
+	}
+	if query.Assignee != "" {
+		conds = append(conds, `EXISTS (SELECT 1 FROM todo_assignee WHERE todo_assignee.todo_id = todo.id AND todo_assignee.username = ?)`)
+		args = append(args, query.Assignee)
+	}
+	if !query.DeadlineFrom.IsZero() {
+		conds = append(conds, fmt.Sprintf(`%s >= ?`, todoTableDeadline))
+		args = append(args, query.DeadlineFrom.Format(time.DateOnly))
+	}
+	if !query.DeadlineTo.IsZero() {
+		conds = append(conds, fmt.Sprintf(`%s <= ?`, todoTableDeadline))
+		args = append(args, query.DeadlineTo.Format(time.DateOnly))
+	}
+	if query.Text != "" {
+		conds = append(conds, fmt.Sprintf(`(%s ILIKE ? OR %s ILIKE ?)`, todoTableName, todoTableDescription))
+		pattern := "%" + likeEscaper.Replace(query.Text) + "%"
+		args = append(args, pattern, pattern)
+	}
+	if query.Label != "" {
+		conds = append(conds, `EXISTS (SELECT 1 FROM todo_label JOIN label ON label.id = todo_label.label_id WHERE todo_label.todo_id = todo.id AND label.name = ?)`)
+		args = append(args, query.Label)
 	}
 
-	return r.converter.ConvertEntitiesToModels(entities)
+	return conds, args
 }
 
+// CreateTodo stores the todo in the first state of the workflow of its list.
 func (r *DBRepositoryTodo) CreateTodo(ctx context.Context, input structures.TodoEntity) error {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	tx, err := r.db.Beginx()
+	stmt := `SELECT name FROM workflow_state WHERE list_id = ? ORDER BY position LIMIT 1 FOR SHARE`
+	var initialStatus string
+	err := r.executor(ctx).Get(&initialStatus, sqlx.Rebind(sqlx.DOLLAR, stmt), input.ListId)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found list with id: %s", input.ListId))
+		}
+
 		log.Error(err)
 		return err
 	}
-	defer tx.Rollback()
 
-	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES(?, ?, ?, ?, ?, ?)`, todoTable, strings.Join(insertTodoColumns, ", "))
+	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`, todoTable, strings.Join(insertTodoColumns, ", "))
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := r.db.Exec(query, input.Id, input.ListId, input.Name, input.Description, input.Deadline, input.Priority)
+	result, err := r.executor(ctx).Exec(query, input.Id, input.ListId, input.Name, input.Description, input.Deadline, input.Priority, initialStatus,
+		input.Recurrence, input.SeriesId)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error already exists todo with the same name %s in list with id: %s", input.Name, input.ListId))
@@ -104,34 +267,21 @@ func (r *DBRepositoryTodo) CreateTodo(ctx context.Context, input structures.Todo
 		return err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Error(err)
-	}
-
-	return err
+	return nil
 }
 
 func (r *DBRepositoryTodo) DeleteTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoModel, error) {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	tx, err := r.db.Beginx()
-	if err != nil {
-		log.Error(err)
-		return nil, err
-	}
-	defer tx.Rollback()
-
-	deletedTodo, err := r.GetTodo(ctx, todoId, listId)
+	deletedTodo, err := r.lockTodo(ctx, todoId, listId)
 	if err != nil {
-		log.Error(err)
 		return nil, err
 	}
 
 	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
 	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, todoTable, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := r.db.Exec(query, todoId, listId)
+	result, err := r.executor(ctx).Exec(query, todoId, listId)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
@@ -152,15 +302,11 @@ func (r *DBRepositoryTodo) DeleteTodo(ctx context.Context, todoId, listId uuid.U
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Error(err)
-	}
-
-	return deletedTodo, err
+	todoModel := r.converter.ConvertEntityToModel(*deletedTodo)
+	return &todoModel, nil
 }
 
-func (r *DBRepositoryTodo) validate(originalTodo *structures.TodoEntity, updateTodo structures.TodoEntity) {
+func applyTodoUpdate(originalTodo *structures.TodoEntity, updateTodo structures.TodoEntity) {
 	if updateTodo.Name != "" {
 		originalTodo.Name = updateTodo.Name
 	}
@@ -179,29 +325,16 @@ func (r *DBRepositoryTodo) validate(originalTodo *structures.TodoEntity, updateT
 func (r *DBRepositoryTodo) UpdateTodo(ctx context.Context, updatedTask structures.TodoEntity, listId uuid.UUID) (*structures.TodoModel, error) {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	tx, err := r.db.Beginx()
+	todoEntity, err := r.lockTodo(ctx, updatedTask.Id, listId)
 	if err != nil {
-		log.Error(err)
 		return nil, err
 	}
-	defer tx.Rollback()
+	applyTodoUpdate(todoEntity, updatedTask)
 
-	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
-	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(todoColumns, ", "), todoTable, cond)
+	cond := fmt.Sprintf(`%s = ?`, todoTableId)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s WHERE %s`, todoTable, strings.Join(updateSetTodoColumns, ", "), cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	var todoEntity structures.TodoEntity
-	err = r.db.Get(&todoEntity, query, updatedTask.Id, listId)
-	if errors.Is(err, sql.ErrNoRows) {
-		err = errors.New(fmt.Sprintf("error not found todo with id: %s", updatedTask.Id))
-		log.Error(err)
-		return nil, err
-	}
-	r.validate(&todoEntity, updatedTask)
-
-	cond = fmt.Sprintf(`%s = ?`, todoTableId)
-	stmt = fmt.Sprintf(`UPDATE %s SET %s WHERE %s`, todoTable, strings.Join(updateSetTodoColumns, ", "), cond)
-	query = sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := r.db.Exec(query, todoEntity.Name, todoEntity.Description, todoEntity.Deadline, todoEntity.Priority, todoEntity.Id)
+	result, err := r.executor(ctx).Exec(query, todoEntity.Name, todoEntity.Description, todoEntity.Deadline, todoEntity.Priority, todoEntity.Id)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", updatedTask.Id, listId))
@@ -219,48 +352,103 @@ func (r *DBRepositoryTodo) UpdateTodo(ctx context.Context, updatedTask structure
 		return nil, err
 	}
 	if affectedRows != 1 {
-		err = errors.New(fmt.Sprintf("error updating todo with id: %s", todoId))
+		err = errors.New(fmt.Sprintf("error updating todo with id: %s", updatedTask.Id))
 		log.Error(err)
 		return nil, err
 	}
 
-	err = tx.Commit()
+	todoModel := r.converter.ConvertEntityToModel(*todoEntity)
+	return &todoModel, nil
+}
+
+// AssignTodoToUser also moves the todo to Assigned when the workflow of the list allows it.
+func (r *DBRepositoryTodo) AssignTodoToUser(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if username == "" {
+		err := errors.New("username is required")
+		log.Error(err)
+		return err
+	}
+
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
 	if err != nil {
+		return err
+	}
+	if slices.Contains(todoEntity.Assignees, username) {
+		err = errors.New(fmt.Sprintf("error assigning %s because the user is already assigned to todo with id: %s", username, todoId))
 		log.Error(err)
-		return nil, err
+		return err
 	}
 
-	todoModel := r.converter.ConvertEntityToModel(todoEntity)
-	return &todoModel, nil
+	err = r.insertTodoUser(ctx, todoAssigneeTable, todoId, username)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	allowed, err := r.allowsTransition(ctx, listId, todoEntity.Status, utils.Assigned)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return nil
+	}
+
+	return r.setTodoColumns(ctx, todoId, listId, []string{todoTableStatus}, []any{utils.Assigned},
+		fmt.Sprintf("error assigning %s to todo with id: %s", username, todoId))
 }
 
-func (r *DBRepositoryTodo) AssignTodoToUser(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+// ChangeTodoStatus moves the todo to status along one of the transitions of the workflow of its list.
+func (r *DBRepositoryTodo) ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID, status string) error {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	tx, err := r.db.Beginx()
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
 	if err != nil {
+		return err
+	}
+	if todoEntity.Status == status {
+		err = errors.New(fmt.Sprintf("error changing status of todo with id: %s because it is already %s", todoId, status))
 		log.Error(err)
 		return err
 	}
-	defer tx.Rollback()
 
-	if username == "" {
-		err = errors.New(fmt.Sprintf("username is required"))
+	stmt := `SELECT name FROM workflow_state WHERE list_id = ? AND name = ? FOR SHARE`
+	var name string
+	err = r.executor(ctx).Get(&name, sqlx.Rebind(sqlx.DOLLAR, stmt), listId, status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error changing status of todo with id: %s because %s is not a status in the workflow of list with id: %s", todoId, status, listId))
+		}
+
 		log.Error(err)
 		return err
 	}
-
-	assignee := r.GetTodoAssignee(ctx, todoId)
-	if assignee != "" {
-		err = errors.New(fmt.Sprintf("error assigning %s because %s is already assigned to todo with id: %s", username, assignee, todoId))
+	allowed, err := r.allowsTransition(ctx, listId, todoEntity.Status, status)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		err = errors.New(fmt.Sprintf("error changing status of todo with id: %s from %s to %s is not allowed by the workflow", todoId, todoEntity.Status, status))
 		log.Error(err)
 		return err
 	}
+	if isBlocked(todoEntity.BlockedBy) {
+		past, err := r.isPastInProgress(ctx, listId, status)
+		if err != nil {
+			return err
+		}
+		if past {
+			err = errors.New(fmt.Sprintf("error changing status of todo with id: %s to %s because it is blocked by todos that are not done", todoId, status))
+			log.Error(err)
+			return err
+		}
+	}
 
 	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
-	stmt := fmt.Sprintf(`UPDATE %s SET %s WHERE %s`, todoTable, strings.Join(assignTodoColumn, ", "), cond)
+	stmt = fmt.Sprintf(`UPDATE %s SET %s = ? WHERE %s`, todoTable, todoTableStatus, cond)
 	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	result, err := r.db.Exec(query, username, utils.Assigned, todoId, listId)
+	result, err := r.executor(ctx).Exec(query, status, todoId, listId)
 	if err != nil {
 		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
 			err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
@@ -276,100 +464,763 @@ func (r *DBRepositoryTodo) AssignTodoToUser(ctx context.Context, todoId, listId
 		return err
 	}
 	if affectedRows != 1 {
-		err = errors.New(fmt.Sprintf("error assigning %s to todo with id: %s", username, todoId))
+		err = errors.New(fmt.Sprintf("error changing status to todo with id: %s", todoId))
 		log.Error(err)
 		return err
 	}
 
-	err = tx.Commit()
+	return nil
+}
+
+// isPastInProgress tells whether status is a done status or comes after In Progress in the workflow of the list,
+// which a blocked todo may not reach. Without In Progress in the workflow only the done statuses are past it.
+func (r *DBRepositoryTodo) isPastInProgress(ctx context.Context, listId uuid.UUID, status string) (bool, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := `SELECT done OR position > COALESCE((SELECT position FROM workflow_state WHERE list_id = ? AND name = ?), position) ` +
+		`FROM workflow_state WHERE list_id = ? AND name = ?`
+	var past bool
+	err := r.executor(ctx).Get(&past, sqlx.Rebind(sqlx.DOLLAR, stmt), listId, utils.InProgress, listId, status)
 	if err != nil {
 		log.Error(err)
+		return false, err
 	}
 
-	return err
+	return past, nil
 }
 
-func (r *DBRepositoryTodo) getStatus(todoId uuid.UUID) string {
-	cond := fmt.Sprintf(`%s = ?`, todoTableId)
-	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, todoTableStatus, todoTable, cond)
-	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	var status string
-	err := r.db.Get(&status, query, todoId)
+// allowsTransition tells whether the workflow of the list leads from one status to the other. The transition
+// stays locked until the end of the running unit of work, so the workflow cannot drop it meanwhile.
+func (r *DBRepositoryTodo) allowsTransition(ctx context.Context, listId uuid.UUID, from, to string) (bool, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := `SELECT to_state FROM workflow_transition WHERE list_id = ? AND from_state = ? AND to_state = ? FOR SHARE`
+	var toState string
+	err := r.executor(ctx).Get(&toState, sqlx.Rebind(sqlx.DOLLAR, stmt), listId, from, to)
 	if errors.Is(err, sql.ErrNoRows) {
-		return utils.Undefined
+		return false, nil
+	}
+	if err != nil {
+		log.Error(err)
+		return false, err
 	}
 
-	return status
+	return true, nil
 }
 
-func (r *DBRepositoryTodo) ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID) error {
+// ReopenTodo moves a done todo back to the closest status before it that is not done.
+func (r *DBRepositoryTodo) ReopenTodo(ctx context.Context, todoId, listId uuid.UUID) error {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	tx, err := r.db.Beginx()
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
 	if err != nil {
+		return err
+	}
+	if !todoEntity.Done {
+		err = errors.New(fmt.Sprintf("error reopening todo with id: %s because %s is not a done status", todoId, todoEntity.Status))
 		log.Error(err)
 		return err
 	}
-	defer tx.Rollback()
 
-	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
-	stmt := fmt.Sprintf(`UPDATE %s SET %s = ? WHERE %s`, todoTable, todoTableStatus, cond)
-	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	currentStatus := r.getStatus(todoId)
-	result, err := r.db.Exec(query, utils.NextStatus(currentStatus), todoId, listId)
+	status, err := r.statusBefore(ctx, listId, todoEntity.StatusPosition, true)
 	if err != nil {
-		if strings.Contains(err.Error(), utils.NotFoundSQLErrorMsg) {
-			err = errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", todoId, listId))
-		}
+		return err
+	}
+	if status == "" {
+		err = errors.New(fmt.Sprintf("error reopening todo with id: %s because no open status comes before %s", todoId, todoEntity.Status))
+		log.Error(err)
+		return err
+	}
+
+	return r.setTodoColumns(ctx, todoId, listId, []string{todoTableStatus}, []any{status},
+		fmt.Sprintf("error reopening todo with id: %s", todoId))
+}
+
+// StepBackTodo moves an open todo to the status right before its current one in the workflow.
+func (r *DBRepositoryTodo) StepBackTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+	if todoEntity.Done {
+		err = errors.New(fmt.Sprintf("error stepping back todo with id: %s because it is done, reopen it instead", todoId))
 		log.Error(err)
 		return err
 	}
 
-	affectedRows, err := result.RowsAffected()
+	status, err := r.statusBefore(ctx, listId, todoEntity.StatusPosition, false)
+	if err != nil {
+		return err
+	}
+	if status == "" {
+		err = errors.New(fmt.Sprintf("error stepping back todo with id: %s because %s is the first status of the workflow", todoId, todoEntity.Status))
+		log.Error(err)
+		return err
+	}
+
+	return r.setTodoColumns(ctx, todoId, listId, []string{todoTableStatus}, []any{status},
+		fmt.Sprintf("error stepping back todo with id: %s", todoId))
+}
+
+// UnassignTodo removes all assignees of the todo.
+func (r *DBRepositoryTodo) UnassignTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
 	if err != nil {
+		return err
+	}
+	if len(todoEntity.Assignees) == 0 {
+		err = errors.New(fmt.Sprintf("error unassigning todo with id: %s because it is not assigned", todoId))
 		log.Error(err)
 		return err
 	}
+
+	_, err = r.deleteTodoUsers(ctx, todoAssigneeTable, todoId)
+	if err != nil {
+		return err
+	}
+
+	return r.leaveAssigned(ctx, todoEntity)
+}
+
+func (r *DBRepositoryTodo) RemoveTodoAssignee(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := r.deleteTodoUsers(ctx, todoAssigneeTable, todoId, username)
+	if err != nil {
+		return err
+	}
 	if affectedRows != 1 {
-		err = errors.New(fmt.Sprintf("error changing status to todo with id: %s", todoId))
+		err = errors.New(fmt.Sprintf("error not found assignee %s on todo with id: %s", username, todoId))
+		log.Error(err)
+		return err
+	}
+	if len(todoEntity.Assignees) > 1 {
+		return nil
+	}
+
+	return r.leaveAssigned(ctx, todoEntity)
+}
+
+// leaveAssigned moves a todo that lost all of its assignees from Assigned back to the first status of the workflow.
+func (r *DBRepositoryTodo) leaveAssigned(ctx context.Context, todoEntity *structures.TodoEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if todoEntity.Status != utils.Assigned {
+		return nil
+	}
+
+	stmt := `SELECT name FROM workflow_state WHERE list_id = ? ORDER BY position LIMIT 1 FOR SHARE`
+	var status string
+	err := r.executor(ctx).Get(&status, sqlx.Rebind(sqlx.DOLLAR, stmt), todoEntity.ListId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return r.setTodoColumns(ctx, todoEntity.Id, todoEntity.ListId, []string{todoTableStatus}, []any{status},
+		fmt.Sprintf("error unassigning todo with id: %s", todoEntity.Id))
+}
+
+// ReassignTodo hands an assigned todo over to another user, who replaces all of its assignees, without touching its status.
+func (r *DBRepositoryTodo) ReassignTodo(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if username == "" {
+		err := errors.New("username is required")
 		log.Error(err)
 		return err
 	}
 
-	err = tx.Commit()
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
 	if err != nil {
+		return err
+	}
+	if len(todoEntity.Assignees) == 0 {
+		err = errors.New(fmt.Sprintf("error reassigning todo with id: %s because it is not assigned", todoId))
+		log.Error(err)
+		return err
+	}
+	if slices.Equal(todoEntity.Assignees, []string{username}) {
+		err = errors.New(fmt.Sprintf("error reassigning todo with id: %s because it is already assigned to %s", todoId, username))
 		log.Error(err)
+		return err
 	}
 
-	return err
+	_, err = r.deleteTodoUsers(ctx, todoAssigneeTable, todoId)
+	if err != nil {
+		return err
+	}
+	err = r.insertTodoUser(ctx, todoAssigneeTable, todoId, username)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	return nil
 }
 
-func (r *DBRepositoryTodo) CheckIfListContainsTodo(ctx context.Context, todoId, listId uuid.UUID) bool {
+func (r *DBRepositoryTodo) AddTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error {
 	log := ctx.Value(utils.Logger).(*logrus.Entry)
 
-	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
-	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s WHERE %s`, todoTableId, todoTable, cond)
-	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	var count int
-	err := r.db.Get(&count, query, todoId, listId)
-	if errors.Is(err, sql.ErrNoRows) {
+	if username == "" {
+		err := errors.New("username is required")
 		log.Error(err)
-		return false
+		return err
 	}
 
-	return count == 1
+	_, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+
+	err = r.insertTodoUser(ctx, todoWatcherTable, todoId, username)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists watcher %s on todo with id: %s", username, todoId))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	return nil
 }
 
-func (r *DBRepositoryTodo) GetTodoAssignee(ctx context.Context, todoId uuid.UUID) string {
-	var assignee string
-	cond := fmt.Sprintf(`%s = ?`, todoTableId)
-	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, todoTableAssignee, todoTable, cond)
-	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
-	err := r.db.Get(&assignee, query, todoId)
-	if errors.Is(err, sql.ErrNoRows) {
-		return ""
+func (r *DBRepositoryTodo) RemoveTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	_, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := r.deleteTodoUsers(ctx, todoWatcherTable, todoId, username)
+	if err != nil {
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found watcher %s on todo with id: %s", username, todoId))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// SetTodoRecurrence replaces the rule of the todo, which starts a series named after it when it is in none.
+func (r *DBRepositoryTodo) SetTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID, rule string) error {
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+	seriesId := todoEntity.SeriesId
+	if !seriesId.Valid {
+		seriesId = uuid.NullUUID{UUID: todoId, Valid: true}
+	}
+
+	return r.setTodoColumns(ctx, todoId, listId, []string{todoTableRecurrence, todoTableSeriesId}, []any{rule, seriesId},
+		fmt.Sprintf("error updating recurrence of todo with id: %s", todoId))
+}
+
+// StopTodoRecurrence clears the rule of the todo, so completing it no longer creates another occurrence.
+func (r *DBRepositoryTodo) StopTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+	if todoEntity.Recurrence == "" {
+		err = errors.New(fmt.Sprintf("error not found recurrence on todo with id: %s", todoId))
+		log.Error(err)
+		return err
+	}
+
+	return r.setTodoColumns(ctx, todoId, listId, []string{todoTableRecurrence}, []any{""},
+		fmt.Sprintf("error updating recurrence of todo with id: %s", todoId))
+}
+
+// SupersedeTodo clears the rule of the occurrence and hands its name over to the next occurrence of its series.
+func (r *DBRepositoryTodo) SupersedeTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	return r.setTodoColumns(ctx, todoId, listId, []string{todoTableRecurrence, todoTableSuperseded}, []any{"", true},
+		fmt.Sprintf("error updating recurrence of todo with id: %s", todoId))
+}
+
+// AddTodoDependency makes the todo wait for the blocker, which may be in another list. A cycle can be closed through
+// any other dependencies, so every new dependency takes the transaction-level dependency lock before looking for
+// one and keeps it until the end of the running unit of work.
+func (r *DBRepositoryTodo) AddTodoDependency(ctx context.Context, todoId, listId, blockerId, blockerListId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	if todoId == blockerId {
+		err := errors.New(fmt.Sprintf("%s of todo with id: %s on itself", dependencyErrorMsg, todoId))
+		log.Error(err)
+		return err
+	}
+
+	_, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, `SELECT pg_advisory_xact_lock(?)`), todoDependencyLockKey)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	_, err = r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+	_, err = r.lockTodo(ctx, blockerId, blockerListId)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf(`WITH RECURSIVE blockers(id) AS (`+
+		`SELECT %[1]s FROM %[2]s WHERE %[3]s = ? UNION SELECT %[2]s.%[1]s FROM %[2]s JOIN blockers ON %[2]s.%[3]s = blockers.id) `+
+		`SELECT COUNT(id) FROM blockers WHERE id = ?`, todoDependencyBlocker, todoDependencyTable, todoDependencyTodoId)
+	var count int
+	err = r.executor(ctx).Get(&count, sqlx.Rebind(sqlx.DOLLAR, stmt), blockerId, todoId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if count > 0 {
+		err = errors.New(fmt.Sprintf("%s of todo with id: %s on todo with id: %s would create a cycle", dependencyErrorMsg, todoId, blockerId))
+		log.Error(err)
+		return err
+	}
+
+	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?)`, todoDependencyTable, strings.Join(todoDependencyColumns, ", "))
+	_, err = r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), todoId, blockerId)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists dependency on todo with id %s in todo with id: %s", blockerId, todoId))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositoryTodo) RemoveTodoDependency(ctx context.Context, todoId, listId, blockerId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	_, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoDependencyTodoId, todoDependencyBlocker)
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, todoDependencyTable, cond)
+	result, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), todoId, blockerId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found dependency on todo with id %s in todo with id: %s", blockerId, todoId))
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// ContainsTodoName tells whether the list has a todo with the name, the superseded occurrences of a series aside.
+func (r *DBRepositoryTodo) ContainsTodoName(ctx context.Context, listId uuid.UUID, name string) (bool, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ? AND NOT %s`, todoTableListId, todoTableName, todoTableSuperseded)
+	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s WHERE %s`, todoTableId, todoTable, cond)
+	var count int
+	err := r.executor(ctx).Get(&count, sqlx.Rebind(sqlx.DOLLAR, stmt), listId, name)
+	if err != nil {
+		log.Error(err)
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// MoveTodo hands the todo over to the target list under name. It keeps its status when the workflow of the target
+// list has it and starts over otherwise. The labels of the old list are detached and only the members of the target
+// list stay assigned to it, to its subtasks or watching it.
+func (r *DBRepositoryTodo) MoveTodo(ctx context.Context, todoId, listId, targetListId uuid.UUID, name string) error {
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+	status, err := r.targetStatus(ctx, targetListId, todoEntity.Status)
+	if err != nil {
+		return err
+	}
+
+	err = r.setTodoColumns(ctx, todoId, listId, []string{todoTableListId, todoTableName, todoTableStatus}, []any{targetListId, name, status},
+		fmt.Sprintf("error moving todo with id: %s", todoId))
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists todo with the same name %s in list with id: %s", name, targetListId))
+		}
+
+		return err
+	}
+
+	err = r.execute(ctx, fmt.Sprintf(`DELETE FROM %s WHERE %s = ?`, todoLabelTable, todoLabelTableTodoId), todoId)
+	if err != nil {
+		return err
+	}
+	for _, table := range []string{todoAssigneeTable, todoWatcherTable} {
+		stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s = ? AND %s NOT IN (%s)`, table, todoUserTableTodoId, todoUserTableUsername, listMembersQuery)
+		err = r.execute(ctx, stmt, todoId, targetListId)
+		if err != nil {
+			return err
+		}
+	}
+	stmt := fmt.Sprintf(`UPDATE %[1]s SET %[2]s = '' WHERE %[3]s = ? AND %[2]s <> '' AND %[2]s NOT IN (%[4]s)`,
+		subtaskTable, subtaskTableAssignee, subtaskTableTodoId, listMembersQuery)
+	err = r.execute(ctx, stmt, todoId, targetListId)
+	if err != nil {
+		return err
+	}
+
+	todoEntity.ListId, todoEntity.Status = targetListId, status
+	if len(r.GetTodoAssignees(ctx, todoId)) > 0 {
+		return nil
+	}
+
+	return r.leaveAssigned(ctx, todoEntity)
+}
+
+// CopyTodo creates a copy of the todo with copyId in the target list under name, in the status MoveTodo would give it.
+// The copy gets the subtasks of the todo, its labels when it stays in the same list and the assignees and watchers who
+// are members of the target list. The copy of a recurring todo starts a series of its own.
+func (r *DBRepositoryTodo) CopyTodo(ctx context.Context, todoId, listId, copyId, targetListId uuid.UUID, name string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	todoEntity, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+	status, err := r.targetStatus(ctx, targetListId, todoEntity.Status)
+	if err != nil {
+		return err
+	}
+	seriesId := uuid.NullUUID{}
+	if todoEntity.Recurrence != "" {
+		seriesId = uuid.NullUUID{UUID: copyId, Valid: true}
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`, todoTable, strings.Join(insertTodoColumns, ", "))
+	_, err = r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), copyId, targetListId, name, todoEntity.Description, todoEntity.Deadline,
+		todoEntity.Priority, status, todoEntity.Recurrence, seriesId)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists todo with the same name %s in list with id: %s", name, targetListId))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	for _, table := range []string{todoAssigneeTable, todoWatcherTable} {
+		stmt = fmt.Sprintf(`INSERT INTO %[1]s(%[2]s) SELECT ?, %[3]s FROM %[1]s WHERE %[4]s = ? AND %[3]s IN (%[5]s)`,
+			table, strings.Join(todoUserColumns, ", "), todoUserTableUsername, todoUserTableTodoId, listMembersQuery)
+		err = r.execute(ctx, stmt, copyId, todoId, targetListId)
+		if err != nil {
+			return err
+		}
+	}
+	if targetListId == listId {
+		stmt = fmt.Sprintf(`INSERT INTO %[1]s(%[2]s) SELECT ?, %[3]s FROM %[1]s WHERE %[4]s = ?`,
+			todoLabelTable, strings.Join(todoLabelColumns, ", "), todoLabelTableLabelId, todoLabelTableTodoId)
+		err = r.execute(ctx, stmt, copyId, todoId)
+		if err != nil {
+			return err
+		}
+	}
+	err = r.copySubtasks(ctx, todoId, copyId, targetListId)
+	if err != nil {
+		return err
+	}
+
+	todoEntity.Id, todoEntity.ListId, todoEntity.Status = copyId, targetListId, status
+	if len(r.GetTodoAssignees(ctx, copyId)) > 0 {
+		return nil
+	}
+
+	return r.leaveAssigned(ctx, todoEntity)
+}
+
+// copySubtasks gives the copy of the todo its subtasks, only the assignees who are members of the list of the copy
+// stay on them.
+func (r *DBRepositoryTodo) copySubtasks(ctx context.Context, todoId, copyId, listId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = ? ORDER BY %s`,
+		strings.Join(subtaskColumns, ", "), subtaskTable, subtaskTableTodoId, subtaskTablePosition)
+	var subtasks []structures.SubtaskEntity
+	err := r.executor(ctx).Select(&subtasks, sqlx.Rebind(sqlx.DOLLAR, stmt), todoId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if len(subtasks) == 0 {
+		return nil
+	}
+	var members []string
+	err = r.executor(ctx).Select(&members, sqlx.Rebind(sqlx.DOLLAR, listMembersQuery), listId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES(?, ?, ?, ?, ?, ?)`, subtaskTable, strings.Join(subtaskColumns, ", "))
+	for _, subtask := range subtasks {
+		if !slices.Contains(members, subtask.Assignee) {
+			subtask.Assignee = ""
+		}
+		err = r.execute(ctx, stmt, uuid.New(), copyId, subtask.Title, subtask.Done, subtask.Assignee, subtask.Position)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// targetStatus is the status a todo in status takes in the workflow of the list, the same one when the workflow has it
+// and the first one otherwise.
+func (r *DBRepositoryTodo) targetStatus(ctx context.Context, listId uuid.UUID, status string) (string, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	stmt := `SELECT name FROM workflow_state WHERE list_id = ? ORDER BY name = ? DESC, position LIMIT 1 FOR SHARE`
+	var targetStatus string
+	err := r.executor(ctx).Get(&targetStatus, sqlx.Rebind(sqlx.DOLLAR, stmt), listId, status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error not found list with id: %s", listId))
+		}
+
+		log.Error(err)
+		return "", err
+	}
+
+	return targetStatus, nil
+}
+
+func (r *DBRepositoryTodo) execute(ctx context.Context, stmt string, args ...any) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	_, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return err
+}
+
+func (r *DBRepositoryTodo) insertTodoUser(ctx context.Context, table string, todoId uuid.UUID, username string) error {
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?)`, table, strings.Join(todoUserColumns, ", "))
+	_, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), todoId, username)
+	return err
+}
+
+// deleteTodoUsers removes the rows of the todo from table, only the one of username when it is given.
+func (r *DBRepositoryTodo) deleteTodoUsers(ctx context.Context, table string, todoId uuid.UUID, username ...string) (int64, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ?`, todoUserTableTodoId)
+	args := []any{todoId}
+	if len(username) > 0 {
+		cond += fmt.Sprintf(` AND %s = ?`, todoUserTableUsername)
+		args = append(args, username[0])
+	}
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, table, cond)
+	result, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), args...)
+	if err != nil {
+		log.Error(err)
+		return 0, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return 0, err
+	}
+
+	return affectedRows, nil
+}
+
+// statusBefore finds the closest status of the workflow before position, skipping the done ones when asked.
+// It returns an empty name when there is none.
+func (r *DBRepositoryTodo) statusBefore(ctx context.Context, listId uuid.UUID, position int, skipDone bool) (string, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := `list_id = ? AND position < ?`
+	if skipDone {
+		cond += ` AND NOT done`
+	}
+	stmt := fmt.Sprintf(`SELECT name FROM workflow_state WHERE %s ORDER BY position DESC LIMIT 1 FOR SHARE`, cond)
+	var status string
+	err := r.executor(ctx).Get(&status, sqlx.Rebind(sqlx.DOLLAR, stmt), listId, position)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		log.Error(err)
+		return "", err
+	}
+
+	return status, nil
+}
+
+// setTodoColumns sets the columns of the todo to values and fails with failure when the todo was not updated.
+func (r *DBRepositoryTodo) setTodoColumns(ctx context.Context, todoId, listId uuid.UUID, columns []string, values []any, failure string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	set := make([]string, len(columns))
+	for i, column := range columns {
+		set[i] = column + " = ?"
+	}
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s WHERE %s`, todoTable, strings.Join(set, ", "), cond)
+	result, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), append(values, todoId, listId)...)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(failure)
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositoryTodo) CheckIfListContainsTodo(ctx context.Context, todoId, listId uuid.UUID) bool {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoTableId, todoTableListId)
+	stmt := fmt.Sprintf(`SELECT COUNT(%s) FROM %s WHERE %s`, todoTableId, todoTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var count int
+	err := r.executor(ctx).Get(&count, query, todoId, listId)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Error(err)
+		return false
+	}
+
+	return count == 1
+}
+
+func (r *DBRepositoryTodo) GetTodoAssignees(ctx context.Context, todoId uuid.UUID) []string {
+	var assignees []string
+	cond := fmt.Sprintf(`%s = ?`, todoUserTableTodoId)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY %s`, todoUserTableUsername, todoAssigneeTable, cond, todoUserTableUsername)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	err := r.executor(ctx).Select(&assignees, query, todoId)
+	if err != nil {
+		return nil
+	}
+
+	return assignees
+}
+
+func (r *DBRepositoryTodo) GetUserListIds(ctx context.Context, username string) ([]uuid.UUID, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var listIds []uuid.UUID
+	err := r.executor(ctx).Select(&listIds, sqlx.Rebind(sqlx.DOLLAR, userListsQuery), username)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return listIds, nil
+}
+
+func (r *DBRepositoryTodo) AttachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	_, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+
+	stmt := `SELECT COUNT(id) FROM label WHERE id = ? AND list_id = ?`
+	var count int
+	err = r.executor(ctx).Get(&count, sqlx.Rebind(sqlx.DOLLAR, stmt), labelId, listId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if count != 1 {
+		err = errors.New(fmt.Sprintf("error not found label with id %s in list with id: %s", labelId, listId))
+		log.Error(err)
+		return err
+	}
+
+	stmt = fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?)`, todoLabelTable, strings.Join(todoLabelColumns, ", "))
+	_, err = r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), todoId, labelId)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists label with id %s on todo with id: %s", labelId, todoId))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DBRepositoryTodo) DetachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	_, err := r.lockTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+
+	cond := fmt.Sprintf(`%s = ? AND %s = ?`, todoLabelTableTodoId, todoLabelTableLabelId)
+	stmt := fmt.Sprintf(`DELETE FROM %s WHERE %s`, todoLabelTable, cond)
+	result, err := r.executor(ctx).Exec(sqlx.Rebind(sqlx.DOLLAR, stmt), todoId, labelId)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found label with id %s on todo with id: %s", labelId, todoId))
+		log.Error(err)
+		return err
 	}
 
-	return assignee
+	return nil
 }
@@ -1,19 +1,45 @@
 package todo_test
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	sqlxmock "github.com/zhashkevych/go-sqlxmock"
 	"project/structures"
 	"project/todo"
+	"project/uow"
 	"project/utils"
 	"regexp"
 	"testing"
 	"time"
 )
 
+const selectTodoColumns = `SELECT id, list_id, name, description, deadline, created_at, status, priority, recurrence, series_id, superseded, ` +
+	`\(SELECT COUNT\(subtask.id\) FROM subtask WHERE subtask.todo_id = todo.id\) AS subtasks_total, ` +
+	`\(SELECT COUNT\(subtask.id\) FROM subtask WHERE subtask.todo_id = todo.id AND subtask.done\) AS subtasks_done, ` +
+	`\(SELECT COALESCE\(json_agg\(.+\), '\[\]'\) FROM todo_label JOIN label ON label.id = todo_label.label_id ` +
+	`WHERE todo_label.todo_id = todo.id\) AS labels, ` +
+	`\(SELECT COALESCE\(json_agg\(.+\), '\[\]'\) FROM todo_assignee WHERE todo_assignee.todo_id = todo.id\) AS assignees, ` +
+	`\(SELECT COALESCE\(json_agg\(.+\), '\[\]'\) FROM todo_watcher WHERE todo_watcher.todo_id = todo.id\) AS watchers, ` +
+	`\(SELECT workflow_state.position FROM workflow_state .+\) AS status_position, ` +
+	`\(SELECT workflow_state.done FROM workflow_state .+\) AS done, ` +
+	`\(SELECT COALESCE\(json_agg\(.+\), '\[\]'\) FROM todo_dependency .+ WHERE todo_dependency.todo_id = todo.id\) AS blocked_by, ` +
+	`\(SELECT COALESCE\(json_agg\(.+\), '\[\]'\) FROM todo_dependency .+ WHERE todo_dependency.blocked_by_id = todo.id\) AS blocks `
+
+const insertTodo = `INSERT INTO todo\(id, list_id, name, description, deadline, priority, status, recurrence, series_id\) ` +
+	`VALUES\(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9\)`
+
+const (
+	selectInitialStatus = `SELECT name FROM workflow_state WHERE list_id = \$1 ORDER BY position LIMIT 1 FOR SHARE`
+	selectStatus        = `SELECT name FROM workflow_state WHERE list_id = \$1 AND name = \$2 FOR SHARE`
+	selectTransition    = `SELECT to_state FROM workflow_transition WHERE list_id = \$1 AND from_state = \$2 AND to_state = \$3 FOR SHARE`
+)
+
 func TestRepositoryGetTodo(t *testing.T) {
 	db, mock, err := sqlxmock.Newx()
 	if err != nil {
@@ -37,10 +63,10 @@ func TestRepositoryGetTodo(t *testing.T) {
 			inputTodoId: utils.TestTodoId,
 			mock: func() {
 				rows := sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
-					"created_at", "assignee", "status", "priority"}).
+					"created_at", "assignees", "status", "priority"}).
 					AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
-						utils.TestUsername, utils.Assigned, utils.MediumPriority)
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
+						helperAssignees(utils.TestUsername), utils.Assigned, utils.MediumPriority)
+				mock.ExpectQuery(selectTodoColumns+
 					`FROM todo WHERE id = \$1`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnRows(rows)
@@ -50,12 +76,22 @@ func TestRepositoryGetTodo(t *testing.T) {
 			name:        "getting non-existing todo",
 			inputTodoId: utils.TestTodoId,
 			mock: func() {
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
+				mock.ExpectQuery(selectTodoColumns+
 					`FROM todo WHERE id = \$1`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectedErr: errors.New("error getting todo with id: .+"),
+		}, {
+			name:        "getting todo in aborted transaction",
+			inputTodoId: utils.TestTodoId,
+			mock: func() {
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnError(errors.New("pq: current transaction is aborted"))
+			},
+			expectedErr: errors.New("current transaction is aborted"),
 		},
 	}
 
@@ -65,12 +101,14 @@ func TestRepositoryGetTodo(t *testing.T) {
 
 			actual, err := repo.GetTodo(ctx, testCase.inputTodoId, utils.TestListId)
 			if err != nil {
+				require.NotNil(t, testCase.expectedErr, err.Error())
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
 				return
 			}
 
+			require.Nil(t, testCase.expectedErr)
 			require.Equal(t, testCase.expected, actual.Name)
 		})
 	}
@@ -86,40 +124,139 @@ func TestRepositoryGetAllTasks(t *testing.T) {
 	convertor := todo.NewRepositoryTodoConvertor()
 	repo := todo.NewDBRepositoryTodo(db, *convertor)
 	ctx := utils.HelperGetContext()
+	todoColumns := []string{"id", "list_id", "name", "description", "deadline", "created_at", "assignees", "status", "priority"}
+	selectTodos := selectTodoColumns + `FROM todo `
+	cursor := &structures.Cursor{SortBy: utils.SortByDeadline, Descending: true, Value: "2026-01-02", Id: uuid.UUID{1}}
 
 	testCases := []struct {
-		name        string
-		inputListId uuid.UUID
-		mock        func()
-		expected    []string
+		name             string
+		inputQuery       structures.TodoQuery
+		mock             func()
+		expected         []string
+		expectedPageInfo structures.PageInfo
+		expectedErr      string
 	}{
 		{
-			name:        "get all lists",
-			inputListId: utils.TestListId,
+			name: "get all todos",
 			mock: func() {
-				rows := sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
-					"created_at", "assignee", "status", "priority"}).
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE list_id = \$1`).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(2))
+				rows := sqlxmock.NewRows(todoColumns).
 					AddRow(uuid.UUID{1}, utils.TestListId, "TestTask1", "TestDescription", time.Time{}, time.Time{},
-						"TestUser", "assigned", "medium").
+						helperAssignees(utils.TestUsername), "assigned", "medium").
 					AddRow(uuid.UUID{2}, utils.TestListId, "TestTask2", "TestDescription", time.Time{}, time.Time{},
-						"TestUser", "assigned", "medium")
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority ` +
-					`FROM todo WHERE list_id = \$1`).
+						helperAssignees(utils.TestUsername), "assigned", "medium")
+				mock.ExpectQuery(selectTodos + `WHERE list_id = \$1 ORDER BY name ASC, id ASC$`).
 					WithArgs(utils.TestListId).
 					WillReturnRows(rows)
 			},
 			expected: []string{"TestTask1", "TestTask2"},
+			expectedPageInfo: structures.PageInfo{
+				TotalCount:  2,
+				StartCursor: &structures.Cursor{SortBy: utils.SortByName, Value: "TestTask1", Id: uuid.UUID{1}},
+				EndCursor:   &structures.Cursor{SortBy: utils.SortByName, Value: "TestTask2", Id: uuid.UUID{2}},
+			},
 		}, {
-			name:        "empty lists",
-			inputListId: utils.TestListId,
+			name: "empty list",
 			mock: func() {
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority ` +
-					`FROM todo WHERE list_id = \$2`).
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE list_id = \$1`).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(selectTodos + `WHERE list_id = \$1 ORDER BY name ASC, id ASC$`).
 					WithArgs(utils.TestListId).
-					WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
-						"created_at", "assignee", "status", "priority"}))
+					WillReturnRows(sqlxmock.NewRows(todoColumns))
 			},
 			expected: make([]string, 0),
+		}, {
+			name: "filtered, sorted and paged todos",
+			inputQuery: structures.TodoQuery{
+				Status:       utils.Assigned,
+				Priority:     utils.HighPriority,
+				Assignee:     utils.TestUsername,
+				DeadlineFrom: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				DeadlineTo:   time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+				Text:         "50%",
+				SortBy:       utils.SortByDeadline,
+				Descending:   true,
+				PageQuery:    structures.PageQuery{Limit: 1, After: cursor},
+			},
+			mock: func() {
+				filters := `WHERE list_id = \$1 AND status = \$2 AND priority = \$3 AND EXISTS \(SELECT 1 FROM todo_assignee WHERE todo_assignee.todo_id = todo.id AND todo_assignee.username = \$4\) AND deadline >= \$5 ` +
+					`AND deadline <= \$6 AND \(name ILIKE \$7 OR description ILIKE \$8\)`
+				filterArgs := []driver.Value{utils.TestListId, utils.Assigned, utils.HighPriority, utils.TestUsername,
+					"2026-01-01", "2026-02-01", `%50\%%`, `%50\%%`}
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo ` + filters + `$`).
+					WithArgs(filterArgs...).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(5))
+				rows := sqlxmock.NewRows(todoColumns).
+					AddRow(uuid.UUID{2}, utils.TestListId, "TestTask2", "TestDescription", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+						time.Time{}, helperAssignees(utils.TestUsername), utils.Assigned, utils.HighPriority).
+					AddRow(uuid.UUID{3}, utils.TestListId, "TestTask3", "TestDescription", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+						time.Time{}, helperAssignees(utils.TestUsername), utils.Assigned, utils.HighPriority)
+				mock.ExpectQuery(selectTodos + filters + ` AND \(deadline, id\) < \(\$9, \$10\) ORDER BY deadline DESC, id DESC LIMIT \$11$`).
+					WithArgs(append(filterArgs, cursor.Value, cursor.Id, 2)...).
+					WillReturnRows(rows)
+			},
+			expected: []string{"TestTask2"},
+			expectedPageInfo: structures.PageInfo{
+				TotalCount:      5,
+				StartCursor:     &structures.Cursor{SortBy: utils.SortByDeadline, Descending: true, Value: "2026-01-02", Id: uuid.UUID{2}},
+				EndCursor:       &structures.Cursor{SortBy: utils.SortByDeadline, Descending: true, Value: "2026-01-02", Id: uuid.UUID{2}},
+				HasNextPage:     true,
+				HasPreviousPage: true,
+			},
+		}, {
+			name: "last todos before a cursor",
+			inputQuery: structures.TodoQuery{
+				SortBy:     utils.SortByDeadline,
+				Descending: true,
+				PageQuery:  structures.PageQuery{Last: 1, Before: cursor},
+			},
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE list_id = \$1$`).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(5))
+				rows := sqlxmock.NewRows(todoColumns).
+					AddRow(uuid.UUID{3}, utils.TestListId, "TestTask3", "TestDescription", time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+						time.Time{}, helperAssignees(utils.TestUsername), utils.Assigned, utils.HighPriority).
+					AddRow(uuid.UUID{4}, utils.TestListId, "TestTask4", "TestDescription", time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC),
+						time.Time{}, helperAssignees(utils.TestUsername), utils.Assigned, utils.HighPriority)
+				mock.ExpectQuery(selectTodos+`WHERE list_id = \$1 AND \(deadline, id\) > \(\$2, \$3\) ORDER BY deadline ASC, id ASC LIMIT \$4$`).
+					WithArgs(utils.TestListId, cursor.Value, cursor.Id, 2).
+					WillReturnRows(rows)
+			},
+			expected: []string{"TestTask3"},
+			expectedPageInfo: structures.PageInfo{
+				TotalCount:      5,
+				StartCursor:     &structures.Cursor{SortBy: utils.SortByDeadline, Descending: true, Value: "2026-01-03", Id: uuid.UUID{3}},
+				EndCursor:       &structures.Cursor{SortBy: utils.SortByDeadline, Descending: true, Value: "2026-01-03", Id: uuid.UUID{3}},
+				HasNextPage:     true,
+				HasPreviousPage: true,
+			},
+		}, {
+			name:        "unknown sort key",
+			inputQuery:  structures.TodoQuery{SortBy: "assignee"},
+			mock:        func() {},
+			expectedErr: "error unknown sort key assignee",
+		}, {
+			name:        "cursor of another order",
+			inputQuery:  structures.TodoQuery{PageQuery: structures.PageQuery{After: cursor}},
+			mock:        func() {},
+			expectedErr: utils.InvalidCursorErrorMsg,
+		}, {
+			name:        "limit combined with last",
+			inputQuery:  structures.TodoQuery{PageQuery: structures.PageQuery{Limit: 1, Last: 1}},
+			mock:        func() {},
+			expectedErr: utils.InvalidPageErrorMsg,
+		}, {
+			name: "counting todos failed",
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE list_id = \$1`).
+					WithArgs(utils.TestListId).
+					WillReturnError(errors.New("connection refused"))
+			},
+			expectedErr: "connection refused",
 		},
 	}
 
@@ -127,13 +264,20 @@ func TestRepositoryGetAllTasks(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			actualEntities := repo.GetAllTasks(ctx, testCase.inputListId)
-			actual := make([]string, len(actualEntities))
-			for i, entity := range actualEntities {
-				actual[i] = entity.Name
+			actualPage, err := repo.GetAllTasks(ctx, utils.TestListId, testCase.inputQuery)
+			require.NoError(t, mock.ExpectationsWereMet())
+			if testCase.expectedErr != "" {
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
 			}
 
+			require.NoError(t, err)
+			actual := make([]string, len(actualPage.Todos))
+			for i, entity := range actualPage.Todos {
+				actual[i] = entity.Name
+			}
 			require.Equal(t, testCase.expected, actual)
+			require.Equal(t, testCase.expectedPageInfo, actualPage.PageInfo)
 		})
 	}
 }
@@ -147,6 +291,7 @@ func TestRepositoryCreateTodo(t *testing.T) {
 
 	convertor := todo.NewRepositoryTodoConvertor()
 	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
 	testCases := []struct {
@@ -161,8 +306,11 @@ func TestRepositoryCreateTodo(t *testing.T) {
 				Description: utils.TestTodoDescription, Deadline: time.Time{}, Priority: utils.MediumPriority},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO todo\(id, list_id, name, description, deadline, priority\) VALUES\(\$1, \$2, \$3, \$4, \$5, \$6\)`).
-					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority).
+				mock.ExpectQuery(selectInitialStatus).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(insertTodo).
+					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority, utils.NotAssigned, "", nil).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
@@ -172,20 +320,43 @@ func TestRepositoryCreateTodo(t *testing.T) {
 				Deadline: time.Time{}, Priority: utils.MediumPriority, Status: utils.NotAssigned},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO todo\(id, list_id, name, description, deadline, priority\) VALUES\(\$1, \$2, \$3, \$4, \$5, \$6\)`).
-					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority).
+				mock.ExpectQuery(selectInitialStatus).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(insertTodo).
+					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority, utils.NotAssigned, "", nil).
 					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error already exists todo with the same name .+ in list with id: .+"),
+		}, {
+			name: "create recurring todo",
+			inputEntity: structures.TodoEntity{Id: utils.TestTodoId, ListId: utils.TestListId, Name: utils.TestTodoName, Description: utils.TestTodoDescription,
+				Deadline: time.Time{}, Priority: utils.MediumPriority, Recurrence: "FREQ=WEEKLY", SeriesId: uuid.NullUUID{UUID: utils.TestTodoId, Valid: true}},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectInitialStatus).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(insertTodo).
+					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority, utils.NotAssigned,
+						"FREQ=WEEKLY", utils.TestTodoId.String()).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
 		}, {
 			name: "list is not found",
 			inputEntity: structures.TodoEntity{Id: utils.TestTodoId, ListId: utils.TestListId, Name: utils.TestTodoName, Description: utils.TestTodoDescription,
 				Deadline: time.Time{}, Priority: utils.MediumPriority, Status: utils.NotAssigned},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO todo\(id, list_id, name, description, deadline, priority\) VALUES\(\$1, \$2, \$3, \$4, \$5, \$6\)`).
-					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority).
+				mock.ExpectQuery(selectInitialStatus).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(insertTodo).
+					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority, utils.NotAssigned, "", nil).
 					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error not found list with id: .+"),
 		}, {
@@ -194,11 +365,27 @@ func TestRepositoryCreateTodo(t *testing.T) {
 				Deadline: time.Time{}, Priority: utils.MediumPriority, Status: utils.NotAssigned},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(`INSERT INTO todo\(id, list_id, name, description, deadline, priority\) VALUES\(\$1, \$2, \$3, \$4, \$5, \$6\)`).
-					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority).
+				mock.ExpectQuery(selectInitialStatus).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(insertTodo).
+					WithArgs(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority, utils.NotAssigned, "", nil).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error creating todo with this name .+"),
+		}, {
+			name: "list without workflow",
+			inputEntity: structures.TodoEntity{Id: utils.TestTodoId, ListId: utils.TestListId, Name: utils.TestTodoName, Description: utils.TestTodoDescription,
+				Deadline: time.Time{}, Priority: utils.MediumPriority},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectInitialStatus).
+					WithArgs(utils.TestListId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found list with id: .+"),
 		},
 	}
 
@@ -206,12 +393,13 @@ func TestRepositoryCreateTodo(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			err := repo.CreateTodo(ctx, testCase.inputEntity)
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.CreateTodo(ctx, testCase.inputEntity)
+			})
 			if err != nil {
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -230,6 +418,7 @@ func TestRepositoryDeleteTodo(t *testing.T) {
 
 	convertor := todo.NewRepositoryTodoConvertor()
 	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
 	testCases := []struct {
@@ -244,11 +433,11 @@ func TestRepositoryDeleteTodo(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				rows := sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
-					"created_at", "assignee", "status", "priority"}).
+					"created_at", "assignees", "status", "priority"}).
 					AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
-						utils.TestUsername, utils.Assigned, utils.MediumPriority)
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
-					`FROM todo WHERE id = \$1`).
+						helperAssignees(utils.TestUsername), utils.Assigned, utils.MediumPriority)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnRows(rows)
 				mock.ExpectExec(`DELETE FROM todo WHERE id = \$1 AND list_id = \$2`).
@@ -261,29 +450,30 @@ func TestRepositoryDeleteTodo(t *testing.T) {
 			inputTodoId: utils.TestTodoId,
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
-					`FROM todo WHERE id = \$1`).
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 			},
-			expectedErr: errors.New("error getting todo with id: .+"),
+			expectedErr: errors.New("error not found todo with id .+ in the list with id: .+"),
 		}, {
 			name:        "delete todo fails deleting it form table",
 			inputTodoId: utils.TestTodoId,
 			mock: func() {
 				mock.ExpectBegin()
 				rows := sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
-					"created_at", "assignee", "status", "priority"}).
+					"created_at", "assignees", "status", "priority"}).
 					AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
-						utils.TestUsername, utils.Assigned, utils.MediumPriority)
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
-					`FROM todo WHERE id = \$1`).
+						helperAssignees(utils.TestUsername), utils.Assigned, utils.MediumPriority)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnRows(rows)
 				mock.ExpectExec(`DELETE FROM todo WHERE id = \$1 AND list_id = \$2`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
-				mock.ExpectCommit()
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error deleting todo with id: .+"),
 		},
@@ -293,12 +483,14 @@ func TestRepositoryDeleteTodo(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			_, err := repo.DeleteTodo(ctx, testCase.inputTodoId, utils.TestListId)
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				_, err := repo.DeleteTodo(ctx, testCase.inputTodoId, utils.TestListId)
+				return err
+			})
 			if err != nil {
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -317,6 +509,7 @@ func TestRepositoryUpdateTodo(t *testing.T) {
 
 	convertor := todo.NewRepositoryTodoConvertor()
 	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
 	testCases := []struct {
@@ -334,11 +527,11 @@ func TestRepositoryUpdateTodo(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				rows := sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
-					"created_at", "assignee", "status", "priority"}).
+					"created_at", "assignees", "status", "priority"}).
 					AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
-						utils.TestUsername, utils.Assigned, utils.MediumPriority)
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
-					`FROM todo WHERE id = \$1 AND list_id = \$2`).
+						helperAssignees(utils.TestUsername), utils.Assigned, utils.MediumPriority)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnRows(rows)
 
@@ -354,12 +547,13 @@ func TestRepositoryUpdateTodo(t *testing.T) {
 				Description: utils.TestTodoDescription, Deadline: time.Time{}, Priority: utils.MediumPriority},
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
-					`FROM todo WHERE id = \$1 AND list_id = \$2`).
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 			},
-			expectedErr: errors.New("error not found todo with id: .+"),
+			expectedErr: errors.New("error not found todo with id .+ in the list with id: .+"),
 		}, {
 			name:        "update to already existing todo",
 			inputTodoId: utils.TestTodoId,
@@ -368,39 +562,40 @@ func TestRepositoryUpdateTodo(t *testing.T) {
 			mock: func() {
 				mock.ExpectBegin()
 				rows := sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
-					"created_at", "assignee", "status", "priority"}).
+					"created_at", "assignees", "status", "priority"}).
 					AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
-						utils.TestUsername, utils.Assigned, utils.MediumPriority)
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
-					`FROM todo WHERE id = \$1 AND list_id = \$2`).
+						helperAssignees(utils.TestUsername), utils.Assigned, utils.MediumPriority)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnRows(rows)
 
 				mock.ExpectExec(`UPDATE todo SET name = \$1, description = \$2, deadline = \$3, priority = \$4 WHERE id = \$5`).
 					WithArgs(utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority, utils.TestTodoId).
 					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error todo with this name is already created"),
 		}, {
-			name:        "update existing todo",
+			name:        "the update was not saved in the table",
 			inputTodoId: utils.TestTodoId,
 			inputUpdate: structures.TodoEntity{Id: utils.TestTodoId, ListId: utils.TestListId, Name: utils.TestTodoName,
 				Description: utils.TestTodoDescription, Deadline: time.Time{}, Priority: utils.MediumPriority},
 			mock: func() {
 				mock.ExpectBegin()
 				rows := sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
-					"created_at", "assignee", "status", "priority"}).
+					"created_at", "assignees", "status", "priority"}).
 					AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
-						utils.TestUsername, utils.Assigned, utils.MediumPriority)
-				mock.ExpectQuery(`SELECT id, list_id, name, description, deadline, created_at, assignee, status, priority `+
-					`FROM todo WHERE id = \$1 AND list_id = \$2`).
+						helperAssignees(utils.TestUsername), utils.Assigned, utils.MediumPriority)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
 					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnRows(rows)
 
 				mock.ExpectExec(`UPDATE todo SET name = \$1, description = \$2, deadline = \$3, priority = \$4 WHERE id = \$5`).
 					WithArgs(utils.TestTodoName, utils.TestTodoDescription, time.Time{}, utils.MediumPriority, utils.TestTodoId).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
-				mock.ExpectCommit()
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error updating todo with id: .+"),
 		},
@@ -410,12 +605,14 @@ func TestRepositoryUpdateTodo(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			_, err := repo.UpdateTodo(ctx, testCase.inputUpdate, utils.TestListId)
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				_, err := repo.UpdateTodo(ctx, testCase.inputUpdate, utils.TestListId)
+				return err
+			})
 			if err != nil {
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -434,8 +631,16 @@ func TestRepositoryAssignUserToTodo(t *testing.T) {
 
 	convertor := todo.NewRepositoryTodoConvertor()
 	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
+	helperLockedTodo := func(assignee, status string) *sqlxmock.Rows {
+		return sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
+			"created_at", "assignees", "status", "priority"}).
+			AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
+				helperAssignees(assignee), status, utils.MediumPriority)
+	}
+
 	testCases := []struct {
 		name        string
 		inputTodoId uuid.UUID
@@ -449,12 +654,19 @@ func TestRepositoryAssignUserToTodo(t *testing.T) {
 			inputUser:   utils.TestUsername,
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(`SELECT assignee FROM todo WHERE id = \$1`).
-					WithArgs(utils.TestTodoId).
-					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo("", utils.NotAssigned))
+				mock.ExpectExec(`INSERT INTO todo_assignee\(todo_id, username\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(selectTransition).
+					WithArgs(utils.TestListId, utils.NotAssigned, utils.Assigned).
+					WillReturnRows(sqlxmock.NewRows([]string{"to_state"}).AddRow(utils.Assigned))
 
-				mock.ExpectExec(`UPDATE todo SET assignee = \$1, status = \$2 WHERE id = \$3 AND list_id = \$4`).
-					WithArgs(utils.TestUsername, utils.Assigned, utils.TestTodoId, utils.TestListId).
+				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs(utils.Assigned, utils.TestTodoId, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
@@ -464,19 +676,38 @@ func TestRepositoryAssignUserToTodo(t *testing.T) {
 			inputUser:   utils.TestUsername,
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(`SELECT assignee FROM todo WHERE id = \$1`).
-					WithArgs(utils.TestTodoId).
-					WillReturnRows(
-						sqlxmock.NewRows([]string{"assignee"}).
-							AddRow(utils.TestUsername))
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo(utils.TestUsername, utils.Assigned))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error assigning .+ because .+ is already assigned to todo with id: .+"),
+		}, {
+			name:        "assign user keeps status the workflow cannot leave",
+			inputTodoId: utils.TestTodoId,
+			inputUser:   utils.TestUsername,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo("", utils.Completed))
+				mock.ExpectExec(`INSERT INTO todo_assignee\(todo_id, username\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(selectTransition).
+					WithArgs(utils.TestListId, utils.Completed, utils.Assigned).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectCommit()
+			},
 		}, {
 			name:        "assign empty username",
 			inputTodoId: utils.TestTodoId,
 			inputUser:   "",
 			mock: func() {
 				mock.ExpectBegin()
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("username is required"),
 		}, {
@@ -485,29 +716,34 @@ func TestRepositoryAssignUserToTodo(t *testing.T) {
 			inputUser:   utils.TestUsername,
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(`SELECT assignee FROM todo WHERE id = \$1`).
-					WithArgs(utils.TestTodoId).
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
 					WillReturnError(sql.ErrNoRows)
-
-				mock.ExpectExec(`UPDATE todo SET assignee = \$1, status = \$2 WHERE id = \$3 AND list_id = \$4`).
-					WithArgs(utils.TestUsername, utils.Assigned, utils.TestTodoId, utils.TestListId).
-					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error not found todo with id .+ in the list with id: .+"),
 		}, {
-			name:        "the update on assignee was not added in the table",
+			name:        "the status of the assigned todo was not saved in the table",
 			inputTodoId: utils.TestTodoId,
 			inputUser:   utils.TestUsername,
 			mock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(`SELECT assignee FROM todo WHERE id = \$1`).
-					WithArgs(utils.TestTodoId).
-					WillReturnError(sql.ErrNoRows)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo("", utils.NotAssigned))
+				mock.ExpectExec(`INSERT INTO todo_assignee\(todo_id, username\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(selectTransition).
+					WithArgs(utils.TestListId, utils.NotAssigned, utils.Assigned).
+					WillReturnRows(sqlxmock.NewRows([]string{"to_state"}).AddRow(utils.Assigned))
 
-				mock.ExpectExec(`UPDATE todo SET assignee = \$1, status = \$2 WHERE id = \$3 AND list_id = \$4`).
-					WithArgs(utils.TestUsername, utils.Assigned, utils.TestTodoId, utils.TestListId).
+				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs(utils.Assigned, utils.TestTodoId, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
-				mock.ExpectCommit()
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error assigning .+ to todo with id: .+"),
 		},
@@ -517,12 +753,13 @@ func TestRepositoryAssignUserToTodo(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			err := repo.AssignTodoToUser(ctx, testCase.inputTodoId, utils.TestListId, testCase.inputUser)
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.AssignTodoToUser(ctx, testCase.inputTodoId, utils.TestListId, testCase.inputUser)
+			})
 			if err != nil {
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -541,24 +778,43 @@ func TestRepositoryChangeStatusOfTodo(t *testing.T) {
 
 	convertor := todo.NewRepositoryTodoConvertor()
 	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
+	helperLockedTodo := func(status string) *sqlxmock.Rows {
+		return sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
+			"created_at", "assignees", "status", "priority"}).
+			AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
+				helperAssignees(utils.TestUsername), status, utils.MediumPriority)
+	}
+
+	helperAllowedStatus := func(from, to string) {
+		mock.ExpectQuery(selectStatus).
+			WithArgs(utils.TestListId, to).
+			WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(to))
+		mock.ExpectQuery(selectTransition).
+			WithArgs(utils.TestListId, from, to).
+			WillReturnRows(sqlxmock.NewRows([]string{"to_state"}).AddRow(to))
+	}
+
 	testCases := []struct {
 		name        string
 		inputTodoId uuid.UUID
+		inputStatus string
 		mock        func()
 		expectedErr error
 	}{
 		{
 			name:        "change status to existing task",
 			inputTodoId: utils.TestTodoId,
+			inputStatus: utils.Completed,
 			mock: func() {
 				mock.ExpectBegin()
-				rows := sqlxmock.NewRows([]string{"status"}).
-					AddRow(utils.InReview)
-				mock.ExpectQuery(`SELECT status FROM todo WHERE id = \$1`).
-					WithArgs(utils.TestTodoId).
-					WillReturnRows(rows)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo(utils.InReview))
+				helperAllowedStatus(utils.InReview, utils.Completed)
 				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
 					WithArgs(utils.Completed, utils.TestTodoId, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
@@ -567,31 +823,79 @@ func TestRepositoryChangeStatusOfTodo(t *testing.T) {
 		}, {
 			name:        "change status to not existing task",
 			inputTodoId: utils.TestTodoId,
+			inputStatus: utils.Completed,
 			mock: func() {
 				mock.ExpectBegin()
-				rows := sqlxmock.NewRows([]string{"status"}).
-					AddRow(utils.InProgress)
-				mock.ExpectQuery(`SELECT status FROM todo WHERE id = \$1`).
-					WithArgs(utils.TestTodoId).
-					WillReturnRows(rows)
-				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
-					WithArgs(utils.InReview, utils.TestTodoId, utils.TestListId).
-					WillReturnError(errors.New(utils.NotFoundSQLErrorMsg))
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error not found todo with id .+ in the list with id: .+"),
+		}, {
+			name:        "change status to the current one",
+			inputTodoId: utils.TestTodoId,
+			inputStatus: utils.InReview,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo(utils.InReview))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error changing status of todo with id: .+ because it is already .+"),
+		}, {
+			name:        "change status to one missing from the workflow",
+			inputTodoId: utils.TestTodoId,
+			inputStatus: "Done",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo(utils.InReview))
+				mock.ExpectQuery(selectStatus).
+					WithArgs(utils.TestListId, "Done").
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error changing status of todo with id: .+ because Done is not a status in the workflow of list with id: .+"),
+		}, {
+			name:        "change status without a transition",
+			inputTodoId: utils.TestTodoId,
+			inputStatus: utils.NotAssigned,
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo(utils.InReview))
+				mock.ExpectQuery(selectStatus).
+					WithArgs(utils.TestListId, utils.NotAssigned).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectQuery(selectTransition).
+					WithArgs(utils.TestListId, utils.InReview, utils.NotAssigned).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error changing status of todo with id: .+ from .+ to .+ is not allowed by the workflow"),
 		}, {
 			name:        "the changed status was not saved in the table",
 			inputTodoId: utils.TestTodoId,
+			inputStatus: utils.InReview,
 			mock: func() {
 				mock.ExpectBegin()
-				rows := sqlxmock.NewRows([]string{"status"}).
-					AddRow(utils.InProgress)
-				mock.ExpectQuery(`SELECT status FROM todo WHERE id = \$1`).
-					WithArgs(utils.TestTodoId).
-					WillReturnRows(rows)
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo(utils.InProgress))
+				helperAllowedStatus(utils.InProgress, utils.InReview)
 				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
 					WithArgs(utils.InReview, utils.TestTodoId, utils.TestListId).
 					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
 			},
 			expectedErr: errors.New("error changing status to todo with id: .+"),
 		},
@@ -601,12 +905,13 @@ func TestRepositoryChangeStatusOfTodo(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mock()
 
-			err := repo.ChangeTodoStatus(ctx, testCase.inputTodoId, utils.TestListId)
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.ChangeTodoStatus(ctx, testCase.inputTodoId, utils.TestListId, testCase.inputStatus)
+			})
 			if err != nil {
 				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
 				require.NoError(t, err)
 				require.True(t, result)
-				return
 			} else if testCase.expectedErr != nil {
 				t.Error("Expected error but got nil")
 			}
@@ -616,7 +921,7 @@ func TestRepositoryChangeStatusOfTodo(t *testing.T) {
 	}
 }
 
-func TestRepositoryContainsTodoInList(t *testing.T) {
+func TestRepositoryMoveTodoBackwards(t *testing.T) {
 	db, mock, err := sqlxmock.Newx()
 	if err != nil {
 		t.Fatal(err)
@@ -625,98 +930,1010 @@ func TestRepositoryContainsTodoInList(t *testing.T) {
 
 	convertor := todo.NewRepositoryTodoConvertor()
 	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
 	ctx := utils.HelperGetContext()
 
+	helperLockTodo := func(assignee, status string, position int, done bool) {
+		mock.ExpectQuery(selectTodoColumns+
+			`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+			WithArgs(utils.TestTodoId, utils.TestListId).
+			WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
+				"created_at", "assignees", "status", "priority", "status_position", "done"}).
+				AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
+					helperAssignees(assignee), status, utils.MediumPriority, position, done))
+	}
+
 	testCases := []struct {
 		name        string
-		inputTodoId uuid.UUID
-		inputListId uuid.UUID
+		change      func(ctx context.Context) error
 		mock        func()
-		expected    bool
+		expectedErr error
 	}{
 		{
-			name:        "contains todo",
-			inputTodoId: utils.TestTodoId,
-			inputListId: utils.TestListId,
+			name: "reopen completed todo",
+			change: func(ctx context.Context) error {
+				return repo.ReopenTodo(ctx, utils.TestTodoId, utils.TestListId)
+			},
 			mock: func() {
-				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE id = \$1 AND list_id = \$2`).
-					WithArgs(utils.TestTodoId, utils.TestListId).
-					WillReturnRows(
-						sqlxmock.NewRows([]string{"count"}).
-							AddRow(1))
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestUsername, utils.Completed, 4, true)
+				mock.ExpectQuery(`SELECT name FROM workflow_state WHERE list_id = \$1 AND position < \$2 AND NOT done ORDER BY position DESC LIMIT 1 FOR SHARE`).
+					WithArgs(utils.TestListId, 4).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.InReview))
+				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs(utils.InReview, utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
 			},
-			expected: true,
 		}, {
-			name:        "does not contains",
-			inputTodoId: utils.TestTodoId,
-			inputListId: utils.TestListId,
+			name: "reopen todo that is not done",
+			change: func(ctx context.Context) error {
+				return repo.ReopenTodo(ctx, utils.TestTodoId, utils.TestListId)
+			},
 			mock: func() {
-				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE id = \$1 AND list_id = \$2`).
-					WithArgs(utils.TestTodoId, utils.TestListId).
-					WillReturnRows(
-						sqlxmock.NewRows([]string{"count"}).
-							AddRow(0))
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestUsername, utils.InProgress, 2, false)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error reopening todo with id: .+ because .+ is not a done status"),
+		}, {
+			name: "step back todo in review",
+			change: func(ctx context.Context) error {
+				return repo.StepBackTodo(ctx, utils.TestTodoId, utils.TestListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestUsername, utils.InReview, 3, false)
+				mock.ExpectQuery(`SELECT name FROM workflow_state WHERE list_id = \$1 AND position < \$2 ORDER BY position DESC LIMIT 1 FOR SHARE`).
+					WithArgs(utils.TestListId, 3).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.InProgress))
+				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs(utils.InProgress, utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "step back todo in the first status",
+			change: func(ctx context.Context) error {
+				return repo.StepBackTodo(ctx, utils.TestTodoId, utils.TestListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("", utils.NotAssigned, 0, false)
+				mock.ExpectQuery(`SELECT name FROM workflow_state WHERE list_id = \$1 AND position < \$2 ORDER BY position DESC LIMIT 1 FOR SHARE`).
+					WithArgs(utils.TestListId, 0).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error stepping back todo with id: .+ because .+ is the first status of the workflow"),
+		}, {
+			name: "unassign assigned todo",
+			change: func(ctx context.Context) error {
+				return repo.UnassignTodo(ctx, utils.TestTodoId, utils.TestListId)
 			},
-			expected: false,
-		},
-	}
-
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.mock()
-
-			actual := repo.CheckIfListContainsTodo(ctx, testCase.inputTodoId, testCase.inputListId)
-			require.Equal(t, testCase.expected, actual)
-		})
-	}
-}
-
-func TestRepositoryGetTodoAssignee(t *testing.T) {
-	db, mock, err := sqlxmock.Newx()
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer db.Close()
-
-	convertor := todo.NewRepositoryTodoConvertor()
-	repo := todo.NewDBRepositoryTodo(db, *convertor)
-	ctx := utils.HelperGetContext()
-
-	testCases := []struct {
-		name        string
-		inputTodoId uuid.UUID
-		mock        func()
-		expected    string
-	}{
-		{
-			name:        "get todo assignee",
-			inputTodoId: utils.TestTodoId,
 			mock: func() {
-				mock.ExpectQuery(`SELECT assignee FROM todo WHERE id = \$1`).
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestUsername, utils.Assigned, 1, false)
+				mock.ExpectExec(`DELETE FROM todo_assignee WHERE todo_id = \$1`).
 					WithArgs(utils.TestTodoId).
-					WillReturnRows(
-						sqlxmock.NewRows([]string{"assignee"}).
-							AddRow(utils.TestUsername))
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(selectInitialStatus).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs(utils.NotAssigned, utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
 			},
-			expected: utils.TestUsername,
 		}, {
-			name:        "todo does not exist",
-			inputTodoId: utils.TestTodoId,
+			name: "unassign todo without assignee",
+			change: func(ctx context.Context) error {
+				return repo.UnassignTodo(ctx, utils.TestTodoId, utils.TestListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("", utils.NotAssigned, 0, false)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error unassigning todo with id: .+ because it is not assigned"),
+		}, {
+			name: "reassign todo in progress",
+			change: func(ctx context.Context) error {
+				return repo.ReassignTodo(ctx, utils.TestTodoId, utils.TestListId, "RandomUser")
+			},
 			mock: func() {
-				mock.ExpectQuery(`SELECT assignee FROM todo WHERE id = \$2`).
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestUsername, utils.InProgress, 2, false)
+				mock.ExpectExec(`DELETE FROM todo_assignee WHERE todo_id = \$1`).
 					WithArgs(utils.TestTodoId).
-					WillReturnError(sql.ErrNoRows)
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO todo_assignee\(todo_id, username\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, "RandomUser").
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
 			},
-			expected: "",
-		},
-	}
-
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.mock()
-
-			actual := repo.GetTodoAssignee(ctx, testCase.inputTodoId)
-			require.Equal(t, testCase.expected, actual)
+		}, {
+			name: "reassign todo to its assignee",
+			change: func(ctx context.Context) error {
+				return repo.ReassignTodo(ctx, utils.TestTodoId, utils.TestListId, utils.TestUsername)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestUsername, utils.InProgress, 2, false)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error reassigning todo with id: .+ because it is already assigned to .+"),
+		}, {
+			name: "remove the last assignee of an assigned todo",
+			change: func(ctx context.Context) error {
+				return repo.RemoveTodoAssignee(ctx, utils.TestTodoId, utils.TestListId, utils.TestUsername)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestUsername, utils.Assigned, 1, false)
+				mock.ExpectExec(`DELETE FROM todo_assignee WHERE todo_id = \$1 AND username = \$2`).
+					WithArgs(utils.TestTodoId, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(selectInitialStatus).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs(utils.NotAssigned, utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "remove a user who is not an assignee",
+			change: func(ctx context.Context) error {
+				return repo.RemoveTodoAssignee(ctx, utils.TestTodoId, utils.TestListId, "RandomUser")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestUsername, utils.Assigned, 1, false)
+				mock.ExpectExec(`DELETE FROM todo_assignee WHERE todo_id = \$1 AND username = \$2`).
+					WithArgs(utils.TestTodoId, "RandomUser").
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found assignee RandomUser on todo with id: .+"),
+		}, {
+			name: "watch todo",
+			change: func(ctx context.Context) error {
+				return repo.AddTodoWatcher(ctx, utils.TestTodoId, utils.TestListId, utils.TestUsername)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("", utils.NotAssigned, 0, false)
+				mock.ExpectExec(`INSERT INTO todo_watcher\(todo_id, username\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "watch todo twice",
+			change: func(ctx context.Context) error {
+				return repo.AddTodoWatcher(ctx, utils.TestTodoId, utils.TestListId, utils.TestUsername)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("", utils.NotAssigned, 0, false)
+				mock.ExpectExec(`INSERT INTO todo_watcher\(todo_id, username\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, utils.TestUsername).
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error already exists watcher .+ on todo with id: .+"),
+		}, {
+			name: "stop watching todo",
+			change: func(ctx context.Context) error {
+				return repo.RemoveTodoWatcher(ctx, utils.TestTodoId, utils.TestListId, utils.TestUsername)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("", utils.NotAssigned, 0, false)
+				mock.ExpectExec(`DELETE FROM todo_watcher WHERE todo_id = \$1 AND username = \$2`).
+					WithArgs(utils.TestTodoId, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found watcher .+ on todo with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, testCase.change)
+			if err != nil {
+				require.NotNil(t, testCase.expectedErr, err.Error())
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
+
+func TestRepositoryTodoRecurrence(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := todo.NewRepositoryTodoConvertor()
+	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+	seriesId := uuid.UUID{9}
+
+	helperLockTodo := func(recurrence string, seriesId any) {
+		mock.ExpectQuery(selectTodoColumns+
+			`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+			WithArgs(utils.TestTodoId, utils.TestListId).
+			WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
+				"created_at", "status", "priority", "recurrence", "series_id"}).
+				AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
+					utils.NotAssigned, utils.MediumPriority, recurrence, seriesId))
+	}
+
+	testCases := []struct {
+		name        string
+		change      func(ctx context.Context) error
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "start series with the todo",
+			change: func(ctx context.Context) error {
+				return repo.SetTodoRecurrence(ctx, utils.TestTodoId, utils.TestListId, "FREQ=DAILY")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("", nil)
+				mock.ExpectExec(`UPDATE todo SET recurrence = \$1, series_id = \$2 WHERE id = \$3 AND list_id = \$4`).
+					WithArgs("FREQ=DAILY", utils.TestTodoId.String(), utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "edit rule of the series",
+			change: func(ctx context.Context) error {
+				return repo.SetTodoRecurrence(ctx, utils.TestTodoId, utils.TestListId, "FREQ=MONTHLY")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("FREQ=DAILY", seriesId.String())
+				mock.ExpectExec(`UPDATE todo SET recurrence = \$1, series_id = \$2 WHERE id = \$3 AND list_id = \$4`).
+					WithArgs("FREQ=MONTHLY", seriesId.String(), utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "stop series",
+			change: func(ctx context.Context) error {
+				return repo.StopTodoRecurrence(ctx, utils.TestTodoId, utils.TestListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("FREQ=DAILY", seriesId.String())
+				mock.ExpectExec(`UPDATE todo SET recurrence = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs("", utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "stop todo that does not recur",
+			change: func(ctx context.Context) error {
+				return repo.StopTodoRecurrence(ctx, utils.TestTodoId, utils.TestListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo("", seriesId.String())
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found recurrence on todo with id: .+"),
+		}, {
+			name: "supersede occurrence",
+			change: func(ctx context.Context) error {
+				return repo.SupersedeTodo(ctx, utils.TestTodoId, utils.TestListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE todo SET recurrence = \$1, superseded = \$2 WHERE id = \$3 AND list_id = \$4`).
+					WithArgs("", true, utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, testCase.change)
+			if err != nil {
+				require.NotNil(t, testCase.expectedErr, err.Error())
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryTodoDependencies(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := todo.NewRepositoryTodoConvertor()
+	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+	blockerId, blockerListId := uuid.UUID{7}, uuid.UUID{8}
+	selectBlockers := `WITH RECURSIVE blockers\(id\) AS \(SELECT blocked_by_id FROM todo_dependency WHERE todo_id = \$1 ` +
+		`UNION SELECT todo_dependency.blocked_by_id FROM todo_dependency JOIN blockers ON todo_dependency.todo_id = blockers.id\) ` +
+		`SELECT COUNT\(id\) FROM blockers WHERE id = \$2`
+	lockDependencies := `SELECT pg_advisory_xact_lock\(\$1\)`
+
+	helperLockTodo := func(todoId, listId uuid.UUID, status, blockedBy string) {
+		mock.ExpectQuery(selectTodoColumns+
+			`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+			WithArgs(todoId, listId).
+			WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
+				"created_at", "status", "priority", "blocked_by"}).
+				AddRow(todoId, listId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
+					status, utils.MediumPriority, blockedBy))
+	}
+
+	testCases := []struct {
+		name        string
+		change      func(ctx context.Context) error
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "add dependency on todo from another list",
+			change: func(ctx context.Context) error {
+				return repo.AddTodoDependency(ctx, utils.TestTodoId, utils.TestListId, blockerId, blockerListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(lockDependencies).WithArgs(0x74646570).WillReturnResult(sqlxmock.NewResult(0, 0))
+				helperLockTodo(utils.TestTodoId, utils.TestListId, utils.NotAssigned, "[]")
+				helperLockTodo(blockerId, blockerListId, utils.NotAssigned, "[]")
+				mock.ExpectQuery(selectBlockers).
+					WithArgs(blockerId, utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(`INSERT INTO todo_dependency\(todo_id, blocked_by_id\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, blockerId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "add dependency that closes a cycle",
+			change: func(ctx context.Context) error {
+				return repo.AddTodoDependency(ctx, utils.TestTodoId, utils.TestListId, blockerId, blockerListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(lockDependencies).WithArgs(0x74646570).WillReturnResult(sqlxmock.NewResult(0, 0))
+				helperLockTodo(utils.TestTodoId, utils.TestListId, utils.NotAssigned, "[]")
+				helperLockTodo(blockerId, blockerListId, utils.NotAssigned, "[]")
+				mock.ExpectQuery(selectBlockers).
+					WithArgs(blockerId, utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error dependency of todo with id: .+ on todo with id: .+ would create a cycle"),
+		}, {
+			name: "add dependency on itself",
+			change: func(ctx context.Context) error {
+				return repo.AddTodoDependency(ctx, utils.TestTodoId, utils.TestListId, utils.TestTodoId, utils.TestListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error dependency of todo with id: .+ on itself"),
+		}, {
+			name: "add existing dependency",
+			change: func(ctx context.Context) error {
+				return repo.AddTodoDependency(ctx, utils.TestTodoId, utils.TestListId, blockerId, blockerListId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(lockDependencies).WithArgs(0x74646570).WillReturnResult(sqlxmock.NewResult(0, 0))
+				helperLockTodo(utils.TestTodoId, utils.TestListId, utils.NotAssigned, "[]")
+				helperLockTodo(blockerId, blockerListId, utils.NotAssigned, "[]")
+				mock.ExpectQuery(selectBlockers).
+					WithArgs(blockerId, utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(`INSERT INTO todo_dependency`).
+					WithArgs(utils.TestTodoId, blockerId).
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error already exists dependency on todo with id .+ in todo with id: .+"),
+		}, {
+			name: "remove dependency",
+			change: func(ctx context.Context) error {
+				return repo.RemoveTodoDependency(ctx, utils.TestTodoId, utils.TestListId, blockerId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestTodoId, utils.TestListId, utils.NotAssigned, "[]")
+				mock.ExpectExec(`DELETE FROM todo_dependency WHERE todo_id = \$1 AND blocked_by_id = \$2`).
+					WithArgs(utils.TestTodoId, blockerId).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "remove missing dependency",
+			change: func(ctx context.Context) error {
+				return repo.RemoveTodoDependency(ctx, utils.TestTodoId, utils.TestListId, blockerId)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestTodoId, utils.TestListId, utils.NotAssigned, "[]")
+				mock.ExpectExec(`DELETE FROM todo_dependency`).
+					WithArgs(utils.TestTodoId, blockerId).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found dependency on todo with id .+ in todo with id: .+"),
+		}, {
+			name: "complete blocked todo",
+			change: func(ctx context.Context) error {
+				return repo.ChangeTodoStatus(ctx, utils.TestTodoId, utils.TestListId, utils.InReview)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestTodoId, utils.TestListId, utils.InProgress,
+					fmt.Sprintf(`[{"id":"%s","list_id":"%s","name":"Blocker","done":false}]`, blockerId, blockerListId))
+				mock.ExpectQuery(selectStatus).
+					WithArgs(utils.TestListId, utils.InReview).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.InReview))
+				mock.ExpectQuery(selectTransition).
+					WithArgs(utils.TestListId, utils.InProgress, utils.InReview).
+					WillReturnRows(sqlxmock.NewRows([]string{"to_state"}).AddRow(utils.InReview))
+				mock.ExpectQuery(`SELECT done OR position > COALESCE\(.+\) FROM workflow_state WHERE list_id = \$3 AND name = \$4`).
+					WithArgs(utils.TestListId, utils.InProgress, utils.TestListId, utils.InReview).
+					WillReturnRows(sqlxmock.NewRows([]string{"past"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error changing status of todo with id: .+ because it is blocked by todos that are not done"),
+		}, {
+			name: "start blocked todo",
+			change: func(ctx context.Context) error {
+				return repo.ChangeTodoStatus(ctx, utils.TestTodoId, utils.TestListId, utils.InProgress)
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.TestTodoId, utils.TestListId, utils.Assigned,
+					fmt.Sprintf(`[{"id":"%s","list_id":"%s","name":"Blocker","done":false}]`, blockerId, blockerListId))
+				mock.ExpectQuery(selectStatus).
+					WithArgs(utils.TestListId, utils.InProgress).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.InProgress))
+				mock.ExpectQuery(selectTransition).
+					WithArgs(utils.TestListId, utils.Assigned, utils.InProgress).
+					WillReturnRows(sqlxmock.NewRows([]string{"to_state"}).AddRow(utils.InProgress))
+				mock.ExpectQuery(`SELECT done OR position > COALESCE`).
+					WithArgs(utils.TestListId, utils.InProgress, utils.TestListId, utils.InProgress).
+					WillReturnRows(sqlxmock.NewRows([]string{"past"}).AddRow(false))
+				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs(utils.InProgress, utils.TestTodoId, utils.TestListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, testCase.change)
+			if err != nil {
+				require.NotNil(t, testCase.expectedErr, err.Error())
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryTransferTodo(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := todo.NewRepositoryTodoConvertor()
+	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+	targetListId, copyId := uuid.UUID{8}, uuid.UUID{9}
+	selectTargetStatus := `SELECT name FROM workflow_state WHERE list_id = \$1 ORDER BY name = \$2 DESC, position LIMIT 1 FOR SHARE`
+	selectAssignees := `SELECT username FROM todo_assignee WHERE todo_id = \$1 ORDER BY username`
+	members := `SELECT username FROM users_lists WHERE list_id = \$\d`
+
+	helperLockTodo := func(status, recurrence string) {
+		mock.ExpectQuery(selectTodoColumns+
+			`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+			WithArgs(utils.TestTodoId, utils.TestListId).
+			WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
+				"created_at", "status", "priority", "recurrence"}).
+				AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
+					status, utils.MediumPriority, recurrence))
+	}
+	helperMoveTodo := func(status string) {
+		mock.ExpectExec(`UPDATE todo SET list_id = \$1, name = \$2, status = \$3 WHERE id = \$4 AND list_id = \$5`).
+			WithArgs(targetListId, "Moved", status, utils.TestTodoId, utils.TestListId).
+			WillReturnResult(sqlxmock.NewResult(1, 1))
+		mock.ExpectExec(`DELETE FROM todo_label WHERE todo_id = \$1`).
+			WithArgs(utils.TestTodoId).
+			WillReturnResult(sqlxmock.NewResult(0, 2))
+		for _, table := range []string{"todo_assignee", "todo_watcher"} {
+			mock.ExpectExec(`DELETE FROM ` + table + ` WHERE todo_id = \$1 AND username NOT IN \(` + members + `\)`).
+				WithArgs(utils.TestTodoId, targetListId).
+				WillReturnResult(sqlxmock.NewResult(0, 1))
+		}
+		mock.ExpectExec(`UPDATE subtask SET assignee = '' WHERE todo_id = \$1 AND assignee <> '' AND assignee NOT IN \(` + members + `\)`).
+			WithArgs(utils.TestTodoId, targetListId).
+			WillReturnResult(sqlxmock.NewResult(0, 0))
+	}
+
+	testCases := []struct {
+		name        string
+		change      func(ctx context.Context) error
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "move todo keeping its status",
+			change: func(ctx context.Context) error {
+				return repo.MoveTodo(ctx, utils.TestTodoId, utils.TestListId, targetListId, "Moved")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.Assigned, "")
+				mock.ExpectQuery(selectTargetStatus).
+					WithArgs(targetListId, utils.Assigned).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.Assigned))
+				helperMoveTodo(utils.Assigned)
+				mock.ExpectQuery(selectAssignees).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"username"}).AddRow(utils.TestUsername))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "move assigned todo to list without its assignees",
+			change: func(ctx context.Context) error {
+				return repo.MoveTodo(ctx, utils.TestTodoId, utils.TestListId, targetListId, "Moved")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.Assigned, "")
+				mock.ExpectQuery(selectTargetStatus).
+					WithArgs(targetListId, utils.Assigned).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.Assigned))
+				helperMoveTodo(utils.Assigned)
+				mock.ExpectQuery(selectAssignees).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"username"}))
+				mock.ExpectQuery(`SELECT name FROM workflow_state WHERE list_id = \$1 ORDER BY position LIMIT 1 FOR SHARE`).
+					WithArgs(targetListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(`UPDATE todo SET status = \$1 WHERE id = \$2 AND list_id = \$3`).
+					WithArgs(utils.NotAssigned, utils.TestTodoId, targetListId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "move todo to list with the same todo name",
+			change: func(ctx context.Context) error {
+				return repo.MoveTodo(ctx, utils.TestTodoId, utils.TestListId, targetListId, "Moved")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.InProgress, "")
+				mock.ExpectQuery(selectTargetStatus).
+					WithArgs(targetListId, utils.InProgress).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(`UPDATE todo SET list_id = \$1, name = \$2, status = \$3`).
+					WithArgs(targetListId, "Moved", utils.NotAssigned, utils.TestTodoId, utils.TestListId).
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error already exists todo with the same name Moved in list with id: .+"),
+		}, {
+			name: "move todo to missing list",
+			change: func(ctx context.Context) error {
+				return repo.MoveTodo(ctx, utils.TestTodoId, utils.TestListId, targetListId, "Moved")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.InProgress, "")
+				mock.ExpectQuery(selectTargetStatus).
+					WithArgs(targetListId, utils.InProgress).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found list with id: .+"),
+		}, {
+			name: "copy recurring todo into its own list",
+			change: func(ctx context.Context) error {
+				return repo.CopyTodo(ctx, utils.TestTodoId, utils.TestListId, copyId, utils.TestListId, "Copied")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.InProgress, "FREQ=DAILY")
+				mock.ExpectQuery(selectTargetStatus).
+					WithArgs(utils.TestListId, utils.InProgress).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.InProgress))
+				mock.ExpectExec(`INSERT INTO todo\(id, list_id, name, description, deadline, priority, status, recurrence, series_id\)`).
+					WithArgs(copyId, utils.TestListId, "Copied", utils.TestTodoDescription, time.Time{}, utils.MediumPriority,
+						utils.InProgress, "FREQ=DAILY", uuid.NullUUID{UUID: copyId, Valid: true}).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				for _, table := range []string{"todo_assignee", "todo_watcher"} {
+					mock.ExpectExec(`INSERT INTO ` + table + `\(todo_id, username\) SELECT \$1, username FROM ` + table +
+						` WHERE todo_id = \$2 AND username IN \(` + members + `\)`).
+						WithArgs(copyId, utils.TestTodoId, utils.TestListId).
+						WillReturnResult(sqlxmock.NewResult(0, 1))
+				}
+				mock.ExpectExec(`INSERT INTO todo_label\(todo_id, label_id\) SELECT \$1, label_id FROM todo_label WHERE todo_id = \$2`).
+					WithArgs(copyId, utils.TestTodoId).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectQuery(`SELECT id, todo_id, title, done, assignee, position FROM subtask WHERE todo_id = \$1 ORDER BY position`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "todo_id", "title", "done", "assignee", "position"}).
+						AddRow(uuid.UUID{10}, utils.TestTodoId, utils.TestSubtaskTitle, true, utils.TestUsername, 0).
+						AddRow(uuid.UUID{11}, utils.TestTodoId, "Left", false, "Former", 1))
+				mock.ExpectQuery(members).
+					WithArgs(utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"username"}).AddRow(utils.TestUsername))
+				mock.ExpectExec(`INSERT INTO subtask\(id, todo_id, title, done, assignee, position\) VALUES\(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+					WithArgs(sqlxmock.AnyArg(), copyId, utils.TestSubtaskTitle, true, utils.TestUsername, 0).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO subtask\(id, todo_id, title, done, assignee, position\) VALUES\(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+					WithArgs(sqlxmock.AnyArg(), copyId, "Left", false, "", 1).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectQuery(selectAssignees).
+					WithArgs(copyId).
+					WillReturnRows(sqlxmock.NewRows([]string{"username"}).AddRow(utils.TestUsername))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "copy todo to list with the same todo name",
+			change: func(ctx context.Context) error {
+				return repo.CopyTodo(ctx, utils.TestTodoId, utils.TestListId, copyId, targetListId, "Copied")
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				helperLockTodo(utils.NotAssigned, "")
+				mock.ExpectQuery(selectTargetStatus).
+					WithArgs(targetListId, utils.NotAssigned).
+					WillReturnRows(sqlxmock.NewRows([]string{"name"}).AddRow(utils.NotAssigned))
+				mock.ExpectExec(`INSERT INTO todo`).
+					WithArgs(copyId, targetListId, "Copied", utils.TestTodoDescription, time.Time{}, utils.MediumPriority,
+						utils.NotAssigned, "", uuid.NullUUID{}).
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error already exists todo with the same name Copied in list with id: .+"),
+		}, {
+			name: "check todo name",
+			change: func(ctx context.Context) error {
+				contains, err := repo.ContainsTodoName(ctx, targetListId, "Moved")
+				if err == nil && !contains {
+					err = errors.New("expected the name to be taken")
+				}
+				return err
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE list_id = \$1 AND name = \$2`).
+					WithArgs(targetListId, "Moved").
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, testCase.change)
+			if err != nil {
+				require.NotNil(t, testCase.expectedErr, err.Error())
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryContainsTodoInList(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := todo.NewRepositoryTodoConvertor()
+	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		inputTodoId uuid.UUID
+		inputListId uuid.UUID
+		mock        func()
+		expected    bool
+	}{
+		{
+			name:        "contains todo",
+			inputTodoId: utils.TestTodoId,
+			inputListId: utils.TestListId,
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(
+						sqlxmock.NewRows([]string{"count"}).
+							AddRow(1))
+			},
+			expected: true,
+		}, {
+			name:        "does not contains",
+			inputTodoId: utils.TestTodoId,
+			inputListId: utils.TestListId,
+			mock: func() {
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM todo WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(
+						sqlxmock.NewRows([]string{"count"}).
+							AddRow(0))
+			},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual := repo.CheckIfListContainsTodo(ctx, testCase.inputTodoId, testCase.inputListId)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRepositoryGetTodoAssignees(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := todo.NewRepositoryTodoConvertor()
+	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		inputTodoId uuid.UUID
+		mock        func()
+		expected    []string
+	}{
+		{
+			name:        "get todo assignees",
+			inputTodoId: utils.TestTodoId,
+			mock: func() {
+				mock.ExpectQuery(`SELECT username FROM todo_assignee WHERE todo_id = \$1 ORDER BY username`).
+					WithArgs(utils.TestTodoId).
+					WillReturnRows(
+						sqlxmock.NewRows([]string{"username"}).
+							AddRow("RandomUser").
+							AddRow(utils.TestUsername))
+			},
+			expected: []string{"RandomUser", utils.TestUsername},
+		}, {
+			name:        "todo without assignees",
+			inputTodoId: utils.TestTodoId,
+			mock: func() {
+				mock.ExpectQuery(`SELECT username FROM todo_assignee WHERE todo_id = \$1 ORDER BY username`).
+					WithArgs(utils.TestTodoId).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual := repo.GetTodoAssignees(ctx, testCase.inputTodoId)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRepositoryGetUserListIds(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := todo.NewRepositoryTodoConvertor()
+	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expected    []uuid.UUID
+		expectedErr error
+	}{
+		{
+			name: "get the lists of the user",
+			mock: func() {
+				mock.ExpectQuery(`SELECT list_id FROM users_lists WHERE username = \$1`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(sqlxmock.NewRows([]string{"list_id"}).AddRow(utils.TestListId))
+			},
+			expected: []uuid.UUID{utils.TestListId},
+		}, {
+			name: "database error",
+			mock: func() {
+				mock.ExpectQuery(`SELECT list_id FROM users_lists WHERE username = \$1`).
+					WithArgs(utils.TestUsername).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.GetUserListIds(ctx, utils.TestUsername)
+			require.ErrorIs(t, err, testCase.expectedErr)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRepositoryAttachLabel(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := todo.NewRepositoryTodoConvertor()
+	repo := todo.NewDBRepositoryTodo(db, *convertor)
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+
+	helperLockedTodo := func() *sqlxmock.Rows {
+		return sqlxmock.NewRows([]string{"id", "list_id", "name", "description", "deadline",
+			"created_at", "assignees", "status", "priority"}).
+			AddRow(utils.TestTodoId, utils.TestListId, utils.TestTodoName, utils.TestTodoDescription, time.Time{}, time.Time{},
+				helperAssignees(), utils.NotAssigned, utils.MediumPriority)
+	}
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "attach label of the list",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo())
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM label WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestLabelId, utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectExec(`INSERT INTO todo_label\(todo_id, label_id\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, utils.TestLabelId).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "attach label of another list",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo())
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM label WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestLabelId, utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found label with id .+ in list with id: .+"),
+		}, {
+			name: "attach label twice",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(selectTodoColumns+
+					`FROM todo WHERE id = \$1 AND list_id = \$2 FOR UPDATE`).
+					WithArgs(utils.TestTodoId, utils.TestListId).
+					WillReturnRows(helperLockedTodo())
+				mock.ExpectQuery(`SELECT COUNT\(id\) FROM label WHERE id = \$1 AND list_id = \$2`).
+					WithArgs(utils.TestLabelId, utils.TestListId).
+					WillReturnRows(sqlxmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectExec(`INSERT INTO todo_label\(todo_id, label_id\) VALUES \(\$1, \$2\)`).
+					WithArgs(utils.TestTodoId, utils.TestLabelId).
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error already exists label with id .+ on todo with id: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repo.AttachLabel(ctx, utils.TestTodoId, utils.TestListId, utils.TestLabelId)
+			})
+			if err != nil {
+				result, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, result)
+			} else if testCase.expectedErr != nil {
+				t.Error("Expected error but got nil")
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func helperAssignees(usernames ...string) string {
+	assignees := make([]string, 0, len(usernames))
+	for _, username := range usernames {
+		if username != "" {
+			assignees = append(assignees, username)
+		}
+	}
+	result, _ := json.Marshal(assignees)
+
+	return string(result)
+}
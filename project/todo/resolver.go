@@ -3,14 +3,19 @@ package todo
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
+	"io"
 	"net/http"
+	"net/url"
 	"project/structures"
 	"project/utils"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -19,30 +24,97 @@ const (
 	listId                 = "listId"
 	todoId                 = "todoId"
 	username               = "userId"
+	labelId                = "labelId"
+	targetUsername         = "username"
+	dependencyId           = "dependencyId"
 	assigningErrorMsg      = "error assigning"
 	changingStatusErrorMsg = "error changing status"
+	reopeningErrorMsg      = "error reopening"
+	steppingBackErrorMsg   = "error stepping back"
+	unassigningErrorMsg    = "error unassigning"
+	reassigningErrorMsg    = "error reassigning"
+	dependencyErrorMsg     = "error dependency"
+	transferErrorMsg       = "error transferring"
+	bulkErrorMsg           = "error applying"
+	rolledBackErrorMsg     = "error rolled back"
+	notAssignedErrorMsg    = "not assigned to"
+
+	failOnConflict    = "fail"
+	renameOnConflict  = "rename"
+	maxTodoNameLength = 100
+
+	atomicMode       = "atomic"
+	bestEffortMode   = "best_effort"
+	bulkChangeStatus = "change_status"
+	bulkSetPriority  = "set_priority"
+	bulkAssign       = "assign"
+	bulkAddLabel     = "add_label"
+	bulkDelete       = "delete"
+	bulkMove         = "move"
+
+	statusParam       = "status"
+	priorityParam     = "priority"
+	assigneeParam     = "assignee"
+	deadlineFromParam = "deadline_from"
+	deadlineToParam   = "deadline_to"
+	textParam         = "q"
+	labelParam        = "label"
+	sortParam         = "sort"
+	orderParam        = "order"
+	ascendingOrder    = "asc"
+	descendingOrder   = "desc"
+
+	defaultUpcomingLimit = 5
 )
 
 //go:generate mockery --name ServiceTodo --output=automock --with-expecter=true
 type ServiceTodo interface {
 	GetTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoOutput, error)
-	GetAllTasks(ctx context.Context, listId uuid.UUID) []structures.TodoOutput
+	GetAllTasks(ctx context.Context, listId uuid.UUID, query structures.TodoQuery) (*structures.TodoPageOutput, error)
 	CreateTodo(ctx context.Context, todoInput structures.TodoInput, listId uuid.UUID) (*structures.TodoOutput, error)
 	DeleteTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoOutput, error)
 	UpdateTodo(ctx context.Context, todoId, listId uuid.UUID, todoUpdate structures.TodoInput) (*structures.TodoOutput, error)
 	AssignUserToTodo(ctx context.Context, todoId, listId uuid.UUID, username string) error
-	ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID) error
+	ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID, status string) error
+	ReopenTodo(ctx context.Context, todoId, listId uuid.UUID) error
+	StepBackTodo(ctx context.Context, todoId, listId uuid.UUID) error
+	UnassignTodo(ctx context.Context, todoId, listId uuid.UUID) error
+	ReassignTodo(ctx context.Context, todoId, listId uuid.UUID, username string) error
+	RemoveTodoAssignee(ctx context.Context, todoId, listId uuid.UUID, username string) error
+	AddTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error
+	RemoveTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error
 	CheckIfListContainsTodo(ctx context.Context, todoId, listId uuid.UUID) bool
-	GetTodoAssignee(ctx context.Context, todoId uuid.UUID) string
+	GetTodoAssignees(ctx context.Context, todoId uuid.UUID) []string
+	AttachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error
+	DetachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error
+	SetTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID, rule string) error
+	StopTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID) error
+	AddTodoDependency(ctx context.Context, todoId, listId, blockerId, blockerListId uuid.UUID) error
+	RemoveTodoDependency(ctx context.Context, todoId, listId, blockerId uuid.UUID) error
+	GetTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID, limit int) (*structures.TodoRecurrenceOutput, error)
+	MoveTodos(ctx context.Context, todoIds []uuid.UUID, listId, targetListId uuid.UUID, conflict string) ([]structures.TodoOutput, error)
+	CopyTodos(ctx context.Context, todoIds []uuid.UUID, listId, targetListId uuid.UUID, conflict string) ([]structures.TodoOutput, error)
+	BulkUpdateTodos(ctx context.Context, listId uuid.UUID, input structures.TodoBulkInput) (*structures.TodoBulkOutput, error)
+}
+
+// ListMembers tells whether a user belongs to a list and with which role, so todos are only handed over to its
+// members and only moved to lists the user can write to.
+//
+//go:generate mockery --name ListMembers --output=automock --with-expecter=true
+type ListMembers interface {
+	ContainUserInList(ctx context.Context, listId uuid.UUID, username string) bool
+	GetUserListRole(ctx context.Context, listId uuid.UUID, username string) string
 }
 
 type ResolverTodo struct {
 	service ServiceTodo
+	members ListMembers
 }
 
-func NewResolverTodo(service ServiceTodo) *ResolverTodo {
+func NewResolverTodo(service ServiceTodo, members ListMembers) *ResolverTodo {
 	return &ResolverTodo{
 		service: service,
+		members: members,
 	}
 }
 
@@ -101,11 +173,93 @@ func (r *ResolverTodo) GetAllTasks(w http.ResponseWriter, req *http.Request) {
 		utils.ResponseHandling(req, w, err)
 		return
 	}
-	result := r.service.GetAllTasks(ctx, *listId)
 
+	query, err := parseTodoQuery(req.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err.Error())
+		return
+	}
+
+	result, err := r.service.GetAllTasks(ctx, *listId, *query)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.InvalidCursorErrorMsg) || strings.Contains(err.Error(), utils.InvalidPageErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to get todos of list with id: %s", *listId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	utils.SetPageHeaders(w, result.PageInfoOutput)
 	w.WriteHeader(http.StatusOK)
 	log.Info(fmt.Sprintf("success getting all tasks form list with id: %s", *listId))
-	utils.ResponseHandling(req, w, result)
+	utils.ResponseHandling(req, w, result.Todos)
+}
+
+func parseTodoQuery(values url.Values) (*structures.TodoQuery, error) {
+	query := structures.TodoQuery{
+		Status:   values.Get(statusParam),
+		Priority: values.Get(priorityParam),
+		Assignee: values.Get(assigneeParam),
+		Text:     values.Get(textParam),
+		Label:    values.Get(labelParam),
+		SortBy:   values.Get(sortParam),
+	}
+
+	if _, ok := utils.PriorityRank[query.Priority]; query.Priority != "" && !ok {
+		return nil, errors.New(fmt.Sprintf("error invalid priority %s", query.Priority))
+	}
+	if query.SortBy != "" && !slices.Contains(utils.TodoSortKeys, query.SortBy) {
+		return nil, errors.New(fmt.Sprintf("error invalid sort key %s, expected one of: %s", query.SortBy, strings.Join(utils.TodoSortKeys, ", ")))
+	}
+
+	switch values.Get(orderParam) {
+	case "", ascendingOrder:
+	case descendingOrder:
+		query.Descending = true
+	default:
+		return nil, errors.New(fmt.Sprintf("error invalid order %s, expected %s or %s", values.Get(orderParam), ascendingOrder, descendingOrder))
+	}
+
+	var err error
+	query.DeadlineFrom, err = parseDate(values, deadlineFromParam)
+	if err != nil {
+		return nil, err
+	}
+	query.DeadlineTo, err = parseDate(values, deadlineToParam)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := utils.ParsePageQuery(values)
+	if err != nil {
+		return nil, err
+	}
+	query.PageQuery = *page
+
+	return &query, nil
+}
+
+func parseDate(values url.Values, param string) (time.Time, error) {
+	value := values.Get(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		date, err = time.Parse(time.RFC3339, value)
+	}
+	if err != nil {
+		return time.Time{}, errors.New(fmt.Sprintf("error invalid %s %s, expected a date such as 2006-01-02", param, value))
+	}
+
+	return date, nil
 }
 
 func (r *ResolverTodo) validateTodo(input structures.TodoInput) bool {
@@ -152,7 +306,7 @@ func (r *ResolverTodo) CreateTodo(w http.ResponseWriter, req *http.Request) {
 			w.WriteHeader(http.StatusConflict)
 		} else if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
 			w.WriteHeader(http.StatusNotFound)
-		} else if strings.Contains(err.Error(), utils.CreateErrorMsg) {
+		} else if strings.Contains(err.Error(), utils.CreateErrorMsg) || strings.Contains(err.Error(), invalidRecurrenceErrorMsg) {
 			w.WriteHeader(http.StatusBadRequest)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -258,8 +412,6 @@ func (r *ResolverTodo) UpdateTodo(w http.ResponseWriter, req *http.Request) {
 }
 
 func (r *ResolverTodo) AssignUserToTodo(w http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-
 	vars := mux.Vars(req)
 	todoId, err := utils.GetID(vars, todoId)
 	if err != nil {
@@ -274,15 +426,45 @@ func (r *ResolverTodo) AssignUserToTodo(w http.ResponseWriter, req *http.Request
 		return
 	}
 
-	username := req.Header.Get(username)
-	if username == "" {
+	user := req.Header.Get(username)
+	if user == "" {
 		w.WriteHeader(http.StatusBadRequest)
 		msg := "username is required"
 		utils.ResponseHandling(req, w, msg)
 		return
 	}
 
-	err = r.service.AssignUserToTodo(ctx, *todoId, *listId, username)
+	var input structures.TodoAssigneeInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil && !errors.Is(err, io.EOF) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode assignee")
+		return
+	}
+
+	username := strings.TrimSpace(input.Username)
+	if username == "" {
+		username = user
+	}
+	if !r.allowedForUser(w, req, *listId, user, username, true) {
+		return
+	}
+
+	r.assignUserToTodo(w, req, *todoId, *listId, username)
+}
+
+// AddTodoAssignee adds the user of the path to the assignees of the todo.
+func (r *ResolverTodo) AddTodoAssignee(w http.ResponseWriter, req *http.Request) {
+	todoId, listId, username, ok := r.getTodoUserInput(w, req, true)
+	if !ok {
+		return
+	}
+
+	r.assignUserToTodo(w, req, *todoId, *listId, username)
+}
+
+func (r *ResolverTodo) assignUserToTodo(w http.ResponseWriter, req *http.Request, todoId, listId uuid.UUID, username string) {
+	err := r.service.AssignUserToTodo(req.Context(), todoId, listId, username)
 	if err != nil {
 		msg := err.Error()
 		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
@@ -303,6 +485,144 @@ func (r *ResolverTodo) AssignUserToTodo(w http.ResponseWriter, req *http.Request
 	utils.ResponseHandling(req, w, msg)
 }
 
+func (r *ResolverTodo) RemoveTodoAssignee(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, username, ok := r.getTodoUserInput(w, req, false)
+	if !ok {
+		return
+	}
+
+	err := r.service.RemoveTodoAssignee(ctx, *todoId, *listId, username)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), unassigningErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to remove assignee %s from todo with id: %s", username, todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success removing assignee %s from todo with id: %s", username, todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) AddTodoWatcher(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, username, ok := r.getTodoUserInput(w, req, true)
+	if !ok {
+		return
+	}
+
+	err := r.service.AddTodoWatcher(ctx, *todoId, *listId, username)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), utils.AlreadyExistsErrorMsg) {
+			w.WriteHeader(http.StatusConflict)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to add watcher %s to todo with id: %s", username, todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success adding watcher %s to todo with id: %s", username, todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) RemoveTodoWatcher(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, username, ok := r.getTodoUserInput(w, req, false)
+	if !ok {
+		return
+	}
+
+	err := r.service.RemoveTodoWatcher(ctx, *todoId, *listId, username)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to remove watcher %s from todo with id: %s", username, todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success removing watcher %s from todo with id: %s", username, todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+// getTodoUserInput reads the todo and the user of the path and checks that the caller may act for that user.
+func (r *ResolverTodo) getTodoUserInput(w http.ResponseWriter, req *http.Request, adding bool) (*uuid.UUID, *uuid.UUID, string, bool) {
+	vars := mux.Vars(req)
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return nil, nil, "", false
+	}
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return nil, nil, "", false
+	}
+	target := strings.TrimSpace(vars[targetUsername])
+	if target == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "username is required")
+		return nil, nil, "", false
+	}
+
+	if !r.allowedForUser(w, req, *listId, req.Header.Get(username), target, adding) {
+		return nil, nil, "", false
+	}
+
+	return todoId, listId, target, true
+}
+
+// allowedForUser lets everyone act for themselves and the managers and owner of the list, which admins always
+// are, act for any user. Users added to a todo must be members of its list.
+func (r *ResolverTodo) allowedForUser(w http.ResponseWriter, req *http.Request, listId uuid.UUID, user, target string, adding bool) bool {
+	ctx := req.Context()
+
+	if target == user {
+		return true
+	}
+	if utils.GetListRoleFromContext(ctx) < utils.ListRole[utils.Manager] {
+		w.WriteHeader(http.StatusForbidden)
+		msg := fmt.Sprintf("%s is not authorized to act for other users on todos in list: %s", user, listId)
+		utils.ResponseHandling(req, w, msg)
+		return false
+	}
+	if adding && !r.members.ContainUserInList(ctx, listId, target) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("%s is not a member of list with id: %s", target, listId)
+		utils.ResponseHandling(req, w, msg)
+		return false
+	}
+
+	return true
+}
+
 func (r *ResolverTodo) ChangeTodoStatus(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
@@ -320,36 +640,729 @@ func (r *ResolverTodo) ChangeTodoStatus(w http.ResponseWriter, req *http.Request
 		return
 	}
 
-	user := req.Header.Get(username)
+	var input structures.TodoStatusInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode status")
+		return
+	}
+	input.Status = strings.TrimSpace(input.Status)
+	if input.Status == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "status is required")
+		return
+	}
 
-	if utils.GetUsersRights(user) != utils.Role[utils.Admin] {
-		todoAssignee := r.service.GetTodoAssignee(ctx, *todoId)
+	user := req.Header.Get(username)
+	if !r.canChangeAssignedTodo(ctx, *todoId, user) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("task %s is not assigned to %s", todoId, user)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
 
-		if todoAssignee != user {
+	err = r.service.ChangeTodoStatus(ctx, *todoId, *listId, input.Status)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), changingStatusErrorMsg) {
 			w.WriteHeader(http.StatusBadRequest)
-			msg := fmt.Sprintf("task %s is not assigned to %s", todoId, user)
-			utils.ResponseHandling(req, w, msg)
-			return
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("error changing task status with id: %s", todoId)
 		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("status of todo with id: %s successfuly changed to %s", todoId, input.Status)
+	utils.ResponseHandling(req, w, msg)
+}
+
+// canChangeAssignedTodo lets the assignees of the todo and the managers and owner of the list, which admins
+// always are, move the todo through the workflow and hand it over.
+func (r *ResolverTodo) canChangeAssignedTodo(ctx context.Context, todoId uuid.UUID, user string) bool {
+	if utils.GetListRoleFromContext(ctx) >= utils.ListRole[utils.Manager] {
+		return true
+	}
+
+	return slices.Contains(r.service.GetTodoAssignees(ctx, todoId), user)
+}
+
+// changeAssignedTodo runs change on the todo of the request once canChangeAssignedTodo allows it and reports
+// whether it succeeded. Errors containing errorMsg are the caller's mistake.
+func (r *ResolverTodo) changeAssignedTodo(w http.ResponseWriter, req *http.Request, errorMsg string,
+	change func(ctx context.Context, todoId, listId uuid.UUID) error) (*uuid.UUID, bool) {
+	ctx := req.Context()
+
+	vars := mux.Vars(req)
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return nil, false
+	}
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return nil, false
+	}
+
+	user := req.Header.Get(username)
+	if !r.canChangeAssignedTodo(ctx, *todoId, user) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("task %s is not assigned to %s", todoId, user)
+		utils.ResponseHandling(req, w, msg)
+		return nil, false
 	}
 
-	err = r.service.ChangeTodoStatus(ctx, *todoId, *listId)
+	err = change(ctx, *todoId, *listId)
 	if err != nil {
 		msg := err.Error()
 		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
 			w.WriteHeader(http.StatusNotFound)
-		} else if strings.Contains(err.Error(), changingStatusErrorMsg) {
+		} else if strings.Contains(err.Error(), errorMsg) || strings.Contains(err.Error(), "username is required") {
 			w.WriteHeader(http.StatusBadRequest)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
-			msg = fmt.Sprintf("error changing task status with id: %s", todoId)
+			msg = fmt.Sprintf("failed to change todo with id: %s", todoId)
 		}
 
 		utils.ResponseHandling(req, w, msg)
+		return nil, false
+	}
+
+	return todoId, true
+}
+
+func (r *ResolverTodo) ReopenTodo(w http.ResponseWriter, req *http.Request) {
+	todoId, ok := r.changeAssignedTodo(w, req, reopeningErrorMsg, r.service.ReopenTodo)
+	if !ok {
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success reopening todo with id: %s", todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) StepBackTodo(w http.ResponseWriter, req *http.Request) {
+	todoId, ok := r.changeAssignedTodo(w, req, steppingBackErrorMsg, r.service.StepBackTodo)
+	if !ok {
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success stepping back todo with id: %s", todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) UnassignTodo(w http.ResponseWriter, req *http.Request) {
+	todoId, ok := r.changeAssignedTodo(w, req, unassigningErrorMsg, r.service.UnassignTodo)
+	if !ok {
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
-	msg := fmt.Sprintf("status successfuly changed to todo with id: %s", todoId)
+	msg := fmt.Sprintf("success unassigning todo with id: %s", todoId)
 	utils.ResponseHandling(req, w, msg)
 }
+
+func (r *ResolverTodo) ReassignTodo(w http.ResponseWriter, req *http.Request) {
+	var input structures.TodoAssigneeInput
+	err := json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode assignee")
+		return
+	}
+	input.Username = strings.TrimSpace(input.Username)
+	if input.Username == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "username is required")
+		return
+	}
+
+	todoId, ok := r.changeAssignedTodo(w, req, reassigningErrorMsg, func(ctx context.Context, todoId, listId uuid.UUID) error {
+		if !r.members.ContainUserInList(ctx, listId, input.Username) {
+			return errors.New(fmt.Sprintf("error reassigning todo with id: %s because %s is not a member of list with id: %s", todoId, input.Username, listId))
+		}
+
+		return r.service.ReassignTodo(ctx, todoId, listId, input.Username)
+	})
+	if !ok {
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success reassigning todo with id: %s to %s", todoId, input.Username)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) getLabelIdsInput(req *http.Request) (*uuid.UUID, *uuid.UUID, *uuid.UUID, error) {
+	vars := mux.Vars(req)
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	labelId, err := utils.GetID(vars, labelId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return todoId, listId, labelId, nil
+}
+
+func (r *ResolverTodo) AttachLabel(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, labelId, err := r.getLabelIdsInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	err = r.service.AttachLabel(ctx, *todoId, *listId, *labelId)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), utils.AlreadyExistsErrorMsg) {
+			w.WriteHeader(http.StatusConflict)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to attach label with id %s to todo with id: %s", labelId, todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success attaching label with id %s to todo with id: %s", labelId, todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) DetachLabel(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, labelId, err := r.getLabelIdsInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	err = r.service.DetachLabel(ctx, *todoId, *listId, *labelId)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to detach label with id %s from todo with id: %s", labelId, todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success detaching label with id %s from todo with id: %s", labelId, todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) getTodoIdsInput(req *http.Request) (*uuid.UUID, *uuid.UUID, error) {
+	vars := mux.Vars(req)
+	todoId, err := utils.GetID(vars, todoId)
+	if err != nil {
+		return nil, nil, err
+	}
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return todoId, listId, nil
+}
+
+// SetTodoRecurrence makes the todo recurring or edits the rule of its series.
+func (r *ResolverTodo) SetTodoRecurrence(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, err := r.getTodoIdsInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	var input structures.TodoRecurrenceInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode recurrence")
+		return
+	}
+
+	err = r.service.SetTodoRecurrence(ctx, *todoId, *listId, input.Rule)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), invalidRecurrenceErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to set recurrence of todo with id: %s", todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success setting recurrence of todo with id: %s", todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+// StopTodoRecurrence ends the series, the todo stays but completing it creates no further occurrence.
+func (r *ResolverTodo) StopTodoRecurrence(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, err := r.getTodoIdsInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	err = r.service.StopTodoRecurrence(ctx, *todoId, *listId)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to stop recurrence of todo with id: %s", todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success stopping recurrence of todo with id: %s", todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) GetTodoRecurrence(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, err := r.getTodoIdsInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	limit := defaultUpcomingLimit
+	if req.URL.Query().Has(utils.LimitParam) {
+		limit, err = strconv.Atoi(req.URL.Query().Get(utils.LimitParam))
+		if err != nil || limit < 1 || limit > utils.MaxPageSize {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := fmt.Sprintf("%s must be a number between 1 and %d", utils.LimitParam, utils.MaxPageSize)
+			utils.ResponseHandling(req, w, msg)
+			return
+		}
+	}
+
+	output, err := r.service.GetTodoRecurrence(ctx, *todoId, *listId, limit)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) || strings.Contains(err.Error(), utils.GetErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to get recurrence of todo with id: %s", todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	utils.ResponseHandling(req, w, output)
+}
+
+// AddTodoDependency makes the todo wait for another one, from its own list or from any list the user can read.
+func (r *ResolverTodo) AddTodoDependency(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, err := r.getTodoIdsInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	var input structures.TodoDependencyInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil || input.TodoId == uuid.Nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode dependency, todo_id is required")
+		return
+	}
+	if input.ListId == uuid.Nil {
+		input.ListId = *listId
+	}
+
+	user := req.Header.Get(username)
+	if input.ListId != *listId && utils.GetRoleFromContext(ctx) != utils.Role[utils.Admin] &&
+		!r.members.ContainUserInList(ctx, input.ListId, user) {
+		w.WriteHeader(http.StatusForbidden)
+		msg := fmt.Sprintf("%s is not authorized to read list: %s", user, input.ListId)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	err = r.service.AddTodoDependency(ctx, *todoId, *listId, input.TodoId, input.ListId)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), utils.AlreadyExistsErrorMsg) {
+			w.WriteHeader(http.StatusConflict)
+		} else if strings.Contains(err.Error(), dependencyErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to add dependency on todo with id %s to todo with id: %s", input.TodoId, todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success adding dependency on todo with id %s to todo with id: %s", input.TodoId, todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverTodo) RemoveTodoDependency(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	todoId, listId, err := r.getTodoIdsInput(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+	blockerId, err := utils.GetID(mux.Vars(req), dependencyId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	err = r.service.RemoveTodoDependency(ctx, *todoId, *listId, *blockerId)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to remove dependency on todo with id %s from todo with id: %s", blockerId, todoId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	msg := fmt.Sprintf("success removing dependency on todo with id %s from todo with id: %s", blockerId, todoId)
+	utils.ResponseHandling(req, w, msg)
+}
+
+// MoveTodo moves the todo of the path, or the todo_ids of the body on the bulk route, to another list the user can
+// write to.
+func (r *ResolverTodo) MoveTodo(w http.ResponseWriter, req *http.Request) {
+	r.transferTodos(w, req, http.StatusOK, r.service.MoveTodos)
+}
+
+// CopyTodo copies the todo of the path, or the todo_ids of the body on the bulk route, to a list the user can write
+// to, its own one included.
+func (r *ResolverTodo) CopyTodo(w http.ResponseWriter, req *http.Request) {
+	r.transferTodos(w, req, http.StatusCreated, r.service.CopyTodos)
+}
+
+type todoTransfer func(ctx context.Context, todoIds []uuid.UUID, listId, targetListId uuid.UUID, conflict string) ([]structures.TodoOutput, error)
+
+func (r *ResolverTodo) transferTodos(w http.ResponseWriter, req *http.Request, successStatus int, transfer todoTransfer) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	vars := mux.Vars(req)
+	sourceListId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	var input structures.TodoTransferInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil || input.ListId == uuid.Nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode transfer, list_id is required")
+		return
+	}
+	if input.Conflict == "" {
+		input.Conflict = failOnConflict
+	}
+	if input.Conflict != failOnConflict && input.Conflict != renameOnConflict {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("conflict must be one of: %s, %s", failOnConflict, renameOnConflict)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	_, single := vars[todoId]
+	if single {
+		pathTodoId, err := utils.GetID(vars, todoId)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			utils.ResponseHandling(req, w, err)
+			return
+		}
+		input.TodoIds = []uuid.UUID{*pathTodoId}
+	} else if !validTodoIds(input.TodoIds) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("todo_ids must hold from 1 to %d different todo ids", utils.MaxPageSize)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	user := req.Header.Get(username)
+	if !r.canWriteToList(ctx, input.ListId, user) {
+		w.WriteHeader(http.StatusForbidden)
+		msg := fmt.Sprintf("%s is not authorized as %s in list: %s", user, utils.Editor, input.ListId)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	outputs, err := transfer(ctx, input.TodoIds, *sourceListId, input.ListId, input.Conflict)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) || strings.Contains(err.Error(), utils.GetErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), utils.AlreadyExistsErrorMsg) {
+			w.WriteHeader(http.StatusConflict)
+		} else if strings.Contains(err.Error(), transferErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to transfer todos from list with id: %s to list with id: %s", sourceListId, input.ListId)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(successStatus)
+	log.Info(fmt.Sprintf("success transferring %d todos to list with id: %s", len(outputs), input.ListId))
+	if single {
+		utils.ResponseHandling(req, w, outputs[0])
+		return
+	}
+	utils.ResponseHandling(req, w, outputs)
+}
+
+// canWriteToList tells whether the user may add todos to the list, which admins always may.
+func (r *ResolverTodo) canWriteToList(ctx context.Context, listId uuid.UUID, user string) bool {
+	return utils.GetRoleFromContext(ctx) == utils.Role[utils.Admin] ||
+		utils.ListRole[r.members.GetUserListRole(ctx, listId, user)] >= utils.ListRole[utils.Editor]
+}
+
+func validTodoIds(todoIds []uuid.UUID) bool {
+	if len(todoIds) == 0 || len(todoIds) > utils.MaxPageSize {
+		return false
+	}
+
+	seen := make(map[uuid.UUID]bool, len(todoIds))
+	for _, id := range todoIds {
+		if id == uuid.Nil || seen[id] {
+			return false
+		}
+		seen[id] = true
+	}
+
+	return true
+}
+
+// bulkErrorMsgs mark the errors of a bulk operation that are the caller's to see, the others are replaced by a
+// general message like the single todo routes do.
+var bulkErrorMsgs = []string{
+	utils.NotFoundErrorMsg, utils.GetErrorMsg, utils.AlreadyExistsErrorMsg, utils.UpdateErrorMsg,
+	utils.DeletingErrorMsg, assigningErrorMsg, changingStatusErrorMsg, transferErrorMsg, rolledBackErrorMsg,
+	notAssignedErrorMsg,
+}
+
+// BulkUpdateTodos applies one operation to many todos of the list and reports how it went for each of them. Every
+// todo is checked like on its single todo route, so only their assignees and the managers of the list change their
+// status. In atomic mode a todo failing a check refuses the whole request.
+func (r *ResolverTodo) BulkUpdateTodos(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	vars := mux.Vars(req)
+	listId, err := utils.GetID(vars, listId)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, err)
+		return
+	}
+
+	var input structures.TodoBulkInput
+	err = json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, "failed to decode bulk operation")
+		return
+	}
+	if input.Mode == "" {
+		input.Mode = atomicMode
+	}
+	if input.Mode != atomicMode && input.Mode != bestEffortMode {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("mode must be one of: %s, %s", atomicMode, bestEffortMode)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+	if !validTodoIds(input.TodoIds) {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("todo_ids must hold from 1 to %d different todo ids", utils.MaxPageSize)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	user := req.Header.Get(username)
+	if !r.validBulkArguments(w, req, *listId, user, &input) {
+		return
+	}
+
+	denied := make(map[uuid.UUID]bool)
+	if input.Operation == bulkChangeStatus {
+		for _, id := range input.TodoIds {
+			denied[id] = !r.canChangeAssignedTodo(ctx, id, user)
+		}
+	}
+	requested := input.TodoIds
+	input.TodoIds = slices.DeleteFunc(slices.Clone(requested), func(id uuid.UUID) bool {
+		return denied[id]
+	})
+	if len(input.TodoIds) < len(requested) && input.Mode == atomicMode {
+		w.WriteHeader(http.StatusForbidden)
+		msg := fmt.Sprintf("some of the tasks are %s %s", notAssignedErrorMsg, user)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	results := make(map[uuid.UUID]structures.TodoBulkResult, len(requested))
+	output := &structures.TodoBulkOutput{Operation: input.Operation, Mode: input.Mode}
+	if len(input.TodoIds) > 0 {
+		output, err = r.service.BulkUpdateTodos(ctx, *listId, input)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg := fmt.Sprintf("failed to %s todos in list with id: %s", input.Operation, listId)
+			utils.ResponseHandling(req, w, msg)
+			return
+		}
+	}
+	for _, result := range output.Results {
+		results[result.TodoId] = result
+	}
+
+	output.Results = make([]structures.TodoBulkResult, len(requested))
+	for i, id := range requested {
+		result, ok := results[id]
+		if !ok {
+			result = structures.TodoBulkResult{TodoId: id, Error: fmt.Sprintf("task %s is %s %s", id, notAssignedErrorMsg, user)}
+		}
+		if !result.Success && !slices.ContainsFunc(bulkErrorMsgs, func(msg string) bool {
+			return strings.Contains(result.Error, msg)
+		}) {
+			result.Error = fmt.Sprintf("failed to %s todo with id: %s", input.Operation, id)
+		}
+		output.Results[i] = result
+	}
+	countBulkResults(output)
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success applying %s to %d of %d todos in list with id: %s", input.Operation, output.Succeeded, len(requested), listId))
+	utils.ResponseHandling(req, w, output)
+}
+
+// validBulkArguments checks the arguments the operation of the input takes, filling in the defaults of the ones
+// that have them, and writes the response when they are not valid.
+func (r *ResolverTodo) validBulkArguments(w http.ResponseWriter, req *http.Request, listId uuid.UUID, user string, input *structures.TodoBulkInput) bool {
+	ctx := req.Context()
+
+	msg := ""
+	switch input.Operation {
+	case bulkChangeStatus:
+		input.Status = strings.TrimSpace(input.Status)
+		if input.Status == "" {
+			msg = "status is required"
+		}
+	case bulkSetPriority:
+		if utils.PriorityRank[input.Priority] == utils.PriorityRank[utils.UnknownPriority] {
+			msg = fmt.Sprintf("priority must be one of: %s, %s, %s", utils.LowPriority, utils.MediumPriority, utils.HighPriority)
+		}
+	case bulkAssign:
+		input.Username = strings.TrimSpace(input.Username)
+		if input.Username == "" {
+			input.Username = user
+		}
+		return r.allowedForUser(w, req, listId, user, input.Username, true)
+	case bulkAddLabel:
+		if input.LabelId == uuid.Nil {
+			msg = "label_id is required"
+		}
+	case bulkDelete:
+	case bulkMove:
+		if input.Conflict == "" {
+			input.Conflict = failOnConflict
+		}
+		if input.ListId == uuid.Nil || input.ListId == listId {
+			msg = "list_id of another list is required"
+		} else if input.Conflict != failOnConflict && input.Conflict != renameOnConflict {
+			msg = fmt.Sprintf("conflict must be one of: %s, %s", failOnConflict, renameOnConflict)
+		} else if !r.canWriteToList(ctx, input.ListId, user) {
+			w.WriteHeader(http.StatusForbidden)
+			msg = fmt.Sprintf("%s is not authorized as %s in list: %s", user, utils.Editor, input.ListId)
+			utils.ResponseHandling(req, w, msg)
+			return false
+		}
+	default:
+		msg = fmt.Sprintf("operation must be one of: %s", strings.Join([]string{bulkChangeStatus, bulkSetPriority,
+			bulkAssign, bulkAddLabel, bulkDelete, bulkMove}, ", "))
+	}
+	if msg != "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, msg)
+		return false
+	}
+
+	return true
+}
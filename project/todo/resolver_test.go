@@ -3,6 +3,7 @@ package todo_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
@@ -84,56 +85,202 @@ func TestResolverGetTodo(t *testing.T) {
 }
 
 func TestResolverGetAll(t *testing.T) {
+	cursor := structures.Cursor{SortBy: utils.SortByDeadline, Descending: true, Value: "2026-01-02", Id: uuid.UUID{1}}
+
 	testCases := []struct {
-		name           string
-		service        func() *mocks.ServiceTodo
-		inputListId    uuid.UUID
-		expected       []string
-		expectedStatus int
+		name            string
+		service         func() *mocks.ServiceTodo
+		inputQuery      string
+		expected        []string
+		expectedStatus  int
+		expectedHeaders map[string]string
 	}{
 		{
 			name: "get all tasks",
 			service: func() *mocks.ServiceTodo {
 				service := &mocks.ServiceTodo{}
-				service.EXPECT().GetAllTasks(mock.Anything, utils.TestListId).Return([]structures.TodoOutput{
-					{
-						Id:     uuid.UUID{0},
-						Name:   "TestTask0",
-						ListId: utils.TestListId,
-					}, {
-						Id:     uuid.UUID{1},
-						Name:   "TestTask1",
-						ListId: utils.TestListId,
-					}, {
-						Id:     uuid.UUID{2},
-						Name:   "TestTask2",
-						ListId: utils.TestListId,
+				service.EXPECT().GetAllTasks(mock.Anything, utils.TestListId, structures.TodoQuery{}).Return(&structures.TodoPageOutput{
+					Todos: []structures.TodoOutput{
+						{
+							Id:     uuid.UUID{0},
+							Name:   "TestTask0",
+							ListId: utils.TestListId,
+						}, {
+							Id:     uuid.UUID{1},
+							Name:   "TestTask1",
+							ListId: utils.TestListId,
+						}, {
+							Id:     uuid.UUID{2},
+							Name:   "TestTask2",
+							ListId: utils.TestListId,
+						},
 					},
-				})
+					PageInfoOutput: structures.PageInfoOutput{
+						TotalCount:  3,
+						StartCursor: "start-cursor",
+						EndCursor:   "end-cursor",
+					},
+				}, nil).Once()
 				return service
 			},
-			inputListId:    utils.TestListId,
 			expected:       []string{"TestTask0", "TestTask1", "TestTask2"},
 			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				utils.TotalCountHeader:      "3",
+				utils.StartCursorHeader:     "start-cursor",
+				utils.EndCursorHeader:       "end-cursor",
+				utils.HasNextPageHeader:     "false",
+				utils.HasPreviousPageHeader: "false",
+			},
 		}, {
 			name: "get from non-existing/empty list",
 			service: func() *mocks.ServiceTodo {
 				service := &mocks.ServiceTodo{}
-				service.EXPECT().GetAllTasks(mock.Anything, utils.TestListId).Return([]structures.TodoOutput(nil)).Once()
+				service.EXPECT().GetAllTasks(mock.Anything, utils.TestListId, structures.TodoQuery{}).
+					Return(&structures.TodoPageOutput{Todos: []structures.TodoOutput{}}, nil).Once()
 				return service
 			},
-			inputListId:    utils.TestListId,
 			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				utils.TotalCountHeader:      "0",
+				utils.StartCursorHeader:     "",
+				utils.HasNextPageHeader:     "false",
+				utils.HasPreviousPageHeader: "false",
+			},
+		}, {
+			name: "filtered, sorted and paged tasks",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetAllTasks(mock.Anything, utils.TestListId, structures.TodoQuery{
+					Status:       utils.Assigned,
+					Priority:     utils.HighPriority,
+					Assignee:     utils.TestUsername,
+					DeadlineFrom: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					DeadlineTo:   time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+					Text:         "docs",
+					Label:        utils.TestLabelName,
+					SortBy:       utils.SortByDeadline,
+					Descending:   true,
+					PageQuery:    structures.PageQuery{Limit: 2, After: &cursor},
+				}).Return(&structures.TodoPageOutput{
+					Todos: []structures.TodoOutput{{Id: uuid.UUID{2}, Name: "TestTask2", ListId: utils.TestListId}},
+					PageInfoOutput: structures.PageInfoOutput{
+						TotalCount:      5,
+						StartCursor:     "start-cursor",
+						EndCursor:       "end-cursor",
+						HasNextPage:     true,
+						HasPreviousPage: true,
+					},
+				}, nil).Once()
+				return service
+			},
+			inputQuery: "status=Assigned&priority=High&assignee=" + utils.TestUsername +
+				"&deadline_from=2026-01-01&deadline_to=2026-02-01T00:00:00Z&q=docs&label=" + utils.TestLabelName + "&sort=deadline&order=desc&limit=2&cursor=" +
+				utils.EncodeCursor(cursor),
+			expected:       []string{"TestTask2"},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				utils.TotalCountHeader:      "5",
+				utils.StartCursorHeader:     "start-cursor",
+				utils.EndCursorHeader:       "end-cursor",
+				utils.HasNextPageHeader:     "true",
+				utils.HasPreviousPageHeader: "true",
+			},
+		}, {
+			name: "last tasks before a cursor",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetAllTasks(mock.Anything, utils.TestListId, structures.TodoQuery{
+					SortBy:     utils.SortByDeadline,
+					Descending: true,
+					PageQuery:  structures.PageQuery{Last: 2, Before: &cursor},
+				}).Return(&structures.TodoPageOutput{
+					Todos:          []structures.TodoOutput{},
+					PageInfoOutput: structures.PageInfoOutput{TotalCount: 5, HasNextPage: true},
+				}, nil).Once()
+				return service
+			},
+			inputQuery:     "sort=deadline&order=desc&last=2&before=" + utils.EncodeCursor(cursor),
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				utils.TotalCountHeader:      "5",
+				utils.HasNextPageHeader:     "true",
+				utils.HasPreviousPageHeader: "false",
+			},
+		}, {
+			name:           "invalid priority",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputQuery:     "priority=Urgent",
+			expected:       []string{"error invalid priority Urgent"},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "invalid sort key",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputQuery:     "sort=assignee",
+			expected:       []string{"error invalid sort key assignee"},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "invalid order",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputQuery:     "order=up",
+			expected:       []string{"error invalid order up"},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "invalid deadline",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputQuery:     "deadline_from=tomorrow",
+			expected:       []string{"error invalid deadline_from tomorrow"},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "limit out of range",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputQuery:     "limit=101",
+			expected:       []string{"error invalid limit 101"},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "limit combined with last",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputQuery:     "limit=1&last=1",
+			expected:       []string{utils.InvalidPageErrorMsg},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "malformed cursor",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputQuery:     "cursor=not-a-cursor",
+			expected:       []string{utils.InvalidCursorErrorMsg},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "cursor of another order",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetAllTasks(mock.Anything, utils.TestListId, structures.TodoQuery{PageQuery: structures.PageQuery{After: &cursor}}).
+					Return(nil, errors.New(utils.InvalidCursorErrorMsg)).Once()
+				return service
+			},
+			inputQuery:     "cursor=" + utils.EncodeCursor(cursor),
+			expected:       []string{utils.InvalidCursorErrorMsg},
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "getting tasks failed",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetAllTasks(mock.Anything, utils.TestListId, structures.TodoQuery{}).
+					Return(nil, errors.New("connection refused")).Once()
+				return service
+			},
+			expected:       []string{"failed to get todos of list with id"},
+			expectedStatus: http.StatusInternalServerError,
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			resolver := todo.NewResolverWithService(testCase.service())
+			service := testCase.service()
+			resolver := todo.NewResolverWithService(service)
 
-			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/todo/api/%s", testCase.inputListId), nil)
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/todo/api/%s/todos?%s", utils.TestListId, testCase.inputQuery), nil)
 			req = req.WithContext(utils.HelperGetContext())
-			req = mux.SetURLVars(req, map[string]string{"listId": testCase.inputListId.String()})
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String()})
 			require.NoError(t, err)
 
 			rr := httptest.NewRecorder()
@@ -141,11 +288,13 @@ func TestResolverGetAll(t *testing.T) {
 			resolver.GetAllTasks(rr, req)
 
 			require.Equal(t, testCase.expectedStatus, rr.Code)
+			for header, expected := range testCase.expectedHeaders {
+				require.Equal(t, expected, rr.Header().Get(header), header)
+			}
 			for _, expected := range testCase.expected {
-				result, err := regexp.MatchString(expected, rr.Body.String())
-				require.NoError(t, err)
-				require.True(t, result)
+				require.Contains(t, rr.Body.String(), expected)
 			}
+			service.AssertExpectations(t)
 		})
 	}
 }
@@ -525,11 +674,17 @@ func TestResolverUpdate(t *testing.T) {
 }
 
 func TestResolverAssign(t *testing.T) {
+	managerCtx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[utils.Manager])
+	editorCtx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[utils.Editor])
+
 	testCases := []struct {
 		name           string
 		service        func() *mocks.ServiceTodo
+		members        func() *mocks.ListMembers
+		ctx            context.Context
 		inputTodoId    uuid.UUID
 		inputUsername  string
+		inputAssignee  []byte
 		expectedStatus int
 	}{
 		{
@@ -578,15 +733,89 @@ func TestResolverAssign(t *testing.T) {
 			inputTodoId:    utils.TestTodoId,
 			inputUsername:  utils.TestUsername,
 			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "assign task to yourself by username",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AssignUserToTodo(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestUsername).
+					Return(nil).
+					Once()
+				return service
+			},
+			ctx:            editorCtx,
+			inputTodoId:    utils.TestTodoId,
+			inputUsername:  utils.TestUsername,
+			inputAssignee:  []byte(fmt.Sprintf(`{"username": "%s"}`, utils.TestUsername)),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "manager assigns task to list member",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AssignUserToTodo(mock.Anything, utils.TestTodoId, utils.TestListId, "RandomUser").
+					Return(nil).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, utils.TestListId, "RandomUser").
+					Return(true).
+					Once()
+				return members
+			},
+			ctx:            managerCtx,
+			inputTodoId:    utils.TestTodoId,
+			inputUsername:  utils.TestUsername,
+			inputAssignee:  []byte(`{"username": "RandomUser"}`),
+			expectedStatus: http.StatusOK,
+		}, {
+			name:    "manager assigns task to user outside the list",
+			service: func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, utils.TestListId, "RandomUser").
+					Return(false).
+					Once()
+				return members
+			},
+			ctx:            managerCtx,
+			inputTodoId:    utils.TestTodoId,
+			inputUsername:  utils.TestUsername,
+			inputAssignee:  []byte(`{"username": "RandomUser"}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "editor assigns task to another user",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			ctx:            editorCtx,
+			inputTodoId:    utils.TestTodoId,
+			inputUsername:  utils.TestUsername,
+			inputAssignee:  []byte(`{"username": "RandomUser"}`),
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name:           "assign task with invalid body",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			ctx:            managerCtx,
+			inputTodoId:    utils.TestTodoId,
+			inputUsername:  utils.TestUsername,
+			inputAssignee:  []byte(`{"username": `),
+			expectedStatus: http.StatusBadRequest,
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			resolver := todo.NewResolverWithService(testCase.service())
+			members := &mocks.ListMembers{}
+			if testCase.members != nil {
+				members = testCase.members()
+			}
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = utils.HelperGetContext()
+			}
+			resolver := todo.NewResolverTodo(testCase.service(), members)
 
-			req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/todo/api/%s/%s", utils.TestListName, testCase.inputTodoId), nil)
-			req = req.WithContext(utils.HelperGetContext())
+			req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/todo/api/%s/%s", utils.TestListName, testCase.inputTodoId), bytes.NewReader(testCase.inputAssignee))
+			req = req.WithContext(ctx)
 			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String(), "todoId": testCase.inputTodoId.String()})
 			req.Header.Set("userId", testCase.inputUsername)
 			require.NoError(t, err)
@@ -596,6 +825,7 @@ func TestResolverAssign(t *testing.T) {
 			resolver.AssignUserToTodo(rr, req)
 
 			require.Equal(t, testCase.expectedStatus, rr.Code)
+			members.AssertExpectations(t)
 		})
 	}
 }
@@ -606,71 +836,120 @@ func TestResolverStatus(t *testing.T) {
 		service        func() *mocks.ServiceTodo
 		ctx            context.Context
 		inputTodoId    uuid.UUID
+		inputStatus    []byte
 		expectedStatus int
 	}{
 		{
 			name: "change todo status success",
 			service: func() *mocks.ServiceTodo {
 				service := &mocks.ServiceTodo{}
-				service.EXPECT().GetTodoAssignee(mock.Anything, utils.TestTodoId).
-					Return(utils.TestUsername).
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().ChangeTodoStatus(mock.Anything, utils.TestTodoId, utils.TestListId, utils.InReview).
+					Return(nil).
+					Once()
+				return service
+			},
+			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(fmt.Sprintf(`{"status": "%s"}`, utils.InReview)),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "change status of todo with several assignees",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{"RandomUser", utils.TestUsername}).
 					Once()
-				service.EXPECT().ChangeTodoStatus(mock.Anything, utils.TestTodoId, utils.TestListId).
+				service.EXPECT().ChangeTodoStatus(mock.Anything, utils.TestTodoId, utils.TestListId, utils.InReview).
 					Return(nil).
 					Once()
 				return service
 			},
 			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(fmt.Sprintf(`{"status": "%s"}`, utils.InReview)),
 			expectedStatus: http.StatusOK,
 		}, {
 			name: "todo does not have user assigned",
 			service: func() *mocks.ServiceTodo {
 				service := &mocks.ServiceTodo{}
-				service.EXPECT().GetTodoAssignee(mock.Anything, utils.TestTodoId).
-					Return("").
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{}).
 					Once()
 				return service
 			},
 			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(fmt.Sprintf(`{"status": "%s"}`, utils.InReview)),
 			expectedStatus: http.StatusBadRequest,
 		}, {
 			name: "todo has different user assigned",
 			service: func() *mocks.ServiceTodo {
 				service := &mocks.ServiceTodo{}
-				service.EXPECT().GetTodoAssignee(mock.Anything, utils.TestTodoId).
-					Return("RandomUser").
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{"RandomUser"}).
 					Once()
 				return service
 			},
 			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(fmt.Sprintf(`{"status": "%s"}`, utils.InReview)),
 			expectedStatus: http.StatusBadRequest,
 		}, {
 			name: "change todo status not found",
 			service: func() *mocks.ServiceTodo {
 				service := &mocks.ServiceTodo{}
-				service.EXPECT().GetTodoAssignee(mock.Anything, utils.TestTodoId).
-					Return(utils.TestUsername).
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
 					Once()
-				service.EXPECT().ChangeTodoStatus(mock.Anything, utils.TestTodoId, utils.TestListId).
+				service.EXPECT().ChangeTodoStatus(mock.Anything, utils.TestTodoId, utils.TestListId, utils.InReview).
 					Return(errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", utils.TestTodoId, utils.TestListId))).
 					Once()
 				return service
 			},
 			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(fmt.Sprintf(`{"status": "%s"}`, utils.InReview)),
 			expectedStatus: http.StatusNotFound,
 		}, {
 			name: "change todo status failing table not changed",
 			service: func() *mocks.ServiceTodo {
 				service := &mocks.ServiceTodo{}
-				service.EXPECT().GetTodoAssignee(mock.Anything, utils.TestTodoId).
-					Return(utils.TestUsername).
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
 					Once()
-				service.EXPECT().ChangeTodoStatus(mock.Anything, utils.TestTodoId, utils.TestListId).
+				service.EXPECT().ChangeTodoStatus(mock.Anything, utils.TestTodoId, utils.TestListId, utils.InReview).
 					Return(errors.New(fmt.Sprintf("error changing status to todo with id: %s", utils.TestTodoId))).
 					Once()
 				return service
 			},
 			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(fmt.Sprintf(`{"status": "%s"}`, utils.InReview)),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "change todo status not allowed by the workflow",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().ChangeTodoStatus(mock.Anything, utils.TestTodoId, utils.TestListId, utils.InReview).
+					Return(errors.New(fmt.Sprintf("error changing status of todo with id: %s from %s to %s is not allowed by the workflow",
+						utils.TestTodoId, utils.NotAssigned, utils.InReview))).
+					Once()
+				return service
+			},
+			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(fmt.Sprintf(`{"status": "%s"}`, utils.InReview)),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "change todo status without status",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(`{"status": " "}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "change todo status with invalid body",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			inputTodoId:    utils.TestTodoId,
+			inputStatus:    []byte(`{"status": `),
 			expectedStatus: http.StatusBadRequest,
 		},
 	}
@@ -679,7 +958,7 @@ func TestResolverStatus(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			resolver := todo.NewResolverWithService(testCase.service())
 
-			req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/todo/api/%s/%s/status", utils.TestListId, testCase.inputTodoId), nil)
+			req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/todo/api/%s/%s/status", utils.TestListId, testCase.inputTodoId), bytes.NewReader(testCase.inputStatus))
 			req = req.WithContext(utils.HelperGetContext())
 			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String(), "todoId": testCase.inputTodoId.String()})
 			req.Header.Set("userId", utils.TestUsername)
@@ -693,3 +972,1047 @@ func TestResolverStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestResolverAttachLabel(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceTodo
+		expectedStatus int
+	}{
+		{
+			name: "attach label success",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AttachLabel(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestLabelId).
+					Return(nil).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "attach label from another list",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AttachLabel(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestLabelId).
+					Return(errors.New(fmt.Sprintf("error not found label with id %s in list with id: %s", utils.TestLabelId, utils.TestListId))).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "attach label twice",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AttachLabel(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestLabelId).
+					Return(errors.New(fmt.Sprintf("error already exists label with id %s on todo with id: %s", utils.TestLabelId, utils.TestTodoId))).
+					Once()
+				return service
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := todo.NewResolverWithService(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/todo/api/list/%s/todo/%s/labels/%s", utils.TestListId, utils.TestTodoId, utils.TestLabelId), nil)
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{
+				"listId":  utils.TestListId.String(),
+				"todoId":  utils.TestTodoId.String(),
+				"labelId": utils.TestLabelId.String(),
+			})
+
+			rr := httptest.NewRecorder()
+
+			resolver.AttachLabel(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverMoveBackwards(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceTodo
+		handler        func(resolver *todo.ResolverTodo) http.HandlerFunc
+		expectedStatus int
+	}{
+		{
+			name: "reopen todo success",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().ReopenTodo(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(nil).
+					Once()
+				return service
+			},
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.ReopenTodo },
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "reopen todo that is not done",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().ReopenTodo(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(errors.New(fmt.Sprintf("error reopening todo with id: %s because %s is not a done status", utils.TestTodoId, utils.InProgress))).
+					Once()
+				return service
+			},
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.ReopenTodo },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "reopen todo assigned to another user",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{"RandomUser"}).
+					Once()
+				return service
+			},
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.ReopenTodo },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "step back todo success",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().StepBackTodo(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(nil).
+					Once()
+				return service
+			},
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.StepBackTodo },
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "step back todo not found",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().StepBackTodo(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(errors.New(fmt.Sprintf("error not found todo with id %s in the list with id: %s", utils.TestTodoId, utils.TestListId))).
+					Once()
+				return service
+			},
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.StepBackTodo },
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "unassign todo success",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().UnassignTodo(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(nil).
+					Once()
+				return service
+			},
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.UnassignTodo },
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "unassign todo failing",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().UnassignTodo(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(errors.New("connection refused")).
+					Once()
+				return service
+			},
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.UnassignTodo },
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := todo.NewResolverWithService(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("/todo/api/list/%s/todo/%s", utils.TestListId, utils.TestTodoId), nil)
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String(), "todoId": utils.TestTodoId.String()})
+			req.Header.Set("userId", utils.TestUsername)
+
+			rr := httptest.NewRecorder()
+
+			testCase.handler(resolver)(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverReassign(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceTodo
+		members        func() *mocks.ListMembers
+		input          []byte
+		expectedStatus int
+	}{
+		{
+			name: "reassign todo success",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().ReassignTodo(mock.Anything, utils.TestTodoId, utils.TestListId, "RandomUser").
+					Return(nil).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, utils.TestListId, "RandomUser").
+					Return(true).
+					Once()
+				return members
+			},
+			input:          []byte(`{"username": "RandomUser"}`),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "reassign todo to user outside the list",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, utils.TestListId, "RandomUser").
+					Return(false).
+					Once()
+				return members
+			},
+			input:          []byte(`{"username": "RandomUser"}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "reassign todo that is not assigned",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+					Return([]string{utils.TestUsername}).
+					Once()
+				service.EXPECT().ReassignTodo(mock.Anything, utils.TestTodoId, utils.TestListId, "RandomUser").
+					Return(errors.New(fmt.Sprintf("error reassigning todo with id: %s because it is not assigned", utils.TestTodoId))).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, utils.TestListId, "RandomUser").
+					Return(true).
+					Once()
+				return members
+			},
+			input:          []byte(`{"username": "RandomUser"}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "reassign todo without username",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			input:          []byte(`{"username": ""}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "reassign todo with invalid body",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			input:          []byte(`{"username": `),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := todo.NewResolverTodo(testCase.service(), testCase.members())
+
+			req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/todo/api/list/%s/todo/%s/assignee", utils.TestListId, utils.TestTodoId), bytes.NewReader(testCase.input))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String(), "todoId": utils.TestTodoId.String()})
+			req.Header.Set("userId", utils.TestUsername)
+
+			rr := httptest.NewRecorder()
+
+			resolver.ReassignTodo(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverTodoUsers(t *testing.T) {
+	managerCtx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[utils.Manager])
+	editorCtx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[utils.Editor])
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceTodo
+		members        func() *mocks.ListMembers
+		ctx            context.Context
+		handler        func(resolver *todo.ResolverTodo) http.HandlerFunc
+		inputUsername  string
+		expectedStatus int
+	}{
+		{
+			name: "manager adds assignee",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AssignUserToTodo(mock.Anything, utils.TestTodoId, utils.TestListId, "RandomUser").
+					Return(nil).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, utils.TestListId, "RandomUser").
+					Return(true).
+					Once()
+				return members
+			},
+			ctx:            managerCtx,
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoAssignee },
+			inputUsername:  "RandomUser",
+			expectedStatus: http.StatusOK,
+		}, {
+			name:           "editor adds another assignee",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            editorCtx,
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoAssignee },
+			inputUsername:  "RandomUser",
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name:    "manager adds assignee outside the list",
+			service: func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, utils.TestListId, "RandomUser").
+					Return(false).
+					Once()
+				return members
+			},
+			ctx:            managerCtx,
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoAssignee },
+			inputUsername:  "RandomUser",
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "editor removes themselves from the assignees",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().RemoveTodoAssignee(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestUsername).
+					Return(nil).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            editorCtx,
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.RemoveTodoAssignee },
+			inputUsername:  utils.TestUsername,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "remove user who is not an assignee",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().RemoveTodoAssignee(mock.Anything, utils.TestTodoId, utils.TestListId, "RandomUser").
+					Return(errors.New(fmt.Sprintf("error not found assignee RandomUser on todo with id: %s", utils.TestTodoId))).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            managerCtx,
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.RemoveTodoAssignee },
+			inputUsername:  "RandomUser",
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "watch todo",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AddTodoWatcher(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestUsername).
+					Return(nil).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoWatcher },
+			inputUsername:  utils.TestUsername,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "watch todo twice",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AddTodoWatcher(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestUsername).
+					Return(errors.New(fmt.Sprintf("error already exists watcher %s on todo with id: %s", utils.TestUsername, utils.TestTodoId))).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoWatcher },
+			inputUsername:  utils.TestUsername,
+			expectedStatus: http.StatusConflict,
+		}, {
+			name:           "viewer removes another watcher",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.RemoveTodoWatcher },
+			inputUsername:  "RandomUser",
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name: "stop watching todo",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().RemoveTodoWatcher(mock.Anything, utils.TestTodoId, utils.TestListId, utils.TestUsername).
+					Return(nil).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.RemoveTodoWatcher },
+			inputUsername:  utils.TestUsername,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := testCase.service()
+			members := testCase.members()
+			resolver := todo.NewResolverTodo(service, members)
+
+			req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/todo/api/list/%s/todo/%s/assignees/%s", utils.TestListId, utils.TestTodoId, testCase.inputUsername), nil)
+			require.NoError(t, err)
+			req = req.WithContext(testCase.ctx)
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String(), "todoId": utils.TestTodoId.String(), "username": testCase.inputUsername})
+			req.Header.Set("userId", utils.TestUsername)
+
+			rr := httptest.NewRecorder()
+
+			testCase.handler(resolver)(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			service.AssertExpectations(t)
+			members.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolverRecurrence(t *testing.T) {
+	upcoming := &structures.TodoRecurrenceOutput{
+		Rule:     "FREQ=WEEKLY",
+		SeriesId: utils.TestTodoId,
+		Upcoming: []time.Time{time.Date(2026, 2, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceTodo
+		method         string
+		query          string
+		input          []byte
+		handler        func(resolver *todo.ResolverTodo) http.HandlerFunc
+		expectedStatus int
+	}{
+		{
+			name: "set recurrence",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().SetTodoRecurrence(mock.Anything, utils.TestTodoId, utils.TestListId, "weekly").
+					Return(nil).
+					Once()
+				return service
+			},
+			method:         http.MethodPut,
+			input:          []byte(`{"rule": "weekly"}`),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.SetTodoRecurrence },
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "set invalid recurrence",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().SetTodoRecurrence(mock.Anything, utils.TestTodoId, utils.TestListId, "FREQ=YEARLY").
+					Return(errors.New("error invalid recurrence, FREQ must be DAILY, WEEKLY or MONTHLY")).
+					Once()
+				return service
+			},
+			method:         http.MethodPut,
+			input:          []byte(`{"rule": "FREQ=YEARLY"}`),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.SetTodoRecurrence },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "set recurrence with invalid body",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			method:         http.MethodPut,
+			input:          []byte(`{"rule": `),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.SetTodoRecurrence },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "stop todo that does not recur",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().StopTodoRecurrence(mock.Anything, utils.TestTodoId, utils.TestListId).
+					Return(errors.New(fmt.Sprintf("error not found recurrence on todo with id: %s", utils.TestTodoId))).
+					Once()
+				return service
+			},
+			method:         http.MethodDelete,
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.StopTodoRecurrence },
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "get upcoming occurrences",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().GetTodoRecurrence(mock.Anything, utils.TestTodoId, utils.TestListId, 1).
+					Return(upcoming, nil).
+					Once()
+				return service
+			},
+			method:         http.MethodGet,
+			query:          "?limit=1",
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.GetTodoRecurrence },
+			expectedStatus: http.StatusOK,
+		}, {
+			name:           "get too many upcoming occurrences",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			method:         http.MethodGet,
+			query:          "?limit=1000",
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.GetTodoRecurrence },
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := testCase.service()
+			resolver := todo.NewResolverWithService(service)
+
+			req, err := http.NewRequest(testCase.method, fmt.Sprintf("/todo/api/list/%s/todo/%s/recurrence%s", utils.TestListId, utils.TestTodoId, testCase.query), bytes.NewReader(testCase.input))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String(), "todoId": utils.TestTodoId.String()})
+
+			rr := httptest.NewRecorder()
+
+			testCase.handler(resolver)(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolverDependencies(t *testing.T) {
+	blockerId, blockerListId := uuid.UUID{7}, uuid.UUID{8}
+	adminCtx := context.WithValue(utils.HelperGetContext(), utils.UserRole, utils.Role[utils.Admin])
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceTodo
+		members        func() *mocks.ListMembers
+		ctx            context.Context
+		method         string
+		input          []byte
+		handler        func(resolver *todo.ResolverTodo) http.HandlerFunc
+		expectedStatus int
+	}{
+		{
+			name: "add dependency on todo in the same list",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AddTodoDependency(mock.Anything, utils.TestTodoId, utils.TestListId, blockerId, utils.TestListId).
+					Return(nil).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			method:         http.MethodPost,
+			input:          []byte(fmt.Sprintf(`{"todo_id": "%s"}`, blockerId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoDependency },
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "add dependency on todo in another readable list",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AddTodoDependency(mock.Anything, utils.TestTodoId, utils.TestListId, blockerId, blockerListId).
+					Return(nil).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, blockerListId, utils.TestUsername).
+					Return(true).
+					Once()
+				return members
+			},
+			ctx:            utils.HelperGetContext(),
+			method:         http.MethodPost,
+			input:          []byte(fmt.Sprintf(`{"todo_id": "%s", "list_id": "%s"}`, blockerId, blockerListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoDependency },
+			expectedStatus: http.StatusOK,
+		}, {
+			name:    "add dependency on todo in an unreadable list",
+			service: func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().ContainUserInList(mock.Anything, blockerListId, utils.TestUsername).
+					Return(false).
+					Once()
+				return members
+			},
+			ctx:            utils.HelperGetContext(),
+			method:         http.MethodPost,
+			input:          []byte(fmt.Sprintf(`{"todo_id": "%s", "list_id": "%s"}`, blockerId, blockerListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoDependency },
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name: "admin adds dependency on todo in any list",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AddTodoDependency(mock.Anything, utils.TestTodoId, utils.TestListId, blockerId, blockerListId).
+					Return(nil).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            adminCtx,
+			method:         http.MethodPost,
+			input:          []byte(fmt.Sprintf(`{"todo_id": "%s", "list_id": "%s"}`, blockerId, blockerListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoDependency },
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "add dependency that closes a cycle",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AddTodoDependency(mock.Anything, utils.TestTodoId, utils.TestListId, blockerId, utils.TestListId).
+					Return(errors.New(fmt.Sprintf("error dependency of todo with id: %s on todo with id: %s would create a cycle", utils.TestTodoId, blockerId))).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			method:         http.MethodPost,
+			input:          []byte(fmt.Sprintf(`{"todo_id": "%s"}`, blockerId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoDependency },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "add existing dependency",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().AddTodoDependency(mock.Anything, utils.TestTodoId, utils.TestListId, blockerId, utils.TestListId).
+					Return(errors.New(fmt.Sprintf("error already exists dependency on todo with id %s in todo with id: %s", blockerId, utils.TestTodoId))).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			method:         http.MethodPost,
+			input:          []byte(fmt.Sprintf(`{"todo_id": "%s"}`, blockerId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoDependency },
+			expectedStatus: http.StatusConflict,
+		}, {
+			name:           "add dependency without todo",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			method:         http.MethodPost,
+			input:          []byte(`{}`),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.AddTodoDependency },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "remove missing dependency",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().RemoveTodoDependency(mock.Anything, utils.TestTodoId, utils.TestListId, blockerId).
+					Return(errors.New(fmt.Sprintf("error not found dependency on todo with id %s in todo with id: %s", blockerId, utils.TestTodoId))).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			method:         http.MethodDelete,
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.RemoveTodoDependency },
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := testCase.service()
+			members := testCase.members()
+			resolver := todo.NewResolverTodo(service, members)
+
+			req, err := http.NewRequest(testCase.method, fmt.Sprintf("/todo/api/list/%s/todo/%s/dependencies", utils.TestListId, utils.TestTodoId), bytes.NewReader(testCase.input))
+			require.NoError(t, err)
+			req = req.WithContext(testCase.ctx)
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String(), "todoId": utils.TestTodoId.String(), "dependencyId": blockerId.String()})
+			req.Header.Set("userId", utils.TestUsername)
+
+			rr := httptest.NewRecorder()
+
+			testCase.handler(resolver)(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			service.AssertExpectations(t)
+			members.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolverTransfer(t *testing.T) {
+	targetListId, otherTodoId := uuid.UUID{8}, uuid.UUID{9}
+	singleVars := map[string]string{"listId": utils.TestListId.String(), "todoId": utils.TestTodoId.String()}
+	bulkVars := map[string]string{"listId": utils.TestListId.String()}
+	helperEditor := func(role string) func() *mocks.ListMembers {
+		return func() *mocks.ListMembers {
+			members := &mocks.ListMembers{}
+			members.EXPECT().GetUserListRole(mock.Anything, targetListId, utils.TestUsername).
+				Return(role).
+				Once()
+			return members
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceTodo
+		members        func() *mocks.ListMembers
+		ctx            context.Context
+		vars           map[string]string
+		input          []byte
+		handler        func(resolver *todo.ResolverTodo) http.HandlerFunc
+		expectedStatus int
+	}{
+		{
+			name: "move todo",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().MoveTodos(mock.Anything, []uuid.UUID{utils.TestTodoId}, utils.TestListId, targetListId, "fail").
+					Return([]structures.TodoOutput{{Id: utils.TestTodoId, ListId: targetListId}}, nil).
+					Once()
+				return service
+			},
+			members:        helperEditor(utils.Editor),
+			ctx:            utils.HelperGetContext(),
+			vars:           singleVars,
+			input:          []byte(fmt.Sprintf(`{"list_id": "%s"}`, targetListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.MoveTodo },
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "copy todos renaming them on conflict",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().CopyTodos(mock.Anything, []uuid.UUID{utils.TestTodoId, otherTodoId}, utils.TestListId, targetListId, "rename").
+					Return([]structures.TodoOutput{{ListId: targetListId}, {ListId: targetListId}}, nil).
+					Once()
+				return service
+			},
+			members: helperEditor(utils.Owner),
+			ctx:     utils.HelperGetContext(),
+			vars:    bulkVars,
+			input: []byte(fmt.Sprintf(`{"list_id": "%s", "conflict": "rename", "todo_ids": ["%s", "%s"]}`,
+				targetListId, utils.TestTodoId, otherTodoId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.CopyTodo },
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "admin moves todo to any list",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().MoveTodos(mock.Anything, []uuid.UUID{utils.TestTodoId}, utils.TestListId, targetListId, "fail").
+					Return([]structures.TodoOutput{{Id: utils.TestTodoId, ListId: targetListId}}, nil).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            context.WithValue(utils.HelperGetContext(), utils.UserRole, utils.Role[utils.Admin]),
+			vars:           singleVars,
+			input:          []byte(fmt.Sprintf(`{"list_id": "%s"}`, targetListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.MoveTodo },
+			expectedStatus: http.StatusOK,
+		}, {
+			name:           "move todo to a list the user can only view",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        helperEditor(utils.Viewer),
+			ctx:            utils.HelperGetContext(),
+			vars:           singleVars,
+			input:          []byte(fmt.Sprintf(`{"list_id": "%s"}`, targetListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.MoveTodo },
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name: "move todo to list with the same todo name",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().MoveTodos(mock.Anything, []uuid.UUID{utils.TestTodoId}, utils.TestListId, targetListId, "fail").
+					Return(nil, errors.New(fmt.Sprintf("error already exists todo with the same name %s in list with id: %s", utils.TestTodoName, targetListId))).
+					Once()
+				return service
+			},
+			members:        helperEditor(utils.Editor),
+			ctx:            utils.HelperGetContext(),
+			vars:           singleVars,
+			input:          []byte(fmt.Sprintf(`{"list_id": "%s"}`, targetListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.MoveTodo },
+			expectedStatus: http.StatusConflict,
+		}, {
+			name: "move todo to its own list",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().MoveTodos(mock.Anything, []uuid.UUID{utils.TestTodoId}, utils.TestListId, utils.TestListId, "fail").
+					Return(nil, errors.New(fmt.Sprintf("error transferring todos to the list with id: %s they are already in", utils.TestListId))).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().GetUserListRole(mock.Anything, utils.TestListId, utils.TestUsername).
+					Return(utils.Editor).
+					Once()
+				return members
+			},
+			ctx:            utils.HelperGetContext(),
+			vars:           singleVars,
+			input:          []byte(fmt.Sprintf(`{"list_id": "%s"}`, utils.TestListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.MoveTodo },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "move todo with unknown conflict strategy",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			vars:           singleVars,
+			input:          []byte(fmt.Sprintf(`{"list_id": "%s", "conflict": "overwrite"}`, targetListId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.MoveTodo },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "copy repeated todos",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			vars:           bulkVars,
+			input:          []byte(fmt.Sprintf(`{"list_id": "%s", "todo_ids": ["%s", "%s"]}`, targetListId, utils.TestTodoId, utils.TestTodoId)),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.CopyTodo },
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "copy without target list",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            utils.HelperGetContext(),
+			vars:           singleVars,
+			input:          []byte(`{}`),
+			handler:        func(resolver *todo.ResolverTodo) http.HandlerFunc { return resolver.CopyTodo },
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := testCase.service()
+			members := testCase.members()
+			resolver := todo.NewResolverTodo(service, members)
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/todo/api/list/%s/todo/move", utils.TestListId), bytes.NewReader(testCase.input))
+			require.NoError(t, err)
+			req = req.WithContext(testCase.ctx)
+			req = mux.SetURLVars(req, testCase.vars)
+			req.Header.Set("userId", utils.TestUsername)
+
+			rr := httptest.NewRecorder()
+
+			testCase.handler(resolver)(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			service.AssertExpectations(t)
+			members.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolverBulkUpdateTodos(t *testing.T) {
+	targetListId, otherTodoId := uuid.UUID{8}, uuid.UUID{9}
+	todoIds := []uuid.UUID{utils.TestTodoId, otherTodoId}
+	managerCtx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[utils.Manager])
+	editorCtx := context.WithValue(utils.HelperGetContext(), utils.UserListRole, utils.ListRole[utils.Editor])
+	helperAssignees := func(service *mocks.ServiceTodo) {
+		service.EXPECT().GetTodoAssignees(mock.Anything, utils.TestTodoId).
+			Return([]string{utils.TestUsername}).
+			Once()
+		service.EXPECT().GetTodoAssignees(mock.Anything, otherTodoId).
+			Return([]string{"RandomUser"}).
+			Once()
+	}
+
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceTodo
+		members        func() *mocks.ListMembers
+		ctx            context.Context
+		input          string
+		expectedStatus int
+		expectedErrors []string
+	}{
+		{
+			name: "set priority of todos",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().BulkUpdateTodos(mock.Anything, utils.TestListId, structures.TodoBulkInput{
+					Operation: "set_priority", Mode: "atomic", TodoIds: todoIds, Priority: utils.HighPriority,
+				}).
+					Return(&structures.TodoBulkOutput{Results: []structures.TodoBulkResult{
+						{TodoId: utils.TestTodoId, Success: true}, {TodoId: otherTodoId, Success: true},
+					}}, nil).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:     editorCtx,
+			input: fmt.Sprintf(`{"operation": "set_priority", "priority": "%s", "todo_ids": ["%s", "%s"]}`,
+				utils.HighPriority, utils.TestTodoId, otherTodoId),
+			expectedStatus: http.StatusOK,
+			expectedErrors: []string{"", ""},
+		}, {
+			name: "change status of the todos assigned to the user on best effort",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				helperAssignees(service)
+				service.EXPECT().BulkUpdateTodos(mock.Anything, utils.TestListId, structures.TodoBulkInput{
+					Operation: "change_status", Mode: "best_effort", TodoIds: []uuid.UUID{utils.TestTodoId}, Status: utils.InReview,
+				}).
+					Return(&structures.TodoBulkOutput{Results: []structures.TodoBulkResult{{TodoId: utils.TestTodoId, Success: true}}}, nil).
+					Once()
+				return service
+			},
+			members: func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:     editorCtx,
+			input: fmt.Sprintf(`{"operation": "change_status", "mode": "best_effort", "status": "%s", "todo_ids": ["%s", "%s"]}`,
+				utils.InReview, utils.TestTodoId, otherTodoId),
+			expectedStatus: http.StatusOK,
+			expectedErrors: []string{"", fmt.Sprintf("task %s is not assigned to %s", otherTodoId, utils.TestUsername)},
+		}, {
+			name: "change status of todos not assigned to the user",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				helperAssignees(service)
+				return service
+			},
+			members: func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:     editorCtx,
+			input: fmt.Sprintf(`{"operation": "change_status", "status": "%s", "todo_ids": ["%s", "%s"]}`,
+				utils.InReview, utils.TestTodoId, otherTodoId),
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name: "hide internal errors of the todos",
+			service: func() *mocks.ServiceTodo {
+				service := &mocks.ServiceTodo{}
+				service.EXPECT().BulkUpdateTodos(mock.Anything, utils.TestListId, mock.Anything).
+					Return(&structures.TodoBulkOutput{Results: []structures.TodoBulkResult{
+						{TodoId: utils.TestTodoId, Error: "pq: connection reset"},
+						{TodoId: otherTodoId, Error: fmt.Sprintf("error rolled back because todo with id: %s failed", utils.TestTodoId)},
+					}}, nil).
+					Once()
+				return service
+			},
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            managerCtx,
+			input:          fmt.Sprintf(`{"operation": "delete", "todo_ids": ["%s", "%s"]}`, utils.TestTodoId, otherTodoId),
+			expectedStatus: http.StatusOK,
+			expectedErrors: []string{
+				fmt.Sprintf("failed to delete todo with id: %s", utils.TestTodoId),
+				fmt.Sprintf("error rolled back because todo with id: %s failed", utils.TestTodoId),
+			},
+		}, {
+			name:           "assign other user as editor",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            editorCtx,
+			input:          fmt.Sprintf(`{"operation": "assign", "username": "RandomUser", "todo_ids": ["%s"]}`, utils.TestTodoId),
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name:    "move todos to a list the user can only view",
+			service: func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members: func() *mocks.ListMembers {
+				members := &mocks.ListMembers{}
+				members.EXPECT().GetUserListRole(mock.Anything, targetListId, utils.TestUsername).
+					Return(utils.Viewer).
+					Once()
+				return members
+			},
+			ctx:            editorCtx,
+			input:          fmt.Sprintf(`{"operation": "move", "list_id": "%s", "todo_ids": ["%s"]}`, targetListId, utils.TestTodoId),
+			expectedStatus: http.StatusForbidden,
+		}, {
+			name:           "unknown operation",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            editorCtx,
+			input:          fmt.Sprintf(`{"operation": "archive", "todo_ids": ["%s"]}`, utils.TestTodoId),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "unknown mode",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            editorCtx,
+			input:          fmt.Sprintf(`{"operation": "delete", "mode": "partial", "todo_ids": ["%s"]}`, utils.TestTodoId),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name:           "set invalid priority",
+			service:        func() *mocks.ServiceTodo { return &mocks.ServiceTodo{} },
+			members:        func() *mocks.ListMembers { return &mocks.ListMembers{} },
+			ctx:            editorCtx,
+			input:          fmt.Sprintf(`{"operation": "set_priority", "priority": "Urgent", "todo_ids": ["%s"]}`, utils.TestTodoId),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := testCase.service()
+			members := testCase.members()
+			resolver := todo.NewResolverTodo(service, members)
+
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/todo/api/list/%s/todo/bulk", utils.TestListId), bytes.NewReader([]byte(testCase.input)))
+			require.NoError(t, err)
+			req = req.WithContext(testCase.ctx)
+			req = mux.SetURLVars(req, map[string]string{"listId": utils.TestListId.String()})
+			req.Header.Set("userId", utils.TestUsername)
+
+			rr := httptest.NewRecorder()
+
+			resolver.BulkUpdateTodos(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+			if testCase.expectedErrors != nil {
+				var output structures.TodoBulkOutput
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &output))
+				require.Len(t, output.Results, len(testCase.expectedErrors))
+				for i, expectedError := range testCase.expectedErrors {
+					require.Equal(t, expectedError, output.Results[i].Error)
+				}
+			}
+			service.AssertExpectations(t)
+			members.AssertExpectations(t)
+		})
+	}
+}
@@ -2,31 +2,152 @@ package todo
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"project/structures"
+	"project/uow"
 	"project/utils"
+	"slices"
 )
 
 //go:generate mockery --name RepositoryTodo --output=automock --with-expecter=true
 type RepositoryTodo interface {
 	GetTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoModel, error)
-	GetAllTasks(ctx context.Context, listId uuid.UUID) []structures.TodoModel
+	GetAllTasks(ctx context.Context, listId uuid.UUID, query structures.TodoQuery) (*structures.TodoPageModel, error)
 	CreateTodo(ctx context.Context, newTask structures.TodoEntity) error
 	DeleteTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoModel, error)
 	UpdateTodo(ctx context.Context, updatedTask structures.TodoEntity, listId uuid.UUID) (*structures.TodoModel, error)
 	AssignTodoToUser(ctx context.Context, todoId, listId uuid.UUID, username string) error
-	ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID) error
+	ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID, status string) error
+	ReopenTodo(ctx context.Context, todoId, listId uuid.UUID) error
+	StepBackTodo(ctx context.Context, todoId, listId uuid.UUID) error
+	UnassignTodo(ctx context.Context, todoId, listId uuid.UUID) error
+	ReassignTodo(ctx context.Context, todoId, listId uuid.UUID, username string) error
+	RemoveTodoAssignee(ctx context.Context, todoId, listId uuid.UUID, username string) error
+	AddTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error
+	RemoveTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error
+	SetTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID, rule string) error
+	StopTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID) error
+	SupersedeTodo(ctx context.Context, todoId, listId uuid.UUID) error
+	AddTodoDependency(ctx context.Context, todoId, listId, blockerId, blockerListId uuid.UUID) error
+	RemoveTodoDependency(ctx context.Context, todoId, listId, blockerId uuid.UUID) error
+	ContainsTodoName(ctx context.Context, listId uuid.UUID, name string) (bool, error)
+	MoveTodo(ctx context.Context, todoId, listId, targetListId uuid.UUID, name string) error
+	CopyTodo(ctx context.Context, todoId, listId, copyId, targetListId uuid.UUID, name string) error
 	CheckIfListContainsTodo(ctx context.Context, listId, todoId uuid.UUID) bool
-	GetTodoAssignee(ctx context.Context, todoId uuid.UUID) string
+	GetTodoAssignees(ctx context.Context, todoId uuid.UUID) []string
+	GetUserListIds(ctx context.Context, username string) ([]uuid.UUID, error)
+	AttachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error
+	DetachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error
+}
+
+//go:generate mockery --name AuditLog --output=automock --with-expecter=true
+type AuditLog interface {
+	AddEntry(ctx context.Context, entry structures.AuditEntity) error
 }
 
 type ServiceTodoImpl struct {
-	repo      RepositoryTodo
-	convertor ServiceTodoConvertor
+	repo       RepositoryTodo
+	convertor  ServiceTodoConvertor
+	unitOfWork uow.UnitOfWork
+	auditLog   AuditLog
+}
+
+func NewServiceTodo(repo RepositoryTodo, convertor ServiceTodoConvertor, unitOfWork uow.UnitOfWork, auditLog AuditLog) *ServiceTodoImpl {
+	return &ServiceTodoImpl{repo: repo, convertor: convertor, unitOfWork: unitOfWork, auditLog: auditLog}
+}
+
+// record adds the change of the todo to the audit log in the unit of work that made it.
+func (s *ServiceTodoImpl) record(ctx context.Context, action string, todoId, listId uuid.UUID, before, after *structures.TodoModel) error {
+	// The log is read by the members of the list, so it only keeps the dependencies inside of it.
+	var beforeOutput, afterOutput *structures.TodoOutput
+	if before != nil {
+		listed := withDependenciesIn(*before, nil)
+		beforeOutput = s.convertor.ConvertTodoModelToOutput(&listed)
+	}
+	if after != nil {
+		listed := withDependenciesIn(*after, nil)
+		afterOutput = s.convertor.ConvertTodoModelToOutput(&listed)
+	}
+
+	entry, err := utils.NewAuditEntry(ctx, action, utils.AuditEntityTodo, todoId.String(), listId, &todoId, beforeOutput, afterOutput)
+	if err != nil {
+		return err
+	}
+
+	return s.auditLog.AddEntry(ctx, *entry)
+}
+
+// changeTodo runs change in a unit of work and records the todo as it was before and after it.
+func (s *ServiceTodoImpl) changeTodo(ctx context.Context, action string, todoId, listId uuid.UUID, change func(ctx context.Context) error) (*structures.TodoModel, error) {
+	var after *structures.TodoModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+		err = change(ctx)
+		if err != nil {
+			return err
+		}
+		after, err = s.repo.GetTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, action, todoId, listId, before, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return after, nil
+}
+
+// outputs converts the todos for the current user. Their dependencies on todos in lists the user is not a member
+// of are left out, so a todo does not give away the todos of another list; Blocked still counts them.
+func (s *ServiceTodoImpl) outputs(ctx context.Context, todoModels ...structures.TodoModel) ([]structures.TodoOutput, error) {
+	readsEveryList := utils.GetRoleFromContext(ctx) == utils.Role[utils.Admin]
+	var listIds []uuid.UUID
+	if !readsEveryList && slices.ContainsFunc(todoModels, dependsAcrossLists) {
+		username, _ := ctx.Value(utils.CurrentUser).(string)
+		var err error
+		listIds, err = s.repo.GetUserListIds(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	outputs := make([]structures.TodoOutput, len(todoModels))
+	for i, todoModel := range todoModels {
+		if !readsEveryList {
+			todoModel = withDependenciesIn(todoModel, listIds)
+		}
+		outputs[i] = *s.convertor.ConvertTodoModelToOutput(&todoModel)
+	}
+
+	return outputs, nil
 }
 
-func NewServiceTodo(repo RepositoryTodo, convertor ServiceTodoConvertor) *ServiceTodoImpl {
-	return &ServiceTodoImpl{repo: repo, convertor: convertor}
+// dependsAcrossLists tells whether the todo blocks or is blocked by a todo in another list.
+func dependsAcrossLists(todoModel structures.TodoModel) bool {
+	inOtherList := func(dependency structures.TodoDependencyModel) bool {
+		return dependency.ListId != todoModel.ListId
+	}
+
+	return slices.ContainsFunc(todoModel.BlockedBy, inOtherList) || slices.ContainsFunc(todoModel.Blocks, inOtherList)
+}
+
+// withDependenciesIn keeps only the dependencies of the todo on todos in its own list or in one of listIds.
+func withDependenciesIn(todoModel structures.TodoModel, listIds []uuid.UUID) structures.TodoModel {
+	hidden := func(dependency structures.TodoDependencyModel) bool {
+		return dependency.ListId != todoModel.ListId && !slices.Contains(listIds, dependency.ListId)
+	}
+	todoModel.BlockedBy = slices.DeleteFunc(slices.Clone(todoModel.BlockedBy), hidden)
+	todoModel.Blocks = slices.DeleteFunc(slices.Clone(todoModel.Blocks), hidden)
+
+	return todoModel
 }
 
 func (s *ServiceTodoImpl) GetTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoOutput, error) {
@@ -34,18 +155,26 @@ func (s *ServiceTodoImpl) GetTodo(ctx context.Context, todoId, listId uuid.UUID)
 	if err != nil {
 		return nil, err
 	}
+	outputs, err := s.outputs(ctx, *todoModel)
+	if err != nil {
+		return nil, err
+	}
 
-	return s.convertor.ConvertTodoModelToOutput(todoModel), nil
+	return &outputs[0], nil
 }
 
-func (s *ServiceTodoImpl) GetAllTasks(ctx context.Context, listId uuid.UUID) []structures.TodoOutput {
-	todoModels := s.repo.GetAllTasks(ctx, listId)
-	result := make([]structures.TodoOutput, len(todoModels))
-	for i, model := range todoModels {
-		result[i] = *s.convertor.ConvertTodoModelToOutput(&model)
+func (s *ServiceTodoImpl) GetAllTasks(ctx context.Context, listId uuid.UUID, query structures.TodoQuery) (*structures.TodoPageOutput, error) {
+	todoPage, err := s.repo.GetAllTasks(ctx, listId, query)
+	if err != nil {
+		return nil, err
 	}
 
-	return result
+	todos, err := s.outputs(ctx, todoPage.Todos...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &structures.TodoPageOutput{Todos: todos, PageInfoOutput: utils.ConvertPageInfoToOutput(todoPage.PageInfo)}, nil
 }
 
 func (s *ServiceTodoImpl) CreateTodo(ctx context.Context, input structures.TodoInput, listId uuid.UUID) (*structures.TodoOutput, error) {
@@ -55,26 +184,66 @@ func (s *ServiceTodoImpl) CreateTodo(ctx context.Context, input structures.TodoI
 		Name:        input.Name,
 		Description: input.Description,
 		Deadline:    input.Deadline,
-		Assignee:    "",
-		Status:      utils.NotAssigned,
 		Priority:    input.Priority,
 	}
+	if input.Recurrence != "" {
+		recurrence, err := ParseRecurrence(input.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		if input.Deadline.IsZero() {
+			return nil, errors.New(fmt.Sprintf("%s, a recurring todo needs a deadline", invalidRecurrenceErrorMsg))
+		}
+		todoModel.Recurrence = recurrence.Anchored(input.Deadline).String()
+		todoModel.SeriesId = &todoModel.Id
+	}
+
+	var createdTodo *structures.TodoModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		err := s.repo.CreateTodo(ctx, *s.convertor.ConvertTodoModelToEntity(&todoModel))
+		if err != nil {
+			return err
+		}
+		createdTodo, err = s.repo.GetTodo(ctx, todoModel.Id, listId)
+		if err != nil {
+			return err
+		}
 
-	err := s.repo.CreateTodo(ctx, *s.convertor.ConvertTodoModelToEntity(&todoModel))
+		return s.record(ctx, utils.AuditCreateTodo, todoModel.Id, listId, nil, createdTodo)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.convertor.ConvertTodoModelToOutput(&todoModel), nil
+	outputs, err := s.outputs(ctx, *createdTodo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outputs[0], nil
 }
 
 func (s *ServiceTodoImpl) DeleteTodo(ctx context.Context, todoId, listId uuid.UUID) (*structures.TodoOutput, error) {
-	deletedTodoModel, err := s.repo.DeleteTodo(ctx, todoId, listId)
+	var deletedTodoModel *structures.TodoModel
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		deletedTodoModel, err = s.repo.DeleteTodo(ctx, todoId, listId)
+		if err != nil {
+			return err
+		}
+
+		return s.record(ctx, utils.AuditDeleteTodo, todoId, listId, deletedTodoModel, nil)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.convertor.ConvertTodoModelToOutput(deletedTodoModel), nil
+	outputs, err := s.outputs(ctx, *deletedTodoModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outputs[0], nil
 }
 
 func (s *ServiceTodoImpl) UpdateTodo(ctx context.Context, todoId, listId uuid.UUID, input structures.TodoInput) (*structures.TodoOutput, error) {
@@ -86,27 +255,434 @@ func (s *ServiceTodoImpl) UpdateTodo(ctx context.Context, todoId, listId uuid.UU
 		Priority:    input.Priority,
 	}
 
-	todoUpdated, err := s.repo.UpdateTodo(ctx, *s.convertor.ConvertTodoModelToEntity(&todoModel), listId)
+	todoUpdated, err := s.changeTodo(ctx, utils.AuditUpdateTodo, todoId, listId, func(ctx context.Context) error {
+		_, err := s.repo.UpdateTodo(ctx, *s.convertor.ConvertTodoModelToEntity(&todoModel), listId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := s.outputs(ctx, *todoUpdated)
 	if err != nil {
 		return nil, err
 	}
-	todoUpdateOutput := s.convertor.ConvertTodoModelToOutput(todoUpdated)
 
-	return todoUpdateOutput, nil
+	return &outputs[0], nil
 }
 
 func (s *ServiceTodoImpl) AssignUserToTodo(ctx context.Context, todoId, listId uuid.UUID, username string) error {
-	return s.repo.AssignTodoToUser(ctx, todoId, listId, username)
+	_, err := s.changeTodo(ctx, utils.AuditAssignTodo, todoId, listId, func(ctx context.Context) error {
+		return s.repo.AssignTodoToUser(ctx, todoId, listId, username)
+	})
+	return err
 }
 
-func (s *ServiceTodoImpl) ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID) error {
-	return s.repo.ChangeTodoStatus(ctx, todoId, listId)
+func (s *ServiceTodoImpl) ChangeTodoStatus(ctx context.Context, todoId, listId uuid.UUID, status string) error {
+	_, err := s.changeTodo(ctx, utils.AuditChangeStatus, todoId, listId, func(ctx context.Context) error {
+		err := s.repo.ChangeTodoStatus(ctx, todoId, listId, status)
+		if err != nil {
+			return err
+		}
+
+		return s.continueSeries(ctx, todoId, listId)
+	})
+	return err
+}
+
+// continueSeries creates the next occurrence of a recurring todo once it is done. The rule moves over to the
+// new occurrence with its labels and watchers, so completing the done one again does not repeat it.
+func (s *ServiceTodoImpl) continueSeries(ctx context.Context, todoId, listId uuid.UUID) error {
+	todoModel, err := s.repo.GetTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+	if !todoModel.Done || todoModel.Recurrence == "" {
+		return nil
+	}
+	recurrence, err := ParseRecurrence(todoModel.Recurrence)
+	if err != nil {
+		return err
+	}
+
+	deadline, following, ok := recurrence.Next(todoModel.Deadline)
+	if !ok {
+		return s.repo.StopTodoRecurrence(ctx, todoId, listId)
+	}
+	err = s.repo.SupersedeTodo(ctx, todoId, listId)
+	if err != nil {
+		return err
+	}
+
+	nextTodo := structures.TodoModel{
+		Id:          uuid.New(),
+		ListId:      listId,
+		Name:        todoModel.Name,
+		Description: todoModel.Description,
+		Deadline:    deadline,
+		Priority:    todoModel.Priority,
+		Recurrence:  following.String(),
+		SeriesId:    todoModel.SeriesId,
+	}
+	err = s.repo.CreateTodo(ctx, *s.convertor.ConvertTodoModelToEntity(&nextTodo))
+	if err != nil {
+		return err
+	}
+	for _, label := range todoModel.Labels {
+		err = s.repo.AttachLabel(ctx, nextTodo.Id, listId, label.Id)
+		if err != nil {
+			return err
+		}
+	}
+	for _, watcher := range todoModel.Watchers {
+		err = s.repo.AddTodoWatcher(ctx, nextTodo.Id, listId, watcher)
+		if err != nil {
+			return err
+		}
+	}
+
+	createdTodo, err := s.repo.GetTodo(ctx, nextTodo.Id, listId)
+	if err != nil {
+		return err
+	}
+
+	return s.record(ctx, utils.AuditCreateTodo, nextTodo.Id, listId, nil, createdTodo)
+}
+
+func (s *ServiceTodoImpl) SetTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID, rule string) error {
+	recurrence, err := ParseRecurrence(rule)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.changeTodo(ctx, utils.AuditSetRecurrence, todoId, listId, func(ctx context.Context) error {
+		return s.repo.SetTodoRecurrence(ctx, todoId, listId, recurrence.String())
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) StopTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID) error {
+	_, err := s.changeTodo(ctx, utils.AuditStopRecurrence, todoId, listId, func(ctx context.Context) error {
+		return s.repo.StopTodoRecurrence(ctx, todoId, listId)
+	})
+	return err
+}
+
+// GetTodoRecurrence shows the rule of the todo with the deadlines of at most limit occurrences after it.
+func (s *ServiceTodoImpl) GetTodoRecurrence(ctx context.Context, todoId, listId uuid.UUID, limit int) (*structures.TodoRecurrenceOutput, error) {
+	todoModel, err := s.repo.GetTodo(ctx, todoId, listId)
+	if err != nil {
+		return nil, err
+	}
+	if todoModel.Recurrence == "" {
+		return nil, errors.New(fmt.Sprintf("error not found recurrence on todo with id: %s", todoId))
+	}
+	recurrence, err := ParseRecurrence(todoModel.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &structures.TodoRecurrenceOutput{
+		Rule:     todoModel.Recurrence,
+		SeriesId: *todoModel.SeriesId,
+		Upcoming: recurrence.Upcoming(todoModel.Deadline, limit),
+	}, nil
+}
+
+func (s *ServiceTodoImpl) ReopenTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	_, err := s.changeTodo(ctx, utils.AuditReopenTodo, todoId, listId, func(ctx context.Context) error {
+		return s.repo.ReopenTodo(ctx, todoId, listId)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) StepBackTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	_, err := s.changeTodo(ctx, utils.AuditStepBackTodo, todoId, listId, func(ctx context.Context) error {
+		return s.repo.StepBackTodo(ctx, todoId, listId)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) UnassignTodo(ctx context.Context, todoId, listId uuid.UUID) error {
+	_, err := s.changeTodo(ctx, utils.AuditUnassignTodo, todoId, listId, func(ctx context.Context) error {
+		return s.repo.UnassignTodo(ctx, todoId, listId)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) ReassignTodo(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	_, err := s.changeTodo(ctx, utils.AuditReassignTodo, todoId, listId, func(ctx context.Context) error {
+		return s.repo.ReassignTodo(ctx, todoId, listId, username)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) RemoveTodoAssignee(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	_, err := s.changeTodo(ctx, utils.AuditRemoveAssignee, todoId, listId, func(ctx context.Context) error {
+		return s.repo.RemoveTodoAssignee(ctx, todoId, listId, username)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) AddTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	_, err := s.changeTodo(ctx, utils.AuditAddWatcher, todoId, listId, func(ctx context.Context) error {
+		return s.repo.AddTodoWatcher(ctx, todoId, listId, username)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) RemoveTodoWatcher(ctx context.Context, todoId, listId uuid.UUID, username string) error {
+	_, err := s.changeTodo(ctx, utils.AuditRemoveWatcher, todoId, listId, func(ctx context.Context) error {
+		return s.repo.RemoveTodoWatcher(ctx, todoId, listId, username)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) AddTodoDependency(ctx context.Context, todoId, listId, blockerId, blockerListId uuid.UUID) error {
+	_, err := s.changeTodo(ctx, utils.AuditAddDependency, todoId, listId, func(ctx context.Context) error {
+		return s.repo.AddTodoDependency(ctx, todoId, listId, blockerId, blockerListId)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) RemoveTodoDependency(ctx context.Context, todoId, listId, blockerId uuid.UUID) error {
+	_, err := s.changeTodo(ctx, utils.AuditRemoveDependency, todoId, listId, func(ctx context.Context) error {
+		return s.repo.RemoveTodoDependency(ctx, todoId, listId, blockerId)
+	})
+	return err
+}
+
+// MoveTodos moves the todos to the target list in one unit of work, so either all of them move or none does.
+func (s *ServiceTodoImpl) MoveTodos(ctx context.Context, todoIds []uuid.UUID, listId, targetListId uuid.UUID, conflict string) ([]structures.TodoOutput, error) {
+	if listId == targetListId {
+		return nil, errors.New(fmt.Sprintf("%s todos to the list with id: %s they are already in", transferErrorMsg, listId))
+	}
+
+	models := make([]structures.TodoModel, len(todoIds))
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, todoId := range todoIds {
+			before, err := s.repo.GetTodo(ctx, todoId, listId)
+			if err != nil {
+				return err
+			}
+			name, err := s.targetName(ctx, targetListId, before.Name, conflict)
+			if err != nil {
+				return err
+			}
+			err = s.repo.MoveTodo(ctx, todoId, listId, targetListId, name)
+			if err != nil {
+				return err
+			}
+			after, err := s.repo.GetTodo(ctx, todoId, targetListId)
+			if err != nil {
+				return err
+			}
+
+			err = s.record(ctx, utils.AuditMoveTodo, todoId, targetListId, before, after)
+			if err != nil {
+				return err
+			}
+			models[i] = *after
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.outputs(ctx, models...)
+}
+
+// CopyTodos copies the todos to the target list, which may be their own one, in one unit of work.
+func (s *ServiceTodoImpl) CopyTodos(ctx context.Context, todoIds []uuid.UUID, listId, targetListId uuid.UUID, conflict string) ([]structures.TodoOutput, error) {
+	models := make([]structures.TodoModel, len(todoIds))
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, todoId := range todoIds {
+			original, err := s.repo.GetTodo(ctx, todoId, listId)
+			if err != nil {
+				return err
+			}
+			name, err := s.targetName(ctx, targetListId, original.Name, conflict)
+			if err != nil {
+				return err
+			}
+			copyId := uuid.New()
+			err = s.repo.CopyTodo(ctx, todoId, listId, copyId, targetListId, name)
+			if err != nil {
+				return err
+			}
+			copied, err := s.repo.GetTodo(ctx, copyId, targetListId)
+			if err != nil {
+				return err
+			}
+
+			err = s.record(ctx, utils.AuditCopyTodo, copyId, targetListId, nil, copied)
+			if err != nil {
+				return err
+			}
+			models[i] = *copied
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.outputs(ctx, models...)
+}
+
+// targetName is the name a todo takes in the target list. On a conflict it fails, or when renaming is asked for it
+// takes the first free name with a number appended.
+func (s *ServiceTodoImpl) targetName(ctx context.Context, listId uuid.UUID, name, conflict string) (string, error) {
+	for number := 1; ; number++ {
+		candidate := name
+		if number > 1 {
+			candidate = numberedName(name, number)
+		}
+
+		taken, err := s.repo.ContainsTodoName(ctx, listId, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+		if conflict != renameOnConflict {
+			return "", errors.New(fmt.Sprintf("error already exists todo with the same name %s in list with id: %s", name, listId))
+		}
+	}
+}
+
+// numberedName appends the number to the name, shortening the name when both would not fit in a todo name.
+func numberedName(name string, number int) string {
+	suffix := fmt.Sprintf(" (%d)", number)
+	runes := []rune(name)
+	if len(runes)+len(suffix) > maxTodoNameLength {
+		runes = runes[:maxTodoNameLength-len(suffix)]
+	}
+
+	return string(runes) + suffix
+}
+
+// BulkUpdateTodos applies the operation of the input to its todos. In atomic mode all of them change in one unit of
+// work, which is rolled back once one of them fails, while in best_effort mode every todo changes in its own one.
+func (s *ServiceTodoImpl) BulkUpdateTodos(ctx context.Context, listId uuid.UUID, input structures.TodoBulkInput) (*structures.TodoBulkOutput, error) {
+	output := &structures.TodoBulkOutput{
+		Operation: input.Operation,
+		Mode:      input.Mode,
+		Results:   make([]structures.TodoBulkResult, len(input.TodoIds)),
+	}
+
+	if input.Mode == bestEffortMode {
+		for i, todoId := range input.TodoIds {
+			todoOutput, err := s.applyBulkOperation(ctx, todoId, listId, input)
+			output.Results[i] = bulkResult(todoId, todoOutput, err)
+		}
+
+		countBulkResults(output)
+		return output, nil
+	}
+
+	failed := -1
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for i, todoId := range input.TodoIds {
+			todoOutput, err := s.applyBulkOperation(ctx, todoId, listId, input)
+			if err != nil {
+				failed = i
+				return err
+			}
+			output.Results[i] = bulkResult(todoId, todoOutput, nil)
+		}
+
+		return nil
+	})
+	if err != nil && failed < 0 {
+		return nil, err
+	}
+	if err != nil {
+		rolledBack := errors.New(fmt.Sprintf("%s because todo with id: %s failed", rolledBackErrorMsg, input.TodoIds[failed]))
+		for i, todoId := range input.TodoIds {
+			if i == failed {
+				output.Results[i] = bulkResult(todoId, nil, err)
+			} else {
+				output.Results[i] = bulkResult(todoId, nil, rolledBack)
+			}
+		}
+	}
+
+	countBulkResults(output)
+	return output, nil
+}
+
+// applyBulkOperation runs the operation of the input on one todo through the method of its single todo route.
+func (s *ServiceTodoImpl) applyBulkOperation(ctx context.Context, todoId, listId uuid.UUID, input structures.TodoBulkInput) (*structures.TodoOutput, error) {
+	var err error
+	switch input.Operation {
+	case bulkChangeStatus:
+		err = s.ChangeTodoStatus(ctx, todoId, listId, input.Status)
+	case bulkSetPriority:
+		return s.UpdateTodo(ctx, todoId, listId, structures.TodoInput{Priority: input.Priority})
+	case bulkAssign:
+		err = s.AssignUserToTodo(ctx, todoId, listId, input.Username)
+	case bulkAddLabel:
+		err = s.AttachLabel(ctx, todoId, listId, input.LabelId)
+	case bulkDelete:
+		return s.DeleteTodo(ctx, todoId, listId)
+	case bulkMove:
+		outputs, err := s.MoveTodos(ctx, []uuid.UUID{todoId}, listId, input.ListId, input.Conflict)
+		if err != nil {
+			return nil, err
+		}
+		return &outputs[0], nil
+	default:
+		err = errors.New(fmt.Sprintf("%s todos with unknown operation %s", bulkErrorMsg, input.Operation))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetTodo(ctx, todoId, listId)
+}
+
+func bulkResult(todoId uuid.UUID, todoOutput *structures.TodoOutput, err error) structures.TodoBulkResult {
+	if err != nil {
+		return structures.TodoBulkResult{TodoId: todoId, Error: err.Error()}
+	}
+
+	return structures.TodoBulkResult{TodoId: todoId, Success: true, Todo: todoOutput}
+}
+
+// countBulkResults sets how many todos of the output succeeded and how many failed.
+func countBulkResults(output *structures.TodoBulkOutput) {
+	output.Succeeded, output.Failed = 0, 0
+	for _, result := range output.Results {
+		if result.Success {
+			output.Succeeded++
+		} else {
+			output.Failed++
+		}
+	}
 }
 
 func (s *ServiceTodoImpl) CheckIfListContainsTodo(ctx context.Context, todoId, listId uuid.UUID) bool {
 	return s.repo.CheckIfListContainsTodo(ctx, todoId, listId)
 }
 
-func (s *ServiceTodoImpl) GetTodoAssignee(ctx context.Context, todoId uuid.UUID) string {
-	return s.repo.GetTodoAssignee(ctx, todoId)
+func (s *ServiceTodoImpl) GetTodoAssignees(ctx context.Context, todoId uuid.UUID) []string {
+	return s.repo.GetTodoAssignees(ctx, todoId)
+}
+
+func (s *ServiceTodoImpl) AttachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error {
+	_, err := s.changeTodo(ctx, utils.AuditAttachLabel, todoId, listId, func(ctx context.Context) error {
+		return s.repo.AttachLabel(ctx, todoId, listId, labelId)
+	})
+	return err
+}
+
+func (s *ServiceTodoImpl) DetachLabel(ctx context.Context, todoId, listId, labelId uuid.UUID) error {
+	_, err := s.changeTodo(ctx, utils.AuditDetachLabel, todoId, listId, func(ctx context.Context) error {
+		return s.repo.DetachLabel(ctx, todoId, listId, labelId)
+	})
+	return err
 }
@@ -0,0 +1,67 @@
+package uow
+
+import (
+	"context"
+	"database/sql"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"project/utils"
+)
+
+// Executor is the subset of *sqlx.DB and *sqlx.Tx used by the repositories.
+type Executor interface {
+	Get(dest any, query string, args ...any) error
+	Select(dest any, query string, args ...any) error
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+//go:generate mockery --name UnitOfWork --output=automock --with-expecter=true
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type DBUnitOfWork struct {
+	db *sqlx.DB
+}
+
+func NewDBUnitOfWork(db *sqlx.DB) *DBUnitOfWork {
+	return &DBUnitOfWork{db: db}
+}
+
+// Do runs fn on a single transaction which is committed when fn succeeds and
+// rolled back otherwise. Calls nested in fn join the already started transaction.
+func (u *DBUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(utils.Transaction).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	tx, err := u.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	err = fn(context.WithValue(ctx, utils.Transaction, tx))
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(err)
+	}
+
+	return err
+}
+
+// GetExecutor returns the transaction of the unit of work running in ctx or db when there is none.
+func GetExecutor(ctx context.Context, db Executor) Executor {
+	if tx, ok := ctx.Value(utils.Transaction).(*sqlx.Tx); ok {
+		return tx
+	}
+
+	return db
+}
@@ -0,0 +1,114 @@
+package uow_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"project/uow"
+	"project/utils"
+	"testing"
+)
+
+func TestUnitOfWorkDo(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	unitOfWork := uow.NewDBUnitOfWork(db)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		fn          func(ctx context.Context) error
+		expectedErr error
+	}{
+		{
+			name: "commits when the work succeeds",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`DELETE FROM list`).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			fn: func(ctx context.Context) error {
+				_, err := uow.GetExecutor(ctx, db).Exec(`DELETE FROM list`)
+				return err
+			},
+		}, {
+			name: "rolls back when the work fails",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`DELETE FROM list`).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			fn: func(ctx context.Context) error {
+				_, err := uow.GetExecutor(ctx, db).Exec(`DELETE FROM list`)
+				require.NoError(t, err)
+				return errors.New("error deleting list")
+			},
+			expectedErr: errors.New("error deleting list"),
+		}, {
+			name: "nested work joins the running transaction",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`DELETE FROM todo`).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM list`).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			fn: func(ctx context.Context) error {
+				err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+					_, err := uow.GetExecutor(ctx, db).Exec(`DELETE FROM todo`)
+					return err
+				})
+				if err != nil {
+					return err
+				}
+
+				_, err = uow.GetExecutor(ctx, db).Exec(`DELETE FROM list`)
+				return err
+			},
+		}, {
+			name: "transaction cannot be started",
+			mock: func() {
+				mock.ExpectBegin().WillReturnError(errors.New("error connection refused"))
+			},
+			fn: func(ctx context.Context) error {
+				t.Error("Expected work not to run")
+				return nil
+			},
+			expectedErr: errors.New("error connection refused"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := unitOfWork.Do(ctx, testCase.fn)
+			if testCase.expectedErr != nil {
+				require.EqualError(t, err, testCase.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetExecutorWithoutUnitOfWork(t *testing.T) {
+	db, _, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	require.Equal(t, db, uow.GetExecutor(utils.HelperGetContext(), db))
+}
@@ -0,0 +1,405 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepositoryUser is an autogenerated mock type for the RepositoryUser type
+type RepositoryUser struct {
+	mock.Mock
+}
+
+type RepositoryUser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepositoryUser) EXPECT() *RepositoryUser_Expecter {
+	return &RepositoryUser_Expecter{mock: &_m.Mock}
+}
+
+// CreateUser provides a mock function with given fields: ctx, entity
+func (_m *RepositoryUser) CreateUser(ctx context.Context, entity structures.UserAccountEntity) error {
+	ret := _m.Called(ctx, entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.UserAccountEntity) error); ok {
+		r0 = rf(ctx, entity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryUser_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type RepositoryUser_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entity structures.UserAccountEntity
+func (_e *RepositoryUser_Expecter) CreateUser(ctx interface{}, entity interface{}) *RepositoryUser_CreateUser_Call {
+	return &RepositoryUser_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, entity)}
+}
+
+func (_c *RepositoryUser_CreateUser_Call) Run(run func(ctx context.Context, entity structures.UserAccountEntity)) *RepositoryUser_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.UserAccountEntity))
+	})
+	return _c
+}
+
+func (_c *RepositoryUser_CreateUser_Call) Return(_a0 error) *RepositoryUser_CreateUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryUser_CreateUser_Call) RunAndReturn(run func(context.Context, structures.UserAccountEntity) error) *RepositoryUser_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateUser provides a mock function with given fields: ctx, username
+func (_m *RepositoryUser) DeactivateUser(ctx context.Context, username string) (*structures.UserAccountModel, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 *structures.UserAccountModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*structures.UserAccountModel, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *structures.UserAccountModel); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryUser_DeactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateUser'
+type RepositoryUser_DeactivateUser_Call struct {
+	*mock.Call
+}
+
+// DeactivateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *RepositoryUser_Expecter) DeactivateUser(ctx interface{}, username interface{}) *RepositoryUser_DeactivateUser_Call {
+	return &RepositoryUser_DeactivateUser_Call{Call: _e.mock.On("DeactivateUser", ctx, username)}
+}
+
+func (_c *RepositoryUser_DeactivateUser_Call) Run(run func(ctx context.Context, username string)) *RepositoryUser_DeactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryUser_DeactivateUser_Call) Return(_a0 *structures.UserAccountModel, _a1 error) *RepositoryUser_DeactivateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryUser_DeactivateUser_Call) RunAndReturn(run func(context.Context, string) (*structures.UserAccountModel, error)) *RepositoryUser_DeactivateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveUserRole provides a mock function with given fields: ctx, username
+func (_m *RepositoryUser) GetActiveUserRole(ctx context.Context, username string) string {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveUserRole")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// RepositoryUser_GetActiveUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveUserRole'
+type RepositoryUser_GetActiveUserRole_Call struct {
+	*mock.Call
+}
+
+// GetActiveUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *RepositoryUser_Expecter) GetActiveUserRole(ctx interface{}, username interface{}) *RepositoryUser_GetActiveUserRole_Call {
+	return &RepositoryUser_GetActiveUserRole_Call{Call: _e.mock.On("GetActiveUserRole", ctx, username)}
+}
+
+func (_c *RepositoryUser_GetActiveUserRole_Call) Run(run func(ctx context.Context, username string)) *RepositoryUser_GetActiveUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryUser_GetActiveUserRole_Call) Return(_a0 string) *RepositoryUser_GetActiveUserRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryUser_GetActiveUserRole_Call) RunAndReturn(run func(context.Context, string) string) *RepositoryUser_GetActiveUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllUsers provides a mock function with given fields: ctx
+func (_m *RepositoryUser) GetAllUsers(ctx context.Context) []*structures.UserAccountModel {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllUsers")
+	}
+
+	var r0 []*structures.UserAccountModel
+	if rf, ok := ret.Get(0).(func(context.Context) []*structures.UserAccountModel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*structures.UserAccountModel)
+		}
+	}
+
+	return r0
+}
+
+// RepositoryUser_GetAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllUsers'
+type RepositoryUser_GetAllUsers_Call struct {
+	*mock.Call
+}
+
+// GetAllUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RepositoryUser_Expecter) GetAllUsers(ctx interface{}) *RepositoryUser_GetAllUsers_Call {
+	return &RepositoryUser_GetAllUsers_Call{Call: _e.mock.On("GetAllUsers", ctx)}
+}
+
+func (_c *RepositoryUser_GetAllUsers_Call) Run(run func(ctx context.Context)) *RepositoryUser_GetAllUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RepositoryUser_GetAllUsers_Call) Return(_a0 []*structures.UserAccountModel) *RepositoryUser_GetAllUsers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryUser_GetAllUsers_Call) RunAndReturn(run func(context.Context) []*structures.UserAccountModel) *RepositoryUser_GetAllUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, username
+func (_m *RepositoryUser) GetUser(ctx context.Context, username string) (*structures.UserAccountModel, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *structures.UserAccountModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*structures.UserAccountModel, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *structures.UserAccountModel); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryUser_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type RepositoryUser_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *RepositoryUser_Expecter) GetUser(ctx interface{}, username interface{}) *RepositoryUser_GetUser_Call {
+	return &RepositoryUser_GetUser_Call{Call: _e.mock.On("GetUser", ctx, username)}
+}
+
+func (_c *RepositoryUser_GetUser_Call) Run(run func(ctx context.Context, username string)) *RepositoryUser_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryUser_GetUser_Call) Return(_a0 *structures.UserAccountModel, _a1 error) *RepositoryUser_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryUser_GetUser_Call) RunAndReturn(run func(context.Context, string) (*structures.UserAccountModel, error)) *RepositoryUser_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserPassword provides a mock function with given fields: ctx, username, passwordHash
+func (_m *RepositoryUser) UpdateUserPassword(ctx context.Context, username string, passwordHash string) error {
+	ret := _m.Called(ctx, username, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, username, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryUser_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
+type RepositoryUser_UpdateUserPassword_Call struct {
+	*mock.Call
+}
+
+// UpdateUserPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - passwordHash string
+func (_e *RepositoryUser_Expecter) UpdateUserPassword(ctx interface{}, username interface{}, passwordHash interface{}) *RepositoryUser_UpdateUserPassword_Call {
+	return &RepositoryUser_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, username, passwordHash)}
+}
+
+func (_c *RepositoryUser_UpdateUserPassword_Call) Run(run func(ctx context.Context, username string, passwordHash string)) *RepositoryUser_UpdateUserPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryUser_UpdateUserPassword_Call) Return(_a0 error) *RepositoryUser_UpdateUserPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryUser_UpdateUserPassword_Call) RunAndReturn(run func(context.Context, string, string) error) *RepositoryUser_UpdateUserPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserRole provides a mock function with given fields: ctx, username, role
+func (_m *RepositoryUser) UpdateUserRole(ctx context.Context, username string, role string) (*structures.UserAccountModel, error) {
+	ret := _m.Called(ctx, username, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRole")
+	}
+
+	var r0 *structures.UserAccountModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*structures.UserAccountModel, error)); ok {
+		return rf(ctx, username, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *structures.UserAccountModel); ok {
+		r0 = rf(ctx, username, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryUser_UpdateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRole'
+type RepositoryUser_UpdateUserRole_Call struct {
+	*mock.Call
+}
+
+// UpdateUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - role string
+func (_e *RepositoryUser_Expecter) UpdateUserRole(ctx interface{}, username interface{}, role interface{}) *RepositoryUser_UpdateUserRole_Call {
+	return &RepositoryUser_UpdateUserRole_Call{Call: _e.mock.On("UpdateUserRole", ctx, username, role)}
+}
+
+func (_c *RepositoryUser_UpdateUserRole_Call) Run(run func(ctx context.Context, username string, role string)) *RepositoryUser_UpdateUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryUser_UpdateUserRole_Call) Return(_a0 *structures.UserAccountModel, _a1 error) *RepositoryUser_UpdateUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryUser_UpdateUserRole_Call) RunAndReturn(run func(context.Context, string, string) (*structures.UserAccountModel, error)) *RepositoryUser_UpdateUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepositoryUser creates a new instance of RepositoryUser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepositoryUser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepositoryUser {
+	mock := &RepositoryUser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,417 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	structures "project/structures"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceUser is an autogenerated mock type for the ServiceUser type
+type ServiceUser struct {
+	mock.Mock
+}
+
+type ServiceUser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceUser) EXPECT() *ServiceUser_Expecter {
+	return &ServiceUser_Expecter{mock: &_m.Mock}
+}
+
+// CreateUser provides a mock function with given fields: ctx, input
+func (_m *ServiceUser) CreateUser(ctx context.Context, input structures.UserAccountInput) (*structures.UserAccountOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 *structures.UserAccountOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, structures.UserAccountInput) (*structures.UserAccountOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, structures.UserAccountInput) *structures.UserAccountOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, structures.UserAccountInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUser_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type ServiceUser_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input structures.UserAccountInput
+func (_e *ServiceUser_Expecter) CreateUser(ctx interface{}, input interface{}) *ServiceUser_CreateUser_Call {
+	return &ServiceUser_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, input)}
+}
+
+func (_c *ServiceUser_CreateUser_Call) Run(run func(ctx context.Context, input structures.UserAccountInput)) *ServiceUser_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(structures.UserAccountInput))
+	})
+	return _c
+}
+
+func (_c *ServiceUser_CreateUser_Call) Return(_a0 *structures.UserAccountOutput, _a1 error) *ServiceUser_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUser_CreateUser_Call) RunAndReturn(run func(context.Context, structures.UserAccountInput) (*structures.UserAccountOutput, error)) *ServiceUser_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateUser provides a mock function with given fields: ctx, username
+func (_m *ServiceUser) DeactivateUser(ctx context.Context, username string) (*structures.UserAccountOutput, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateUser")
+	}
+
+	var r0 *structures.UserAccountOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*structures.UserAccountOutput, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *structures.UserAccountOutput); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUser_DeactivateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateUser'
+type ServiceUser_DeactivateUser_Call struct {
+	*mock.Call
+}
+
+// DeactivateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *ServiceUser_Expecter) DeactivateUser(ctx interface{}, username interface{}) *ServiceUser_DeactivateUser_Call {
+	return &ServiceUser_DeactivateUser_Call{Call: _e.mock.On("DeactivateUser", ctx, username)}
+}
+
+func (_c *ServiceUser_DeactivateUser_Call) Run(run func(ctx context.Context, username string)) *ServiceUser_DeactivateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUser_DeactivateUser_Call) Return(_a0 *structures.UserAccountOutput, _a1 error) *ServiceUser_DeactivateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUser_DeactivateUser_Call) RunAndReturn(run func(context.Context, string) (*structures.UserAccountOutput, error)) *ServiceUser_DeactivateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllUsers provides a mock function with given fields: ctx
+func (_m *ServiceUser) GetAllUsers(ctx context.Context) []*structures.UserAccountOutput {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllUsers")
+	}
+
+	var r0 []*structures.UserAccountOutput
+	if rf, ok := ret.Get(0).(func(context.Context) []*structures.UserAccountOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*structures.UserAccountOutput)
+		}
+	}
+
+	return r0
+}
+
+// ServiceUser_GetAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllUsers'
+type ServiceUser_GetAllUsers_Call struct {
+	*mock.Call
+}
+
+// GetAllUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ServiceUser_Expecter) GetAllUsers(ctx interface{}) *ServiceUser_GetAllUsers_Call {
+	return &ServiceUser_GetAllUsers_Call{Call: _e.mock.On("GetAllUsers", ctx)}
+}
+
+func (_c *ServiceUser_GetAllUsers_Call) Run(run func(ctx context.Context)) *ServiceUser_GetAllUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ServiceUser_GetAllUsers_Call) Return(_a0 []*structures.UserAccountOutput) *ServiceUser_GetAllUsers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceUser_GetAllUsers_Call) RunAndReturn(run func(context.Context) []*structures.UserAccountOutput) *ServiceUser_GetAllUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: ctx, username
+func (_m *ServiceUser) GetUser(ctx context.Context, username string) (*structures.UserAccountOutput, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *structures.UserAccountOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*structures.UserAccountOutput, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *structures.UserAccountOutput); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUser_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type ServiceUser_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *ServiceUser_Expecter) GetUser(ctx interface{}, username interface{}) *ServiceUser_GetUser_Call {
+	return &ServiceUser_GetUser_Call{Call: _e.mock.On("GetUser", ctx, username)}
+}
+
+func (_c *ServiceUser_GetUser_Call) Run(run func(ctx context.Context, username string)) *ServiceUser_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUser_GetUser_Call) Return(_a0 *structures.UserAccountOutput, _a1 error) *ServiceUser_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUser_GetUser_Call) RunAndReturn(run func(context.Context, string) (*structures.UserAccountOutput, error)) *ServiceUser_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRole provides a mock function with given fields: ctx, username
+func (_m *ServiceUser) GetUserRole(ctx context.Context, username string) string {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRole")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ServiceUser_GetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRole'
+type ServiceUser_GetUserRole_Call struct {
+	*mock.Call
+}
+
+// GetUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *ServiceUser_Expecter) GetUserRole(ctx interface{}, username interface{}) *ServiceUser_GetUserRole_Call {
+	return &ServiceUser_GetUserRole_Call{Call: _e.mock.On("GetUserRole", ctx, username)}
+}
+
+func (_c *ServiceUser_GetUserRole_Call) Run(run func(ctx context.Context, username string)) *ServiceUser_GetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUser_GetUserRole_Call) Return(_a0 string) *ServiceUser_GetUserRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceUser_GetUserRole_Call) RunAndReturn(run func(context.Context, string) string) *ServiceUser_GetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserPassword provides a mock function with given fields: ctx, username, password
+func (_m *ServiceUser) UpdateUserPassword(ctx context.Context, username string, password string) error {
+	ret := _m.Called(ctx, username, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, username, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceUser_UpdateUserPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPassword'
+type ServiceUser_UpdateUserPassword_Call struct {
+	*mock.Call
+}
+
+// UpdateUserPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - password string
+func (_e *ServiceUser_Expecter) UpdateUserPassword(ctx interface{}, username interface{}, password interface{}) *ServiceUser_UpdateUserPassword_Call {
+	return &ServiceUser_UpdateUserPassword_Call{Call: _e.mock.On("UpdateUserPassword", ctx, username, password)}
+}
+
+func (_c *ServiceUser_UpdateUserPassword_Call) Run(run func(ctx context.Context, username string, password string)) *ServiceUser_UpdateUserPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUser_UpdateUserPassword_Call) Return(_a0 error) *ServiceUser_UpdateUserPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceUser_UpdateUserPassword_Call) RunAndReturn(run func(context.Context, string, string) error) *ServiceUser_UpdateUserPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserRole provides a mock function with given fields: ctx, username, role
+func (_m *ServiceUser) UpdateUserRole(ctx context.Context, username string, role string) (*structures.UserAccountOutput, error) {
+	ret := _m.Called(ctx, username, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRole")
+	}
+
+	var r0 *structures.UserAccountOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*structures.UserAccountOutput, error)); ok {
+		return rf(ctx, username, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *structures.UserAccountOutput); ok {
+		r0 = rf(ctx, username, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structures.UserAccountOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceUser_UpdateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserRole'
+type ServiceUser_UpdateUserRole_Call struct {
+	*mock.Call
+}
+
+// UpdateUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - role string
+func (_e *ServiceUser_Expecter) UpdateUserRole(ctx interface{}, username interface{}, role interface{}) *ServiceUser_UpdateUserRole_Call {
+	return &ServiceUser_UpdateUserRole_Call{Call: _e.mock.On("UpdateUserRole", ctx, username, role)}
+}
+
+func (_c *ServiceUser_UpdateUserRole_Call) Run(run func(ctx context.Context, username string, role string)) *ServiceUser_UpdateUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceUser_UpdateUserRole_Call) Return(_a0 *structures.UserAccountOutput, _a1 error) *ServiceUser_UpdateUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceUser_UpdateUserRole_Call) RunAndReturn(run func(context.Context, string, string) (*structures.UserAccountOutput, error)) *ServiceUser_UpdateUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceUser creates a new instance of ServiceUser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceUser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceUser {
+	mock := &ServiceUser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"project/structures"
+)
+
+type ServiceConvertorUser struct{}
+
+func NewServiceUserConvertor() *ServiceConvertorUser {
+	return &ServiceConvertorUser{}
+}
+
+func (s *ServiceConvertorUser) ConvertUserInputToEntity(input structures.UserAccountInput) *structures.UserAccountEntity {
+	return &structures.UserAccountEntity{
+		Username: input.Username,
+		Role:     input.Role,
+		IsActive: true,
+	}
+}
+
+func (s *ServiceConvertorUser) ConvertUserModelToOutput(userModel *structures.UserAccountModel) *structures.UserAccountOutput {
+	return &structures.UserAccountOutput{
+		Username: userModel.Username,
+		Role:     userModel.Role,
+		IsActive: userModel.IsActive,
+	}
+}
+
+func (s *ServiceConvertorUser) ConvertUserModelsToOutputs(userModels []*structures.UserAccountModel) []*structures.UserAccountOutput {
+	outputs := make([]*structures.UserAccountOutput, len(userModels))
+	for i, userModel := range userModels {
+		outputs[i] = s.ConvertUserModelToOutput(userModel)
+	}
+
+	return outputs
+}
+
+type RepositoryConvertorUser struct{}
+
+func NewRepositoryUserConvertor() *RepositoryConvertorUser {
+	return &RepositoryConvertorUser{}
+}
+
+func (r *RepositoryConvertorUser) ConvertEntityToModel(entity structures.UserAccountEntity) *structures.UserAccountModel {
+	return &structures.UserAccountModel{
+		Username:     entity.Username,
+		Role:         entity.Role,
+		IsActive:     entity.IsActive,
+		CreationDate: entity.CreatedAt,
+	}
+}
+
+func (r *RepositoryConvertorUser) ConvertEntitiesToModels(entities []structures.UserAccountEntity) []*structures.UserAccountModel {
+	models := make([]*structures.UserAccountModel, len(entities))
+	for i, e := range entities {
+		models[i] = r.ConvertEntityToModel(e)
+	}
+
+	return models
+}
@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"project/memory"
+	"project/structures"
+	"project/utils"
+	"sort"
+	"time"
+)
+
+type MemoryRepositoryUser struct {
+	store     *memory.Store
+	convertor RepositoryConvertorUser
+}
+
+func NewMemoryRepositoryUser(store *memory.Store, convertor RepositoryConvertorUser) *MemoryRepositoryUser {
+	return &MemoryRepositoryUser{store: store, convertor: convertor}
+}
+
+func (r *MemoryRepositoryUser) GetUser(ctx context.Context, username string) (*structures.UserAccountModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var userEntity structures.UserAccountEntity
+	var ok bool
+	r.store.Read(ctx, func() {
+		userEntity, ok = r.store.Users[username]
+	})
+	if !ok {
+		err := errors.New(fmt.Sprintf("error getting user with username: %s", username))
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.convertor.ConvertEntityToModel(userEntity), nil
+}
+
+func (r *MemoryRepositoryUser) GetAllUsers(ctx context.Context) []*structures.UserAccountModel {
+	var entities []structures.UserAccountEntity
+	r.store.Read(ctx, func() {
+		for _, userEntity := range r.store.Users {
+			entities = append(entities, userEntity)
+		}
+	})
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Username < entities[j].Username
+	})
+
+	return r.convertor.ConvertEntitiesToModels(entities)
+}
+
+func (r *MemoryRepositoryUser) GetActiveUserRole(ctx context.Context, username string) string {
+	role := utils.Unknown
+	r.store.Read(ctx, func() {
+		userEntity, ok := r.store.Users[username]
+		if ok && userEntity.IsActive {
+			role = userEntity.Role
+		}
+	})
+
+	return role
+}
+
+func (r *MemoryRepositoryUser) CreateUser(ctx context.Context, entity structures.UserAccountEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		if _, ok := r.store.Users[entity.Username]; ok {
+			err := errors.New(fmt.Sprintf("error already exists user with this username %s", entity.Username))
+			log.Error(err)
+			return err
+		}
+
+		entity.IsActive = true
+		entity.CreatedAt = time.Now()
+		memory.Put(r.store, r.store.Users, entity.Username, entity)
+		return nil
+	})
+}
+
+func (r *MemoryRepositoryUser) UpdateUserRole(ctx context.Context, username, role string) (*structures.UserAccountModel, error) {
+	err := r.updateUser(ctx, username, func(userEntity *structures.UserAccountEntity) {
+		userEntity.Role = role
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetUser(ctx, username)
+}
+
+func (r *MemoryRepositoryUser) UpdateUserPassword(ctx context.Context, username, passwordHash string) error {
+	return r.updateUser(ctx, username, func(userEntity *structures.UserAccountEntity) {
+		userEntity.PasswordHash = passwordHash
+	})
+}
+
+func (r *MemoryRepositoryUser) DeactivateUser(ctx context.Context, username string) (*structures.UserAccountModel, error) {
+	err := r.updateUser(ctx, username, func(userEntity *structures.UserAccountEntity) {
+		userEntity.IsActive = false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetUser(ctx, username)
+}
+
+func (r *MemoryRepositoryUser) updateUser(ctx context.Context, username string, update func(userEntity *structures.UserAccountEntity)) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	return r.store.Do(ctx, func(ctx context.Context) error {
+		userEntity, ok := r.store.Users[username]
+		if !ok {
+			err := errors.New(fmt.Sprintf("error not found user with username: %s", username))
+			log.Error(err)
+			return err
+		}
+
+		update(&userEntity)
+		memory.Put(r.store, r.store.Users, username, userEntity)
+		return nil
+	})
+}
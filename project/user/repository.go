@@ -0,0 +1,234 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+	"project/structures"
+	"project/utils"
+	"strings"
+)
+
+var (
+	usersTable             = "users"
+	usersTableUsername     = "username"
+	usersTableRole         = "role"
+	usersTableIsActive     = "is_active"
+	usersTablePasswordHash = "password_hash"
+	usersColumns           = []string{"username", "role", "is_active", "created_at"}
+	insertUsersColumns     = []string{"username", "role", "password_hash"}
+)
+
+type DBRepositoryUser struct {
+	db        *sqlx.DB
+	convertor RepositoryConvertorUser
+}
+
+func NewDBRepositoryUser(db *sqlx.DB, convertor RepositoryConvertorUser) *DBRepositoryUser {
+	return &DBRepositoryUser{db: db, convertor: convertor}
+}
+
+func (r *DBRepositoryUser) GetUser(ctx context.Context, username string) (*structures.UserAccountModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	cond := fmt.Sprintf(`%s = ?`, usersTableUsername)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, strings.Join(usersColumns, ", "), usersTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var userEntity structures.UserAccountEntity
+	err := r.db.Get(&userEntity, query, username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New(fmt.Sprintf("error getting user with username: %s", username))
+		}
+
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.convertor.ConvertEntityToModel(userEntity), nil
+}
+
+func (r *DBRepositoryUser) GetAllUsers(ctx context.Context) []*structures.UserAccountModel {
+	sortBy := fmt.Sprintf(`ORDER BY %s`, usersTableUsername)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s %s`, strings.Join(usersColumns, ", "), usersTable, sortBy)
+	var entities []structures.UserAccountEntity
+	err := r.db.Select(&entities, stmt)
+	if err != nil {
+		return nil
+	}
+
+	return r.convertor.ConvertEntitiesToModels(entities)
+}
+
+func (r *DBRepositoryUser) GetActiveUserRole(ctx context.Context, username string) string {
+	cond := fmt.Sprintf(`%s = ? AND %s = TRUE`, usersTableUsername, usersTableIsActive)
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE %s`, usersTableRole, usersTable, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	var role string
+	err := r.db.Get(&role, query, username)
+	if err != nil {
+		return utils.Unknown
+	}
+
+	return role
+}
+
+func (r *DBRepositoryUser) CreateUser(ctx context.Context, entity structures.UserAccountEntity) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt := fmt.Sprintf(`INSERT INTO %s(%s) VALUES (?, ?, ?)`, usersTable, strings.Join(insertUsersColumns, ", "))
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := tx.Exec(query, entity.Username, entity.Role, entity.PasswordHash)
+	if err != nil {
+		if strings.Contains(err.Error(), utils.AlreadyExistsSQLErrorMsg) {
+			err = errors.New(fmt.Sprintf("error already exists user with this username %s", entity.Username))
+		}
+
+		log.Error(err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error creating user with this username %s", entity.Username))
+		log.Error(err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(err)
+	}
+
+	return err
+}
+
+func (r *DBRepositoryUser) UpdateUserRole(ctx context.Context, username, role string) (*structures.UserAccountModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	cond := fmt.Sprintf(`%s = ?`, usersTableUsername)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s = ? WHERE %s`, usersTable, usersTableRole, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := tx.Exec(query, role, username)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found user with username: %s", username))
+		log.Error(err)
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.GetUser(ctx, username)
+}
+
+func (r *DBRepositoryUser) UpdateUserPassword(ctx context.Context, username, passwordHash string) error {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	cond := fmt.Sprintf(`%s = ?`, usersTableUsername)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s = ? WHERE %s`, usersTable, usersTablePasswordHash, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := tx.Exec(query, passwordHash, username)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found user with username: %s", username))
+		log.Error(err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(err)
+	}
+
+	return err
+}
+
+func (r *DBRepositoryUser) DeactivateUser(ctx context.Context, username string) (*structures.UserAccountModel, error) {
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	cond := fmt.Sprintf(`%s = ?`, usersTableUsername)
+	stmt := fmt.Sprintf(`UPDATE %s SET %s = FALSE WHERE %s`, usersTable, usersTableIsActive, cond)
+	query := sqlx.Rebind(sqlx.DOLLAR, stmt)
+	result, err := tx.Exec(query, username)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	if affectedRows != 1 {
+		err = errors.New(fmt.Sprintf("error not found user with username: %s", username))
+		log.Error(err)
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	return r.GetUser(ctx, username)
+}
@@ -0,0 +1,386 @@
+package user_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"project/structures"
+	"project/user"
+	"project/utils"
+	"regexp"
+	"testing"
+	"time"
+)
+
+const testPasswordHash = "$2a$10$FH4NNQY/Nmf.00eLfifmk.x0pyi4/Tt./2p3j.1nVxDxg5yj.TazW"
+
+func TestRepositoryGetUser(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := user.NewRepositoryUserConvertor()
+	repo := user.NewDBRepositoryUser(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expected    string
+		expectedErr error
+	}{
+		{
+			name: "getting existing user",
+			mock: func() {
+				rows := sqlxmock.NewRows([]string{"username", "role", "is_active", "created_at"}).
+					AddRow(utils.TestUsername, utils.Writer, true, time.Now())
+				mock.ExpectQuery(`SELECT username, role, is_active, created_at FROM users WHERE username = \$1`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(rows)
+			},
+			expected: utils.Writer,
+		}, {
+			name: "getting non-existing user",
+			mock: func() {
+				mock.ExpectQuery(`SELECT username, role, is_active, created_at FROM users WHERE username = \$1`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(sqlxmock.NewRows([]string{"username", "role", "is_active", "created_at"}))
+			},
+			expectedErr: errors.New("error getting user with username: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.GetUser(ctx, utils.TestUsername)
+			if err != nil {
+				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, ok)
+				return
+			}
+
+			require.Equal(t, testCase.expected, actual.Role)
+		})
+	}
+}
+
+func TestRepositoryGetActiveUserRole(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := user.NewRepositoryUserConvertor()
+	repo := user.NewDBRepositoryUser(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name     string
+		mock     func()
+		expected string
+	}{
+		{
+			name: "active user",
+			mock: func() {
+				rows := sqlxmock.NewRows([]string{"role"}).AddRow(utils.Admin)
+				mock.ExpectQuery(`SELECT role FROM users WHERE username = \$1 AND is_active = TRUE`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(rows)
+			},
+			expected: utils.Admin,
+		}, {
+			name: "deactivated or unknown user",
+			mock: func() {
+				mock.ExpectQuery(`SELECT role FROM users WHERE username = \$1 AND is_active = TRUE`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(sqlxmock.NewRows([]string{"role"}))
+			},
+			expected: utils.Unknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual := repo.GetActiveUserRole(ctx, utils.TestUsername)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRepositoryCreateUser(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := user.NewRepositoryUserConvertor()
+	repo := user.NewDBRepositoryUser(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		input       structures.UserAccountEntity
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "create new user",
+			input: structures.UserAccountEntity{
+				Username:     utils.TestUsername,
+				PasswordHash: testPasswordHash,
+				Role:         utils.Reader,
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO users\(username, role, password_hash\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestUsername, utils.Reader, testPasswordHash).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "already existing user",
+			input: structures.UserAccountEntity{
+				Username:     utils.TestUsername,
+				PasswordHash: testPasswordHash,
+				Role:         utils.Reader,
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO users\(username, role, password_hash\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestUsername, utils.Reader, testPasswordHash).
+					WillReturnError(errors.New(utils.AlreadyExistsSQLErrorMsg))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error already exists user with this username .+"),
+		}, {
+			name: "created new user but not added to table",
+			input: structures.UserAccountEntity{
+				Username:     utils.TestUsername,
+				PasswordHash: testPasswordHash,
+				Role:         utils.Reader,
+			},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO users\(username, role, password_hash\) VALUES \(\$1, \$2, \$3\)`).
+					WithArgs(utils.TestUsername, utils.Reader, testPasswordHash).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error creating user with this username .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := repo.CreateUser(ctx, testCase.input)
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, ok)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryUpdateUserRole(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := user.NewRepositoryUserConvertor()
+	repo := user.NewDBRepositoryUser(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expected    string
+		expectedErr error
+	}{
+		{
+			name: "update role of existing user",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE users SET role = \$1 WHERE username = \$2`).
+					WithArgs(utils.Admin, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				rows := sqlxmock.NewRows([]string{"username", "role", "is_active", "created_at"}).
+					AddRow(utils.TestUsername, utils.Admin, true, time.Now())
+				mock.ExpectQuery(`SELECT username, role, is_active, created_at FROM users WHERE username = \$1`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(rows)
+			},
+			expected: utils.Admin,
+		}, {
+			name: "update role of non-existing user",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE users SET role = \$1 WHERE username = \$2`).
+					WithArgs(utils.Admin, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found user with username: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.UpdateUserRole(ctx, utils.TestUsername, utils.Admin)
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, ok)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual.Role)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryUpdateUserPassword(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := user.NewRepositoryUserConvertor()
+	repo := user.NewDBRepositoryUser(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "update password of existing user",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE users SET password_hash = \$1 WHERE username = \$2`).
+					WithArgs(testPasswordHash, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		}, {
+			name: "update password of non-existing user",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE users SET password_hash = \$1 WHERE username = \$2`).
+					WithArgs(testPasswordHash, utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found user with username: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			err := repo.UpdateUserPassword(ctx, utils.TestUsername, testPasswordHash)
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, ok)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepositoryDeactivateUser(t *testing.T) {
+	db, mock, err := sqlxmock.Newx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	convertor := user.NewRepositoryUserConvertor()
+	repo := user.NewDBRepositoryUser(db, *convertor)
+	ctx := utils.HelperGetContext()
+
+	testCases := []struct {
+		name        string
+		mock        func()
+		expectedErr error
+	}{
+		{
+			name: "deactivate existing user",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE users SET is_active = FALSE WHERE username = \$1`).
+					WithArgs(utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				rows := sqlxmock.NewRows([]string{"username", "role", "is_active", "created_at"}).
+					AddRow(utils.TestUsername, utils.Reader, false, time.Now())
+				mock.ExpectQuery(`SELECT username, role, is_active, created_at FROM users WHERE username = \$1`).
+					WithArgs(utils.TestUsername).
+					WillReturnRows(rows)
+			},
+		}, {
+			name: "deactivate non-existing user",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE users SET is_active = FALSE WHERE username = \$1`).
+					WithArgs(utils.TestUsername).
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedErr: errors.New("error not found user with username: .+"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mock()
+
+			actual, err := repo.DeactivateUser(ctx, utils.TestUsername)
+			if testCase.expectedErr != nil {
+				require.Error(t, err)
+				ok, err := regexp.MatchString(testCase.expectedErr.Error(), err.Error())
+				require.NoError(t, err)
+				require.True(t, ok)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, actual.IsActive)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
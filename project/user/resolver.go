@@ -0,0 +1,300 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"project/structures"
+	"project/utils"
+	"regexp"
+	"strings"
+)
+
+const (
+	username        = "userId"
+	invalidRoleMsg  = "role must be one of: reader, writer, admin"
+	missingUserMsg  = "user's username is required"
+	missingPassMsg  = "user's password is required"
+	decodingUserMsg = "failed to decode user"
+)
+
+//go:generate mockery --name ServiceUser --output=automock --with-expecter=true
+type ServiceUser interface {
+	GetUser(ctx context.Context, username string) (*structures.UserAccountOutput, error)
+	GetAllUsers(ctx context.Context) []*structures.UserAccountOutput
+	GetUserRole(ctx context.Context, username string) string
+	CreateUser(ctx context.Context, input structures.UserAccountInput) (*structures.UserAccountOutput, error)
+	UpdateUserRole(ctx context.Context, username, role string) (*structures.UserAccountOutput, error)
+	UpdateUserPassword(ctx context.Context, username, password string) error
+	DeactivateUser(ctx context.Context, username string) (*structures.UserAccountOutput, error)
+}
+
+type ResolverUserImpl struct {
+	service ServiceUser
+}
+
+func NewResolverUser(service ServiceUser) *ResolverUserImpl {
+	return &ResolverUserImpl{
+		service: service,
+	}
+}
+
+func (r *ResolverUserImpl) isAssignableRole(role string) bool {
+	return role == utils.Reader || role == utils.Writer || role == utils.Admin
+}
+
+func (r *ResolverUserImpl) GetUser(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	params := mux.Vars(req)
+	if params[username] == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingUserMsg)
+		return
+	}
+
+	username := params[username]
+	user, err := r.service.GetUser(ctx, username)
+	if err != nil {
+		isGetError, regErr := regexp.MatchString(utils.GetErrorMsg, err.Error())
+		if isGetError && regErr == nil {
+			w.WriteHeader(http.StatusNotFound)
+			utils.ResponseHandling(req, w, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := fmt.Sprintf("failed to get user %s", username)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success getting user %s", username))
+	utils.ResponseHandling(req, w, user)
+}
+
+func (r *ResolverUserImpl) GetCurrentUser(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	username := req.Header.Get(username)
+	user, err := r.service.GetUser(ctx, username)
+	if err != nil {
+		isGetError, regErr := regexp.MatchString(utils.GetErrorMsg, err.Error())
+		if isGetError && regErr == nil {
+			w.WriteHeader(http.StatusNotFound)
+			utils.ResponseHandling(req, w, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := fmt.Sprintf("failed to get user %s", username)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success getting current user %s", username))
+	utils.ResponseHandling(req, w, user)
+}
+
+func (r *ResolverUserImpl) GetAllUsers(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	allUsers := r.service.GetAllUsers(ctx)
+
+	w.WriteHeader(http.StatusOK)
+	utils.ResponseHandling(req, w, allUsers)
+}
+
+func (r *ResolverUserImpl) CreateUser(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	var input structures.UserAccountInput
+	err := json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, decodingUserMsg)
+		return
+	}
+
+	if input.Username == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingUserMsg)
+		return
+	}
+	if input.Password == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingPassMsg)
+		return
+	}
+	if input.Role == "" {
+		input.Role = utils.Reader
+	}
+	if !r.isAssignableRole(input.Role) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, invalidRoleMsg)
+		return
+	}
+
+	newUser, err := r.service.CreateUser(ctx, input)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.AlreadyExistsErrorMsg) {
+			w.WriteHeader(http.StatusConflict)
+		} else if strings.Contains(err.Error(), utils.CreateErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to create user with username: %s", input.Username)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	log.WithField(utils.Status, http.StatusCreated).Info(fmt.Sprintf("success creating user %s", newUser.Username))
+	w.WriteHeader(http.StatusCreated)
+	utils.ResponseHandling(req, w, newUser)
+}
+
+func (r *ResolverUserImpl) UpdateUserRole(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	params := mux.Vars(req)
+	if params[username] == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingUserMsg)
+		return
+	}
+	username := params[username]
+
+	var input structures.UserRoleInput
+	err := json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("failed to decode new role for user %s", username)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+	if !r.isAssignableRole(input.Role) {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, invalidRoleMsg)
+		return
+	}
+
+	updatedUser, err := r.service.UpdateUserRole(ctx, username, input.Role)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), utils.UpdateErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to update role of user %s", username)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success updating role of user %s to %s", username, updatedUser.Role))
+	utils.ResponseHandling(req, w, updatedUser)
+}
+
+func (r *ResolverUserImpl) UpdateUserPassword(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	params := mux.Vars(req)
+	if params[username] == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingUserMsg)
+		return
+	}
+	username := params[username]
+
+	var input structures.UserPasswordInput
+	err := json.NewDecoder(req.Body).Decode(&input)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		msg := fmt.Sprintf("failed to decode new password for user %s", username)
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+	if input.Password == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingPassMsg)
+		return
+	}
+
+	err = r.service.UpdateUserPassword(ctx, username, input.Password)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else if strings.Contains(err.Error(), utils.UpdateErrorMsg) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to update password of user %s", username)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	msg := fmt.Sprintf("success updating password of user %s", username)
+	w.WriteHeader(http.StatusOK)
+	log.Info(msg)
+	utils.ResponseHandling(req, w, msg)
+}
+
+func (r *ResolverUserImpl) DeactivateUser(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := ctx.Value(utils.Logger).(*logrus.Entry)
+
+	params := mux.Vars(req)
+	if params[username] == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		utils.ResponseHandling(req, w, missingUserMsg)
+		return
+	}
+	username := params[username]
+
+	deactivatedUser, err := r.service.DeactivateUser(ctx, username)
+	if err != nil {
+		msg := err.Error()
+		if strings.Contains(err.Error(), utils.NotFoundErrorMsg) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			msg = fmt.Sprintf("failed to deactivate user %s", username)
+		}
+
+		utils.ResponseHandling(req, w, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	log.Info(fmt.Sprintf("success deactivating user %s", username))
+	utils.ResponseHandling(req, w, deactivatedUser)
+}
+
+func (r *ResolverUserImpl) GetUserRights(ctx context.Context, username string) int {
+	rank, exists := utils.Role[r.service.GetUserRole(ctx, username)]
+	if !exists {
+		return utils.Role[utils.Unknown]
+	}
+
+	return rank
+}
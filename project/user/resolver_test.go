@@ -0,0 +1,355 @@
+package user_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"project/structures"
+	"project/user"
+	mocks "project/user/automock"
+	"project/utils"
+	"testing"
+)
+
+func TestResolverGetUser(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceUser
+		inputUsername  string
+		expectedStatus int
+	}{
+		{
+			name: "get existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().GetUser(mock.Anything, utils.TestUsername).
+					Return(&structures.UserAccountOutput{Username: utils.TestUsername, Role: utils.Reader, IsActive: true}, nil).
+					Once()
+				return srvMock
+			},
+			inputUsername:  utils.TestUsername,
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "get non-existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().GetUser(mock.Anything, utils.TestUsername).
+					Return(nil, errors.New(fmt.Sprintf("error getting user with username: %s", utils.TestUsername))).
+					Once()
+				return srvMock
+			},
+			inputUsername:  utils.TestUsername,
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "missing username",
+			service: func() *mocks.ServiceUser {
+				return &mocks.ServiceUser{}
+			},
+			inputUsername:  "",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := user.NewResolverUser(testCase.service())
+
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/todo/api/users/%s", testCase.inputUsername), nil)
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"userId": testCase.inputUsername})
+
+			rr := httptest.NewRecorder()
+
+			resolver.GetUser(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverCreateUser(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceUser
+		inputUser      []byte
+		expectedStatus int
+	}{
+		{
+			name: "create new user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().CreateUser(mock.Anything, structures.UserAccountInput{Username: utils.TestUsername, Password: testPassword, Role: utils.Writer}).
+					Return(&structures.UserAccountOutput{Username: utils.TestUsername, Role: utils.Writer, IsActive: true}, nil).
+					Once()
+				return srvMock
+			},
+			inputUser:      []byte(fmt.Sprintf(`{"username": "%s", "password": "%s", "role": "%s"}`, utils.TestUsername, testPassword, utils.Writer)),
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "create new user with default role",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().CreateUser(mock.Anything, structures.UserAccountInput{Username: utils.TestUsername, Password: testPassword, Role: utils.Reader}).
+					Return(&structures.UserAccountOutput{Username: utils.TestUsername, Role: utils.Reader, IsActive: true}, nil).
+					Once()
+				return srvMock
+			},
+			inputUser:      []byte(fmt.Sprintf(`{"username": "%s", "password": "%s"}`, utils.TestUsername, testPassword)),
+			expectedStatus: http.StatusCreated,
+		}, {
+			name: "create already existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().CreateUser(mock.Anything, mock.Anything).
+					Return(nil, errors.New("error already exists user with this username "+utils.TestUsername)).
+					Once()
+				return srvMock
+			},
+			inputUser:      []byte(fmt.Sprintf(`{"username": "%s", "password": "%s", "role": "%s"}`, utils.TestUsername, testPassword, utils.Writer)),
+			expectedStatus: http.StatusConflict,
+		}, {
+			name: "create user with invalid role",
+			service: func() *mocks.ServiceUser {
+				return &mocks.ServiceUser{}
+			},
+			inputUser:      []byte(fmt.Sprintf(`{"username": "%s", "password": "%s", "role": "%s"}`, utils.TestUsername, testPassword, utils.Owner)),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "create user without username",
+			service: func() *mocks.ServiceUser {
+				return &mocks.ServiceUser{}
+			},
+			inputUser:      []byte(`{"role": "reader"}`),
+			expectedStatus: http.StatusBadRequest,
+		}, {
+			name: "create user without password",
+			service: func() *mocks.ServiceUser {
+				return &mocks.ServiceUser{}
+			},
+			inputUser:      []byte(fmt.Sprintf(`{"username": "%s"}`, utils.TestUsername)),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := user.NewResolverUser(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPost, "/todo/api/users", bytes.NewReader(testCase.inputUser))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+
+			rr := httptest.NewRecorder()
+
+			resolver.CreateUser(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+const testPassword = "secret"
+
+func TestResolverUpdateUserPassword(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceUser
+		inputPassword  []byte
+		expectedStatus int
+	}{
+		{
+			name: "update password of existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().UpdateUserPassword(mock.Anything, utils.TestUsername, testPassword).Return(nil).Once()
+				return srvMock
+			},
+			inputPassword:  []byte(fmt.Sprintf(`{"password": "%s"}`, testPassword)),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "update password of non-existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().UpdateUserPassword(mock.Anything, utils.TestUsername, testPassword).
+					Return(errors.New(fmt.Sprintf("error not found user with username: %s", utils.TestUsername))).
+					Once()
+				return srvMock
+			},
+			inputPassword:  []byte(fmt.Sprintf(`{"password": "%s"}`, testPassword)),
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "update to empty password",
+			service: func() *mocks.ServiceUser {
+				return &mocks.ServiceUser{}
+			},
+			inputPassword:  []byte(`{"password": ""}`),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := user.NewResolverUser(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPatch,
+				fmt.Sprintf("/todo/api/users/%s/password", utils.TestUsername),
+				bytes.NewReader(testCase.inputPassword))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"userId": utils.TestUsername})
+
+			rr := httptest.NewRecorder()
+
+			resolver.UpdateUserPassword(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverUpdateUserRole(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceUser
+		inputRole      []byte
+		expectedStatus int
+	}{
+		{
+			name: "update role of existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().UpdateUserRole(mock.Anything, utils.TestUsername, utils.Admin).
+					Return(&structures.UserAccountOutput{Username: utils.TestUsername, Role: utils.Admin, IsActive: true}, nil).
+					Once()
+				return srvMock
+			},
+			inputRole:      []byte(fmt.Sprintf(`{"role": "%s"}`, utils.Admin)),
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "update role of non-existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().UpdateUserRole(mock.Anything, utils.TestUsername, utils.Admin).
+					Return(nil, errors.New(fmt.Sprintf("error not found user with username: %s", utils.TestUsername))).
+					Once()
+				return srvMock
+			},
+			inputRole:      []byte(fmt.Sprintf(`{"role": "%s"}`, utils.Admin)),
+			expectedStatus: http.StatusNotFound,
+		}, {
+			name: "update to invalid role",
+			service: func() *mocks.ServiceUser {
+				return &mocks.ServiceUser{}
+			},
+			inputRole:      []byte(`{"role": "superuser"}`),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := user.NewResolverUser(testCase.service())
+
+			req, err := http.NewRequest(http.MethodPatch,
+				fmt.Sprintf("/todo/api/users/%s/role", utils.TestUsername),
+				bytes.NewReader(testCase.inputRole))
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"userId": utils.TestUsername})
+
+			rr := httptest.NewRecorder()
+
+			resolver.UpdateUserRole(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverDeactivateUser(t *testing.T) {
+	testCases := []struct {
+		name           string
+		service        func() *mocks.ServiceUser
+		expectedStatus int
+	}{
+		{
+			name: "deactivate existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().DeactivateUser(mock.Anything, utils.TestUsername).
+					Return(&structures.UserAccountOutput{Username: utils.TestUsername, Role: utils.Reader, IsActive: false}, nil).
+					Once()
+				return srvMock
+			},
+			expectedStatus: http.StatusOK,
+		}, {
+			name: "deactivate non-existing user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().DeactivateUser(mock.Anything, utils.TestUsername).
+					Return(nil, errors.New(fmt.Sprintf("error not found user with username: %s", utils.TestUsername))).
+					Once()
+				return srvMock
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := user.NewResolverUser(testCase.service())
+
+			req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/todo/api/users/%s", utils.TestUsername), nil)
+			require.NoError(t, err)
+			req = req.WithContext(utils.HelperGetContext())
+			req = mux.SetURLVars(req, map[string]string{"userId": utils.TestUsername})
+
+			rr := httptest.NewRecorder()
+
+			resolver.DeactivateUser(rr, req)
+
+			require.Equal(t, testCase.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestResolverGetUserRights(t *testing.T) {
+	testCases := []struct {
+		name     string
+		service  func() *mocks.ServiceUser
+		expected int
+	}{
+		{
+			name: "active writer",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().GetUserRole(mock.Anything, utils.TestUsername).Return(utils.Writer).Once()
+				return srvMock
+			},
+			expected: utils.Role[utils.Writer],
+		}, {
+			name: "unknown or deactivated user",
+			service: func() *mocks.ServiceUser {
+				srvMock := &mocks.ServiceUser{}
+				srvMock.EXPECT().GetUserRole(mock.Anything, utils.TestUsername).Return(utils.Unknown).Once()
+				return srvMock
+			},
+			expected: utils.Role[utils.Unknown],
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := user.NewResolverUser(testCase.service())
+
+			actual := resolver.GetUserRights(utils.HelperGetContext(), utils.TestUsername)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
+	"project/structures"
+)
+
+//go:generate mockery --name RepositoryUser --output=automock --with-expecter=true
+type RepositoryUser interface {
+	GetUser(ctx context.Context, username string) (*structures.UserAccountModel, error)
+	GetAllUsers(ctx context.Context) []*structures.UserAccountModel
+	GetActiveUserRole(ctx context.Context, username string) string
+	CreateUser(ctx context.Context, entity structures.UserAccountEntity) error
+	UpdateUserRole(ctx context.Context, username, role string) (*structures.UserAccountModel, error)
+	UpdateUserPassword(ctx context.Context, username, passwordHash string) error
+	DeactivateUser(ctx context.Context, username string) (*structures.UserAccountModel, error)
+}
+
+type ServiceUserImpl struct {
+	repo      RepositoryUser
+	converter ServiceConvertorUser
+}
+
+func NewServiceUser(repo RepositoryUser, converter ServiceConvertorUser) *ServiceUserImpl {
+	return &ServiceUserImpl{repo: repo, converter: converter}
+}
+
+func (s *ServiceUserImpl) GetUser(ctx context.Context, username string) (*structures.UserAccountOutput, error) {
+	userModel, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertUserModelToOutput(userModel), nil
+}
+
+func (s *ServiceUserImpl) GetAllUsers(ctx context.Context) []*structures.UserAccountOutput {
+	result := s.repo.GetAllUsers(ctx)
+	return s.converter.ConvertUserModelsToOutputs(result)
+}
+
+func (s *ServiceUserImpl) GetUserRole(ctx context.Context, username string) string {
+	return s.repo.GetActiveUserRole(ctx, username)
+}
+
+func (s *ServiceUserImpl) CreateUser(ctx context.Context, input structures.UserAccountInput) (*structures.UserAccountOutput, error) {
+	passwordHash, err := s.hashPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	userEntity := s.converter.ConvertUserInputToEntity(input)
+	userEntity.PasswordHash = passwordHash
+	err = s.repo.CreateUser(ctx, *userEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &structures.UserAccountOutput{
+		Username: userEntity.Username,
+		Role:     userEntity.Role,
+		IsActive: userEntity.IsActive,
+	}, nil
+}
+
+func (s *ServiceUserImpl) UpdateUserRole(ctx context.Context, username, role string) (*structures.UserAccountOutput, error) {
+	updatedUser, err := s.repo.UpdateUserRole(ctx, username, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertUserModelToOutput(updatedUser), nil
+}
+
+func (s *ServiceUserImpl) UpdateUserPassword(ctx context.Context, username, password string) error {
+	passwordHash, err := s.hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateUserPassword(ctx, username, passwordHash)
+}
+
+func (s *ServiceUserImpl) hashPassword(password string) (string, error) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("error updating password: %s", err))
+	}
+
+	return string(passwordHash), nil
+}
+
+func (s *ServiceUserImpl) DeactivateUser(ctx context.Context, username string) (*structures.UserAccountOutput, error) {
+	deactivatedUser, err := s.repo.DeactivateUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.converter.ConvertUserModelToOutput(deactivatedUser), nil
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"project/structures"
+	"reflect"
+	"time"
+)
+
+const (
+	AuditEntityList     = "list"
+	AuditEntityMember   = "member"
+	AuditEntityLabel    = "label"
+	AuditEntityWorkflow = "workflow"
+	AuditEntityTodo     = "todo"
+	AuditEntityTemplate = "template"
+)
+
+const (
+	AuditCreateList       = "create_list"
+	AuditUpdateList       = "update_list"
+	AuditDeleteList       = "delete_list"
+	AuditAddMember        = "add_member"
+	AuditRemoveMember     = "remove_member"
+	AuditCreateLabel      = "create_label"
+	AuditUpdateLabel      = "update_label"
+	AuditDeleteLabel      = "delete_label"
+	AuditUpdateWorkflow   = "update_workflow"
+	AuditCreateTodo       = "create_todo"
+	AuditUpdateTodo       = "update_todo"
+	AuditDeleteTodo       = "delete_todo"
+	AuditAssignTodo       = "assign_todo"
+	AuditUnassignTodo     = "unassign_todo"
+	AuditReassignTodo     = "reassign_todo"
+	AuditRemoveAssignee   = "remove_assignee"
+	AuditAddWatcher       = "add_watcher"
+	AuditRemoveWatcher    = "remove_watcher"
+	AuditChangeStatus     = "change_status"
+	AuditReopenTodo       = "reopen_todo"
+	AuditStepBackTodo     = "step_back_todo"
+	AuditAttachLabel      = "attach_label"
+	AuditDetachLabel      = "detach_label"
+	AuditSetRecurrence    = "set_recurrence"
+	AuditStopRecurrence   = "stop_recurrence"
+	AuditAddDependency    = "add_dependency"
+	AuditRemoveDependency = "remove_dependency"
+	AuditMoveTodo         = "move_todo"
+	AuditCopyTodo         = "copy_todo"
+	AuditDuplicateList    = "duplicate_list"
+	AuditCreateTemplate   = "create_template"
+	AuditUseTemplate      = "use_template"
+)
+
+// NewAuditEntry describes a change made by the user of the request in ctx. before and after are
+// the states of the entity around the change, nil when it did not exist.
+func NewAuditEntry(ctx context.Context, action, entityType, entityId string, listId uuid.UUID, todoId *uuid.UUID, before, after any) (*structures.AuditEntity, error) {
+	beforeChanges, afterChanges, err := auditChanges(before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, _ := ctx.Value(CurrentUser).(string)
+	requestId, _ := ctx.Value(RequestId).(string)
+	return &structures.AuditEntity{
+		Id:         uuid.New(),
+		Actor:      actor,
+		Action:     action,
+		EntityType: entityType,
+		EntityId:   entityId,
+		ListId:     listId,
+		TodoId:     todoId,
+		Before:     beforeChanges,
+		After:      afterChanges,
+		RequestId:  requestId,
+		CreatedAt:  time.Now().UTC().Truncate(time.Microsecond),
+	}, nil
+}
+
+// auditChanges keeps only the fields whose JSON form differs between the two states.
+func auditChanges(before, after any) ([]byte, []byte, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if beforeFields != nil && afterFields != nil {
+		for field, value := range beforeFields {
+			if reflect.DeepEqual(value, afterFields[field]) {
+				delete(beforeFields, field)
+				delete(afterFields, field)
+			}
+		}
+	}
+
+	return marshalAuditFields(beforeFields), marshalAuditFields(afterFields), nil
+}
+
+func auditFields(state any) (map[string]any, error) {
+	if state == nil {
+		return nil, nil
+	}
+	if value := reflect.ValueOf(state); value.Kind() == reflect.Pointer && value.IsNil() {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	err = json.Unmarshal(encoded, &fields)
+	return fields, err
+}
+
+func marshalAuditFields(fields map[string]any) []byte {
+	if fields == nil {
+		return nil
+	}
+
+	encoded, _ := json.Marshal(fields)
+	return encoded
+}
@@ -0,0 +1,208 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"project/structures"
+	"slices"
+	"strconv"
+)
+
+const (
+	TotalCountHeader      = "X-Total-Count"
+	StartCursorHeader     = "X-Start-Cursor"
+	EndCursorHeader       = "X-End-Cursor"
+	HasNextPageHeader     = "X-Has-Next-Page"
+	HasPreviousPageHeader = "X-Has-Previous-Page"
+
+	LimitParam  = "limit"
+	CursorParam = "cursor"
+	LastParam   = "last"
+	BeforeParam = "before"
+	MaxPageSize = 100
+
+	InvalidCursorErrorMsg = "error invalid cursor"
+	InvalidPageErrorMsg   = "error invalid page"
+)
+
+func EncodeCursor(cursor structures.Cursor) string {
+	encoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func DecodeCursor(encoded string) (*structures.Cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New(InvalidCursorErrorMsg)
+	}
+
+	var cursor structures.Cursor
+	err = json.Unmarshal(decoded, &cursor)
+	if err != nil {
+		return nil, errors.New(InvalidCursorErrorMsg)
+	}
+
+	return &cursor, nil
+}
+
+func ParsePageQuery(values url.Values) (*structures.PageQuery, error) {
+	var page structures.PageQuery
+	var err error
+
+	page.Limit, err = parsePageSize(values, LimitParam)
+	if err != nil {
+		return nil, err
+	}
+	page.Last, err = parsePageSize(values, LastParam)
+	if err != nil {
+		return nil, err
+	}
+	if page.Limit > 0 && page.Last > 0 {
+		return nil, errors.New(fmt.Sprintf("%s, %s and %s cannot be combined", InvalidPageErrorMsg, LimitParam, LastParam))
+	}
+
+	if values.Has(CursorParam) {
+		page.After, err = DecodeCursor(values.Get(CursorParam))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if values.Has(BeforeParam) {
+		page.Before, err = DecodeCursor(values.Get(BeforeParam))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &page, nil
+}
+
+func parsePageSize(values url.Values, param string) (int, error) {
+	if !values.Has(param) {
+		return 0, nil
+	}
+
+	size, err := strconv.Atoi(values.Get(param))
+	if err != nil || size < 1 || size > MaxPageSize {
+		return 0, errors.New(fmt.Sprintf("error invalid %s %s, expected a number from 1 to %d", param, values.Get(param), MaxPageSize))
+	}
+
+	return size, nil
+}
+
+// ValidatePageQuery rejects cursors issued for another order of the items.
+func ValidatePageQuery(page structures.PageQuery, sortBy string, descending bool) error {
+	if page.Limit > 0 && page.Last > 0 {
+		return errors.New(fmt.Sprintf("%s, %s and %s cannot be combined", InvalidPageErrorMsg, LimitParam, LastParam))
+	}
+
+	for _, cursor := range []*structures.Cursor{page.After, page.Before} {
+		if cursor != nil && (cursor.SortBy != sortBy || cursor.Descending != descending) {
+			return errors.New(InvalidCursorErrorMsg)
+		}
+	}
+
+	return nil
+}
+
+// KeysetConditions returns the conditions selecting the rows between the cursors of the page
+// and the ORDER BY clause to read them in. Pages counted from the end are read backwards,
+// so the rows have to be reversed with ReverseLastPage once they are fetched.
+func KeysetConditions(page structures.PageQuery, sortColumn, idColumn string, descending bool) ([]string, []any, string) {
+	var conds []string
+	var args []any
+
+	after, before := ">", "<"
+	if descending {
+		after, before = before, after
+	}
+	if page.After != nil {
+		conds = append(conds, fmt.Sprintf(`(%s, %s) %s (?, ?)`, sortColumn, idColumn, after))
+		args = append(args, page.After.Value, page.After.Id)
+	}
+	if page.Before != nil {
+		conds = append(conds, fmt.Sprintf(`(%s, %s) %s (?, ?)`, sortColumn, idColumn, before))
+		args = append(args, page.Before.Value, page.Before.Id)
+	}
+
+	direction := "ASC"
+	if descending != (page.Last > 0) {
+		direction = "DESC"
+	}
+
+	return conds, args, fmt.Sprintf(`ORDER BY %s %s, %s %s`, sortColumn, direction, idColumn, direction)
+}
+
+// RowLimit is the number of rows to fetch for the page: one more than requested,
+// to find out whether another page follows. Zero means every row.
+func RowLimit(page structures.PageQuery) int {
+	if page.Last > 0 {
+		return page.Last + 1
+	}
+	if page.Limit > 0 {
+		return page.Limit + 1
+	}
+
+	return 0
+}
+
+func ReverseLastPage[T any](page structures.PageQuery, items []T) {
+	if page.Last > 0 {
+		slices.Reverse(items)
+	}
+}
+
+// PageItems takes the items between the cursors of the page in order, together with the extra
+// item RowLimit fetches, and cuts the page out of them.
+func PageItems[T any](page structures.PageQuery, items []T, cursorOf func(T) structures.Cursor) ([]T, structures.PageInfo) {
+	pageInfo := structures.PageInfo{
+		HasNextPage:     page.Before != nil,
+		HasPreviousPage: page.After != nil,
+	}
+
+	if page.Last > 0 && len(items) > page.Last {
+		items = items[len(items)-page.Last:]
+		pageInfo.HasPreviousPage = true
+	} else if page.Limit > 0 && len(items) > page.Limit {
+		items = items[:page.Limit]
+		pageInfo.HasNextPage = true
+	}
+
+	if len(items) > 0 {
+		startCursor, endCursor := cursorOf(items[0]), cursorOf(items[len(items)-1])
+		pageInfo.StartCursor, pageInfo.EndCursor = &startCursor, &endCursor
+	}
+
+	return items, pageInfo
+}
+
+func ConvertPageInfoToOutput(pageInfo structures.PageInfo) structures.PageInfoOutput {
+	output := structures.PageInfoOutput{
+		TotalCount:      pageInfo.TotalCount,
+		HasNextPage:     pageInfo.HasNextPage,
+		HasPreviousPage: pageInfo.HasPreviousPage,
+	}
+	if pageInfo.StartCursor != nil {
+		output.StartCursor = EncodeCursor(*pageInfo.StartCursor)
+	}
+	if pageInfo.EndCursor != nil {
+		output.EndCursor = EncodeCursor(*pageInfo.EndCursor)
+	}
+
+	return output
+}
+
+// SetPageHeaders describes the page in the response headers, so the body stays a plain array.
+func SetPageHeaders(w http.ResponseWriter, pageInfo structures.PageInfoOutput) {
+	w.Header().Set(TotalCountHeader, strconv.Itoa(pageInfo.TotalCount))
+	w.Header().Set(HasNextPageHeader, strconv.FormatBool(pageInfo.HasNextPage))
+	w.Header().Set(HasPreviousPageHeader, strconv.FormatBool(pageInfo.HasPreviousPage))
+	if pageInfo.StartCursor != "" {
+		w.Header().Set(StartCursorHeader, pageInfo.StartCursor)
+		w.Header().Set(EndCursorHeader, pageInfo.EndCursor)
+	}
+}
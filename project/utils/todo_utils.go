@@ -1,8 +1,15 @@
 package utils
 
+import (
+	"github.com/google/uuid"
+	"project/structures"
+)
+
 const (
 	UnknownPriority = "Undefined"
+	LowPriority     = "Low"
 	MediumPriority  = "Medium"
+	HighPriority    = "High"
 )
 
 const (
@@ -14,19 +21,46 @@ const (
 	Completed   = "Completed"
 )
 
-func NextStatus(status string) string {
-	switch status {
-	case NotAssigned:
-		return Assigned
-	case Assigned:
-		return InProgress
-	case InReview:
-		return Completed
-	case InProgress:
-		return InReview
-	case Completed:
-		return Completed
-	default:
-		return Undefined
+const (
+	SortByName      = "name"
+	SortByDeadline  = "deadline"
+	SortByCreatedAt = "created_at"
+	SortByPriority  = "priority"
+	SortByStatus    = "status"
+)
+
+// PriorityRank follows the declaration order of the priority enum in the database,
+// which is also the order todos are sorted in.
+var PriorityRank = map[string]int{
+	UnknownPriority: 0,
+	LowPriority:     1,
+	MediumPriority:  2,
+	HighPriority:    3,
+}
+
+var TodoSortKeys = []string{SortByName, SortByDeadline, SortByCreatedAt, SortByPriority, SortByStatus}
+
+// DefaultWorkflow is the workflow every list starts with: one step forward at a time
+// from Not Assigned to Completed.
+func DefaultWorkflow(listId uuid.UUID) structures.WorkflowEntity {
+	chain := []string{NotAssigned, Assigned, InProgress, InReview, Completed}
+
+	workflow := structures.WorkflowEntity{ListId: listId}
+	for i, name := range chain {
+		workflow.States = append(workflow.States, structures.WorkflowStateEntity{
+			ListId:   listId,
+			Name:     name,
+			Position: i,
+			Done:     name == Completed,
+		})
+		if i > 0 {
+			workflow.Transitions = append(workflow.Transitions, structures.WorkflowTransitionEntity{
+				ListId: listId,
+				From:   chain[i-1],
+				To:     name,
+			})
+		}
 	}
+
+	return workflow
 }
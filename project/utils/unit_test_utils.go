@@ -12,11 +12,20 @@ const (
 	TestUsername        = "TestUser"
 	TestTodoName        = "TestTodo"
 	TestTodoDescription = "TestDesc"
+	TestSubtaskTitle    = "TestSubtask"
+	TestLabelName       = "TestLabel"
+	TestLabelColor      = "#ff0000"
+	TestCommentBody     = "TestComment"
+	TestTemplateName    = "TestTemplate"
 )
 
 var (
-	TestListId = uuid.UUID{1}
-	TestTodoId = uuid.UUID{2}
+	TestListId     = uuid.UUID{1}
+	TestTodoId     = uuid.UUID{2}
+	TestSubtaskId  = uuid.UUID{3}
+	TestLabelId    = uuid.UUID{4}
+	TestCommentId  = uuid.UUID{5}
+	TestTemplateId = uuid.UUID{6}
 )
 
 func HelperGetContext() context.Context {
@@ -1,13 +1,14 @@
 package utils
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
 	"net/http"
+	"project/config"
 )
 
 const (
@@ -19,11 +20,20 @@ const (
 	Owner   = "owner"
 	Admin   = "admin"
 
+	Viewer  = "viewer"
+	Editor  = "editor"
+	Manager = "manager"
+
 	contentType     = "Content-Type"
 	applicationJson = "application/json"
 
-	Logger = "logger"
-	Status = "status"
+	Logger       = "logger"
+	Status       = "status"
+	UserRole     = "userRole"
+	UserListRole = "userListRole"
+	Transaction  = "transaction"
+	CurrentUser  = "currentUser"
+	RequestId    = "requestId"
 
 	AlreadyExistsErrorMsg    = "error already exists"
 	NotFoundErrorMsg         = "not found"
@@ -31,52 +41,22 @@ const (
 	CreateErrorMsg           = "error creating"
 	DeletingErrorMsg         = "error deleting"
 	UpdateErrorMsg           = "error updating"
+	InvalidPositionErrorMsg  = "error invalid position"
+	NotMemberErrorMsg        = "is not a member of list"
+	NotAuthorErrorMsg        = "is not the author of comment"
 	NotFoundSQLErrorMsg      = "violates foreign key constraint"
 	AlreadyExistsSQLErrorMsg = "duplicate key value"
 )
 
-type Config struct {
-	DbUser     string `envconfig:"DB_USER"`
-	DbPassword string `envconfig:"DB_PWD"`
-	DbName     string `envconfig:"DB_NAME"`
-	DbHost     string `envconfig:"DB_HOST"`
-	DbPort     string `envconfig:"DB_PORT"`
-}
-
-func testingPurposeFunc() Config {
-	return Config{
-		DbUser:     "postgres",
-		DbPassword: "example",
-		DbName:     "postgres",
-		DbHost:     "localhost",
-		DbPort:     "5433",
-	}
-}
-
-func GetConnectionString() (string, error) {
-	// Commented because of testing porous
-	//var cfg Config
-	//if err := envconfig.Init(&cfg); err != nil {
-	//	return "", err
-	//}
-	cfg := testingPurposeFunc()
-
-	connectionString := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		cfg.DbHost, cfg.DbPort, cfg.DbUser, cfg.DbPassword, cfg.DbName)
-	fmt.Println(connectionString)
-	return connectionString, nil
-}
-
-func ConnectToDB() (*sqlx.DB, error) {
-	connectionString, err := GetConnectionString()
+func ConnectToDB(cfg config.DBConfig) (*sqlx.DB, error) {
+	db, err := sqlx.Connect(postgres, cfg.ConnectionString())
 	if err != nil {
 		return nil, err
 	}
 
-	db, err := sqlx.Connect(postgres, connectionString)
-	if err != nil {
-		return nil, err
-	}
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
 
 	return db, nil
 }
@@ -130,17 +110,27 @@ var Role = map[string]int{
 	Admin:   4,
 }
 
-var users = map[string]int{
-	"Niki":  Role[Admin],
-	"Ivan":  Role[Writer],
-	"Miro":  Role[Reader],
-	"Yosif": Role[Writer],
+func GetRoleFromContext(ctx context.Context) int {
+	rank, ok := ctx.Value(UserRole).(int)
+	if !ok {
+		return Role[Unknown]
+	}
+
+	return rank
+}
+
+var ListRole = map[string]int{
+	Unknown: -1,
+	Viewer:  1,
+	Editor:  2,
+	Manager: 3,
+	Owner:   4,
 }
 
-func GetUsersRights(name string) int {
-	rank, exists := users[name]
-	if !exists {
-		return -1
+func GetListRoleFromContext(ctx context.Context) int {
+	rank, ok := ctx.Value(UserListRole).(int)
+	if !ok {
+		return ListRole[Unknown]
 	}
 
 	return rank